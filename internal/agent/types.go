@@ -49,37 +49,50 @@ func (t AgentType) String() string {
 	return string(t)
 }
 
+// agentTypeAliases is the single alias table for agent types: every accepted
+// spelling (lowercase) maps to its canonical short form.
+var agentTypeAliases = func() map[string]AgentType {
+	groups := map[AgentType][]string{
+		AgentTypeClaudeCode:  {"cc", "claude", "claude-code", "claude_code", "claudecode"},
+		AgentTypeCodex:       {"cod", "codex", "codex-cli", "codex_cli", "codexcli", "openai", "openai-codex", "openai_codex", "openaicodex"},
+		AgentTypeGemini:      {"gmi", "gemini", "gemini-cli", "gemini_cli", "geminicli", "google", "google-ai", "google_ai", "google-gemini", "google_gemini", "googlegemini"},
+		AgentTypeAntigravity: {"agy", "antigravity", "antigravity-cli", "antigravity_cli", "antigravitycli", "google-antigravity"},
+		AgentTypeGrok:        {"grok", "grok-build", "grok_build", "grokbuild", "xai-grok-build", "xai_grok_build", "xaigrokbuild"},
+		AgentTypeCursor:      {"cursor"},
+		AgentTypeWindsurf:    {"windsurf", "ws"},
+		AgentTypeAider:       {"aider"},
+		AgentTypeOpencode:    {"oc", "opencode"},
+		AgentTypeOllama:      {"ollama"},
+		AgentTypeUser:        {"user"},
+		AgentTypeUnknown:     {"unknown"},
+	}
+	aliases := make(map[string]AgentType)
+	for canonical, names := range groups {
+		for _, name := range names {
+			aliases[name] = canonical
+		}
+	}
+	return aliases
+}()
+
 // Canonical normalizes aliases and formatting drift into the canonical short form
 // used throughout tmux metadata and parser dispatch.
 func (t AgentType) Canonical() AgentType {
-	switch strings.ToLower(strings.TrimSpace(string(t))) {
-	case "cc", "claude", "claude-code", "claude_code", "claudecode":
-		return AgentTypeClaudeCode
-	case "cod", "codex", "codex-cli", "codex_cli", "codexcli", "openai", "openai-codex", "openai_codex", "openaicodex":
-		return AgentTypeCodex
-	case "gmi", "gemini", "gemini-cli", "gemini_cli", "geminicli", "google", "google-ai", "google_ai", "google-gemini", "google_gemini", "googlegemini":
-		return AgentTypeGemini
-	case "agy", "antigravity", "antigravity-cli", "antigravity_cli", "antigravitycli", "google-antigravity":
-		return AgentTypeAntigravity
-	case "grok", "grok-build", "grok_build", "grokbuild", "xai-grok-build", "xai_grok_build", "xaigrokbuild":
-		return AgentTypeGrok
-	case "cursor":
-		return AgentTypeCursor
-	case "windsurf", "ws":
-		return AgentTypeWindsurf
-	case "aider":
-		return AgentTypeAider
-	case "oc", "opencode":
-		return AgentTypeOpencode
-	case "ollama":
-		return AgentTypeOllama
-	case "user":
-		return AgentTypeUser
-	case "unknown":
-		return AgentTypeUnknown
-	default:
-		return AgentType(strings.TrimSpace(string(t)))
+	if canonical, ok := agentTypeAliases[strings.ToLower(strings.TrimSpace(string(t)))]; ok {
+		return canonical
+	}
+	return AgentType(strings.TrimSpace(string(t)))
+}
+
+// NormalizeAgentType resolves any accepted alias (cc, claude, cod,
+// codex, openai, gmi, gemini, ...) to its canonical agent type. ok is false
+// for empty, unknown, or unrecognized input, so CLI parsers can reject it.
+func NormalizeAgentType(s string) (AgentType, bool) {
+	canonical, ok := agentTypeAliases[strings.ToLower(strings.TrimSpace(s))]
+	if !ok || canonical == AgentTypeUnknown {
+		return "", false
 	}
+	return canonical, true
 }
 
 // DisplayName returns a human-readable name for the agent type.
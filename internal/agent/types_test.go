@@ -99,6 +99,37 @@ func TestAgentType_Canonical(t *testing.T) {
 	}
 }
 
+func TestNormalizeAgentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in     string
+		want   AgentType
+		wantOK bool
+	}{
+		{"cc", AgentTypeClaudeCode, true},
+		{"Claude", AgentTypeClaudeCode, true},
+		{" claude-code ", AgentTypeClaudeCode, true},
+		{"anthropic", "", false},
+		{"cod", AgentTypeCodex, true},
+		{"codex", AgentTypeCodex, true},
+		{"OpenAI", AgentTypeCodex, true},
+		{"gmi", AgentTypeGemini, true},
+		{"gemini", AgentTypeGemini, true},
+		{"antigravity", AgentTypeAntigravity, true},
+		{"user", AgentTypeUser, true},
+		{"", "", false},
+		{"unknown", "", false},
+		{"claudette", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := NormalizeAgentType(tt.in)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("NormalizeAgentType(%q) = (%q, %v), want (%q, %v)", tt.in, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
 func TestAgentType_IsValid(t *testing.T) {
 	t.Parallel()
 
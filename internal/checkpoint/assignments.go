@@ -0,0 +1,100 @@
+package checkpoint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Dicklesworthstone/ntm/internal/tmux"
+)
+
+// AssignmentsFile holds a session's most recently generated assignments. It
+// sits beside the session's checkpoints so `ntm assign --resume` can recover
+// them after a crash without a full checkpoint.
+const AssignmentsFile = "assignments.json"
+
+// ErrNoSavedAssignments is returned by LoadAssignments when the session has
+// never persisted assignments.
+var ErrNoSavedAssignments = errors.New("no saved assignments")
+
+// SavedAssignments is the on-disk form of AssignmentsFile.
+type SavedAssignments struct {
+	SessionName string               `json:"session_name"`
+	SavedAt     time.Time            `json:"saved_at"`
+	Assignments []AssignmentSnapshot `json:"assignments"`
+}
+
+// SaveAssignments replaces the session's saved assignments.
+func (s *Storage) SaveAssignments(sessionName string, assignments []AssignmentSnapshot) error {
+	sessionDir, err := s.safeSessionDir(sessionName)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(sessionDir, 0700); err != nil {
+		return fmt.Errorf("creating session directory: %w", err)
+	}
+	if assignments == nil {
+		assignments = []AssignmentSnapshot{}
+	}
+	return writeJSON(filepath.Join(sessionDir, AssignmentsFile), SavedAssignments{
+		SessionName: sessionName,
+		SavedAt:     time.Now().UTC(),
+		Assignments: assignments,
+	})
+}
+
+// LoadAssignments reads the session's saved assignments.
+func (s *Storage) LoadAssignments(sessionName string) (*SavedAssignments, error) {
+	sessionDir, err := s.safeSessionDir(sessionName)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(sessionDir, AssignmentsFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNoSavedAssignments
+		}
+		return nil, fmt.Errorf("reading saved assignments: %w", err)
+	}
+
+	var saved SavedAssignments
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("parsing saved assignments: %w", err)
+	}
+	if saved.SessionName != sessionName {
+		return nil, fmt.Errorf("saved assignments belong to session %q, not %q", saved.SessionName, sessionName)
+	}
+	return &saved, nil
+}
+
+// ReconcileAssignments marks assigned and working entries whose pane is no
+// longer among panes as failed, and returns how many it changed. Panes are
+// matched by PaneID when the snapshot has one, otherwise by index.
+func ReconcileAssignments(assignments []AssignmentSnapshot, panes []tmux.Pane) int {
+	ids := make(map[string]bool, len(panes))
+	indexes := make(map[int]bool, len(panes))
+	for _, p := range panes {
+		ids[p.ID] = true
+		indexes[p.Index] = true
+	}
+
+	changed := 0
+	for i := range assignments {
+		a := &assignments[i]
+		if a.Status != "assigned" && a.Status != "working" {
+			continue
+		}
+		if a.PaneID != "" && ids[a.PaneID] {
+			continue
+		}
+		if a.PaneID == "" && indexes[a.Pane] {
+			continue
+		}
+		a.Status = "failed"
+		changed++
+	}
+	return changed
+}
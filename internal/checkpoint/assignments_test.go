@@ -0,0 +1,64 @@
+package checkpoint
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/ntm/internal/tmux"
+)
+
+func TestSaveLoadAssignments(t *testing.T) {
+	storage := NewStorageWithDir(t.TempDir())
+	if _, err := storage.LoadAssignments("proj"); !errors.Is(err, ErrNoSavedAssignments) {
+		t.Fatalf("LoadAssignments before save: err = %v, want ErrNoSavedAssignments", err)
+	}
+
+	assignedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	want := []AssignmentSnapshot{
+		{BeadID: "bd-1", Pane: 1, PaneID: "%3", AgentType: "claude", Status: "working", AssignedAt: assignedAt, PromptSent: true},
+		{BeadID: "bd-2", Pane: 2, AgentType: "codex", Status: "assigned", AssignedAt: assignedAt},
+	}
+	if err := storage.SaveAssignments("proj", want); err != nil {
+		t.Fatalf("SaveAssignments: %v", err)
+	}
+
+	saved, err := storage.LoadAssignments("proj")
+	if err != nil {
+		t.Fatalf("LoadAssignments: %v", err)
+	}
+	if len(saved.Assignments) != len(want) {
+		t.Fatalf("loaded %d assignments, want %d", len(saved.Assignments), len(want))
+	}
+	for i, got := range saved.Assignments {
+		if got.BeadID != want[i].BeadID || got.Status != want[i].Status || got.PromptSent != want[i].PromptSent || !got.AssignedAt.Equal(want[i].AssignedAt) {
+			t.Errorf("assignment[%d] = %+v, want %+v", i, got, want[i])
+		}
+	}
+
+	// The assignments file must not be mistaken for a checkpoint.
+	if has, err := storage.HasCheckpointCandidates("proj"); err != nil || has {
+		t.Errorf("HasCheckpointCandidates = %v, %v; want false, nil", has, err)
+	}
+}
+
+func TestReconcileAssignments(t *testing.T) {
+	assignments := []AssignmentSnapshot{
+		{BeadID: "live-id", Pane: 9, PaneID: "%1", Status: "working"},
+		{BeadID: "gone-id", Pane: 1, PaneID: "%7", Status: "assigned"},
+		{BeadID: "live-index", Pane: 2, Status: "assigned"},
+		{BeadID: "gone-index", Pane: 5, Status: "working"},
+		{BeadID: "done", Pane: 5, Status: "completed"},
+	}
+	panes := []tmux.Pane{{ID: "%1", Index: 1}, {ID: "%2", Index: 2}}
+
+	if changed := ReconcileAssignments(assignments, panes); changed != 2 {
+		t.Errorf("ReconcileAssignments changed %d, want 2", changed)
+	}
+	want := []string{"working", "failed", "assigned", "failed", "completed"}
+	for i, a := range assignments {
+		if a.Status != want[i] {
+			t.Errorf("%s status = %q, want %q", a.BeadID, a.Status, want[i])
+		}
+	}
+}
@@ -119,11 +119,20 @@ func (a *AutoCheckpointer) Create(opts AutoCheckpointOptions) (*Checkpoint, erro
 // rotateAutoCheckpoints ensures we don't exceed the max auto-checkpoints
 // by deleting the oldest auto-checkpoints
 func (a *AutoCheckpointer) rotateAutoCheckpoints(sessionName string, maxCount int) error {
-	autoCheckpoints, err := a.autoCheckpointCandidates(sessionName)
+	candidates, err := a.autoCheckpointCandidates(sessionName)
 	if err != nil {
 		return err
 	}
 
+	// Tagged auto-checkpoints were kept on purpose; leave them out of rotation.
+	autoCheckpoints := candidates[:0]
+	for _, candidate := range candidates {
+		if cp, err := a.storage.Load(sessionName, candidate.name); err == nil && len(cp.Tags) > 0 {
+			continue
+		}
+		autoCheckpoints = append(autoCheckpoints, candidate)
+	}
+
 	// If under limit, nothing to do
 	if len(autoCheckpoints) <= maxCount {
 		return nil
@@ -900,3 +900,67 @@ func TestAutoCheckpointer_RotateAutoCheckpoints_DeletesInvalidOverflowAutoCheckp
 		t.Fatal("valid retained auto-checkpoints were not preserved")
 	}
 }
+
+func TestAutoCheckpointer_RotateAutoCheckpoints_KeepsTaggedAutoCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	storage := NewStorageWithDir(t.TempDir())
+	checkpointer := &AutoCheckpointer{
+		capturer: NewCapturerWithStorage(storage),
+		storage:  storage,
+	}
+	session := "auto-rotate-tagged-session"
+
+	ids := []string{
+		"20260101-140000-0001-auto-interval",
+		"20260101-130000-0002-auto-interval",
+		"20260101-120000-0003-auto-interval",
+	}
+	for i, id := range ids {
+		created := time.Date(2026, 1, 1, 14-i, 0, 0, 0, time.UTC)
+		cp := &Checkpoint{
+			ID:          id,
+			Name:        "auto-interval",
+			SessionName: session,
+			CreatedAt:   created,
+			Session:     SessionState{},
+		}
+		if err := storage.Save(cp); err != nil {
+			t.Fatalf("Save(%s): %v", id, err)
+		}
+		if err := os.Chtimes(storage.CheckpointDir(session, id), created, created); err != nil {
+			t.Fatalf("Chtimes(%s): %v", id, err)
+		}
+	}
+
+	note := "last green build"
+	tagged, err := storage.Annotate(session, ids[2], []string{"known-good-baseline", " Known-Good-Baseline "}, nil, &note)
+	if err != nil {
+		t.Fatalf("Annotate(): %v", err)
+	}
+	if len(tagged.Tags) != 1 || !tagged.HasTag("KNOWN-GOOD-BASELINE") || tagged.Note != note {
+		t.Fatalf("annotated checkpoint = tags %v note %q, want one known-good-baseline tag and note", tagged.Tags, tagged.Note)
+	}
+
+	if err := checkpointer.rotateAutoCheckpoints(session, 1); err != nil {
+		t.Fatalf("rotateAutoCheckpoints(): %v", err)
+	}
+
+	if !storage.Exists(session, ids[0]) {
+		t.Error("newest auto-checkpoint was rotated away")
+	}
+	if storage.Exists(session, ids[1]) {
+		t.Error("untagged overflow auto-checkpoint was not rotated away")
+	}
+	if !storage.Exists(session, ids[2]) {
+		t.Error("tagged auto-checkpoint was rotated away")
+	}
+
+	reloaded, err := storage.Annotate(session, ids[2], nil, []string{"known-good-baseline"}, nil)
+	if err != nil {
+		t.Fatalf("Annotate(untag): %v", err)
+	}
+	if len(reloaded.Tags) != 0 || reloaded.Note != note {
+		t.Errorf("after untag = tags %v note %q, want no tags and note kept", reloaded.Tags, reloaded.Note)
+	}
+}
@@ -83,6 +83,9 @@ func (c *Capturer) Create(sessionName, name string, opts ...CheckpointOption) (*
 		Session:     sessionState,
 		PaneCount:   len(sessionState.Panes),
 	}
+	if len(options.tags) > 0 {
+		cp.Annotate(options.tags, nil, nil)
+	}
 
 	// Save checkpoint first so directory exists
 	if err := c.storage.Save(cp); err != nil {
@@ -131,7 +134,15 @@ func (c *Capturer) Create(sessionName, name string, opts ...CheckpointOption) (*
 		}
 	}
 
-	// Save updated checkpoint with all state
+	// Save updated checkpoint with all state, as a delta when a parent was given
+	if options.parentID != "" {
+		err := c.storage.SaveIncremental(cp, options.parentID)
+		if err == nil {
+			return cp, nil
+		}
+		slog.Warn("incremental checkpoint save failed, saving in full", "parent", options.parentID, "error", err)
+		cp.ParentID, cp.ChangedFiles = "", nil
+	}
 	if err := c.storage.Save(cp); err != nil {
 		return nil, fmt.Errorf("saving final checkpoint: %w", err)
 	}
@@ -388,6 +399,61 @@ func parseGitStatus(status string) (staged, unstaged, untracked int) {
 	return
 }
 
+// ParseGitStatusFiles extracts changed paths from the long-form `git status`
+// text saved in a checkpoint's StatusFile. Untracked and newly added files are
+// created, renames count as a deletion plus a creation, and every other
+// change is modified. Each path appears at most once per list.
+func ParseGitStatusFiles(status string) (created, modified, deleted []string) {
+	seen := make(map[string]bool)
+	add := func(list *[]string, kind, path string) {
+		key := kind + "\x00" + path
+		if path == "" || seen[key] {
+			return
+		}
+		seen[key] = true
+		*list = append(*list, path)
+	}
+
+	untracked := false
+	for _, line := range strings.Split(status, "\n") {
+		if !strings.HasPrefix(line, "\t") {
+			if trimmed := strings.TrimSpace(line); strings.HasSuffix(trimmed, ":") {
+				untracked = trimmed == "Untracked files:"
+			}
+			continue
+		}
+		entry := strings.TrimSpace(line)
+		if untracked {
+			add(&created, "c", entry)
+			continue
+		}
+		kind, path, ok := strings.Cut(entry, ":")
+		if !ok {
+			continue
+		}
+		path = strings.TrimSpace(path)
+		switch kind {
+		case "new file":
+			add(&created, "c", path)
+		case "deleted", "deleted by us", "deleted by them", "both deleted":
+			add(&deleted, "d", path)
+		case "renamed", "copied":
+			from, to, found := strings.Cut(path, " -> ")
+			if !found {
+				add(&modified, "m", path)
+				continue
+			}
+			if kind == "renamed" {
+				add(&deleted, "d", from)
+			}
+			add(&created, "c", to)
+		default:
+			add(&modified, "m", path)
+		}
+	}
+	return created, modified, deleted
+}
+
 // countLines counts the number of lines in a string.
 // Empty strings return 0, trailing newlines don't count as extra lines.
 func countLines(s string) int {
@@ -532,6 +598,7 @@ func (c *Capturer) captureAssignments(sessionName string) ([]AssignmentSnapshot,
 			AgentName:  a.AgentName,
 			Status:     string(a.Status),
 			AssignedAt: a.AssignedAt,
+			PromptSent: a.PromptSent != "",
 		})
 	}
 
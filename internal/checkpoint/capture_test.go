@@ -881,3 +881,142 @@ func TestCapturer_CaptureGitState_DirtySavesPatch(t *testing.T) {
 		t.Fatalf("expected patch to contain updated content, got: %s", patch)
 	}
 }
+
+func TestStorage_GitDelta(t *testing.T) {
+	repo := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		if out, err := exec.Command("git", append([]string{"-C", repo}, args...)...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repo, name), []byte(content), 0644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	git("init")
+	git("config", "user.email", "test@example.com")
+	git("config", "user.name", "Test User")
+	write("a.txt", "a")
+	write("b.txt", "b")
+	git("add", ".")
+	git("commit", "-m", "initial")
+	write("a.txt", "a dirty")
+
+	storage := NewStorageWithDir(t.TempDir())
+	checkpointID := "chk-delta"
+	if err := os.MkdirAll(storage.CheckpointDir("session", checkpointID), 0755); err != nil {
+		t.Fatalf("mkdir checkpoint: %v", err)
+	}
+	gitState, err := NewCapturerWithStorage(storage).captureGitState(repo, "session", checkpointID)
+	if err != nil {
+		t.Fatalf("captureGitState: %v", err)
+	}
+	if err := storage.Save(&Checkpoint{
+		Version:     CurrentVersion,
+		ID:          checkpointID,
+		SessionName: "session",
+		WorkingDir:  repo,
+		CreatedAt:   time.Now(),
+		Git:         gitState,
+	}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	write("b.txt", "b changed")
+	git("commit", "-am", "after checkpoint")
+	write("c.txt", "new")
+
+	delta, err := storage.GitDelta("session", checkpointID, "")
+	if err != nil {
+		t.Fatalf("GitDelta: %v", err)
+	}
+	if delta.CommitsSince != 1 || delta.BranchChanged() {
+		t.Fatalf("delta = %+v, want 1 commit on the same branch", delta)
+	}
+	if delta.Current.UntrackedCount != 1 || delta.Checkpoint.UnstagedCount != 1 {
+		t.Fatalf("counts now %+v, then %+v", delta.Current, delta.Checkpoint)
+	}
+	want := []GitDeltaFile{
+		{Path: "a.txt", Status: "modified", DirtyAtCheckpoint: true},
+		{Path: "b.txt", Status: "modified"},
+		{Path: "c.txt", Status: "untracked"},
+	}
+	if fmt.Sprint(delta.Files) != fmt.Sprint(want) {
+		t.Fatalf("files = %+v, want %+v", delta.Files, want)
+	}
+}
+
+func TestStorage_Diff(t *testing.T) {
+	storage := NewStorageWithDir(t.TempDir())
+
+	from := saveArtifactCheckpoint(t, storage, "20260101-100000-from", map[string]string{"%0": "a\n", "%1": "b\n"}, "diff a")
+	from.Git.UnstagedCount = 1
+	if err := storage.Save(from); err != nil {
+		t.Fatalf("Save(from) failed: %v", err)
+	}
+	to := saveArtifactCheckpoint(t, storage, "20260101-110000-to", map[string]string{"%0": "a\n", "%1": "b changed\n"}, "diff a")
+	to.Git.Branch = "feature"
+	to.Git.IsDirty = true
+	to.Git.UnstagedCount = 2
+	// %0 was resized, %1 came back as %7 at the same position, and a new
+	// pane was split off.
+	to.Session.Panes[0].Width = 120
+	to.Session.Panes[1].ID = "%7"
+	to.Session.Panes[1].Title = "tests"
+	to.Session.Panes = append(to.Session.Panes, PaneState{ID: "%9", Index: 2, Width: 80, Height: 24})
+	to.PaneCount = len(to.Session.Panes)
+	if err := storage.Save(to); err != nil {
+		t.Fatalf("Save(to) failed: %v", err)
+	}
+
+	diff, err := storage.Diff(from, to)
+	if err != nil {
+		t.Fatalf("Diff() failed: %v", err)
+	}
+
+	wantPanes := []struct {
+		id, change string
+		fields     []string
+	}{
+		{"%0", "resized", []string{"size"}},
+		{"%7", "changed", []string{"id", "title"}},
+		{"%9", "added", nil},
+	}
+	if len(diff.Panes) != len(wantPanes) {
+		t.Fatalf("Panes = %+v, want %d entries", diff.Panes, len(wantPanes))
+	}
+	for i, want := range wantPanes {
+		got := diff.Panes[i]
+		var fields []string
+		for _, f := range got.Fields {
+			fields = append(fields, f.Field)
+		}
+		if got.ID != want.id || got.Change != want.change || strings.Join(fields, ",") != strings.Join(want.fields, ",") {
+			t.Errorf("Panes[%d] = %s %s %v, want %s %s %v", i, got.ID, got.Change, fields, want.id, want.change, want.fields)
+		}
+	}
+
+	var gitFields []string
+	for _, c := range diff.Git {
+		gitFields = append(gitFields, c.Field+"="+c.From+">"+c.To)
+	}
+	if got, want := strings.Join(gitFields, " "), "branch=main>feature is_dirty=false>true unstaged_count=1>2"; got != want {
+		t.Errorf("Git = %s, want %s", got, want)
+	}
+
+	var files []string
+	for _, f := range diff.Files {
+		files = append(files, f.Change+":"+f.Path)
+	}
+	if got, want := strings.Join(files, " "), "changed:"+from.Session.Panes[1].ScrollbackFile; got != want {
+		t.Errorf("Files = %s, want %s", got, want)
+	}
+
+	same, err := storage.Diff(from, from)
+	if err != nil || !same.Empty() {
+		t.Errorf("Diff(from, from) = %+v, %v; want empty", same, err)
+	}
+}
@@ -2,8 +2,10 @@ package checkpoint
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -1244,6 +1246,53 @@ func TestStorage_ListAll_NoDir(t *testing.T) {
 	}
 }
 
+func TestStorage_FindByTag(t *testing.T) {
+	storage := NewStorageWithDir(t.TempDir())
+	base := time.Date(2025, 12, 10, 12, 0, 0, 0, time.UTC)
+
+	for i, spec := range []struct {
+		session string
+		tags    []string
+	}{
+		{"project-a", []string{"known-good"}},
+		{"project-a", nil},
+		{"project-b", []string{"wip", "Known-Good"}},
+		{"project-b", []string{"wip"}},
+	} {
+		cp := &Checkpoint{
+			ID:          fmt.Sprintf("20251210-12000%d-find", i),
+			SessionName: spec.session,
+			CreatedAt:   base.Add(time.Duration(i) * time.Minute),
+			Session:     SessionState{Panes: []PaneState{}},
+		}
+		cp.Annotate(spec.tags, nil, nil)
+		if err := storage.Save(cp); err != nil {
+			t.Fatalf("Save() failed: %v", err)
+		}
+	}
+
+	found, err := storage.FindByTag("KNOWN-GOOD")
+	if err != nil {
+		t.Fatalf("FindByTag() failed: %v", err)
+	}
+	var got []string
+	for _, cp := range found {
+		got = append(got, cp.SessionName+"/"+cp.ID)
+	}
+	want := []string{"project-b/20251210-120002-find", "project-a/20251210-120000-find"}
+	if !slices.Equal(got, want) {
+		t.Errorf("FindByTag(KNOWN-GOOD) = %v, want %v", got, want)
+	}
+
+	found, err = storage.FindByTag("missing")
+	if err != nil {
+		t.Fatalf("FindByTag(missing) failed: %v", err)
+	}
+	if len(found) != 0 {
+		t.Errorf("FindByTag(missing) returned %d checkpoints, want 0", len(found))
+	}
+}
+
 func TestStorage_Delete(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "ntm-checkpoint-test")
 	if err != nil {
@@ -2335,3 +2384,183 @@ func TestCheckpoint_WithBothAssignmentsAndBVSnapshot(t *testing.T) {
 		t.Errorf("BVSummary.ActionableCount = %d, want 5", loaded.BVSummary.ActionableCount)
 	}
 }
+
+// saveArtifactCheckpoint saves a checkpoint with one scrollback file per
+// entry of scrollback (keyed by pane ID) and a git patch, as the capturer
+// leaves it before its final save.
+func saveArtifactCheckpoint(t *testing.T, storage *Storage, id string, scrollback map[string]string, patch string) *Checkpoint {
+	t.Helper()
+	cp := &Checkpoint{
+		Version:     CurrentVersion,
+		ID:          id,
+		Name:        id,
+		SessionName: "chain",
+		CreatedAt:   time.Now(),
+		Git:         GitState{Branch: "main", Commit: "abc123"},
+	}
+	if err := storage.Save(cp); err != nil {
+		t.Fatalf("Save(%s) failed: %v", id, err)
+	}
+	for i, paneID := range []string{"%0", "%1"} {
+		rel, err := storage.SaveScrollback(cp.SessionName, id, paneID, scrollback[paneID])
+		if err != nil {
+			t.Fatalf("SaveScrollback(%s) failed: %v", paneID, err)
+		}
+		cp.Session.Panes = append(cp.Session.Panes, PaneState{ID: paneID, Index: i, Width: 80, Height: 24, ScrollbackFile: rel})
+	}
+	cp.PaneCount = len(cp.Session.Panes)
+	if err := storage.SaveGitPatch(cp.SessionName, id, patch); err != nil {
+		t.Fatalf("SaveGitPatch failed: %v", err)
+	}
+	cp.Git.PatchFile = GitPatchFile
+	return cp
+}
+
+func assertChainContents(t *testing.T, storage *Storage, cp *Checkpoint, scrollback map[string]string, patch string) {
+	t.Helper()
+	for paneID, want := range scrollback {
+		got, err := storage.LoadScrollback(cp.SessionName, cp.ID, paneID)
+		if err != nil || got != want {
+			t.Errorf("%s: LoadScrollback(%s) = %q, %v; want %q", cp.ID, paneID, got, err, want)
+		}
+	}
+	if got, err := storage.LoadGitPatch(cp.SessionName, cp.ID); err != nil || got != patch {
+		t.Errorf("%s: LoadGitPatch() = %q, %v; want %q", cp.ID, got, err, patch)
+	}
+	if result := cp.Verify(storage); !result.Valid {
+		t.Errorf("%s: Verify() errors = %v", cp.ID, result.Errors)
+	}
+	if err := cp.QuickCheck(storage); err != nil {
+		t.Errorf("%s: QuickCheck() = %v", cp.ID, err)
+	}
+}
+
+func TestStorage_SaveIncremental(t *testing.T) {
+	storage := NewStorageWithDir(t.TempDir())
+
+	parentScrollback := map[string]string{"%0": "build ok\n", "%1": "tests running\n"}
+	parent := saveArtifactCheckpoint(t, storage, "20260101-120000-parent", parentScrollback, "diff a")
+	if err := storage.Save(parent); err != nil {
+		t.Fatalf("Save(parent) failed: %v", err)
+	}
+
+	childScrollback := map[string]string{"%0": "build ok\n", "%1": "tests passed\n"}
+	child := saveArtifactCheckpoint(t, storage, "20260101-130000-child", childScrollback, "diff a")
+	if err := storage.SaveIncremental(child, parent.ID); err != nil {
+		t.Fatalf("SaveIncremental() failed: %v", err)
+	}
+
+	if child.ParentID != parent.ID {
+		t.Errorf("ParentID = %q, want %q", child.ParentID, parent.ID)
+	}
+	changed := child.Session.Panes[1].ScrollbackFile
+	if len(child.ChangedFiles) != 1 || child.ChangedFiles[0] != changed {
+		t.Fatalf("ChangedFiles = %v, want [%s]", child.ChangedFiles, changed)
+	}
+	childDir := storage.CheckpointDir(child.SessionName, child.ID)
+	for _, rel := range []string{child.Session.Panes[0].ScrollbackFile, GitPatchFile} {
+		if _, err := os.Stat(filepath.Join(childDir, rel)); !os.IsNotExist(err) {
+			t.Errorf("unchanged artifact %s still stored in the child: %v", rel, err)
+		}
+	}
+
+	loaded, err := storage.Load(child.SessionName, child.ID)
+	if err != nil {
+		t.Fatalf("Load(child) failed: %v", err)
+	}
+	if !loaded.IsIncremental() {
+		t.Error("loaded child should be incremental")
+	}
+	assertChainContents(t, storage, loaded, childScrollback, "diff a")
+
+	// Exports are flattened, so the imported copy stands alone.
+	archive := filepath.Join(t.TempDir(), "child.tar.gz")
+	if _, err := storage.Export(child.SessionName, child.ID, archive, DefaultExportOptions()); err != nil {
+		t.Fatalf("Export() failed: %v", err)
+	}
+	other := NewStorageWithDir(t.TempDir())
+	imported, err := other.Import(archive, DefaultImportOptions())
+	if err != nil {
+		t.Fatalf("Import() failed: %v", err)
+	}
+	if imported.IsIncremental() {
+		t.Errorf("imported checkpoint should be full, has parent %q", imported.ParentID)
+	}
+	assertChainContents(t, other, imported, childScrollback, "diff a")
+}
+
+func TestStorage_Delete_RebasesIncrementalChildren(t *testing.T) {
+	storage := NewStorageWithDir(t.TempDir())
+	scrollback := map[string]string{"%0": "same\n", "%1": "same too\n"}
+
+	root := saveArtifactCheckpoint(t, storage, "20260101-100000-root", scrollback, "diff a")
+	if err := storage.Save(root); err != nil {
+		t.Fatalf("Save(root) failed: %v", err)
+	}
+	middle := saveArtifactCheckpoint(t, storage, "20260101-110000-middle", scrollback, "diff b")
+	if err := storage.SaveIncremental(middle, root.ID); err != nil {
+		t.Fatalf("SaveIncremental(middle) failed: %v", err)
+	}
+	leaf := saveArtifactCheckpoint(t, storage, "20260101-120000-leaf", scrollback, "diff b")
+	if err := storage.SaveIncremental(leaf, middle.ID); err != nil {
+		t.Fatalf("SaveIncremental(leaf) failed: %v", err)
+	}
+	if len(leaf.ChangedFiles) != 0 {
+		t.Fatalf("leaf ChangedFiles = %v, want none", leaf.ChangedFiles)
+	}
+
+	if err := storage.Delete(leaf.SessionName, middle.ID); err != nil {
+		t.Fatalf("Delete(middle) failed: %v", err)
+	}
+	leaf, err := storage.Load(leaf.SessionName, leaf.ID)
+	if err != nil {
+		t.Fatalf("Load(leaf) failed: %v", err)
+	}
+	if leaf.ParentID != root.ID || len(leaf.ChangedFiles) != 1 || leaf.ChangedFiles[0] != GitPatchFile {
+		t.Errorf("after deleting middle: parent %q, changed %v; want %q, [%s]", leaf.ParentID, leaf.ChangedFiles, root.ID, GitPatchFile)
+	}
+	assertChainContents(t, storage, leaf, scrollback, "diff b")
+
+	if err := storage.Delete(leaf.SessionName, root.ID); err != nil {
+		t.Fatalf("Delete(root) failed: %v", err)
+	}
+	leaf, err = storage.Load(leaf.SessionName, leaf.ID)
+	if err != nil {
+		t.Fatalf("Load(leaf) failed: %v", err)
+	}
+	if leaf.IsIncremental() {
+		t.Errorf("leaf should be full once its whole chain is gone, has parent %q", leaf.ParentID)
+	}
+	assertChainContents(t, storage, leaf, scrollback, "diff b")
+}
+
+func TestCheckpoint_QuickCheck_DetectsBrokenParentChain(t *testing.T) {
+	storage := NewStorageWithDir(t.TempDir())
+	scrollback := map[string]string{"%0": "a\n", "%1": "b\n"}
+
+	parent := saveArtifactCheckpoint(t, storage, "20260101-100000-parent", scrollback, "diff a")
+	if err := storage.Save(parent); err != nil {
+		t.Fatalf("Save(parent) failed: %v", err)
+	}
+	child := saveArtifactCheckpoint(t, storage, "20260101-110000-child", scrollback, "diff a")
+	if err := storage.SaveIncremental(child, parent.ID); err != nil {
+		t.Fatalf("SaveIncremental() failed: %v", err)
+	}
+
+	// Removing the parent behind Storage's back breaks the chain.
+	if err := os.RemoveAll(storage.CheckpointDir(parent.SessionName, parent.ID)); err != nil {
+		t.Fatal(err)
+	}
+	err := child.QuickCheck(storage)
+	if err == nil || !strings.Contains(err.Error(), "broken parent chain") {
+		t.Errorf("QuickCheck() = %v, want broken parent chain", err)
+	}
+	if _, err := storage.LoadScrollback(child.SessionName, child.ID, "%0"); err == nil {
+		t.Error("LoadScrollback() should fail when the parent holding it is gone")
+	}
+
+	child.ParentID = child.ID
+	if err := child.QuickCheck(storage); err == nil || !strings.Contains(err.Error(), "its own parent") {
+		t.Errorf("QuickCheck() with a self-parent = %v, want its own parent", err)
+	}
+}
@@ -1066,6 +1066,110 @@ func TestImportZip_PathTraversal(t *testing.T) {
 	}
 }
 
+// writeLinkArchive writes a checkpoint archive whose last entry is a link
+// named name pointing at target, in format (tar.gz or zip).
+func writeLinkArchive(t *testing.T, destPath string, format ExportFormat, typeflag byte, name, target string) {
+	t.Helper()
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{MetadataFile, validCheckpointJSON(t, "link-session", "link-cp")},
+		{SessionFile, validSessionJSON(t, SessionState{})},
+	}
+	f, err := os.Create(destPath)
+	if err != nil {
+		t.Fatalf("create archive: %v", err)
+	}
+	defer f.Close()
+
+	if format == FormatZip {
+		zw := zip.NewWriter(f)
+		for _, file := range files {
+			w, err := zw.Create(file.name)
+			if err != nil {
+				t.Fatalf("create zip entry %s: %v", file.name, err)
+			}
+			if _, err := w.Write(file.data); err != nil {
+				t.Fatalf("write zip entry %s: %v", file.name, err)
+			}
+		}
+		hdr := &zip.FileHeader{Name: name, Method: zip.Store}
+		hdr.SetMode(os.ModeSymlink | 0o777)
+		w, err := zw.CreateHeader(hdr)
+		if err != nil {
+			t.Fatalf("create zip symlink %s: %v", name, err)
+		}
+		if _, err := w.Write([]byte(target)); err != nil {
+			t.Fatalf("write zip symlink %s: %v", name, err)
+		}
+		if err := zw.Close(); err != nil {
+			t.Fatalf("close zip: %v", err)
+		}
+		return
+	}
+
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+	for _, file := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: file.name, Mode: 0o644, Size: int64(len(file.data)), ModTime: time.Now()}); err != nil {
+			t.Fatalf("write tar header %s: %v", file.name, err)
+		}
+		if _, err := tw.Write(file.data); err != nil {
+			t.Fatalf("write tar body %s: %v", file.name, err)
+		}
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: name, Typeflag: typeflag, Linkname: target, Mode: 0o777, ModTime: time.Now()}); err != nil {
+		t.Fatalf("write tar link %s: %v", name, err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("close tar: %v", err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatalf("close gzip: %v", err)
+	}
+}
+
+func TestImport_RejectsLinkEntries(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		format   ExportFormat
+		typeflag byte
+		entry    string
+		target   string
+		wantErr  string
+	}{
+		{"tar symlink escaping root", FormatTarGz, tar.TypeSymlink, "panes/pane__0.txt", "../../../etc/passwd", "unsafe symlink"},
+		{"tar absolute symlink", FormatTarGz, tar.TypeSymlink, "panes/pane__0.txt", "/etc/passwd", "unsafe symlink"},
+		{"tar hard link escaping root", FormatTarGz, tar.TypeLink, GitPatchFile, "../outside.patch", "unsafe symlink"},
+		{"tar symlink within root", FormatTarGz, tar.TypeSymlink, "panes/pane__0.txt", "../" + SessionFile, "non-regular entry"},
+		{"zip symlink escaping root", FormatZip, 0, "panes/pane__0.txt", "../../../etc/passwd", "unsafe symlink"},
+		{"zip absolute symlink", FormatZip, 0, "panes/pane__0.txt", "/etc/passwd", "unsafe symlink"},
+		{"zip symlink within root", FormatZip, 0, "panes/pane__0.txt", "../" + SessionFile, "non-regular entry"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tmpDir := t.TempDir()
+			storage := NewStorageWithDir(filepath.Join(tmpDir, "store"))
+			archive := filepath.Join(tmpDir, "link."+string(tt.format))
+			writeLinkArchive(t, archive, tt.format, tt.typeflag, tt.entry, tt.target)
+
+			_, err := storage.Import(archive, ImportOptions{VerifyChecksums: false})
+			if err == nil {
+				t.Fatal("expected import to reject link entry")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+			if storage.Exists("link-session", "link-cp") {
+				t.Error("checkpoint was imported despite the link entry")
+			}
+		})
+	}
+}
+
 func TestImportTarGz_RejectsNonCanonicalArchivePath(t *testing.T) {
 	t.Parallel()
 	tmpDir := t.TempDir()
@@ -0,0 +1,343 @@
+package checkpoint
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GitDelta describes how a repository changed since a checkpoint was taken.
+type GitDelta struct {
+	SessionName  string `json:"session_name"`
+	CheckpointID string `json:"checkpoint_id"`
+	WorkingDir   string `json:"working_dir"`
+	// Checkpoint is the git state captured with the checkpoint
+	Checkpoint GitState `json:"checkpoint"`
+	// Current is the repository's git state now
+	Current GitState `json:"current"`
+	// CommitsSince counts commits reachable from HEAD but not from the
+	// checkpoint commit
+	CommitsSince int `json:"commits_since"`
+	// Files lists paths that differ between the checkpoint commit and the
+	// current working tree, including untracked files
+	Files []GitDeltaFile `json:"files"`
+}
+
+// GitDeltaFile is one path that changed since a checkpoint.
+type GitDeltaFile struct {
+	Path   string `json:"path"`
+	Status string `json:"status"`
+	// DirtyAtCheckpoint is set when the path already had uncommitted changes
+	// captured in the checkpoint's git patch
+	DirtyAtCheckpoint bool `json:"dirty_at_checkpoint,omitempty"`
+}
+
+// BranchChanged reports whether HEAD is on a different branch than at
+// checkpoint time.
+func (d *GitDelta) BranchChanged() bool {
+	return d.Checkpoint.Branch != d.Current.Branch
+}
+
+// GitDelta compares a checkpoint's captured git state with the repository in
+// dir (the checkpoint's working directory when dir is empty): commits made
+// since, current status counts, and every file changed relative to the
+// checkpoint commit.
+func (s *Storage) GitDelta(sessionName, checkpointID, dir string) (*GitDelta, error) {
+	cp, err := s.Load(sessionName, checkpointID)
+	if err != nil {
+		return nil, err
+	}
+	if cp.Git.Commit == "" {
+		return nil, fmt.Errorf("checkpoint %s has no git state", checkpointID)
+	}
+	if dir == "" {
+		dir = cp.WorkingDir
+	}
+	if dir == "" || dir == "${WORKING_DIR}" {
+		return nil, fmt.Errorf("checkpoint %s has no usable working directory; pass one explicitly", checkpointID)
+	}
+	if !isGitRepo(dir) {
+		return nil, fmt.Errorf("%s is not a git repository", dir)
+	}
+
+	delta := &GitDelta{
+		SessionName:  cp.SessionName,
+		CheckpointID: cp.ID,
+		WorkingDir:   dir,
+		Checkpoint:   cp.Git,
+		Files:        []GitDeltaFile{},
+	}
+
+	branch, err := gitCommand(dir, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("getting git branch: %w", err)
+	}
+	delta.Current.Branch = strings.TrimSpace(branch)
+	commit, err := gitCommand(dir, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("getting git commit: %w", err)
+	}
+	delta.Current.Commit = strings.TrimSpace(commit)
+	status, err := gitCommand(dir, "status", "--porcelain")
+	if err != nil {
+		return nil, fmt.Errorf("getting git status: %w", err)
+	}
+	delta.Current.StagedCount, delta.Current.UnstagedCount, delta.Current.UntrackedCount = parseGitStatus(status)
+	delta.Current.IsDirty = (delta.Current.StagedCount + delta.Current.UnstagedCount + delta.Current.UntrackedCount) > 0
+
+	if _, err := gitCommand(dir, "cat-file", "-e", cp.Git.Commit+"^{commit}"); err != nil {
+		return nil, fmt.Errorf("checkpoint commit %s is not in this repository", shortHash(cp.Git.Commit))
+	}
+	count, err := gitCommand(dir, "rev-list", "--count", cp.Git.Commit+"..HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("counting commits since checkpoint: %w", err)
+	}
+	if delta.CommitsSince, err = strconv.Atoi(strings.TrimSpace(count)); err != nil {
+		return nil, fmt.Errorf("parsing commit count %q: %w", strings.TrimSpace(count), err)
+	}
+
+	changed, err := gitCommand(dir, "diff", "--name-status", "--no-renames", cp.Git.Commit)
+	if err != nil {
+		return nil, fmt.Errorf("diffing against checkpoint commit: %w", err)
+	}
+	untracked, err := gitCommand(dir, "ls-files", "--others", "--exclude-standard")
+	if err != nil {
+		return nil, fmt.Errorf("listing untracked files: %w", err)
+	}
+
+	var dirty map[string]bool
+	if cp.HasGitPatch() {
+		if patch, err := s.LoadGitPatch(sessionName, checkpointID); err == nil {
+			dirty = patchPaths(patch)
+		}
+	}
+	delta.Files = parseDeltaFiles(changed, untracked, dirty)
+	return delta, nil
+}
+
+// parseDeltaFiles merges `git diff --name-status` and `git ls-files --others`
+// output into a path-sorted file list.
+func parseDeltaFiles(nameStatus, untracked string, dirty map[string]bool) []GitDeltaFile {
+	files := []GitDeltaFile{}
+	for _, line := range strings.Split(nameStatus, "\n") {
+		code, path, ok := strings.Cut(line, "\t")
+		if !ok || path == "" {
+			continue
+		}
+		files = append(files, GitDeltaFile{Path: path, Status: deltaStatusName(code), DirtyAtCheckpoint: dirty[path]})
+	}
+	for _, path := range strings.Split(untracked, "\n") {
+		if path = strings.TrimSpace(path); path == "" {
+			continue
+		}
+		files = append(files, GitDeltaFile{Path: path, Status: "untracked"})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+	return files
+}
+
+func deltaStatusName(code string) string {
+	switch strings.TrimSpace(code) {
+	case "A":
+		return "added"
+	case "M":
+		return "modified"
+	case "D":
+		return "deleted"
+	case "T":
+		return "type_changed"
+	case "U":
+		return "unmerged"
+	default:
+		return strings.TrimSpace(code)
+	}
+}
+
+// patchPaths returns the destination paths of the files in a git patch.
+func patchPaths(patch string) map[string]bool {
+	paths := make(map[string]bool)
+	for _, line := range strings.Split(patch, "\n") {
+		if !strings.HasPrefix(line, "diff --git ") {
+			continue
+		}
+		if i := strings.LastIndex(line, " b/"); i >= 0 {
+			paths[line[i+len(" b/"):]] = true
+		}
+	}
+	return paths
+}
+
+// CheckpointDiff describes how a later checkpoint differs from an earlier
+// one: panes added, removed, or changed, git state movement, and artifact
+// files whose checksums differ.
+type CheckpointDiff struct {
+	SessionName string        `json:"session_name"`
+	From        string        `json:"from"`
+	To          string        `json:"to"`
+	Panes       []PaneDiff    `json:"panes"`
+	Git         []FieldChange `json:"git"`
+	Files       []FileDiff    `json:"files"`
+}
+
+// PaneDiff is one pane that differs between two checkpoints.
+type PaneDiff struct {
+	ID          string `json:"id"`
+	WindowIndex int    `json:"window_index"`
+	Index       int    `json:"index"`
+	// Change is "added", "removed", "resized", or "changed" (same size,
+	// other fields differ)
+	Change string        `json:"change"`
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange is one field whose value differs between two checkpoints.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// FileDiff is one checkpoint artifact whose checksum differs.
+type FileDiff struct {
+	Path string `json:"path"`
+	// Change is "added", "removed", or "changed"
+	Change       string `json:"change"`
+	FromChecksum string `json:"from_checksum,omitempty"`
+	ToChecksum   string `json:"to_checksum,omitempty"`
+}
+
+// Empty reports whether the two checkpoints had no differences.
+func (d *CheckpointDiff) Empty() bool {
+	return len(d.Panes) == 0 && len(d.Git) == 0 && len(d.Files) == 0
+}
+
+// Diff compares checkpoint from with checkpoint to. Panes are paired by
+// tmux pane ID first, then by window and pane index, so panes that were
+// recreated under new IDs still line up. Files are compared by the
+// checksums of their GenerateManifest entries; metadata.json and
+// session.json always differ and are left out.
+func (s *Storage) Diff(from, to *Checkpoint) (*CheckpointDiff, error) {
+	fromManifest, err := from.GenerateManifest(s)
+	if err != nil {
+		return nil, fmt.Errorf("hashing checkpoint %s: %w", from.ID, err)
+	}
+	toManifest, err := to.GenerateManifest(s)
+	if err != nil {
+		return nil, fmt.Errorf("hashing checkpoint %s: %w", to.ID, err)
+	}
+
+	return &CheckpointDiff{
+		SessionName: to.SessionName,
+		From:        from.ID,
+		To:          to.ID,
+		Panes:       diffPanes(from.Session.Panes, to.Session.Panes),
+		Git:         diffGitState(from.Git, to.Git),
+		Files:       diffManifests(fromManifest, toManifest),
+	}, nil
+}
+
+func diffPanes(from, to []PaneState) []PaneDiff {
+	pairs := make(map[int]int) // from index -> to index
+	taken := make(map[int]bool)
+	match := func(same func(a, b PaneState) bool) {
+		for i, a := range from {
+			if _, ok := pairs[i]; ok {
+				continue
+			}
+			for j, b := range to {
+				if !taken[j] && same(a, b) {
+					pairs[i], taken[j] = j, true
+					break
+				}
+			}
+		}
+	}
+	match(func(a, b PaneState) bool { return a.ID != "" && a.ID == b.ID })
+	match(func(a, b PaneState) bool { return a.WindowIndex == b.WindowIndex && a.Index == b.Index })
+
+	diffs := []PaneDiff{}
+	for i, a := range from {
+		j, ok := pairs[i]
+		if !ok {
+			diffs = append(diffs, PaneDiff{ID: a.ID, WindowIndex: a.WindowIndex, Index: a.Index, Change: "removed"})
+			continue
+		}
+		b := to[j]
+		var fields []FieldChange
+		addField := func(field, was, now string) {
+			if was != now {
+				fields = append(fields, FieldChange{Field: field, From: was, To: now})
+			}
+		}
+		resized := a.Width != b.Width || a.Height != b.Height
+		addField("size", fmt.Sprintf("%dx%d", a.Width, a.Height), fmt.Sprintf("%dx%d", b.Width, b.Height))
+		addField("id", a.ID, b.ID)
+		addField("position", fmt.Sprintf("%d.%d", a.WindowIndex, a.Index), fmt.Sprintf("%d.%d", b.WindowIndex, b.Index))
+		addField("title", a.Title, b.Title)
+		addField("agent_type", a.AgentType, b.AgentType)
+		addField("command", a.Command, b.Command)
+		if len(fields) == 0 {
+			continue
+		}
+		change := "changed"
+		if resized {
+			change = "resized"
+		}
+		diffs = append(diffs, PaneDiff{ID: b.ID, WindowIndex: b.WindowIndex, Index: b.Index, Change: change, Fields: fields})
+	}
+	for j, b := range to {
+		if !taken[j] {
+			diffs = append(diffs, PaneDiff{ID: b.ID, WindowIndex: b.WindowIndex, Index: b.Index, Change: "added"})
+		}
+	}
+	sort.SliceStable(diffs, func(i, j int) bool {
+		if diffs[i].WindowIndex != diffs[j].WindowIndex {
+			return diffs[i].WindowIndex < diffs[j].WindowIndex
+		}
+		return diffs[i].Index < diffs[j].Index
+	})
+	return diffs
+}
+
+func diffGitState(from, to GitState) []FieldChange {
+	changes := []FieldChange{}
+	add := func(field, was, now string) {
+		if was != now {
+			changes = append(changes, FieldChange{Field: field, From: was, To: now})
+		}
+	}
+	add("branch", from.Branch, to.Branch)
+	add("commit", from.Commit, to.Commit)
+	add("is_dirty", strconv.FormatBool(from.IsDirty), strconv.FormatBool(to.IsDirty))
+	add("staged_count", strconv.Itoa(from.StagedCount), strconv.Itoa(to.StagedCount))
+	add("unstaged_count", strconv.Itoa(from.UnstagedCount), strconv.Itoa(to.UnstagedCount))
+	add("untracked_count", strconv.Itoa(from.UntrackedCount), strconv.Itoa(to.UntrackedCount))
+	return changes
+}
+
+func diffManifests(from, to *FileManifest) []FileDiff {
+	diffs := []FileDiff{}
+	for path, was := range from.Files {
+		if path == MetadataFile || path == SessionFile {
+			continue
+		}
+		now, ok := to.Files[path]
+		switch {
+		case !ok:
+			diffs = append(diffs, FileDiff{Path: path, Change: "removed", FromChecksum: was})
+		case now != was:
+			diffs = append(diffs, FileDiff{Path: path, Change: "changed", FromChecksum: was, ToChecksum: now})
+		}
+	}
+	for path, now := range to.Files {
+		if path == MetadataFile || path == SessionFile {
+			continue
+		}
+		if _, ok := from.Files[path]; !ok {
+			diffs = append(diffs, FileDiff{Path: path, Change: "added", ToChecksum: now})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Path < diffs[j].Path })
+	return diffs
+}
@@ -5,11 +5,14 @@ import (
 	"archive/zip"
 	"bytes"
 	"compress/gzip"
+	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
 	"path"
 	"path/filepath"
@@ -17,6 +20,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/Dicklesworthstone/ntm/internal/encryption"
 	"github.com/Dicklesworthstone/ntm/internal/redaction"
 	"github.com/Dicklesworthstone/ntm/internal/util"
 )
@@ -48,8 +52,20 @@ type ExportOptions struct {
 	IncludeScrollback bool
 	// IncludeGitPatch includes git patch file in export
 	IncludeGitPatch bool
+	// SigningKey, when set, signs MANIFEST.json with HMAC-SHA256
+	SigningKey []byte
+	// IncludeReadme adds a generated README.md describing the checkpoint
+	// and how to import it
+	IncludeReadme bool
+	// EncryptionKey, when set, seals the whole archive with AES-256-GCM;
+	// Import recognizes it by its header and decrypts it transparently
+	EncryptionKey []byte
 }
 
+// ReadmeFile is the generated, human-readable summary written into an
+// archive when ExportOptions.IncludeReadme is set. Import ignores it.
+const ReadmeFile = "README.md"
+
 // DefaultExportOptions returns sensible defaults for export.
 func DefaultExportOptions() ExportOptions {
 	return ExportOptions{
@@ -68,11 +84,30 @@ type ExportManifest struct {
 	SessionName    string            `json:"session_name"`
 	CheckpointID   string            `json:"checkpoint_id"`
 	CheckpointName string            `json:"checkpoint_name"`
+	Tags           []string          `json:"tags,omitempty"`
 	OriginalPath   string            `json:"original_path"`
 	Files          []ManifestEntry   `json:"files"`
 	Checksums      map[string]string `json:"checksums"`
+	// Encrypted records that the archive was sealed with Cipher; the
+	// manifest itself is only readable once the archive is decrypted.
+	Encrypted bool   `json:"encrypted,omitempty"`
+	Cipher    string `json:"cipher,omitempty"`
+	// Signature is an HMAC over the rest of the manifest, present when the
+	// exporter had a signing key. Format: "hmac-sha256:<hex>".
+	Signature string `json:"signature,omitempty"`
 }
 
+// ArchiveCipher is the cipher encrypted exports are sealed with.
+const ArchiveCipher = "aes-256-gcm"
+
+// encryptedArchiveMagic prefixes an encrypted export, followed by the
+// encryption.Encrypt envelope of the plain tar.gz or zip archive.
+const encryptedArchiveMagic = "NTMCKPT-ENC\n"
+
+// ErrArchiveWrongKey is returned when none of the configured keys decrypts
+// an encrypted archive.
+var ErrArchiveWrongKey = errors.New("archive was encrypted with a different key")
+
 // ManifestEntry describes a file in the export.
 type ManifestEntry struct {
 	Path     string `json:"path"`
@@ -90,6 +125,18 @@ type ImportOptions struct {
 	VerifyChecksums bool
 	// AllowOverwrite permits overwriting existing checkpoints
 	AllowOverwrite bool
+	// SignatureKeys are tried in order to verify the manifest signature.
+	// Verification is skipped when empty unless RequireSignature is set.
+	SignatureKeys [][]byte
+	// RequireSignature fails the import when the manifest signature is
+	// missing or invalid instead of warning.
+	RequireSignature bool
+	// OnSignatureWarning receives non-fatal signature problems. When nil they
+	// are logged with slog.
+	OnSignatureWarning func(error)
+	// DecryptionKeys returns the keys to try on an encrypted archive. It is
+	// only called when one is found, so plain imports never resolve keys.
+	DecryptionKeys func() ([][]byte, error)
 }
 
 // DefaultImportOptions returns sensible defaults for import.
@@ -194,6 +241,7 @@ func (s *Storage) Export(sessionName, checkpointID string, destPath string, opts
 		SessionName:    sessionName,
 		CheckpointID:   cp.ID,
 		CheckpointName: cp.Name,
+		Tags:           cp.Tags,
 		OriginalPath:   cp.WorkingDir,
 		Checksums:      make(map[string]string),
 	}
@@ -203,29 +251,65 @@ func (s *Storage) Export(sessionName, checkpointID string, destPath string, opts
 	if err := validateCheckpointArtifactReferences(cpData); err != nil {
 		return nil, fmt.Errorf("invalid checkpoint artifact references: %w", err)
 	}
-	redactedScrollbackFiles, err := prepareRedactedScrollbackArtifacts(cpDir, cpData, opts)
+	// Archives are always full checkpoints: inherited artifacts are read
+	// through the parent chain and the archived metadata drops ParentID.
+	artifactPath := func(rel string) (string, error) {
+		return s.resolveCheckpointArtifact(cp, cpDir, rel)
+	}
+	redactedScrollbackFiles, err := prepareRedactedScrollbackArtifacts(artifactPath, cpData, opts)
 	if err != nil {
 		return nil, err
 	}
+	if opts.IncludeReadme {
+		if redactedScrollbackFiles == nil {
+			redactedScrollbackFiles = make(map[string][]byte)
+		}
+		redactedScrollbackFiles[ReadmeFile] = renderExportReadme(cpData, filepath.Base(destPath), opts)
+		addFile(ReadmeFile)
+	}
 
 	// Create the archive
+	var writeArchive func(io.Writer) error
 	switch opts.Format {
 	case FormatTarGz:
-		err = s.exportTarGz(destPath, cpDir, cpData, files, opts, manifest, redactedScrollbackFiles)
+		writeArchive = func(w io.Writer) error {
+			return s.exportTarGz(w, artifactPath, cpData, files, opts, manifest, redactedScrollbackFiles)
+		}
 	case FormatZip:
-		err = s.exportZip(destPath, cpDir, cpData, files, opts, manifest, redactedScrollbackFiles)
+		writeArchive = func(w io.Writer) error {
+			return s.exportZip(w, artifactPath, cpData, files, opts, manifest, redactedScrollbackFiles)
+		}
 	default:
 		return nil, fmt.Errorf("unsupported export format: %s", opts.Format)
 	}
+	if len(opts.EncryptionKey) > 0 {
+		manifest.Encrypted = true
+		manifest.Cipher = ArchiveCipher
+	}
 
-	if err != nil {
+	if err := writeExportArchive(destPath, opts.EncryptionKey, writeArchive); err != nil {
 		return nil, err
 	}
 
 	return manifest, nil
 }
 
-func (s *Storage) exportTarGz(destPath, cpDir string, cp *Checkpoint, files []string, opts ExportOptions, manifest *ExportManifest, preparedFiles map[string][]byte) (err error) {
+// writeExportArchive writes the archive produced by write to destPath. With
+// a key, the archive is built in memory and only its sealed form is written,
+// so no plaintext copy touches the disk.
+func writeExportArchive(destPath string, key []byte, write func(io.Writer) error) (err error) {
+	if len(key) > 0 {
+		var buf bytes.Buffer
+		if err := write(&buf); err != nil {
+			return err
+		}
+		sealed, err := encryption.Encrypt(key, buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("encrypting export archive: %w", err)
+		}
+		return util.AtomicWriteFile(destPath, append([]byte(encryptedArchiveMagic), sealed...), 0600)
+	}
+
 	f, err := os.Create(destPath)
 	if err != nil {
 		return fmt.Errorf("failed to create export file: %w", err)
@@ -235,8 +319,11 @@ func (s *Storage) exportTarGz(destPath, cpDir string, cp *Checkpoint, files []st
 			err = fmt.Errorf("closing export file: %w", closeErr)
 		}
 	}()
+	return write(f)
+}
 
-	gw := gzip.NewWriter(f)
+func (s *Storage) exportTarGz(w io.Writer, artifactPath func(string) (string, error), cp *Checkpoint, files []string, opts ExportOptions, manifest *ExportManifest, preparedFiles map[string][]byte) (err error) {
+	gw := gzip.NewWriter(w)
 	defer func() {
 		if closeErr := gw.Close(); err == nil && closeErr != nil {
 			err = fmt.Errorf("closing gzip export stream: %w", closeErr)
@@ -293,7 +380,7 @@ func (s *Storage) exportTarGz(destPath, cpDir string, cp *Checkpoint, files []st
 
 		data, prepared := preparedFiles[file]
 		if !prepared {
-			srcPath, err := resolveExistingCheckpointArtifactPath(cpDir, file)
+			srcPath, err := artifactPath(file)
 			if err != nil {
 				return fmt.Errorf("invalid checkpoint file path %s: %w", file, err)
 			}
@@ -317,6 +404,11 @@ func (s *Storage) exportTarGz(destPath, cpDir string, cp *Checkpoint, files []st
 	}
 
 	// Write manifest
+	if len(opts.SigningKey) > 0 {
+		if err := SignManifest(manifest, opts.SigningKey); err != nil {
+			return err
+		}
+	}
 	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal manifest: %w", err)
@@ -328,18 +420,8 @@ func (s *Storage) exportTarGz(destPath, cpDir string, cp *Checkpoint, files []st
 	return nil
 }
 
-func (s *Storage) exportZip(destPath, cpDir string, cp *Checkpoint, files []string, opts ExportOptions, manifest *ExportManifest, preparedFiles map[string][]byte) (err error) {
-	f, err := os.Create(destPath)
-	if err != nil {
-		return fmt.Errorf("failed to create export file: %w", err)
-	}
-	defer func() {
-		if closeErr := f.Close(); err == nil && closeErr != nil {
-			err = fmt.Errorf("closing export file: %w", closeErr)
-		}
-	}()
-
-	zw := zip.NewWriter(f)
+func (s *Storage) exportZip(w io.Writer, artifactPath func(string) (string, error), cp *Checkpoint, files []string, opts ExportOptions, manifest *ExportManifest, preparedFiles map[string][]byte) (err error) {
+	zw := zip.NewWriter(w)
 	defer func() {
 		if closeErr := zw.Close(); err == nil && closeErr != nil {
 			err = fmt.Errorf("closing zip export stream: %w", closeErr)
@@ -389,7 +471,7 @@ func (s *Storage) exportZip(destPath, cpDir string, cp *Checkpoint, files []stri
 
 		data, prepared := preparedFiles[file]
 		if !prepared {
-			srcPath, err := resolveExistingCheckpointArtifactPath(cpDir, file)
+			srcPath, err := artifactPath(file)
 			if err != nil {
 				return fmt.Errorf("invalid checkpoint file path %s: %w", file, err)
 			}
@@ -413,6 +495,11 @@ func (s *Storage) exportZip(destPath, cpDir string, cp *Checkpoint, files []stri
 	}
 
 	// Write manifest
+	if len(opts.SigningKey) > 0 {
+		if err := SignManifest(manifest, opts.SigningKey); err != nil {
+			return err
+		}
+	}
 	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal manifest: %w", err)
@@ -425,7 +512,7 @@ func (s *Storage) exportZip(destPath, cpDir string, cp *Checkpoint, files []stri
 }
 
 // Import loads a checkpoint from an exported archive.
-func (s *Storage) Import(archivePath string, opts ImportOptions) (*Checkpoint, error) {
+func (s *Storage) Import(archivePath string, opts ImportOptions) (result *Checkpoint, err error) {
 	var format ExportFormat
 	switch {
 	case strings.HasSuffix(archivePath, ".tar.gz") || strings.HasSuffix(archivePath, ".tgz"):
@@ -436,29 +523,259 @@ func (s *Storage) Import(archivePath string, opts ImportOptions) (*Checkpoint, e
 		return nil, fmt.Errorf("unknown archive format: %s", filepath.Ext(archivePath))
 	}
 
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil && closeErr != nil {
+			result = nil
+			err = fmt.Errorf("closing archive file: %w", closeErr)
+		}
+	}()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat archive: %w", err)
+	}
+
+	var archive io.ReaderAt = f
+	size := info.Size()
+	magic := make([]byte, len(encryptedArchiveMagic))
+	if n, _ := f.ReadAt(magic, 0); n == len(magic) && string(magic) == encryptedArchiveMagic {
+		plain, err := decryptImportArchive(io.NewSectionReader(f, int64(len(magic)), size-int64(len(magic))), opts)
+		if err != nil {
+			return nil, err
+		}
+		archive, size = bytes.NewReader(plain), int64(len(plain))
+	}
+
 	switch format {
 	case FormatTarGz:
-		return s.importTarGz(archivePath, opts)
+		return s.importTarGz(io.NewSectionReader(archive, 0, size), opts)
 	case FormatZip:
-		return s.importZip(archivePath, opts)
+		return s.importZip(archive, size, opts)
 	default:
 		return nil, fmt.Errorf("unsupported import format: %s", format)
 	}
 }
 
-func (s *Storage) importTarGz(archivePath string, opts ImportOptions) (result *Checkpoint, err error) {
-	f, err := os.Open(archivePath)
+// decryptImportArchive opens the sealed archive in r with the first of
+// opts.DecryptionKeys that authenticates it.
+func decryptImportArchive(r *io.SectionReader, opts ImportOptions) ([]byte, error) {
+	if r.Size() > maxImportArchiveBytes {
+		return nil, fmt.Errorf("%s: exceeds %d bytes", errImportArchiveTooLarge, maxImportArchiveBytes)
+	}
+	var keys [][]byte
+	if opts.DecryptionKeys != nil {
+		var err error
+		if keys, err = opts.DecryptionKeys(); err != nil {
+			return nil, fmt.Errorf("archive is encrypted: resolving decryption key: %w", err)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("archive is encrypted but no decryption key is configured")
+	}
+
+	sealed, err := io.ReadAll(r)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open archive: %w", err)
+		return nil, fmt.Errorf("failed to read encrypted archive: %w", err)
 	}
-	defer func() {
-		if closeErr := f.Close(); err == nil && closeErr != nil {
-			result = nil
-			err = fmt.Errorf("closing archive file: %w", closeErr)
+	for _, key := range keys {
+		plain, err := encryption.Decrypt(key, sealed)
+		if err == nil {
+			return plain, nil
 		}
-	}()
+		if !encryption.IsWrongKey(err) && !encryption.IsInvalidKey(err) {
+			return nil, fmt.Errorf("decrypting archive: %w", err)
+		}
+	}
+	return nil, fmt.Errorf("decrypting archive: %w (tried %d key(s))", ErrArchiveWrongKey, len(keys))
+}
+
+// BulkExportEntry reports the export of one checkpoint by ExportAll.
+type BulkExportEntry struct {
+	CheckpointID string    `json:"checkpoint_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	Archive      string    `json:"archive,omitempty"`
+	Bytes        int64     `json:"bytes,omitempty"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// BulkExportResult summarizes ExportAll.
+type BulkExportResult struct {
+	Session     string            `json:"session"`
+	Dir         string            `json:"dir"`
+	Exported    int               `json:"exported"`
+	Failed      int               `json:"failed"`
+	TotalBytes  int64             `json:"total_bytes"`
+	Checkpoints []BulkExportEntry `json:"checkpoints"`
+}
+
+// ExportAll exports every checkpoint of sessionName created after since (all
+// of them when since is zero) to dir/<session>_<id>.<ext>, oldest first. A
+// failed export is recorded and the rest are still exported.
+func (s *Storage) ExportAll(sessionName, dir string, since time.Time, opts ExportOptions) (*BulkExportResult, error) {
+	if opts.Format == "" {
+		opts.Format = FormatTarGz
+	}
+	checkpoints, err := s.List(sessionName)
+	if err != nil {
+		return nil, fmt.Errorf("listing checkpoints: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating export directory: %w", err)
+	}
+
+	ext := ".tar.gz"
+	if opts.Format == FormatZip {
+		ext = ".zip"
+	}
+	result := &BulkExportResult{Session: sessionName, Dir: dir, Checkpoints: []BulkExportEntry{}}
+	for i := len(checkpoints) - 1; i >= 0; i-- {
+		cp := checkpoints[i]
+		if !since.IsZero() && !cp.CreatedAt.After(since) {
+			continue
+		}
+		report := BulkExportEntry{CheckpointID: cp.ID, CreatedAt: cp.CreatedAt}
+		archivePath := filepath.Join(dir, fmt.Sprintf("%s_%s%s", sessionName, cp.ID, ext))
+		if _, err := s.Export(sessionName, cp.ID, archivePath, opts); err != nil {
+			report.Error = err.Error()
+			result.Failed++
+			result.Checkpoints = append(result.Checkpoints, report)
+			continue
+		}
+		report.Archive = archivePath
+		if info, err := os.Stat(archivePath); err == nil {
+			report.Bytes = info.Size()
+			result.TotalBytes += report.Bytes
+		}
+		result.Exported++
+		result.Checkpoints = append(result.Checkpoints, report)
+	}
+	return result, nil
+}
+
+// BulkImportEntry reports the import of one archive by ImportAll.
+type BulkImportEntry struct {
+	Archive      string `json:"archive"`
+	SessionName  string `json:"session,omitempty"`
+	CheckpointID string `json:"checkpoint_id,omitempty"`
+	Error        string `json:"error,omitempty"`
+	DedupedFiles int    `json:"deduped_files,omitempty"`
+	BytesSaved   int64  `json:"bytes_saved,omitempty"`
+}
+
+// BulkImportResult summarizes ImportAll.
+type BulkImportResult struct {
+	Imported     int               `json:"imported"`
+	Failed       int               `json:"failed"`
+	DedupedFiles int               `json:"deduped_files"`
+	BytesSaved   int64             `json:"bytes_saved"`
+	Archives     []BulkImportEntry `json:"archives"`
+}
+
+// IsImportArchive reports whether name has an extension Import understands.
+func IsImportArchive(name string) bool {
+	return strings.HasSuffix(name, ".tar.gz") || strings.HasSuffix(name, ".tgz") || strings.HasSuffix(name, ".zip")
+}
+
+// ImportAll imports every archive directly inside dir, in name order, with
+// the same validation as Import. A failed archive is recorded and the rest
+// are still imported. With dedupe set, files whose content matches a file
+// already imported in this batch are replaced by hard links to it; checkpoint
+// files are only ever replaced atomically, so a later write to one copy does
+// not affect the other.
+func (s *Storage) ImportAll(dir string, opts ImportOptions, dedupe bool) (*BulkImportResult, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading archive directory: %w", err)
+	}
+
+	result := &BulkImportResult{Archives: []BulkImportEntry{}}
+	seen := make(map[string]string)
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !IsImportArchive(entry.Name()) {
+			continue
+		}
+		archivePath := filepath.Join(dir, entry.Name())
+		report := BulkImportEntry{Archive: entry.Name()}
+
+		cp, err := s.Import(archivePath, opts)
+		if err != nil {
+			report.Error = err.Error()
+			result.Failed++
+			result.Archives = append(result.Archives, report)
+			continue
+		}
+		report.SessionName = cp.SessionName
+		report.CheckpointID = cp.ID
+		result.Imported++
+
+		if dedupe {
+			report.DedupedFiles, report.BytesSaved = s.dedupeCheckpointFiles(cp, seen)
+			result.DedupedFiles += report.DedupedFiles
+			result.BytesSaved += report.BytesSaved
+		}
+		result.Archives = append(result.Archives, report)
+	}
+	return result, nil
+}
 
-	gr, err := gzip.NewReader(f)
+// dedupeCheckpointFiles hard-links each regular file of cp to an earlier file
+// with the same SHA-256 recorded in seen, and records the ones it keeps.
+// Failures only cost the saving, so they are logged rather than returned.
+func (s *Storage) dedupeCheckpointFiles(cp *Checkpoint, seen map[string]string) (int, int64) {
+	cpDir, err := s.safeCheckpointDir(cp.SessionName, cp.ID)
+	if err != nil {
+		return 0, 0
+	}
+	var (
+		linked int
+		saved  int64
+	)
+	walkErr := filepath.WalkDir(cpDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		sum := sha256sum(data)
+		original, ok := seen[sum]
+		if !ok {
+			seen[sum] = path
+			return nil
+		}
+		tmp := path + ".dedupe"
+		if err := os.Link(original, tmp); err != nil {
+			slog.Default().Debug("checkpoint dedupe link failed", "path", path, "error", err)
+			return nil
+		}
+		if err := os.Rename(tmp, path); err != nil {
+			_ = os.Remove(tmp)
+			slog.Default().Debug("checkpoint dedupe replace failed", "path", path, "error", err)
+			return nil
+		}
+		linked++
+		saved += int64(len(data))
+		return nil
+	})
+	if walkErr != nil {
+		slog.Default().Warn("checkpoint dedupe incomplete",
+			"session", cp.SessionName,
+			"checkpoint", cp.ID,
+			"error", walkErr,
+		)
+	}
+	return linked, saved
+}
+
+func (s *Storage) importTarGz(r io.Reader, opts ImportOptions) (result *Checkpoint, err error) {
+	gr, err := gzip.NewReader(r)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create gzip reader: %w", err)
 	}
@@ -533,9 +850,13 @@ func (s *Storage) importTarGz(archivePath string, opts ImportOptions) (result *C
 	if err := validateImportedManifestMetadata(manifest, cp); err != nil {
 		return nil, err
 	}
+	if err := checkImportSignature(manifest, opts); err != nil {
+		return nil, err
+	}
 	if err := validateImportedArchiveFiles(fileContents, cp); err != nil {
 		return nil, err
 	}
+	delete(fileContents, ReadmeFile)
 
 	sessionName := cp.SessionName
 
@@ -558,6 +879,11 @@ func (s *Storage) importTarGz(archivePath string, opts ImportOptions) (result *C
 		cp.WorkingDir = cwd
 	}
 
+	// The archive carries every artifact, so the imported checkpoint is
+	// full even if the exporter's copy was incremental.
+	cp.ParentID = ""
+	cp.ChangedFiles = nil
+
 	cpJSON, err := json.MarshalIndent(cp, "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal imported checkpoint: %w", err)
@@ -620,17 +946,11 @@ func (s *Storage) importTarGz(archivePath string, opts ImportOptions) (result *C
 	return cp, nil
 }
 
-func (s *Storage) importZip(archivePath string, opts ImportOptions) (result *Checkpoint, err error) {
-	zr, err := zip.OpenReader(archivePath)
+func (s *Storage) importZip(r io.ReaderAt, size int64, opts ImportOptions) (*Checkpoint, error) {
+	zr, err := zip.NewReader(r, size)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open zip archive: %w", err)
 	}
-	defer func() {
-		if closeErr := zr.Close(); err == nil && closeErr != nil {
-			result = nil
-			err = fmt.Errorf("closing zip archive: %w", closeErr)
-		}
-	}()
 
 	var manifest *ExportManifest
 	var cp *Checkpoint
@@ -696,9 +1016,13 @@ func (s *Storage) importZip(archivePath string, opts ImportOptions) (result *Che
 	if err := validateImportedManifestMetadata(manifest, cp); err != nil {
 		return nil, err
 	}
+	if err := checkImportSignature(manifest, opts); err != nil {
+		return nil, err
+	}
 	if err := validateImportedArchiveFiles(fileContents, cp); err != nil {
 		return nil, err
 	}
+	delete(fileContents, ReadmeFile)
 
 	sessionName := cp.SessionName
 
@@ -721,6 +1045,11 @@ func (s *Storage) importZip(archivePath string, opts ImportOptions) (result *Che
 		cp.WorkingDir = cwd
 	}
 
+	// The archive carries every artifact, so the imported checkpoint is
+	// full even if the exporter's copy was incremental.
+	cp.ParentID = ""
+	cp.ChangedFiles = nil
+
 	cpJSON, err := json.MarshalIndent(cp, "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal imported checkpoint: %w", err)
@@ -827,6 +1156,11 @@ func validateTarImportEntry(header *tar.Header) (bool, error) {
 			return false, err
 		}
 		return true, nil
+	case tar.TypeSymlink:
+		return false, rejectImportLinkEntry(header.Name, path.Join(path.Dir(header.Name), header.Linkname), header.Linkname)
+	case tar.TypeLink:
+		// Hard link targets are relative to the archive root.
+		return false, rejectImportLinkEntry(header.Name, header.Linkname, header.Linkname)
 	default:
 		return false, fmt.Errorf("archive contains non-regular entry: %s", header.Name)
 	}
@@ -846,12 +1180,48 @@ func validateZipImportEntry(f *zip.File) (bool, error) {
 	if err := validateImportEntryName(f.Name); err != nil {
 		return false, err
 	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		target, err := readZipLinkTarget(f)
+		if err != nil {
+			return false, err
+		}
+		return false, rejectImportLinkEntry(f.Name, path.Join(path.Dir(f.Name), target), target)
+	}
 	if !info.Mode().IsRegular() {
 		return false, fmt.Errorf("archive contains non-regular entry: %s", f.Name)
 	}
 	return false, nil
 }
 
+// maxImportLinkTarget bounds how much of a zip symlink entry is read as its
+// target.
+const maxImportLinkTarget = 4096
+
+// readZipLinkTarget returns a zip symlink's target, stored as the entry body.
+func readZipLinkTarget(f *zip.File) (string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+	data, err := readImportEntryLimited(rc, f.Name, maxImportLinkTarget)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// rejectImportLinkEntry refuses a link entry. Links are never extracted, but
+// one whose target would leave the checkpoint directory is reported as unsafe
+// rather than merely unsupported; resolved is the target as an
+// archive-relative path.
+func rejectImportLinkEntry(name, resolved, target string) error {
+	if filepath.IsAbs(target) || strings.Contains(target, `\`) || !isPathWithinDir(".", resolved) {
+		return fmt.Errorf("invalid path in archive (unsafe symlink): %s -> %s", name, target)
+	}
+	return fmt.Errorf("archive contains non-regular entry: %s", name)
+}
+
 func validateImportDirectoryEntryName(name string) error {
 	return validateImportEntryName(strings.TrimSuffix(name, "/"))
 }
@@ -919,7 +1289,81 @@ type redactedScrollbackArtifact struct {
 	compression string
 }
 
-func prepareRedactedScrollbackArtifacts(cpDir string, cp *Checkpoint, opts ExportOptions) (map[string][]byte, error) {
+// renderExportReadme summarizes cp for someone receiving the archive and
+// explains how to import it.
+func renderExportReadme(cp *Checkpoint, archiveName string, opts ExportOptions) []byte {
+	var b strings.Builder
+	title := cp.Name
+	if title == "" {
+		title = cp.ID
+	}
+	fmt.Fprintf(&b, "# NTM checkpoint: %s\n\n", title)
+	if cp.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", cp.Description)
+	}
+
+	fmt.Fprintf(&b, "| | |\n|---|---|\n")
+	fmt.Fprintf(&b, "| Session | `%s` |\n", cp.SessionName)
+	fmt.Fprintf(&b, "| Checkpoint ID | `%s` |\n", cp.ID)
+	fmt.Fprintf(&b, "| Created | %s |\n", cp.CreatedAt.UTC().Format(time.RFC3339))
+	workingDir := cp.WorkingDir
+	if workingDir == "${WORKING_DIR}" {
+		workingDir = "rewritten for portability; set on import"
+	}
+	fmt.Fprintf(&b, "| Working directory | %s |\n", workingDir)
+	fmt.Fprintf(&b, "| Panes | %d |\n", cp.PaneCount)
+	if len(cp.Tags) > 0 {
+		fmt.Fprintf(&b, "| Tags | %s |\n", strings.Join(cp.Tags, ", "))
+	}
+	if cp.Note != "" {
+		fmt.Fprintf(&b, "| Note | %s |\n", cp.Note)
+	}
+	fmt.Fprintln(&b)
+
+	if len(cp.Session.Panes) > 0 {
+		fmt.Fprintf(&b, "## Panes\n\n")
+		for _, pane := range cp.Session.Panes {
+			agent := pane.AgentType
+			if agent == "" {
+				agent = "unknown"
+			}
+			fmt.Fprintf(&b, "- %d.%d `%s` (%s)", pane.WindowIndex, pane.Index, pane.Title, agent)
+			if opts.IncludeScrollback && pane.ScrollbackFile != "" {
+				fmt.Fprintf(&b, ", %d lines of scrollback", pane.ScrollbackLines)
+			}
+			fmt.Fprintln(&b)
+		}
+		fmt.Fprintln(&b)
+	}
+
+	if cp.Git.Commit != "" || cp.Git.Branch != "" {
+		fmt.Fprintf(&b, "## Git\n\n")
+		fmt.Fprintf(&b, "- Branch: `%s`\n", cp.Git.Branch)
+		fmt.Fprintf(&b, "- Commit: `%s`\n", cp.Git.Commit)
+		if cp.Git.IsDirty {
+			fmt.Fprintf(&b, "- Uncommitted changes: %d staged, %d unstaged, %d untracked\n",
+				cp.Git.StagedCount, cp.Git.UnstagedCount, cp.Git.UntrackedCount)
+			if opts.IncludeGitPatch && cp.Git.PatchFile != "" {
+				fmt.Fprintf(&b, "- The diff is included as `%s`\n", cp.Git.PatchFile)
+			}
+		} else {
+			fmt.Fprintf(&b, "- Working tree was clean\n")
+		}
+		fmt.Fprintln(&b)
+	}
+
+	fmt.Fprintf(&b, "## Importing\n\n")
+	fmt.Fprintf(&b, "```sh\nntm checkpoint import %s\n```\n\n", archiveName)
+	fmt.Fprintf(&b, "Use `--session <name>` to import under a different session name and\n")
+	fmt.Fprintf(&b, "`--target-dir <path>` to point the checkpoint at your copy of the project.\n")
+	fmt.Fprintf(&b, "Then restore it with `ntm checkpoint restore <session> %s`.\n", cp.ID)
+	if opts.RedactSecrets {
+		fmt.Fprintf(&b, "\nPotential secrets were redacted from scrollback before export.\n")
+	}
+	return []byte(b.String())
+}
+
+func prepareRedactedScrollbackArtifacts(artifactPath func(string) (string, error), cp *Checkpoint, opts ExportOptions) (map[string][]byte, error) {
 	if !opts.RedactSecrets || !opts.IncludeScrollback {
 		return nil, nil
 	}
@@ -931,7 +1375,7 @@ func prepareRedactedScrollbackArtifacts(cpDir string, cp *Checkpoint, opts Expor
 			continue
 		}
 
-		srcPath, err := resolveExistingCheckpointArtifactPath(cpDir, pane.ScrollbackFile)
+		srcPath, err := artifactPath(pane.ScrollbackFile)
 		if err != nil {
 			return nil, fmt.Errorf("invalid checkpoint file path %s: %w", pane.ScrollbackFile, err)
 		}
@@ -1010,6 +1454,8 @@ func rewriteCheckpointForExport(cp *Checkpoint, opts ExportOptions) *Checkpoint
 	result := *cp
 	result.Session.WindowLayouts = cloneWindowLayouts(cp.Session.WindowLayouts)
 	result.Session.Panes = clonePaneStatesForExport(cp.Session.Panes)
+	result.ParentID = ""
+	result.ChangedFiles = nil
 	if opts.RewritePaths && result.WorkingDir != "" {
 		result.WorkingDir = "${WORKING_DIR}"
 	}
@@ -1092,6 +1538,95 @@ func validateImportOverwrite(cpDir string, fileContents map[string][]byte) error
 	return fmt.Errorf("overwrite would leave stale checkpoint artifacts behind: %s", strings.Join(staleFiles, ", "))
 }
 
+const manifestSignaturePrefix = "hmac-sha256:"
+
+var (
+	// ErrManifestUnsigned indicates an archive manifest carries no signature.
+	ErrManifestUnsigned = errors.New("checkpoint manifest is not signed")
+	// ErrManifestSignatureInvalid indicates the manifest signature does not
+	// match any available key.
+	ErrManifestSignatureInvalid = errors.New("checkpoint manifest signature is invalid")
+)
+
+// SignManifest computes an HMAC-SHA256 over the manifest (excluding the
+// signature itself) and stores it in manifest.Signature.
+func SignManifest(manifest *ExportManifest, key []byte) error {
+	mac, err := manifestMAC(manifest, key)
+	if err != nil {
+		return err
+	}
+	manifest.Signature = manifestSignaturePrefix + hex.EncodeToString(mac)
+	return nil
+}
+
+// VerifyManifestSignature checks the manifest signature against each key.
+// It returns ErrManifestUnsigned or ErrManifestSignatureInvalid on failure.
+func VerifyManifestSignature(manifest *ExportManifest, keys [][]byte) error {
+	if manifest == nil || manifest.Signature == "" {
+		return ErrManifestUnsigned
+	}
+	encoded, ok := strings.CutPrefix(manifest.Signature, manifestSignaturePrefix)
+	if !ok {
+		return fmt.Errorf("%w: unsupported signature scheme", ErrManifestSignatureInvalid)
+	}
+	want, err := hex.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("%w: malformed signature", ErrManifestSignatureInvalid)
+	}
+	for _, key := range keys {
+		got, err := manifestMAC(manifest, key)
+		if err != nil {
+			return err
+		}
+		if hmac.Equal(got, want) {
+			return nil
+		}
+	}
+	return ErrManifestSignatureInvalid
+}
+
+func manifestMAC(manifest *ExportManifest, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("manifest signing key is empty")
+	}
+	unsigned := *manifest
+	unsigned.Signature = ""
+	data, err := json.Marshal(&unsigned)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest for signing: %w", err)
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil), nil
+}
+
+// checkImportSignature verifies the manifest signature when keys are
+// available. Problems are fatal only with RequireSignature; otherwise they
+// are reported through OnSignatureWarning.
+func checkImportSignature(manifest *ExportManifest, opts ImportOptions) error {
+	if opts.RequireSignature {
+		if !opts.VerifyChecksums {
+			return fmt.Errorf("signature verification requires checksum verification")
+		}
+		if len(opts.SignatureKeys) == 0 {
+			return fmt.Errorf("signature required but no signing key is configured")
+		}
+	}
+	if len(opts.SignatureKeys) == 0 {
+		return nil
+	}
+	err := VerifyManifestSignature(manifest, opts.SignatureKeys)
+	if err == nil || opts.RequireSignature {
+		return err
+	}
+	if opts.OnSignatureWarning != nil {
+		opts.OnSignatureWarning(err)
+	} else {
+		slog.Warn("checkpoint import signature check failed", "error", err)
+	}
+	return nil
+}
+
 func verifyImportChecksums(fileContents map[string][]byte, manifest *ExportManifest) error {
 	if manifest == nil {
 		return fmt.Errorf("checksum verification requested but archive missing MANIFEST.json")
@@ -1223,7 +1758,7 @@ func validateImportedArchiveFiles(fileContents map[string][]byte, cp *Checkpoint
 
 	expectedFiles := expectedManifestFiles(cp)
 	for name := range fileContents {
-		if name == "MANIFEST.json" {
+		if name == "MANIFEST.json" || name == ReadmeFile {
 			continue
 		}
 		if !isPathWithinDir(".", name) {
@@ -1292,7 +1827,7 @@ func validateGitArtifactReference(kind, artifactPath string) error {
 	}
 	cleanPath := filepath.ToSlash(filepath.Clean(artifactPath))
 	switch cleanPath {
-	case MetadataFile, SessionFile, "MANIFEST.json":
+	case MetadataFile, SessionFile, "MANIFEST.json", ReadmeFile:
 		return fmt.Errorf("invalid %s path: must not alias reserved checkpoint file: %s", kind, artifactPath)
 	}
 	if cleanPath == PanesDir || strings.HasPrefix(cleanPath, PanesDir+"/") {
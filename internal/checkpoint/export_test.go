@@ -3,11 +3,15 @@ package checkpoint
 import (
 	"archive/tar"
 	"archive/zip"
+	"bytes"
 	"compress/gzip"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -966,6 +970,50 @@ func TestExportImport_OverwritePreservesGitStatusArtifact(t *testing.T) {
 	}
 }
 
+func TestExportImport_PreservesTags(t *testing.T) {
+	tmpDir := t.TempDir()
+	exportStorage := NewStorageWithDir(filepath.Join(tmpDir, "export"))
+	importStorage := NewStorageWithDir(filepath.Join(tmpDir, "import"))
+
+	sessionName := "tagged-session"
+	checkpointID := "20251210-143052-tagged"
+	cp := &Checkpoint{
+		Version:     CurrentVersion,
+		ID:          checkpointID,
+		SessionName: sessionName,
+		CreatedAt:   time.Now(),
+	}
+	cp.Annotate([]string{"release", "known-good"}, nil, nil)
+	if err := exportStorage.Save(cp); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	archivePath := filepath.Join(tmpDir, "tagged.tar.gz")
+	manifest, err := exportStorage.Export(sessionName, checkpointID, archivePath, DefaultExportOptions())
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	wantTags := []string{"known-good", "release"}
+	if !slices.Equal(manifest.Tags, wantTags) {
+		t.Errorf("manifest Tags = %v, want %v", manifest.Tags, wantTags)
+	}
+
+	imported, err := importStorage.Import(archivePath, DefaultImportOptions())
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if !slices.Equal(imported.Tags, wantTags) {
+		t.Errorf("imported Tags = %v, want %v", imported.Tags, wantTags)
+	}
+	found, err := importStorage.FindByTag("release")
+	if err != nil {
+		t.Fatalf("FindByTag failed: %v", err)
+	}
+	if len(found) != 1 || found[0].ID != checkpointID {
+		t.Errorf("FindByTag(release) after import = %v, want [%s]", found, checkpointID)
+	}
+}
+
 func TestImport_WithOverrides(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "ntm-import-override-test")
 	if err != nil {
@@ -1027,6 +1075,164 @@ func TestImport_WithOverrides(t *testing.T) {
 	}
 }
 
+func TestExportImport_SignedManifest(t *testing.T) {
+	tmpDir := t.TempDir()
+	exportStorage := NewStorageWithDir(filepath.Join(tmpDir, "export"))
+
+	sessionName := "signed-session"
+	checkpointID := "20251210-143052-signed"
+	cp := &Checkpoint{
+		Version:     CurrentVersion,
+		ID:          checkpointID,
+		SessionName: sessionName,
+		CreatedAt:   time.Now(),
+	}
+	if err := exportStorage.Save(cp); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	key := bytes.Repeat([]byte{0x42}, 32)
+	otherKey := bytes.Repeat([]byte{0x17}, 32)
+
+	signedPath := filepath.Join(tmpDir, "signed.tar.gz")
+	opts := DefaultExportOptions()
+	opts.SigningKey = key
+	manifest, err := exportStorage.Export(sessionName, checkpointID, signedPath, opts)
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if !strings.HasPrefix(manifest.Signature, "hmac-sha256:") {
+		t.Fatalf("Signature = %q, want hmac-sha256 prefix", manifest.Signature)
+	}
+
+	unsignedPath := filepath.Join(tmpDir, "unsigned.tar.gz")
+	if _, err := exportStorage.Export(sessionName, checkpointID, unsignedPath, DefaultExportOptions()); err != nil {
+		t.Fatalf("Export unsigned failed: %v", err)
+	}
+
+	importInto := func(name, archive string, opts ImportOptions) error {
+		opts.VerifyChecksums = true
+		_, err := NewStorageWithDir(filepath.Join(tmpDir, name)).Import(archive, opts)
+		return err
+	}
+
+	if err := importInto("valid", signedPath, ImportOptions{SignatureKeys: [][]byte{otherKey, key}, RequireSignature: true}); err != nil {
+		t.Fatalf("Import with rotated keyring failed: %v", err)
+	}
+
+	err = importInto("wrong-key", signedPath, ImportOptions{SignatureKeys: [][]byte{otherKey}, RequireSignature: true})
+	if !errors.Is(err, ErrManifestSignatureInvalid) {
+		t.Fatalf("Import with wrong key err = %v, want ErrManifestSignatureInvalid", err)
+	}
+
+	err = importInto("unsigned-required", unsignedPath, ImportOptions{SignatureKeys: [][]byte{key}, RequireSignature: true})
+	if !errors.Is(err, ErrManifestUnsigned) {
+		t.Fatalf("Import unsigned err = %v, want ErrManifestUnsigned", err)
+	}
+
+	var warned error
+	err = importInto("unsigned-warn", unsignedPath, ImportOptions{
+		SignatureKeys:      [][]byte{key},
+		OnSignatureWarning: func(err error) { warned = err },
+	})
+	if err != nil {
+		t.Fatalf("Import unsigned without requirement failed: %v", err)
+	}
+	if !errors.Is(warned, ErrManifestUnsigned) {
+		t.Fatalf("warning = %v, want ErrManifestUnsigned", warned)
+	}
+
+	tampered := *manifest
+	tampered.CheckpointName = "tampered"
+	if err := VerifyManifestSignature(&tampered, [][]byte{key}); !errors.Is(err, ErrManifestSignatureInvalid) {
+		t.Fatalf("VerifyManifestSignature(tampered) = %v, want ErrManifestSignatureInvalid", err)
+	}
+}
+
+func TestExportImport_EncryptedArchive(t *testing.T) {
+	for _, format := range []ExportFormat{FormatTarGz, FormatZip} {
+		t.Run(string(format), func(t *testing.T) {
+			tmpDir := t.TempDir()
+			exportStorage := NewStorageWithDir(filepath.Join(tmpDir, "export"))
+
+			sessionName := "sealed-session"
+			checkpointID := "20251210-143052-sealed"
+			cp := &Checkpoint{
+				Version:     CurrentVersion,
+				ID:          checkpointID,
+				SessionName: sessionName,
+				CreatedAt:   time.Now(),
+				Session:     SessionState{Panes: []PaneState{{ID: "%0", Width: 80, Height: 24}}},
+				PaneCount:   1,
+			}
+			if err := exportStorage.Save(cp); err != nil {
+				t.Fatalf("Save failed: %v", err)
+			}
+			const secret = "scrollback-only-visible-after-decryption"
+			rel, err := exportStorage.SaveScrollback(sessionName, checkpointID, "%0", secret)
+			if err != nil {
+				t.Fatalf("SaveScrollback failed: %v", err)
+			}
+			cp.Session.Panes[0].ScrollbackFile = rel
+			if err := exportStorage.Save(cp); err != nil {
+				t.Fatalf("Save failed: %v", err)
+			}
+
+			key := bytes.Repeat([]byte{0x42}, 32)
+			otherKey := bytes.Repeat([]byte{0x17}, 32)
+
+			archive := filepath.Join(tmpDir, "sealed."+string(format))
+			opts := DefaultExportOptions()
+			opts.Format = format
+			opts.EncryptionKey = key
+			opts.SigningKey = key
+			manifest, err := exportStorage.Export(sessionName, checkpointID, archive, opts)
+			if err != nil {
+				t.Fatalf("Export failed: %v", err)
+			}
+			if !manifest.Encrypted || manifest.Cipher != ArchiveCipher {
+				t.Errorf("manifest Encrypted=%v Cipher=%q, want true %q", manifest.Encrypted, manifest.Cipher, ArchiveCipher)
+			}
+			data, err := os.ReadFile(archive)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.HasPrefix(data, []byte(encryptedArchiveMagic)) {
+				t.Errorf("archive does not start with the encrypted header")
+			}
+			if bytes.Contains(data, []byte(checkpointID)) {
+				t.Errorf("encrypted archive leaks the checkpoint ID in plaintext")
+			}
+
+			importInto := func(name string, keys ...[]byte) (*Checkpoint, *Storage, error) {
+				storage := NewStorageWithDir(filepath.Join(tmpDir, name))
+				opts := DefaultImportOptions()
+				opts.SignatureKeys = [][]byte{key}
+				opts.RequireSignature = true
+				opts.DecryptionKeys = func() ([][]byte, error) { return keys, nil }
+				cp, err := storage.Import(archive, opts)
+				return cp, storage, err
+			}
+
+			imported, storage, err := importInto("rotated", otherKey, key)
+			if err != nil {
+				t.Fatalf("Import with rotated keyring failed: %v", err)
+			}
+			got, err := storage.LoadScrollback(imported.SessionName, imported.ID, "%0")
+			if err != nil || got != secret {
+				t.Errorf("imported scrollback = %q, %v; want %q", got, err, secret)
+			}
+
+			if _, _, err := importInto("wrong-key", otherKey); !errors.Is(err, ErrArchiveWrongKey) {
+				t.Errorf("Import with wrong key err = %v, want ErrArchiveWrongKey", err)
+			}
+			if _, _, err := importInto("no-key"); err == nil || !strings.Contains(err.Error(), "no decryption key") {
+				t.Errorf("Import without key err = %v, want no decryption key", err)
+			}
+		})
+	}
+}
+
 func TestExportImport_RoundTrip(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "ntm-roundtrip-test")
 	if err != nil {
@@ -1104,6 +1310,76 @@ func TestExportImport_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestExportImport_IncludeReadme(t *testing.T) {
+	tmpDir := t.TempDir()
+	exportStorage := NewStorageWithDir(filepath.Join(tmpDir, "export"))
+	importStorage := NewStorageWithDir(filepath.Join(tmpDir, "import"))
+
+	cp := &Checkpoint{
+		Version:     CurrentVersion,
+		ID:          "20260101-120000-readme",
+		Name:        "before refactor",
+		SessionName: "readme-session",
+		WorkingDir:  "/test/project",
+		CreatedAt:   time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		Session: SessionState{
+			Panes: []PaneState{{ID: "%0", Index: 1, Title: "readme-session__cc_1", AgentType: "cc"}},
+		},
+		Git:       GitState{Branch: "main", Commit: "abc123", IsDirty: true, StagedCount: 1},
+		PaneCount: 1,
+	}
+	if err := exportStorage.Save(cp); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	archivePath := filepath.Join(tmpDir, "shared.zip")
+	opts := DefaultExportOptions()
+	opts.Format = FormatZip
+	opts.IncludeReadme = true
+	manifest, err := exportStorage.Export(cp.SessionName, cp.ID, archivePath, opts)
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	if _, ok := manifest.Checksums[ReadmeFile]; !ok {
+		t.Fatalf("manifest checksums missing %s", ReadmeFile)
+	}
+
+	r, err := zip.OpenReader(archivePath)
+	if err != nil {
+		t.Fatalf("open zip: %v", err)
+	}
+	var readme string
+	for _, f := range r.File {
+		if f.Name != ReadmeFile {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", ReadmeFile, err)
+		}
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+		readme = string(data)
+	}
+	r.Close()
+	for _, want := range []string{"# NTM checkpoint: before refactor", "`readme-session`", "2026-01-01T12:00:00Z", "| Panes | 1 |", "Branch: `main`", "ntm checkpoint import shared.zip"} {
+		if !strings.Contains(readme, want) {
+			t.Errorf("README missing %q:\n%s", want, readme)
+		}
+	}
+
+	if _, err := importStorage.Import(archivePath, DefaultImportOptions()); err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	cpDir, err := importStorage.safeCheckpointDir(cp.SessionName, cp.ID)
+	if err != nil {
+		t.Fatalf("safeCheckpointDir: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(cpDir, ReadmeFile)); !os.IsNotExist(err) {
+		t.Fatalf("imported checkpoint should not keep %s, stat err = %v", ReadmeFile, err)
+	}
+}
+
 func TestRedactSecrets(t *testing.T) {
 	SetRedactionConfig(&redaction.Config{
 		Mode:      redaction.ModeWarn,
@@ -1591,3 +1867,139 @@ func TestCheckpointSummary(t *testing.T) {
 		t.Errorf("Summary() = %q, want %q", got, "my-checkpoint (abc123)")
 	}
 }
+
+func TestImportAll_DedupesAcrossArchivesAndReportsFailures(t *testing.T) {
+	tmpDir := t.TempDir()
+	exportStorage := NewStorageWithDir(filepath.Join(tmpDir, "export"))
+	importStorage := NewStorageWithDir(filepath.Join(tmpDir, "import"))
+	archiveDir := filepath.Join(tmpDir, "archives")
+	if err := os.MkdirAll(archiveDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	shared := strings.Repeat("identical scrollback\n", 100)
+	for i, sessionName := range []string{"alpha", "beta"} {
+		checkpointID := fmt.Sprintf("20251210-14305%d-bulk", i)
+		cp := &Checkpoint{
+			Version:     CurrentVersion,
+			ID:          checkpointID,
+			SessionName: sessionName,
+			CreatedAt:   time.Now(),
+			Session:     SessionState{Panes: []PaneState{{ID: "%0", Index: 0}}},
+			PaneCount:   1,
+		}
+		if err := exportStorage.Save(cp); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+		scrollbackFile, err := exportStorage.SaveScrollback(sessionName, checkpointID, "%0", shared)
+		if err != nil {
+			t.Fatalf("SaveScrollback: %v", err)
+		}
+		cp.Session.Panes[0].ScrollbackFile = scrollbackFile
+		if err := exportStorage.Save(cp); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+		if _, err := exportStorage.Export(sessionName, checkpointID, filepath.Join(archiveDir, sessionName+".tar.gz"), DefaultExportOptions()); err != nil {
+			t.Fatalf("Export: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(archiveDir, "corrupt.zip"), []byte("not a zip"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(archiveDir, "notes.txt"), []byte("ignored"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := importStorage.ImportAll(archiveDir, DefaultImportOptions(), true)
+	if err != nil {
+		t.Fatalf("ImportAll: %v", err)
+	}
+	if result.Imported != 2 || result.Failed != 1 || len(result.Archives) != 3 {
+		t.Fatalf("result = %+v, want 2 imported, 1 failed, 3 archives", result)
+	}
+	if result.Archives[2].Archive != "corrupt.zip" || result.Archives[2].Error == "" {
+		t.Fatalf("corrupt archive entry = %+v, want error", result.Archives[2])
+	}
+	if result.DedupedFiles < 1 || result.BytesSaved < int64(len(shared)) {
+		t.Fatalf("dedupe = %d files / %d bytes, want at least the shared scrollback", result.DedupedFiles, result.BytesSaved)
+	}
+
+	alpha, err := importStorage.LoadScrollback("alpha", "20251210-143050-bulk", "%0")
+	if err != nil {
+		t.Fatalf("LoadScrollback(alpha): %v", err)
+	}
+	beta, err := importStorage.LoadScrollback("beta", "20251210-143051-bulk", "%0")
+	if err != nil {
+		t.Fatalf("LoadScrollback(beta): %v", err)
+	}
+	if alpha != shared || beta != shared {
+		t.Fatal("deduplicated scrollback content changed")
+	}
+	alphaInfo, _ := os.Stat(filepath.Join(importStorage.CheckpointDir("alpha", "20251210-143050-bulk"), PanesDir, "pane__0.txt"))
+	betaInfo, _ := os.Stat(filepath.Join(importStorage.CheckpointDir("beta", "20251210-143051-bulk"), PanesDir, "pane__0.txt"))
+	if alphaInfo == nil || betaInfo == nil || !os.SameFile(alphaInfo, betaInfo) {
+		t.Fatal("shared scrollback was not hard-linked across sessions")
+	}
+}
+
+func TestExportAll_ExportsSessionCheckpointsNewerThanCutoff(t *testing.T) {
+	tmpDir := t.TempDir()
+	storage := NewStorageWithDir(filepath.Join(tmpDir, "checkpoints"))
+	outDir := filepath.Join(tmpDir, "backup")
+
+	now := time.Now()
+	for i, age := range []time.Duration{72 * time.Hour, 2 * time.Hour, time.Hour} {
+		cp := &Checkpoint{
+			Version:     CurrentVersion,
+			ID:          fmt.Sprintf("20251210-14305%d-all", i),
+			SessionName: "alpha",
+			CreatedAt:   now.Add(-age),
+			Session:     SessionState{Panes: []PaneState{{ID: "%0", Index: 0}}},
+			PaneCount:   1,
+		}
+		if err := storage.Save(cp); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+
+	result, err := storage.ExportAll("alpha", outDir, now.Add(-24*time.Hour), DefaultExportOptions())
+	if err != nil {
+		t.Fatalf("ExportAll: %v", err)
+	}
+	if result.Exported != 2 || result.Failed != 0 || len(result.Checkpoints) != 2 {
+		t.Fatalf("result = %+v, want 2 exported", result)
+	}
+	// Oldest first.
+	if result.Checkpoints[0].CheckpointID != "20251210-143051-all" || result.Checkpoints[1].CheckpointID != "20251210-143052-all" {
+		t.Fatalf("checkpoints = %+v, want 143051 then 143052", result.Checkpoints)
+	}
+	var total int64
+	for _, entry := range result.Checkpoints {
+		want := filepath.Join(outDir, "alpha_"+entry.CheckpointID+".tar.gz")
+		if entry.Archive != want {
+			t.Errorf("Archive = %q, want %q", entry.Archive, want)
+		}
+		info, err := os.Stat(want)
+		if err != nil {
+			t.Fatalf("stat archive: %v", err)
+		}
+		if entry.Bytes != info.Size() {
+			t.Errorf("Bytes = %d, want %d", entry.Bytes, info.Size())
+		}
+		total += entry.Bytes
+	}
+	if result.TotalBytes != total || total == 0 {
+		t.Errorf("TotalBytes = %d, want %d", result.TotalBytes, total)
+	}
+
+	zipResult, err := storage.ExportAll("alpha", outDir, time.Time{}, ExportOptions{Format: FormatZip})
+	if err != nil {
+		t.Fatalf("ExportAll zip: %v", err)
+	}
+	if zipResult.Exported != 3 {
+		t.Fatalf("zip result = %+v, want all 3 exported", zipResult)
+	}
+	if _, err := os.Stat(filepath.Join(outDir, "alpha_20251210-143050-all.zip")); err != nil {
+		t.Errorf("zip archive missing: %v", err)
+	}
+}
@@ -250,7 +250,7 @@ func (c *Checkpoint) checkFiles(storage *Storage, dir string, result *IntegrityR
 	missingScrollback := 0
 	for _, pane := range c.Session.Panes {
 		if pane.ScrollbackFile != "" {
-			_, err := resolveExistingCheckpointArtifactPath(dir, pane.ScrollbackFile)
+			_, err := storage.resolveCheckpointArtifact(c, dir, pane.ScrollbackFile)
 			if err != nil {
 				missingScrollback++
 				if errors.Is(err, os.ErrNotExist) {
@@ -269,7 +269,7 @@ func (c *Checkpoint) checkFiles(storage *Storage, dir string, result *IntegrityR
 
 	// Check git patch if referenced
 	if c.Git.PatchFile != "" {
-		_, err := resolveExistingCheckpointArtifactPath(dir, c.Git.PatchFile)
+		_, err := storage.resolveCheckpointArtifact(c, dir, c.Git.PatchFile)
 		if err != nil {
 			result.FilesPresent = false
 			if errors.Is(err, os.ErrNotExist) {
@@ -284,7 +284,7 @@ func (c *Checkpoint) checkFiles(storage *Storage, dir string, result *IntegrityR
 	}
 
 	if c.Git.StatusFile != "" {
-		_, err := resolveExistingCheckpointArtifactPath(dir, c.Git.StatusFile)
+		_, err := storage.resolveCheckpointArtifact(c, dir, c.Git.StatusFile)
 		if err != nil {
 			result.FilesPresent = false
 			if errors.Is(err, os.ErrNotExist) {
@@ -384,7 +384,7 @@ func (c *Checkpoint) GenerateManifest(storage *Storage) (*FileManifest, error) {
 	// Hash scrollback files
 	for _, pane := range c.Session.Panes {
 		if pane.ScrollbackFile != "" {
-			path, err := resolveExistingCheckpointArtifactPath(dir, pane.ScrollbackFile)
+			path, err := storage.resolveCheckpointArtifact(c, dir, pane.ScrollbackFile)
 			if err != nil {
 				if errors.Is(err, os.ErrNotExist) {
 					return nil, fmt.Errorf("missing scrollback %s", pane.ScrollbackFile)
@@ -403,7 +403,7 @@ func (c *Checkpoint) GenerateManifest(storage *Storage) (*FileManifest, error) {
 
 	// Hash git patch if exists
 	if c.Git.PatchFile != "" {
-		path, err := resolveExistingCheckpointArtifactPath(dir, c.Git.PatchFile)
+		path, err := storage.resolveCheckpointArtifact(c, dir, c.Git.PatchFile)
 		if err != nil {
 			if errors.Is(err, os.ErrNotExist) {
 				return nil, fmt.Errorf("missing git patch %s", c.Git.PatchFile)
@@ -420,7 +420,7 @@ func (c *Checkpoint) GenerateManifest(storage *Storage) (*FileManifest, error) {
 	}
 
 	if c.Git.StatusFile != "" {
-		path, err := resolveExistingCheckpointArtifactPath(dir, c.Git.StatusFile)
+		path, err := storage.resolveCheckpointArtifact(c, dir, c.Git.StatusFile)
 		if err != nil {
 			if errors.Is(err, os.ErrNotExist) {
 				return nil, fmt.Errorf("missing git status %s", c.Git.StatusFile)
@@ -481,7 +481,7 @@ func (c *Checkpoint) VerifyManifest(storage *Storage, manifest *FileManifest) *I
 			continue
 		}
 
-		fullPath, err := resolveExistingCheckpointArtifactPath(dir, relPath)
+		fullPath, err := storage.resolveCheckpointArtifact(c, dir, relPath)
 		if err != nil {
 			result.FilesPresent = false
 			if errors.Is(err, os.ErrNotExist) {
@@ -607,6 +607,16 @@ func (c *Checkpoint) QuickCheck(storage *Storage) error {
 				errs = append(errs, fmt.Errorf("invalid session.json: %w", err))
 			}
 		}
+		if c.ParentID != "" {
+			if _, err := storage.parentChainDepth(c); err != nil {
+				errs = append(errs, fmt.Errorf("broken parent chain: %w", err))
+			}
+			for _, rel := range c.ChangedFiles {
+				if _, err := resolveExistingCheckpointArtifactPath(dir, rel); err != nil {
+					errs = append(errs, errors.New(formatArtifactCheckError(rel, err)))
+				}
+			}
+		}
 	}
 
 	if len(errs) == 0 {
@@ -281,6 +281,34 @@ func TestParseGitStatus_OnlyStaged(t *testing.T) {
 	}
 }
 
+func TestParseGitStatusFiles(t *testing.T) {
+	t.Parallel()
+	status := "On branch main\n" +
+		"Changes to be committed:\n" +
+		"  (use \"git restore --staged <file>...\" to unstage)\n" +
+		"\tnew file:   added.go\n" +
+		"\tmodified:   both.go\n" +
+		"\trenamed:    old.go -> new.go\n" +
+		"\n" +
+		"Changes not staged for commit:\n" +
+		"\tmodified:   both.go\n" +
+		"\tdeleted:    gone.go\n" +
+		"\n" +
+		"Untracked files:\n" +
+		"  (use \"git add <file>...\" to include in what will be committed)\n" +
+		"\tscratch.txt\n"
+	created, modified, deleted := ParseGitStatusFiles(status)
+	if got := strings.Join(created, ","); got != "added.go,new.go,scratch.txt" {
+		t.Errorf("created = %q", got)
+	}
+	if got := strings.Join(modified, ","); got != "both.go" {
+		t.Errorf("modified = %q", got)
+	}
+	if got := strings.Join(deleted, ","); got != "old.go,gone.go" {
+		t.Errorf("deleted = %q", got)
+	}
+}
+
 // =============================================================================
 // countLines — 0% → 100%
 // =============================================================================
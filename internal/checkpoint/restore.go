@@ -1063,7 +1063,7 @@ func (r *Restorer) ValidateCheckpoint(cp *Checkpoint, opts RestoreOptions) []str
 				if pane.ScrollbackFile == "" {
 					continue
 				}
-				scrollbackPath, err := resolveExistingCheckpointArtifactPath(baseDir, pane.ScrollbackFile)
+				scrollbackPath, err := r.storage.resolveCheckpointArtifact(cp, baseDir, pane.ScrollbackFile)
 				if err != nil {
 					if errors.Is(err, os.ErrNotExist) {
 						issues = append(issues,
@@ -237,11 +237,7 @@ func (s *Storage) SaveCompressedScrollback(sessionName, checkpointID, paneID str
 func (s *Storage) LoadCompressedScrollback(sessionName, checkpointID, paneID string) (string, error) {
 	// Try compressed file first
 	filename := fmt.Sprintf("pane_%s.txt.gz", sanitizeName(paneID))
-	dir, err := s.safeCheckpointDir(sessionName, checkpointID)
-	if err != nil {
-		return "", err
-	}
-	fullPath, err := resolveExistingCheckpointArtifactPath(dir, filepath.Join(PanesDir, filename))
+	fullPath, err := s.checkpointArtifactPath(sessionName, checkpointID, filepath.Join(PanesDir, filename))
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			// Fall back to uncompressed file
@@ -267,11 +263,7 @@ func (s *Storage) LoadCompressedScrollback(sessionName, checkpointID, paneID str
 // when present, falling back to the canonical pane-ID location otherwise.
 func (s *Storage) LoadPaneScrollback(sessionName, checkpointID string, pane PaneState) (string, error) {
 	if pane.ScrollbackFile != "" {
-		baseDir, err := s.safeCheckpointDir(sessionName, checkpointID)
-		if err != nil {
-			return "", err
-		}
-		scrollbackPath, err := resolveExistingCheckpointArtifactPath(baseDir, pane.ScrollbackFile)
+		scrollbackPath, err := s.checkpointArtifactPath(sessionName, checkpointID, pane.ScrollbackFile)
 		if err != nil {
 			return "", fmt.Errorf("resolving scrollback path: %w", err)
 		}
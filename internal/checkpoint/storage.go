@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -30,6 +31,10 @@ const (
 	GitStatusFile = "git-status.txt"
 	// PanesDir is the subdirectory for pane scrollback captures
 	PanesDir = "panes"
+	// maxParentChainDepth bounds how many ancestors an incremental
+	// checkpoint may have; SaveIncremental starts a fresh full checkpoint
+	// past it, and chain walks treat anything deeper as a cycle
+	maxParentChainDepth = 32
 )
 
 var checkpointIDRegex = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
@@ -141,7 +146,7 @@ func directoryLikeEntry(entry os.DirEntry) bool {
 }
 
 func selectionCandidateEntry(entry os.DirEntry) bool {
-	if entry.Name() == "incremental" {
+	if entry.Name() == "incremental" || entry.Name() == AssignmentsFile {
 		return false
 	}
 	return validateCheckpointID(entry.Name()) == nil
@@ -364,6 +369,138 @@ func (s *Storage) Save(cp *Checkpoint) error {
 	return nil
 }
 
+// SaveIncremental writes cp as a delta against parentID. cp's artifacts must
+// already be on disk in its own directory; those whose contents match what
+// the parent's chain holds are removed and read back through the chain, and
+// only the changed ones are kept and recorded in ChangedFiles. When the
+// parent's chain is already maxParentChainDepth long, cp is saved in full.
+func (s *Storage) SaveIncremental(cp *Checkpoint, parentID string) error {
+	if cp.ParentID != "" {
+		return fmt.Errorf("checkpoint %s is already incremental", cp.ID)
+	}
+	if parentID == cp.ID {
+		return fmt.Errorf("checkpoint %s cannot be its own parent", cp.ID)
+	}
+	parent, err := s.Load(cp.SessionName, parentID)
+	if err != nil {
+		return fmt.Errorf("loading parent checkpoint: %w", err)
+	}
+	depth, err := s.parentChainDepth(parent)
+	if err != nil {
+		return err
+	}
+	if depth+1 >= maxParentChainDepth {
+		return s.Save(cp)
+	}
+	parentManifest, err := parent.GenerateManifest(s)
+	if err != nil {
+		return fmt.Errorf("hashing parent checkpoint %s: %w", parentID, err)
+	}
+
+	dir, err := s.safeCheckpointDir(cp.SessionName, cp.ID)
+	if err != nil {
+		return err
+	}
+	changed := []string{}
+	var unchanged []string
+	for _, rel := range cp.artifactFiles() {
+		path, err := resolveExistingCheckpointArtifactPath(dir, rel)
+		if err != nil {
+			return err
+		}
+		hash, err := hashFile(path)
+		if err != nil {
+			return fmt.Errorf("hashing %s: %w", rel, err)
+		}
+		if parentManifest.Files[rel] == hash {
+			unchanged = append(unchanged, path)
+		} else {
+			changed = append(changed, rel)
+		}
+	}
+
+	cp.ParentID = parentID
+	cp.ChangedFiles = changed
+	if err := s.Save(cp); err != nil {
+		return err
+	}
+	for _, path := range unchanged {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing unchanged checkpoint artifact %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// parentChainDepth returns how many ancestors cp has, failing when one is
+// missing or unreadable or the chain loops.
+func (s *Storage) parentChainDepth(cp *Checkpoint) (int, error) {
+	depth := 0
+	for cur := cp; cur.ParentID != ""; depth++ {
+		if depth >= maxParentChainDepth {
+			return 0, fmt.Errorf("parent chain of %s is longer than %d checkpoints (cycle?)", cp.ID, maxParentChainDepth)
+		}
+		if cur.ParentID == cur.ID {
+			return 0, fmt.Errorf("checkpoint %s is its own parent", cur.ID)
+		}
+		parent, err := s.Load(cp.SessionName, cur.ParentID)
+		if err != nil {
+			return 0, fmt.Errorf("checkpoint %s: parent %s: %w", cur.ID, cur.ParentID, err)
+		}
+		cur = parent
+	}
+	return depth, nil
+}
+
+// artifactOwner returns the checkpoint in cp's chain whose directory holds
+// relPath: cp itself, or the nearest ancestor that stored it.
+func (s *Storage) artifactOwner(cp *Checkpoint, relPath string) (*Checkpoint, error) {
+	owner := cp
+	for depth := 0; !owner.storesArtifact(relPath); depth++ {
+		if depth >= maxParentChainDepth {
+			return nil, fmt.Errorf("parent chain of %s is longer than %d checkpoints (cycle?)", cp.ID, maxParentChainDepth)
+		}
+		parent, err := s.Load(cp.SessionName, owner.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("checkpoint %s: parent %s: %w", owner.ID, owner.ParentID, err)
+		}
+		owner = parent
+	}
+	return owner, nil
+}
+
+// resolveCheckpointArtifact resolves relPath for cp, whose own directory is
+// dir, following the parent chain when cp inherited the artifact.
+func (s *Storage) resolveCheckpointArtifact(cp *Checkpoint, dir, relPath string) (string, error) {
+	if cp.storesArtifact(relPath) {
+		return resolveExistingCheckpointArtifactPath(dir, relPath)
+	}
+	owner, err := s.artifactOwner(cp, relPath)
+	if err != nil {
+		return "", err
+	}
+	ownerDir, err := s.safeCheckpointDir(owner.SessionName, owner.ID)
+	if err != nil {
+		return "", err
+	}
+	return resolveExistingCheckpointArtifactPath(ownerDir, relPath)
+}
+
+// checkpointArtifactPath resolves relPath for a stored checkpoint, following
+// an incremental checkpoint's parent chain.
+func (s *Storage) checkpointArtifactPath(sessionName, checkpointID, relPath string) (string, error) {
+	dir, err := s.safeCheckpointDir(sessionName, checkpointID)
+	if err != nil {
+		return "", err
+	}
+	if checkpointStateExists(dir) {
+		if cp, err := s.Load(sessionName, checkpointID); err == nil {
+			return s.resolveCheckpointArtifact(cp, dir, relPath)
+		}
+	}
+	return resolveExistingCheckpointArtifactPath(dir, relPath)
+}
+
 func resolveCheckpointArtifactPathSet(baseDir string, cp *Checkpoint) (map[string]struct{}, error) {
 	paths := make(map[string]struct{})
 	if cp == nil {
@@ -374,7 +511,7 @@ func resolveCheckpointArtifactPathSet(baseDir string, cp *Checkpoint) (map[strin
 	}
 
 	for _, pane := range cp.Session.Panes {
-		if pane.ScrollbackFile == "" {
+		if pane.ScrollbackFile == "" || !cp.storesArtifact(pane.ScrollbackFile) {
 			continue
 		}
 		resolvedPath, err := resolveExistingCheckpointArtifactPath(baseDir, pane.ScrollbackFile)
@@ -383,14 +520,14 @@ func resolveCheckpointArtifactPathSet(baseDir string, cp *Checkpoint) (map[strin
 		}
 		paths[resolvedPath] = struct{}{}
 	}
-	if cp.Git.PatchFile != "" {
+	if cp.Git.PatchFile != "" && cp.storesArtifact(cp.Git.PatchFile) {
 		resolvedPath, err := resolveExistingCheckpointArtifactPath(baseDir, cp.Git.PatchFile)
 		if err != nil {
 			return nil, fmt.Errorf("invalid git patch path %q: %w", cp.Git.PatchFile, err)
 		}
 		paths[resolvedPath] = struct{}{}
 	}
-	if cp.Git.StatusFile != "" {
+	if cp.Git.StatusFile != "" && cp.storesArtifact(cp.Git.StatusFile) {
 		resolvedPath, err := resolveExistingCheckpointArtifactPath(baseDir, cp.Git.StatusFile)
 		if err != nil {
 			return nil, fmt.Errorf("invalid git status path %q: %w", cp.Git.StatusFile, err)
@@ -446,6 +583,20 @@ func pruneCheckpointArtifactPaths(previous, current map[string]struct{}) error {
 	return nil
 }
 
+// Annotate updates a stored checkpoint's tags and note (see
+// Checkpoint.Annotate) and writes the metadata back.
+func (s *Storage) Annotate(sessionName, checkpointID string, addTags, removeTags []string, note *string) (*Checkpoint, error) {
+	cp, err := s.Load(sessionName, checkpointID)
+	if err != nil {
+		return nil, err
+	}
+	cp.Annotate(addTags, removeTags, note)
+	if err := s.Save(cp); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
 // Load reads a checkpoint from disk.
 func (s *Storage) Load(sessionName, checkpointID string) (*Checkpoint, error) {
 	dir, err := s.safeCheckpointDir(sessionName, checkpointID)
@@ -606,6 +757,22 @@ func (s *Storage) ListAll() ([]*Checkpoint, error) {
 	return all, nil
 }
 
+// FindByTag returns the checkpoints across all sessions that carry tag
+// (case-insensitive), newest first.
+func (s *Storage) FindByTag(tag string) ([]*Checkpoint, error) {
+	all, err := s.ListAll()
+	if err != nil {
+		return nil, err
+	}
+	var matched []*Checkpoint
+	for _, cp := range all {
+		if cp.HasTag(tag) {
+			matched = append(matched, cp)
+		}
+	}
+	return matched, nil
+}
+
 func checkpointNewerFirst(a, b *Checkpoint) bool {
 	if a.CreatedAt.Equal(b.CreatedAt) {
 		if a.SessionName != b.SessionName {
@@ -771,11 +938,79 @@ func (s *Storage) deleteCheckpointPath(sessionName, checkpointID string) error {
 	return os.RemoveAll(filepath.Join(sessionDir, checkpointID))
 }
 
-// Delete removes a checkpoint from disk.
+// Delete removes a checkpoint from disk. Incremental checkpoints saved
+// against it are first rebased onto its parent, taking copies of the
+// artifacts they inherited from it, so their chains stay intact.
 func (s *Storage) Delete(sessionName, checkpointID string) error {
+	if err := s.rebaseChildren(sessionName, checkpointID); err != nil {
+		return fmt.Errorf("rebasing incremental checkpoints of %s: %w", checkpointID, err)
+	}
 	return s.deleteCheckpointPath(sessionName, checkpointID)
 }
 
+func (s *Storage) rebaseChildren(sessionName, checkpointID string) error {
+	doomed, err := s.Load(sessionName, checkpointID)
+	if err != nil {
+		// Nothing readable to inherit from.
+		return nil
+	}
+	doomedDir, err := s.safeCheckpointDir(sessionName, checkpointID)
+	if err != nil {
+		return err
+	}
+	checkpoints, err := s.List(sessionName)
+	if err != nil {
+		return err
+	}
+	for _, child := range checkpoints {
+		if child.ParentID != checkpointID {
+			continue
+		}
+		childDir, err := s.safeCheckpointDir(sessionName, child.ID)
+		if err != nil {
+			return err
+		}
+		changed := slices.Clone(child.ChangedFiles)
+		for _, rel := range child.artifactFiles() {
+			if child.storesArtifact(rel) {
+				continue
+			}
+			owner, err := s.artifactOwner(doomed, rel)
+			if err != nil || owner.ID != doomed.ID {
+				// Still reachable through the new parent, or already lost
+				// to a broken chain further up.
+				continue
+			}
+			src, err := resolveExistingCheckpointArtifactPath(doomedDir, rel)
+			if err != nil {
+				return err
+			}
+			data, err := os.ReadFile(src)
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", rel, err)
+			}
+			dst, err := resolveCheckpointRelativePath(childDir, rel)
+			if err != nil {
+				return err
+			}
+			if err := util.AtomicWriteFile(dst, data, 0600); err != nil {
+				return fmt.Errorf("copying %s into %s: %w", rel, child.ID, err)
+			}
+			changed = append(changed, rel)
+		}
+		child.ParentID = doomed.ParentID
+		child.ChangedFiles = nil
+		if child.ParentID != "" {
+			sort.Strings(changed)
+			child.ChangedFiles = changed
+		}
+		if err := s.Save(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetLatest returns the most recent checkpoint for a session.
 func (s *Storage) GetLatest(sessionName string) (*Checkpoint, error) {
 	return s.getByRecentIndex(sessionName, 1)
@@ -806,11 +1041,7 @@ func (s *Storage) SaveScrollback(sessionName, checkpointID string, paneID string
 // LoadScrollback reads pane scrollback from a file.
 func (s *Storage) LoadScrollback(sessionName, checkpointID string, paneID string) (string, error) {
 	filename := fmt.Sprintf("pane_%s.txt", sanitizeName(paneID))
-	dir, err := s.safeCheckpointDir(sessionName, checkpointID)
-	if err != nil {
-		return "", err
-	}
-	fullPath, err := resolveExistingCheckpointArtifactPath(dir, filepath.Join(PanesDir, filename))
+	fullPath, err := s.checkpointArtifactPath(sessionName, checkpointID, filepath.Join(PanesDir, filename))
 	if err != nil {
 		return "", fmt.Errorf("resolving scrollback path: %w", err)
 	}
@@ -845,12 +1076,12 @@ func (s *Storage) loadGitArtifact(sessionName, checkpointID, relPath, defaultNam
 		return "", err
 	}
 
-	target := defaultName
+	// An unrecorded artifact is only looked for under its default name in
+	// the checkpoint's own directory, never inherited from a parent.
+	path, err := resolveExistingCheckpointArtifactPath(dir, defaultName)
 	if relPath != "" {
-		target = relPath
+		path, err = s.checkpointArtifactPath(sessionName, checkpointID, relPath)
 	}
-
-	path, err := resolveExistingCheckpointArtifactPath(dir, target)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) && relPath == "" {
 			return "", nil
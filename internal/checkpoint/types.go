@@ -4,7 +4,10 @@
 package checkpoint
 
 import (
+	"path/filepath"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/Dicklesworthstone/ntm/internal/tmux"
@@ -20,6 +23,12 @@ type Checkpoint struct {
 	Name string `json:"name"`
 	// Description is an optional user description
 	Description string `json:"description,omitempty"`
+	// Tags are user labels set by `ntm checkpoint save --tag` or added with
+	// `ntm checkpoint annotate`; tagged auto-checkpoints are exempt from
+	// rotation
+	Tags []string `json:"tags,omitempty"`
+	// Note is a free-form user annotation
+	Note string `json:"note,omitempty"`
 	// SessionName is the tmux session this checkpoint belongs to
 	SessionName string `json:"session_name"`
 	// WorkingDir is the working directory at checkpoint time
@@ -40,6 +49,13 @@ type Checkpoint struct {
 	// BVSummary contains BV triage summary at checkpoint time (bd-32ck)
 	// This field is optional for backward compatibility with older checkpoints.
 	BVSummary *BVSnapshot `json:"bv_summary,omitempty"`
+
+	// ParentID is set on incremental checkpoints: the checkpoint whose chain
+	// holds the artifacts this one did not store (see Storage.SaveIncremental)
+	ParentID string `json:"parent_id,omitempty"`
+	// ChangedFiles lists the artifacts an incremental checkpoint stores in
+	// its own directory; every other artifact is read through ParentID
+	ChangedFiles []string `json:"changed_files,omitempty"`
 }
 
 // AssignmentSnapshot captures bead assignment state for checkpointing.
@@ -51,6 +67,9 @@ type AssignmentSnapshot struct {
 	BeadTitle string `json:"bead_title"`
 	// Pane is the pane index where the agent is working
 	Pane int `json:"pane"`
+	// PaneID is the tmux pane ID (%N), when known; it identifies the pane
+	// more reliably than Pane when reconciling against live panes
+	PaneID string `json:"pane_id,omitempty"`
 	// AgentType is the agent type (cc, cod, gmi)
 	AgentType string `json:"agent_type"`
 	// AgentName is the Agent Mail name if registered
@@ -59,6 +78,8 @@ type AssignmentSnapshot struct {
 	Status string `json:"status"`
 	// AssignedAt is when the bead was assigned
 	AssignedAt time.Time `json:"assigned_at"`
+	// PromptSent records whether the assignment prompt reached the pane
+	PromptSent bool `json:"prompt_sent,omitempty"`
 }
 
 // BVSnapshot captures BV triage state at checkpoint time.
@@ -187,6 +208,70 @@ func (c *Checkpoint) HasGitPatch() bool {
 	return c.Git.PatchFile != ""
 }
 
+// IsIncremental reports whether the checkpoint stores only the artifacts
+// that changed since its parent.
+func (c *Checkpoint) IsIncremental() bool {
+	return c.ParentID != ""
+}
+
+// storesArtifact reports whether relPath lives in the checkpoint's own
+// directory rather than being inherited from its parent chain.
+func (c *Checkpoint) storesArtifact(relPath string) bool {
+	relPath = filepath.Clean(relPath)
+	if c.ParentID == "" || relPath == MetadataFile || relPath == SessionFile {
+		return true
+	}
+	return slices.ContainsFunc(c.ChangedFiles, func(f string) bool { return filepath.Clean(f) == relPath })
+}
+
+// artifactFiles returns the checkpoint's referenced artifacts (scrollback and
+// git files, but not metadata.json or session.json), sorted.
+func (c *Checkpoint) artifactFiles() []string {
+	var files []string
+	for rel := range expectedManifestFiles(c) {
+		if rel != MetadataFile && rel != SessionFile {
+			files = append(files, rel)
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
+// HasTag reports whether the checkpoint carries tag (case-insensitive).
+func (c *Checkpoint) HasTag(tag string) bool {
+	tag = strings.TrimSpace(tag)
+	for _, t := range c.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Annotate adds and removes tags and, when note is non-nil, replaces the
+// note. Tags are trimmed, de-duplicated case-insensitively, and kept sorted.
+func (c *Checkpoint) Annotate(addTags, removeTags []string, note *string) {
+	tags := make([]string, 0, len(c.Tags)+len(addTags))
+	for _, t := range append(slices.Clone(c.Tags), addTags...) {
+		t = strings.TrimSpace(t)
+		if t == "" || slices.ContainsFunc(tags, func(have string) bool { return strings.EqualFold(have, t) }) {
+			continue
+		}
+		if slices.ContainsFunc(removeTags, func(rm string) bool { return strings.EqualFold(strings.TrimSpace(rm), t) }) {
+			continue
+		}
+		tags = append(tags, t)
+	}
+	sort.Strings(tags)
+	if len(tags) == 0 {
+		tags = nil
+	}
+	c.Tags = tags
+	if note != nil {
+		c.Note = strings.TrimSpace(*note)
+	}
+}
+
 // FromTmuxPane converts a tmux.Pane to PaneState.
 func FromTmuxPane(p tmux.Pane) PaneState {
 	return PaneState{
@@ -271,6 +356,8 @@ type checkpointOptions struct {
 	scrollbackMaxSizeMB int
 	captureAssignments  bool // bd-32ck: capture bead-to-agent assignments
 	captureBVSnapshot   bool // bd-32ck: capture BV triage summary
+	parentID            string
+	tags                []string
 }
 
 // WithDescription sets the checkpoint description.
@@ -323,6 +410,21 @@ func WithBVSnapshot(capture bool) CheckpointOption {
 	}
 }
 
+// WithParent saves the checkpoint incrementally against parentID, storing
+// only the artifacts that changed since it.
+func WithParent(parentID string) CheckpointOption {
+	return func(o *checkpointOptions) {
+		o.parentID = parentID
+	}
+}
+
+// WithTags labels the checkpoint at creation, normalized like Annotate.
+func WithTags(tags ...string) CheckpointOption {
+	return func(o *checkpointOptions) {
+		o.tags = append(o.tags, tags...)
+	}
+}
+
 func defaultOptions() checkpointOptions {
 	return checkpointOptions{
 		captureGit:          true,
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Dicklesworthstone/ntm/internal/cli/tiers"
+	"github.com/Dicklesworthstone/ntm/internal/config"
+	"github.com/Dicklesworthstone/ntm/internal/output"
+)
+
+// AchievementStatus is one entry of `ntm achievements`.
+type AchievementStatus struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Unlocked    bool       `json:"unlocked"`
+	UnlockedAt  *time.Time `json:"unlocked_at,omitempty"`
+	Progress    int        `json:"progress"`
+	Threshold   int        `json:"threshold"`
+}
+
+// AchievementsResult is the JSON output of `ntm achievements`.
+type AchievementsResult struct {
+	Unlocked     int                 `json:"unlocked"`
+	Total        int                 `json:"total"`
+	Achievements []AchievementStatus `json:"achievements"`
+}
+
+func newAchievementsCmd() *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "achievements",
+		Short: "List achievements and your progress toward them",
+		Long: `List named achievements, which unlock as you use ntm: spawning sessions,
+sending prompts, running ensembles, and restoring checkpoints. A one-line
+notice is printed when a command unlocks a new one.
+
+Progress is stored in the ntm state dir ($XDG_STATE_HOME/ntm or
+~/.local/state/ntm).
+
+Examples:
+  ntm achievements
+  ntm achievements --format=json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch format {
+			case "text", "json":
+			default:
+				return fmt.Errorf("invalid --format %q (want text or json)", format)
+			}
+			return runAchievements(cmd.OutOrStdout(), jsonOutput || format == "json")
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "text", "Output format: text or json")
+	return cmd
+}
+
+func runAchievements(w io.Writer, asJSON bool) error {
+	cfg, err := config.LoadProficiency()
+	if err != nil {
+		return fmt.Errorf("failed to load proficiency config: %w", err)
+	}
+	// Catch up on anything earned before achievements were tracked.
+	toast := w
+	if asJSON {
+		toast = io.Discard
+	}
+	if err := unlockAchievements(toast, cfg.GetUsageStats()); err != nil {
+		return err
+	}
+	state, err := config.LoadAchievements()
+	if err != nil {
+		return err
+	}
+
+	result := buildAchievementsResult(cfg.GetUsageStats(), state)
+	if asJSON {
+		return output.WriteJSON(w, result, true)
+	}
+
+	fmt.Fprintf(w, "Achievements: %d of %d unlocked\n\n", result.Unlocked, result.Total)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, a := range result.Achievements {
+		mark, detail := "[ ]", fmt.Sprintf("%d/%d", a.Progress, a.Threshold)
+		if a.Unlocked {
+			mark, detail = "[x]", a.UnlockedAt.Local().Format("2006-01-02")
+		}
+		fmt.Fprintf(tw, "%s %s\t%s\t%s\n", mark, a.Name, a.Description, detail)
+	}
+	return tw.Flush()
+}
+
+func buildAchievementsResult(stats config.UsageStats, state *config.AchievementsState) AchievementsResult {
+	result := AchievementsResult{
+		Total:        len(tiers.Achievements),
+		Achievements: make([]AchievementStatus, 0, len(tiers.Achievements)),
+	}
+	for _, a := range tiers.Achievements {
+		status := AchievementStatus{
+			ID:          a.ID,
+			Name:        a.Name,
+			Description: a.Description,
+			Progress:    min(stats.Counter(a.Counter), a.Threshold),
+			Threshold:   a.Threshold,
+		}
+		if at, ok := state.Unlocked[a.ID]; ok {
+			status.Unlocked = true
+			status.UnlockedAt = &at
+			status.Progress = a.Threshold
+			result.Unlocked++
+		}
+		result.Achievements = append(result.Achievements, status)
+	}
+	return result
+}
+
+// unlockAchievements records achievements newly earned by stats and writes
+// a one-line notice for each to w.
+func unlockAchievements(w io.Writer, stats config.UsageStats) error {
+	state, err := config.LoadAchievements()
+	if err != nil {
+		return err
+	}
+	unlocked := state.Unlock(stats, time.Now())
+	if len(unlocked) == 0 {
+		return nil
+	}
+	if err := state.Save(); err != nil {
+		return err
+	}
+	for _, a := range unlocked {
+		fmt.Fprintf(w, "Achievement unlocked: %s (%s)\n", a.Name, a.Description)
+	}
+	return nil
+}
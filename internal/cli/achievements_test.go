@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/ntm/internal/config"
+)
+
+func TestRecordProficiencyActionUnlocksAchievements(t *testing.T) {
+	cleanup := setupTestProficiency(t)
+	defer cleanup()
+
+	recordProficiencyAction((*config.ProficiencyConfig).IncrementCheckpointRestore)
+
+	var out strings.Builder
+	if err := runAchievements(&out, false); err != nil {
+		t.Fatalf("runAchievements: %v", err)
+	}
+	for _, want := range []string{"1 of ", "[x] Recovered from checkpoint", "[ ] Sent 100 prompts", "0/100"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	if err := runAchievements(&out, true); err != nil {
+		t.Fatalf("runAchievements json: %v", err)
+	}
+	var result AchievementsResult
+	if err := json.Unmarshal([]byte(out.String()), &result); err != nil {
+		t.Fatalf("parse JSON: %v\n%s", err, out.String())
+	}
+	if result.Unlocked != 1 || result.Total != len(result.Achievements) {
+		t.Errorf("result = %+v", result)
+	}
+	for _, a := range result.Achievements {
+		if a.ID == "checkpoint-recovery" && (!a.Unlocked || a.UnlockedAt == nil) {
+			t.Errorf("checkpoint-recovery = %+v, want unlocked", a)
+		}
+	}
+}
+
+func TestRunAchievementsCatchesUpOnEarlierUsage(t *testing.T) {
+	cleanup := setupTestProficiency(t)
+	defer cleanup()
+
+	cfg, _ := config.LoadProficiency()
+	if err := cfg.IncrementSession(); err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+	if err := runAchievements(&out, false); err != nil {
+		t.Fatalf("runAchievements: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "Achievement unlocked: Spawned first session") {
+		t.Errorf("output = %q, want unlock notice first", out.String())
+	}
+}
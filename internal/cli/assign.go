@@ -12,6 +12,7 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -25,6 +26,7 @@ import (
 	"github.com/Dicklesworthstone/ntm/internal/assign"
 	"github.com/Dicklesworthstone/ntm/internal/assignment"
 	"github.com/Dicklesworthstone/ntm/internal/bv"
+	"github.com/Dicklesworthstone/ntm/internal/checkpoint"
 	"github.com/Dicklesworthstone/ntm/internal/completion"
 	"github.com/Dicklesworthstone/ntm/internal/config"
 	dispatchsvc "github.com/Dicklesworthstone/ntm/internal/dispatch"
@@ -39,21 +41,24 @@ import (
 )
 
 var (
-	assignAuto         bool
-	assignStrategy     string
-	assignBeads        string
-	assignLimit        int
-	assignAgentType    string // Filter by agent type
-	assignCCOnly       bool   // Alias for --agent=claude
-	assignCodOnly      bool   // Alias for --agent=codex
-	assignGmiOnly      bool   // Alias for --agent=gemini
-	assignTemplate     string // Prompt template: impl, review, custom
-	assignTemplateFile string // Custom template file path
-	assignVerbose      bool
-	assignQuiet        bool
-	assignTimeout      time.Duration
-	assignDryRun       bool // Alias for no --auto
-	assignReserveFiles bool // Enable Agent Mail file reservations
+	assignAuto          bool
+	assignStrategy      string
+	assignBeads         string
+	assignLimit         int
+	assignMinConfidence float64 // Leave beads unassigned when their best match scores below this
+	assignMaxConcurrent int     // Per-agent cap on held beads (0 = unlimited)
+	assignAgentType     string  // Filter by agent type
+	assignCCOnly        bool    // Alias for --agent=claude
+	assignCodOnly       bool    // Alias for --agent=codex
+	assignGmiOnly       bool    // Alias for --agent=gemini
+	assignTemplate      string  // Prompt template: impl, review, custom
+	assignTemplateFile  string  // Custom template file path
+	assignVerbose       bool
+	assignExplain       bool // Show per-assignment confidence breakdown
+	assignQuiet         bool
+	assignTimeout       time.Duration
+	assignDryRun        bool // Alias for no --auto
+	assignReserveFiles  bool // Enable Agent Mail file reservations
 
 	// Direct pane assignment flags
 	assignPane       string // Direct pane assignment using canonical N, W.P, or %N grammar
@@ -81,6 +86,7 @@ var (
 	// Retry flags for retrying failed assignments
 	assignRetry       string // Bead ID to retry
 	assignRetryFailed bool   // Retry all failed assignments
+	assignResume      bool   // Show assignments persisted by the last run
 
 	// Repository binding (issue #123): explicitly pin the bead-source path so
 	// `ntm assign <session>` returns the same ready-bead set regardless of the
@@ -217,6 +223,7 @@ Strategies:
   quality     - Prioritize agent-task match quality
   dependency  - Prioritize unblocking downstream work
   round-robin - Deterministic even distribution
+  load        - Prefer idle agents, then the least-loaded busy one
 
 Prompt Templates:
   impl   - "Work on bead {BEAD_ID}: {TITLE}. Check dependencies first."
@@ -271,11 +278,19 @@ Retry Failed Assignments:
   ntm assign myproject --retry bd-xyz --to-pane=4            # Retry to specific pane
   ntm assign myproject --retry-failed --to-type=claude       # Retry all to claude agents
 
+Resume After a Restart:
+  Generated assignments are saved beside the session's checkpoints. Use --resume
+  to list them with their status and whether the prompt was sent; assignments
+  whose pane no longer exists are marked failed.
+
+  ntm assign myproject --resume
+
 Examples:
   ntm assign myproject                         # Show assignment recommendations
   ntm assign myproject --auto                  # Execute assignments without confirmation
   ntm assign myproject --strategy=quality      # Use quality-focused matching
   ntm assign myproject --strategy=round-robin  # Even distribution
+  ntm assign myproject --explain               # Show confidence factors per assignment
   ntm assign myproject --beads=bd-123,bd-456   # Assign specific beads only
   ntm assign myproject --limit=5               # Limit to 5 assignments
   ntm assign myproject --cc-only               # Only assign to Claude agents
@@ -297,9 +312,11 @@ Examples:
 
 	// Core flags
 	cmd.Flags().BoolVar(&assignAuto, "auto", false, "Execute assignments without confirmation")
-	cmd.Flags().StringVar(&assignStrategy, "strategy", "balanced", "Assignment strategy: balanced, speed, quality, dependency, round-robin")
+	cmd.Flags().StringVar(&assignStrategy, "strategy", "balanced", "Assignment strategy: balanced, speed, quality, dependency, round-robin, load")
 	cmd.Flags().StringVar(&assignBeads, "beads", "", "Comma-separated list of specific bead IDs to assign")
 	cmd.Flags().IntVar(&assignLimit, "limit", 0, "Maximum number of assignments (0 = unlimited)")
+	cmd.Flags().Float64Var(&assignMinConfidence, "min-confidence", 0, "Leave beads unassigned when their best agent match scores below this (quality, dependency)")
+	cmd.Flags().IntVar(&assignMaxConcurrent, "max-concurrent", 0, "Maximum beads one agent may hold, including active assignments (0 = unlimited)")
 
 	// Agent type filters
 	cmd.Flags().StringVar(&assignAgentType, "agent", "", "Filter by agent type: any (no filter), claude, codex, gemini")
@@ -313,6 +330,7 @@ Examples:
 
 	// Common flags
 	cmd.Flags().BoolVarP(&assignVerbose, "verbose", "v", false, "Show detailed scoring/decision logs")
+	cmd.Flags().BoolVar(&assignExplain, "explain", false, "Show the factors behind each assignment's confidence score")
 	cmd.Flags().BoolVarP(&assignQuiet, "quiet", "q", false, "Suppress non-essential output")
 	cmd.Flags().DurationVar(&assignTimeout, "timeout", 30*time.Second, "Timeout for tmux observation and external calls (bv, br, Agent Mail)")
 	cmd.Flags().BoolVar(&assignDryRun, "dry-run", false, "Preview mode (alias for no --auto)")
@@ -344,10 +362,76 @@ Examples:
 	// Retry flags for retrying failed assignments
 	cmd.Flags().StringVar(&assignRetry, "retry", "", "Retry a specific failed assignment (bead ID)")
 	cmd.Flags().BoolVar(&assignRetryFailed, "retry-failed", false, "Retry all failed assignments")
+	cmd.Flags().BoolVar(&assignResume, "resume", false, "Show assignments saved by the last run, marking those whose pane is gone as failed")
 
 	// Repository binding (issue #123)
 	cmd.Flags().StringVar(&assignRepoPath, "repo", "", "Pin the bead-source repository path (overrides CWD discovery; required for daemon/cron use)")
 
+	cmd.AddCommand(newAssignRebalanceCmd())
+
+	return cmd
+}
+
+func newAssignRebalanceCmd() *cobra.Command {
+	var (
+		strategy  string
+		agentType string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rebalance [session]",
+		Short: "Requeue failed and unassigned beads onto now-idle agents",
+		Long: `Load the session's saved assignments, mark those whose pane is gone as
+failed, and hand failed beads, then ready beads nobody holds yet, to idle
+agents using the assignment strategy (assign.strategy from config unless
+--strategy is given).
+
+Working and assigned beads are left alone, and their panes are not offered
+new work. Completed assignments only free their pane. When ready work cannot
+be read from bv, only failed beads are requeued.`,
+		Example: `  ntm assign rebalance myproject
+  ntm assign rebalance myproject --strategy=quality --agent=codex
+  ntm assign rebalance myproject --json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session := ""
+			if len(args) > 0 {
+				session = args[0]
+			}
+			if err := tmux.EnsureInstalled(); err != nil {
+				return err
+			}
+			res, err := ResolveSession(session, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			if res.Session == "" {
+				return nil
+			}
+			res.ExplainIfInferred(cmd.ErrOrStderr())
+
+			if !cmd.Flags().Changed("strategy") && cfg != nil && cfg.Assign.Strategy != "" {
+				strategy = cfg.Assign.Strategy
+			}
+			if !config.IsValidStrategy(strategy) {
+				return fmt.Errorf("unknown strategy %q. Valid strategies: %s",
+					strategy, strings.Join(config.ValidAssignStrategies, ", "))
+			}
+			agentTypeFilter, err := parseAssignAgentTypeFilter(agentType)
+			if err != nil {
+				return err
+			}
+			return runAssignRebalance(cmd.Context(), res.Session, &AssignCommandOptions{
+				Session:         res.Session,
+				Strategy:        strategy,
+				AgentTypeFilter: agentTypeFilter,
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&strategy, "strategy", "balanced", "Assignment strategy: balanced, speed, quality, dependency, round-robin, load")
+	cmd.Flags().StringVar(&agentType, "agent", "", "Only rebalance onto this agent type: claude, codex, gemini")
+
 	return cmd
 }
 
@@ -502,6 +586,16 @@ func runAssign(cmd *cobra.Command, args []string) error {
 			assignStrategy, strings.Join(config.ValidAssignStrategies, ", "))
 	}
 
+	if assignMinConfidence < 0 || assignMinConfidence > 1 {
+		return fmt.Errorf("--min-confidence must be between 0 and 1, got %g", assignMinConfidence)
+	}
+	if assignMinConfidence > 0 && !strategySupportsMinConfidence(assignStrategy) {
+		return fmt.Errorf("--min-confidence applies only to the quality and dependency strategies, not %q", assignStrategy)
+	}
+	if assignMaxConcurrent < 0 {
+		return fmt.Errorf("--max-concurrent must be 0 (unlimited) or positive, got %d", assignMaxConcurrent)
+	}
+
 	// Handle reassignment operation
 	if assignReassign != "" {
 		return runReassignment(cmd.Context(), session)
@@ -512,6 +606,11 @@ func runAssign(cmd *cobra.Command, args []string) error {
 		return runRetryAssignments(cmd.Context(), session)
 	}
 
+	// Handle resuming assignments persisted before a restart
+	if assignResume {
+		return runResumeAssignments(cmd.Context(), session)
+	}
+
 	// Handle watch mode for continuous auto-assignment
 	if assignWatch {
 		return runWatchMode(cmd, session, projectDir, policyProject)
@@ -521,7 +620,10 @@ func runAssign(cmd *cobra.Command, args []string) error {
 	// data when BV is unavailable.
 
 	// Resolve agent type filter from flags
-	agentTypeFilter := resolveAgentTypeFilter()
+	agentTypeFilter, err := resolveAgentTypeFilter()
+	if err != nil {
+		return err
+	}
 
 	// Parse beads if specified
 	var beadIDs []string
@@ -544,10 +646,13 @@ func runAssign(cmd *cobra.Command, args []string) error {
 		BeadIDs:         beadIDs,
 		Strategy:        assignStrategy,
 		Limit:           assignLimit,
+		MinConfidence:   assignMinConfidence,
+		MaxConcurrent:   assignMaxConcurrent,
 		AgentTypeFilter: agentTypeFilter,
 		Template:        assignTemplate,
 		TemplateFile:    assignTemplateFile,
 		Verbose:         assignVerbose,
+		Explain:         assignExplain,
 		Quiet:           assignQuiet,
 		Auto:            assignAuto,
 		Timeout:         assignTimeout,
@@ -577,7 +682,7 @@ func runAssign(cmd *cobra.Command, args []string) error {
 
 	// Display the recommendations
 	if !assignQuiet {
-		displayAssignOutputEnhanced(assignOutput, assignVerbose)
+		displayAssignOutputEnhanced(assignOutput, assignVerbose, assignExplain)
 	}
 
 	// If no recommendations, we're done
@@ -614,7 +719,10 @@ func runAssign(cmd *cobra.Command, args []string) error {
 // work to idle agents, with streaming output and graceful shutdown.
 func runWatchMode(cmd *cobra.Command, session, projectDir, policyProject string) error {
 	// Resolve agent type filter from flags
-	agentTypeFilter := resolveAgentTypeFilter()
+	agentTypeFilter, err := resolveAgentTypeFilter()
+	if err != nil {
+		return err
+	}
 
 	// Build auto-reassign options
 	opts := &AutoReassignOptions{
@@ -681,10 +789,13 @@ func runWatchMode(cmd *cobra.Command, session, projectDir, policyProject string)
 		ProjectDir:      projectDir,
 		Strategy:        assignStrategy,
 		Limit:           assignLimit,
+		MinConfidence:   assignMinConfidence,
+		MaxConcurrent:   assignMaxConcurrent,
 		AgentTypeFilter: agentTypeFilter,
 		Template:        assignTemplate,
 		TemplateFile:    assignTemplateFile,
 		Verbose:         assignVerbose,
+		Explain:         assignExplain,
 		Quiet:           true, // Suppress normal output during initial pass
 		Timeout:         assignTimeout,
 		ReserveFiles:    assignReserveFiles,
@@ -830,40 +941,43 @@ func buildAssignWatchOverlayWarning(key string, err error) string {
 }
 
 // resolveAgentTypeFilter determines the agent type filter from flags
-func resolveAgentTypeFilter() string {
+func resolveAgentTypeFilter() (string, error) {
 	// Explicit --agent flag takes precedence
 	if assignAgentType != "" {
-		return normalizeAgentTypeAlias(assignAgentType)
+		return parseAssignAgentTypeFilter(assignAgentType)
 	}
 	// Convenience flags
 	if assignCCOnly {
-		return "claude"
+		return string(agent.AgentTypeClaudeCode), nil
 	}
 	if assignCodOnly {
-		return "codex"
+		return string(agent.AgentTypeCodex), nil
 	}
 	if assignGmiOnly {
-		return "gemini"
+		return string(agent.AgentTypeGemini), nil
 	}
-	return "" // No filter
+	return "", nil // No filter
 }
 
-// normalizeAgentTypeAlias collapses operator-friendly "no filter" spellings
-// (any/all/*) to the empty string and otherwise resolves provider aliases via
-// robot.ResolveAgentType. Returning "" from this function unambiguously means
-// "do not filter" — callers compare against "" to short-circuit filtering.
+// parseAssignAgentTypeFilter resolves an --agent value through
+// agent.NormalizeAgentType and returns the canonical short form. The
+// operator-friendly "no filter" spellings (any/all/*) and the empty string
+// yield "", which callers compare against to short-circuit filtering.
+// Unknown aliases are rejected, as are user panes, which never take work.
 //
-// Without this, `--agent any` propagated as the literal "any" through
-// robot.ResolveAgentType (which is not an alias it recognizes), so every
-// pane's provider was compared to the string "any" and excluded — making
+// Without the "no filter" spellings, `--agent any` was compared literally
+// against every pane's provider and excluded them all — making
 // mixed-provider sessions report zero idle agents even with idle panes.
-func normalizeAgentTypeAlias(raw string) string {
+func parseAssignAgentTypeFilter(raw string) (string, error) {
 	switch strings.ToLower(strings.TrimSpace(raw)) {
 	case "", "any", "all", "*":
-		return ""
-	default:
-		return robot.ResolveAgentType(raw)
+		return "", nil
+	}
+	agentType, ok := agent.NormalizeAgentType(raw)
+	if !ok || agentType == agent.AgentTypeUser {
+		return "", fmt.Errorf("invalid agent type filter %q", raw)
 	}
+	return string(agentType), nil
 }
 
 // normalizeBeadStatus folds case + delimiter variation (in-progress, In_Progress,
@@ -1108,6 +1222,15 @@ type AssignCommandOptions struct {
 	Auto            bool // Execute planned assignments without confirmation.
 	Timeout         time.Duration
 	ReserveFiles    bool // Reserve file paths via Agent Mail before assignment
+	// MinConfidence leaves a bead unassigned when its best agent match scores
+	// below it (quality and dependency strategies only; 0 disables).
+	MinConfidence float64
+	// MaxConcurrent caps how many beads one agent may hold, counting the
+	// assignments it already has; 0 means unlimited. The balanced planner
+	// already gives an idle agent at most one bead per pass.
+	MaxConcurrent int
+	// Explain attaches each assignment's confidence breakdown (Factors).
+	Explain bool
 
 	// Direct pane assignment options
 	PaneSelector string // Direct pane assignment using N, W.P, or %N
@@ -1155,6 +1278,7 @@ type AssignmentItem struct {
 	Reasoning       string                            `json:"reasoning,omitempty"`
 	ReasonCodes     []string                          `json:"reason_codes,omitempty"`
 	ScoreComponents *assign.AllocationScoreComponents `json:"score_components,omitempty"`
+	Factors         *MatchFactors                     `json:"factors,omitempty"` // Confidence breakdown (--explain)
 }
 
 // AssignAllocationView is a compact JSON summary of the pressure-aware
@@ -1173,6 +1297,10 @@ type SkippedItem struct {
 	BeadTitle    string   `json:"bead_title"`
 	Reason       string   `json:"reason"`
 	BlockedByIDs []string `json:"blocked_by_ids,omitempty"` // Only set when reason is "blocked"
+	// BestScore and BestAgentType record the closest match when reason is
+	// "no_confident_match", so the bead can be routed by hand.
+	BestScore     float64 `json:"best_score,omitempty"`
+	BestAgentType string  `json:"best_agent_type,omitempty"`
 }
 
 // AssignSummaryEnhanced contains summary statistics
@@ -1523,8 +1651,24 @@ func assignmentAgentPanes(agents []assignAgentInfo) []tmux.Pane {
 	return panes
 }
 
+// MatchFactors breaks a match confidence into the parts that produced it.
+// Confidence is Affinity plus PriorityBoost plus StrategyModifier.
+type MatchFactors struct {
+	TaskType         string  `json:"task_type"`
+	Affinity         float64 `json:"affinity"`          // Agent strength for the inferred task type
+	PriorityBoost    float64 `json:"priority_boost"`    // Added for P0/P1 work under the dependency strategy
+	StrategyModifier float64 `json:"strategy_modifier"` // Strategy adjustment, e.g. speed pulling toward 0.9
+	Confidence       float64 `json:"confidence"`
+}
+
 // calculateMatchConfidence calculates how well an agent matches a task
 func calculateMatchConfidence(agentType string, bead bv.BeadPreview, strategy string) float64 {
+	return calculateMatchFactors(agentType, bead, strategy).Confidence
+}
+
+// calculateMatchFactors is calculateMatchConfidence with its sub-scores kept
+// for --explain.
+func calculateMatchFactors(agentType string, bead bv.BeadPreview, strategy string) MatchFactors {
 	baseConfidence := 0.7
 
 	// Task type inference
@@ -1561,19 +1705,41 @@ func calculateMatchConfidence(agentType string, bead bv.BeadPreview, strategy st
 			baseConfidence = strength
 		}
 	}
+	factors := MatchFactors{TaskType: taskType, Affinity: baseConfidence, Confidence: baseConfidence}
 
 	// Strategy adjustments
 	switch strategy {
 	case "speed":
-		baseConfidence = (baseConfidence + 0.9) / 2
+		factors.adjustStrategy((baseConfidence + 0.9) / 2)
 	case "dependency":
 		priority := parsePriorityString(bead.Priority)
 		if priority <= 1 {
-			baseConfidence = min(baseConfidence+0.1, 0.95)
+			factors.boostPriority(min(baseConfidence+0.1, 0.95))
 		}
 	}
 
-	return baseConfidence
+	return factors
+}
+
+// adjustStrategy moves Confidence to score, booking the change as a strategy modifier.
+func (f *MatchFactors) adjustStrategy(score float64) {
+	f.StrategyModifier += score - f.Confidence
+	f.Confidence = score
+}
+
+// boostPriority moves Confidence to score, booking the change as a priority boost.
+func (f *MatchFactors) boostPriority(score float64) {
+	f.PriorityBoost += score - f.Confidence
+	f.Confidence = score
+}
+
+// explainFactors returns factors for --explain and nil otherwise, keeping
+// the breakdown out of JSON unless it was asked for.
+func explainFactors(opts *AssignCommandOptions, factors MatchFactors) *MatchFactors {
+	if opts == nil || !opts.Explain {
+		return nil
+	}
+	return &factors
 }
 
 // parsePriorityString converts "P0"-"P4" to integer
@@ -1885,7 +2051,7 @@ func getAssignOutputEnhanced(ctx context.Context, opts *AssignCommandOptions) (*
 		}
 
 		// Apply agent type filter
-		if opts.AgentTypeFilter != "" && at != opts.AgentTypeFilter {
+		if opts.AgentTypeFilter != "" && agent.AgentType(at).Canonical() != agent.AgentType(opts.AgentTypeFilter).Canonical() {
 			continue
 		}
 
@@ -2048,8 +2214,9 @@ func getAssignOutputEnhanced(ctx context.Context, opts *AssignCommandOptions) (*
 	}
 
 	// Generate assignments using strategy
-	assignments, allocationPlan := generateAssignmentsEnhancedWithPlan(ctx, idleAgents, readyBeads, opts, true)
+	assignments, allocationPlan, unassigned := generateAssignmentsEnhancedWithPlan(ctx, idleAgents, readyBeads, opts, true)
 	result.Allocation = assignAllocationView(allocationPlan)
+	result.Skipped = append(result.Skipped, unassigned...)
 	if allocationPlan != nil && allocationPlan.Decision == assign.AllocationDecisionDefer && len(assignments) == 0 {
 		for _, bead := range readyBeads {
 			result.Skipped = append(result.Skipped, SkippedItem{
@@ -2076,7 +2243,9 @@ func getAssignOutputEnhanced(ctx context.Context, opts *AssignCommandOptions) (*
 	result.Summary.AssignedCount = len(assignments)
 	result.Summary.SkippedCount = len(result.Skipped)
 
-	return redactAssignOutputForProjection(result), nil
+	result = redactAssignOutputForProjection(result)
+	persistAssignments(opts.Session, result.Assignments)
+	return result, nil
 }
 
 func forcedRedactedAssignmentText(value string) string {
@@ -2117,17 +2286,42 @@ func rawAssignmentItemTitle(item *AssignmentItem) string {
 
 // generateAssignmentsEnhanced creates assignment recommendations using the enhanced strategy logic.
 func generateAssignmentsEnhanced(ctx context.Context, agents []assignAgentInfo, beads []bv.BeadPreview, opts *AssignCommandOptions) []AssignmentItem {
-	assignments, _ := generateAssignmentsEnhancedWithPlan(ctx, agents, beads, opts, true)
+	assignments, _, _ := generateAssignmentsEnhancedWithPlan(ctx, agents, beads, opts, true)
 	return assignments
 }
 
-func generateAssignmentsEnhancedWithPlan(ctx context.Context, agents []assignAgentInfo, beads []bv.BeadPreview, opts *AssignCommandOptions, bvAvailable bool) ([]AssignmentItem, *assign.AllocationPlan) {
+// generateAssignmentsEnhancedWithPlan also returns the beads left unassigned
+// because no agent matched them with at least opts.MinConfidence or every
+// agent was at opts.MaxConcurrent.
+func generateAssignmentsEnhancedWithPlan(ctx context.Context, agents []assignAgentInfo, beads []bv.BeadPreview, opts *AssignCommandOptions, bvAvailable bool) ([]AssignmentItem, *assign.AllocationPlan, []SkippedItem) {
 	if usesAllocationPlanner(opts) {
 		assignedAt := time.Now().UTC().Format(time.RFC3339)
 		plan := assign.PlanAllocations(buildAssignAllocationInput(ctx, agents, beads, opts, bvAvailable))
-		return assignmentItemsFromAllocationPlan(plan, assignedAt), &plan
+		return assignmentItemsFromAllocationPlan(plan, assignedAt), &plan, nil
+	}
+	assignments, lowConfidence := generateAssignmentsLegacy(agents, beads, opts)
+	return assignments, nil, lowConfidence
+}
+
+// strategySupportsMinConfidence reports whether a strategy picks the best
+// scoring agent per bead, which is what a confidence threshold gates.
+func strategySupportsMinConfidence(strategy string) bool {
+	switch strings.ToLower(strings.TrimSpace(strategy)) {
+	case "quality", "dependency":
+		return true
+	}
+	return false
+}
+
+// lowConfidenceSkip reports a bead whose best match fell below the threshold.
+func lowConfidenceSkip(bead bv.BeadPreview, best *assignAgentInfo, bestScore float64) SkippedItem {
+	return SkippedItem{
+		BeadID:        bead.ID,
+		BeadTitle:     bead.Title,
+		Reason:        "no_confident_match",
+		BestScore:     bestScore,
+		BestAgentType: best.agentType,
 	}
-	return generateAssignmentsLegacy(agents, beads, opts), nil
 }
 
 func usesAllocationPlanner(opts *AssignCommandOptions) bool {
@@ -2139,13 +2333,24 @@ func usesAllocationPlanner(opts *AssignCommandOptions) bool {
 }
 
 // generateAssignmentsLegacy preserves the explicit non-balanced strategy behavior.
-func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview, opts *AssignCommandOptions) []AssignmentItem {
+// Beads the quality and dependency strategies refuse for falling below
+// opts.MinConfidence, and beads left over because every agent that could take
+// them is at opts.MaxConcurrent, are returned as skipped items.
+func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview, opts *AssignCommandOptions) ([]AssignmentItem, []SkippedItem) {
 	var assignments []AssignmentItem
+	var skipped []SkippedItem
+	capacity := newAgentCapacity(agents, opts.MaxConcurrent)
 	assignedAt := time.Now().UTC().Format(time.RFC3339)
 	defaultStatus := string(assignment.StatusAssigned)
 	multiWindow := tmux.PanesSpanMultipleWindows(assignmentAgentPanes(agents))
 
-	switch strings.ToLower(opts.Strategy) {
+	strategy := strings.ToLower(opts.Strategy)
+	if strategy == "load" && !agentsReportState(agents) {
+		// No pane reported a state, so there is no load signal to act on.
+		strategy = "balanced"
+	}
+
+	switch strategy {
 	case "round-robin":
 		// Deterministic round-robin: bead[i] -> agent[i % N]
 		// Score is always 1.0 (all assignments equally valid in round-robin)
@@ -2163,11 +2368,26 @@ func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview,
 				fmt.Fprintf(os.Stderr, "  Agent %d (%s): %d beads\n", a.pane.Index, a.agentType, count)
 			}
 		}
+		next := 0
 		for i, bead := range beads {
 			if len(agents) == 0 {
 				break
 			}
-			agent := agents[i%len(agents)]
+			// Agents at capacity give up their turn to the next one with room.
+			slot := -1
+			for n := range agents {
+				if j := (next + n) % len(agents); !capacity.full(agents[j]) {
+					slot = j
+					break
+				}
+			}
+			if slot < 0 {
+				skipped = append(skipped, capacitySkip(bead))
+				continue
+			}
+			next = slot + 1
+			agent := agents[slot]
+			capacity.take(agent)
 			assignments = append(assignments, AssignmentItem{
 				BeadID:     bead.ID,
 				BeadTitle:  bead.Title,
@@ -2180,7 +2400,7 @@ func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview,
 				PromptSent: false,
 				AssignedAt: assignedAt,
 				Score:      1.0, // Round-robin: all assignments equally valid
-				Reasoning:  fmt.Sprintf("round-robin slot %d → agent %d", i+1, i%len(agents)),
+				Reasoning:  fmt.Sprintf("round-robin slot %d → agent %d", i+1, slot),
 			})
 		}
 
@@ -2190,18 +2410,34 @@ func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview,
 		for _, bead := range beads {
 			var bestAgent *assignAgentInfo
 			var bestScore float64
+			var bestFactors MatchFactors
+			atCapacity := false
 
 			for i := range agents {
 				if usedAgents[assignmentPaneStableKey(agents[i].pane)] {
 					continue
 				}
-				score := assign.GetAgentScoreByString(agents[i].agentType, inferTaskTypeFromBead(bead))
+				if capacity.full(agents[i]) {
+					atCapacity = true
+					continue
+				}
+				taskType := inferTaskTypeFromBead(bead)
+				score := assign.GetAgentScoreByString(agents[i].agentType, taskType)
 				if score > bestScore {
 					bestScore = score
 					bestAgent = &agents[i]
+					bestFactors = MatchFactors{TaskType: taskType, Affinity: score, Confidence: score}
 				}
 			}
 
+			if bestAgent == nil && atCapacity {
+				skipped = append(skipped, capacitySkip(bead))
+				continue
+			}
+			if bestAgent != nil && bestScore < opts.MinConfidence {
+				skipped = append(skipped, lowConfidenceSkip(bead, bestAgent, bestScore))
+				continue
+			}
 			if bestAgent != nil {
 				assignments = append(assignments, AssignmentItem{
 					BeadID:     bead.ID,
@@ -2216,8 +2452,10 @@ func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview,
 					AssignedAt: assignedAt,
 					Score:      bestScore,
 					Reasoning:  buildReasoning(bestAgent.agentType, bead, "quality"),
+					Factors:    explainFactors(opts, bestFactors),
 				})
 				usedAgents[assignmentPaneStableKey(bestAgent.pane)] = true
+				capacity.take(*bestAgent)
 			}
 		}
 
@@ -2225,11 +2463,18 @@ func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview,
 		// Speed: assign to first available agent
 		usedAgents := make(map[string]bool)
 		for _, bead := range beads {
+			assigned, atCapacity := false, false
 			for i := range agents {
 				if usedAgents[assignmentPaneStableKey(agents[i].pane)] {
 					continue
 				}
-				score := (calculateMatchConfidence(agents[i].agentType, bead, "speed") + 0.9) / 2
+				if capacity.full(agents[i]) {
+					atCapacity = true
+					continue
+				}
+				factors := calculateMatchFactors(agents[i].agentType, bead, "speed")
+				factors.adjustStrategy((factors.Confidence + 0.9) / 2)
+				score := factors.Confidence
 				assignments = append(assignments, AssignmentItem{
 					BeadID:     bead.ID,
 					BeadTitle:  bead.Title,
@@ -2243,10 +2488,16 @@ func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview,
 					AssignedAt: assignedAt,
 					Score:      score,
 					Reasoning:  buildReasoning(agents[i].agentType, bead, "speed"),
+					Factors:    explainFactors(opts, factors),
 				})
 				usedAgents[assignmentPaneStableKey(agents[i].pane)] = true
+				capacity.take(agents[i])
+				assigned = true
 				break
 			}
+			if !assigned && atCapacity {
+				skipped = append(skipped, capacitySkip(bead))
+			}
 		}
 
 	case "dependency":
@@ -2255,23 +2506,38 @@ func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview,
 		for _, bead := range beads {
 			var bestAgent *assignAgentInfo
 			var bestScore float64
+			var bestFactors MatchFactors
+			atCapacity := false
 
 			for i := range agents {
 				if usedAgents[assignmentPaneStableKey(agents[i].pane)] {
 					continue
 				}
-				score := calculateMatchConfidence(agents[i].agentType, bead, "dependency")
+				if capacity.full(agents[i]) {
+					atCapacity = true
+					continue
+				}
+				factors := calculateMatchFactors(agents[i].agentType, bead, "dependency")
 				// Boost for high priority
 				priority := parsePriorityString(bead.Priority)
 				if priority <= 1 {
-					score = min(score+0.1, 0.95)
+					factors.boostPriority(min(factors.Confidence+0.1, 0.95))
 				}
-				if score > bestScore {
+				if score := factors.Confidence; score > bestScore {
 					bestScore = score
 					bestAgent = &agents[i]
+					bestFactors = factors
 				}
 			}
 
+			if bestAgent == nil && atCapacity {
+				skipped = append(skipped, capacitySkip(bead))
+				continue
+			}
+			if bestAgent != nil && bestScore < opts.MinConfidence {
+				skipped = append(skipped, lowConfidenceSkip(bead, bestAgent, bestScore))
+				continue
+			}
 			if bestAgent != nil {
 				assignments = append(assignments, AssignmentItem{
 					BeadID:     bead.ID,
@@ -2286,8 +2552,53 @@ func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview,
 					AssignedAt: assignedAt,
 					Score:      bestScore,
 					Reasoning:  buildReasoning(bestAgent.agentType, bead, "dependency"),
+					Factors:    explainFactors(opts, bestFactors),
 				})
 				usedAgents[assignmentPaneStableKey(bestAgent.pane)] = true
+				capacity.take(*bestAgent)
+			}
+		}
+
+	case "load":
+		// Load: prefer idle agents, then whoever holds the fewest beads
+		for _, bead := range beads {
+			var bestAgent *assignAgentInfo
+			bestLoad := 0
+			atCapacity := false
+
+			for i := range agents {
+				if capacity.full(agents[i]) {
+					atCapacity = true
+					continue
+				}
+				// Idle beats busy; fewer held beads breaks ties; pane order keeps it deterministic.
+				load := agentLoad(agents[i], capacity.held[assignmentPaneStableKey(agents[i].pane)])
+				if bestAgent == nil || load < bestLoad {
+					bestAgent = &agents[i]
+					bestLoad = load
+				}
+			}
+
+			if bestAgent == nil && atCapacity {
+				skipped = append(skipped, capacitySkip(bead))
+				continue
+			}
+			if bestAgent != nil {
+				assignments = append(assignments, AssignmentItem{
+					BeadID:     bead.ID,
+					BeadTitle:  bead.Title,
+					Pane:       bestAgent.pane.Index,
+					PaneTarget: assignmentPaneTarget(bestAgent.pane),
+					PaneID:     bestAgent.pane.ID,
+					AgentType:  bestAgent.agentType,
+					AgentName:  assignmentAgentNameForPane(opts.Session, bestAgent.agentType, bestAgent.pane, multiWindow),
+					Status:     defaultStatus,
+					PromptSent: false,
+					AssignedAt: assignedAt,
+					Score:      1 / float64(1+bestLoad),
+					Reasoning:  loadReasoning(*bestAgent, capacity.held[assignmentPaneStableKey(bestAgent.pane)]),
+				})
+				capacity.take(*bestAgent)
 			}
 		}
 
@@ -2317,13 +2628,20 @@ func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview,
 		for _, bead := range beads {
 			var bestAgent *assignAgentInfo
 			var bestScore float64
+			var bestFactors MatchFactors
 			minAssigns := int(^uint(0) >> 1)
 			var leastRecentTime time.Time
+			atCapacity := false
 
 			for i := range agents {
+				if capacity.full(agents[i]) {
+					atCapacity = true
+					continue
+				}
 				paneKey := assignmentPaneStableKey(agents[i].pane)
 				count := agentAssignCounts[paneKey]
-				score := calculateMatchConfidence(agents[i].agentType, bead, "balanced")
+				factors := calculateMatchFactors(agents[i].agentType, bead, "balanced")
+				score := factors.Confidence
 				lastAssign := agentLastAssigned[paneKey]
 
 				// Tie-breaker cascade:
@@ -2357,10 +2675,15 @@ func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview,
 					minAssigns = count
 					bestScore = score
 					bestAgent = &agents[i]
+					bestFactors = factors
 					leastRecentTime = lastAssign
 				}
 			}
 
+			if bestAgent == nil && atCapacity {
+				skipped = append(skipped, capacitySkip(bead))
+				continue
+			}
 			if bestAgent != nil {
 				assignments = append(assignments, AssignmentItem{
 					BeadID:     bead.ID,
@@ -2375,9 +2698,11 @@ func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview,
 					AssignedAt: assignedAt,
 					Score:      bestScore,
 					Reasoning:  buildReasoning(bestAgent.agentType, bead, "balanced"),
+					Factors:    explainFactors(opts, bestFactors),
 				})
 				bestKey := assignmentPaneStableKey(bestAgent.pane)
 				agentAssignCounts[bestKey]++
+				capacity.take(*bestAgent)
 				// Update last assigned time for this session's assignments
 				now := time.Now()
 				agentLastAssigned[bestKey] = now
@@ -2385,7 +2710,74 @@ func generateAssignmentsLegacy(agents []assignAgentInfo, beads []bv.BeadPreview,
 		}
 	}
 
-	return assignments
+	return assignments, skipped
+}
+
+// agentsReportState reports whether any agent carries a pane state, which the
+// load strategy needs to tell idle agents from busy ones.
+func agentsReportState(agents []assignAgentInfo) bool {
+	for _, agent := range agents {
+		if agent.state != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// agentIdle reports whether an agent's pane state shows it waiting for work.
+// A missing state counts as idle.
+func agentIdle(agent assignAgentInfo) bool {
+	return agent.state == "" || agent.state == "idle"
+}
+
+// agentLoad is the load strategy's cost for giving an agent one more bead:
+// the beads it holds, plus one more when its pane is busy.
+func agentLoad(agent assignAgentInfo, held int) int {
+	if agentIdle(agent) {
+		return held
+	}
+	return held + 1
+}
+
+// loadReasoning explains a load strategy pick from the chosen agent's state.
+func loadReasoning(agent assignAgentInfo, held int) string {
+	if agentIdle(agent) {
+		return fmt.Sprintf("load: idle agent holding %d bead(s)", held)
+	}
+	return fmt.Sprintf("load: least-loaded agent (%s, holding %d bead(s))", agent.state, held)
+}
+
+// agentCapacity tracks how many beads each agent holds, counting the
+// assignments it already had, against a per-agent limit (0 = unlimited).
+type agentCapacity struct {
+	limit int
+	held  map[string]int
+}
+
+func newAgentCapacity(agents []assignAgentInfo, limit int) *agentCapacity {
+	c := &agentCapacity{limit: limit, held: make(map[string]int, len(agents))}
+	for _, agent := range agents {
+		c.held[assignmentPaneStableKey(agent.pane)] = agent.activeAssignments
+	}
+	return c
+}
+
+func (c *agentCapacity) full(agent assignAgentInfo) bool {
+	return c.limit > 0 && c.held[assignmentPaneStableKey(agent.pane)] >= c.limit
+}
+
+func (c *agentCapacity) take(agent assignAgentInfo) {
+	c.held[assignmentPaneStableKey(agent.pane)]++
+}
+
+// capacitySkip reports a bead left over because every agent that could take
+// it already holds opts.MaxConcurrent beads.
+func capacitySkip(bead bv.BeadPreview) SkippedItem {
+	return SkippedItem{
+		BeadID:    bead.ID,
+		BeadTitle: bead.Title,
+		Reason:    "agent_capacity_reached",
+	}
 }
 
 func buildAssignAllocationInput(ctx context.Context, agents []assignAgentInfo, beads []bv.BeadPreview, opts *AssignCommandOptions, bvAvailable bool) assign.AllocationInput {
@@ -2412,7 +2804,7 @@ func buildAssignAllocationInput(ctx context.Context, agents []assignAgentInfo, b
 			PaneTarget:        assignmentPaneTarget(agentInfo.pane),
 			PaneID:            agentInfo.pane.ID,
 			AgentType:         tmux.AgentType(agentInfo.agentType).Canonical(),
-			Idle:              agentInfo.state == "" || agentInfo.state == "idle",
+			Idle:              agentIdle(agentInfo),
 			ContextUsage:      clampAssignScore(agentInfo.contextUsage),
 			ActiveAssignments: active,
 			AssignmentLimit:   1,
@@ -2660,8 +3052,41 @@ func inferTaskTypeFromBead(bead bv.BeadPreview) string {
 	return "task"
 }
 
+// assignmentFactorLines renders an assignment's confidence breakdown as a
+// small table for --explain. Planner assignments show their score components.
+func assignmentFactorLines(item AssignmentItem) []string {
+	row := func(name string, value float64) string {
+		return fmt.Sprintf("  %-18s %+.2f", name, value)
+	}
+	switch {
+	case item.Factors != nil:
+		f := item.Factors
+		return []string{
+			fmt.Sprintf("Factors (task type: %s)", f.TaskType),
+			row("affinity", f.Affinity),
+			row("priority boost", f.PriorityBoost),
+			row("strategy modifier", f.StrategyModifier),
+			row("confidence", f.Confidence),
+		}
+	case item.ScoreComponents != nil:
+		c := item.ScoreComponents
+		return []string{
+			"Factors (allocation planner)",
+			row("graph value", c.GraphValue),
+			row("priority", c.Priority),
+			row("unblock impact", c.UnblockImpact),
+			row("resource fit", c.ResourceFit),
+			row("capability", c.Capability),
+			row("fairness", c.Fairness),
+			row("starvation risk", c.StarvationRisk),
+			row("total", c.Total),
+		}
+	}
+	return []string{"Factors: none (strategy does not score matches)"}
+}
+
 // displayAssignOutputEnhanced renders the enhanced assignment output
-func displayAssignOutputEnhanced(out *AssignOutputEnhanced, verbose bool) {
+func displayAssignOutputEnhanced(out *AssignOutputEnhanced, verbose, explain bool) {
 	th := theme.Current()
 
 	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(th.Primary)
@@ -2696,6 +3121,11 @@ func displayAssignOutputEnhanced(out *AssignOutputEnhanced, verbose bool) {
 			if verbose && item.Reasoning != "" {
 				fmt.Printf("     %s\n", subtitleStyle.Render(item.Reasoning))
 			}
+			if explain {
+				for _, line := range assignmentFactorLines(item) {
+					fmt.Printf("     %s\n", subtitleStyle.Render(line))
+				}
+			}
 			fmt.Println()
 		}
 	} else {
@@ -2730,13 +3160,39 @@ func displayAssignOutputEnhanced(out *AssignOutputEnhanced, verbose bool) {
 		}
 	}
 
+	// Low-confidence beads need manual routing (always show)
+	lowConfidenceCount := countSkippedByReason(out.Skipped, "no_confident_match")
+	if lowConfidenceCount > 0 {
+		fmt.Println()
+		warnStyle := lipgloss.NewStyle().Foreground(th.Warning)
+		fmt.Println(warnStyle.Render(fmt.Sprintf("Unassigned, no confident match (%d):", lowConfidenceCount)))
+		for _, s := range out.Skipped {
+			if s.Reason == "no_confident_match" {
+				fmt.Printf("  - %s (best: %s %.2f)\n", s.BeadID, s.BestAgentType, s.BestScore)
+			}
+		}
+	}
+
+	// Beads left over once every agent hit --max-concurrent (always show)
+	atCapacityCount := countSkippedByReason(out.Skipped, "agent_capacity_reached")
+	if atCapacityCount > 0 {
+		fmt.Println()
+		warnStyle := lipgloss.NewStyle().Foreground(th.Warning)
+		fmt.Println(warnStyle.Render(fmt.Sprintf("Unassigned, all agents at capacity (%d):", atCapacityCount)))
+		for _, s := range out.Skipped {
+			if s.Reason == "agent_capacity_reached" {
+				fmt.Printf("  - %s\n", s.BeadID)
+			}
+		}
+	}
+
 	// Other skipped items (only in verbose mode)
-	if verbose && len(out.Skipped) > blockedCount {
+	if verbose && len(out.Skipped) > blockedCount+lowConfidenceCount+atCapacityCount {
 		fmt.Println()
 		warnStyle := lipgloss.NewStyle().Foreground(th.Warning)
 		fmt.Println(warnStyle.Render("Other skipped:"))
 		for _, s := range out.Skipped {
-			if s.Reason != "blocked_by_dependency" {
+			if s.Reason != "blocked_by_dependency" && s.Reason != "no_confident_match" && s.Reason != "agent_capacity_reached" {
 				fmt.Printf("  - %s: %s\n", s.BeadID, s.Reason)
 			}
 		}
@@ -3062,6 +3518,7 @@ func executeAssignmentsEnhanced(ctx context.Context, session string, out *Assign
 		}
 		fmt.Println("Use 'ntm status --assignments' to monitor progress.")
 	}
+	persistAssignments(session, out.Assignments)
 	if failCount > 0 {
 		return fmt.Errorf("%d of %d assignments failed", failCount, successCount+failCount)
 	}
@@ -3069,6 +3526,381 @@ func executeAssignmentsEnhanced(ctx context.Context, session string, out *Assign
 	return nil
 }
 
+// assignCheckpointStorage returns the checkpoint storage that persisted
+// assignments are written to; tests swap it for a temporary directory.
+var assignCheckpointStorage = checkpoint.NewStorage
+
+// persistAssignments saves items as the session's latest assignments so
+// `ntm assign --resume` can recover them after a restart. Failures are logged,
+// never fatal: persistence must not block assignment.
+func persistAssignments(session string, items []AssignmentItem) {
+	if session == "" {
+		return
+	}
+	if err := assignCheckpointStorage().SaveAssignments(session, assignmentSnapshotsFromItems(items)); err != nil {
+		slog.Default().Warn("failed to persist assignments", "session", session, "error", err)
+	}
+}
+
+func assignmentSnapshotsFromItems(items []AssignmentItem) []checkpoint.AssignmentSnapshot {
+	snapshots := make([]checkpoint.AssignmentSnapshot, 0, len(items))
+	for _, item := range items {
+		assignedAt, _ := time.Parse(time.RFC3339, item.AssignedAt)
+		snapshots = append(snapshots, checkpoint.AssignmentSnapshot{
+			BeadID:     item.BeadID,
+			BeadTitle:  item.BeadTitle,
+			Pane:       item.Pane,
+			PaneID:     item.PaneID,
+			AgentType:  item.AgentType,
+			AgentName:  item.AgentName,
+			Status:     item.Status,
+			AssignedAt: assignedAt,
+			PromptSent: item.PromptSent,
+		})
+	}
+	return snapshots
+}
+
+// assignmentItemsFromSnapshots reconstructs the assignment list saved by
+// persistAssignments.
+func assignmentItemsFromSnapshots(snapshots []checkpoint.AssignmentSnapshot) []AssignmentItem {
+	items := make([]AssignmentItem, 0, len(snapshots))
+	for _, snap := range snapshots {
+		item := AssignmentItem{
+			BeadID:     snap.BeadID,
+			BeadTitle:  snap.BeadTitle,
+			Pane:       snap.Pane,
+			PaneID:     snap.PaneID,
+			AgentType:  snap.AgentType,
+			AgentName:  snap.AgentName,
+			Status:     snap.Status,
+			PromptSent: snap.PromptSent,
+		}
+		if !snap.AssignedAt.IsZero() {
+			item.AssignedAt = snap.AssignedAt.UTC().Format(time.RFC3339)
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// AssignResumeData is the --resume payload: the persisted assignments after
+// reconciliation against the session's live panes.
+type AssignResumeData struct {
+	SavedAt     string           `json:"saved_at"`
+	Assignments []AssignmentItem `json:"assignments"`
+	// MarkedFailed counts assignments whose pane no longer exists.
+	MarkedFailed int `json:"marked_failed"`
+}
+
+// loadResumedAssignments loads the session's persisted assignments, marks
+// those on vanished panes as failed, and saves the reconciled list back.
+func loadResumedAssignments(ctx context.Context, session string) (*AssignResumeData, error) {
+	storage := assignCheckpointStorage()
+	saved, err := storage.LoadAssignments(session)
+	if err != nil {
+		return nil, err
+	}
+	panes, err := tmux.GetPanesContext(ctx, session)
+	if err != nil {
+		// A session that no longer exists has no live panes at all.
+		slog.Default().Debug("resume: listing panes failed", "session", session, "error", err)
+		panes = nil
+	}
+	marked := checkpoint.ReconcileAssignments(saved.Assignments, panes)
+	if marked > 0 {
+		if err := storage.SaveAssignments(session, saved.Assignments); err != nil {
+			return nil, fmt.Errorf("saving reconciled assignments: %w", err)
+		}
+	}
+	return &AssignResumeData{
+		SavedAt:      saved.SavedAt.UTC().Format(time.RFC3339),
+		Assignments:  assignmentItemsFromSnapshots(saved.Assignments),
+		MarkedFailed: marked,
+	}, nil
+}
+
+// AssignRebalanceCounts has the shape of summarizeAssignmentCounts.
+type AssignRebalanceCounts struct {
+	Total    int `json:"total"`
+	Working  int `json:"working"`
+	Assigned int `json:"assigned"`
+	Failed   int `json:"failed"`
+}
+
+func assignRebalanceCounts(snapshots []checkpoint.AssignmentSnapshot) AssignRebalanceCounts {
+	summary := summarizeAssignmentCounts(snapshots)
+	return AssignRebalanceCounts{
+		Total:    summary.total,
+		Working:  summary.working,
+		Assigned: summary.assigned,
+		Failed:   summary.failed,
+	}
+}
+
+// AssignRebalanceData is the `assign rebalance` payload.
+type AssignRebalanceData struct {
+	Strategy      string                `json:"strategy"`
+	Before        AssignRebalanceCounts `json:"before"`
+	After         AssignRebalanceCounts `json:"after"`
+	NewlyAssigned int                   `json:"newly_assigned"`
+	Assignments   []AssignmentItem      `json:"assignments"` // The newly assigned beads
+}
+
+// rebalanceAssignments hands the failed beads in snapshots, followed by the
+// ready beads no snapshot holds, to agents whose panes hold no assigned or
+// working bead, and returns the updated snapshot list alongside the new
+// assignments. Reassigned failed entries are replaced; everything else is
+// kept as is.
+func rebalanceAssignments(ctx context.Context, snapshots []checkpoint.AssignmentSnapshot, ready []bv.BeadPreview, agents []assignAgentInfo, opts *AssignCommandOptions) ([]checkpoint.AssignmentSnapshot, []AssignmentItem) {
+	busyIDs := make(map[string]bool)
+	busyIndexes := make(map[int]bool)
+	settled := make(map[string]bool) // Beads that are in flight or done
+	for _, snap := range snapshots {
+		switch snap.Status {
+		case "assigned", "working":
+			if snap.PaneID != "" {
+				busyIDs[snap.PaneID] = true
+			} else {
+				busyIndexes[snap.Pane] = true
+			}
+			settled[snap.BeadID] = true
+		case "completed":
+			settled[snap.BeadID] = true
+		}
+	}
+
+	var residual []bv.BeadPreview
+	queued := make(map[string]bool)
+	for _, snap := range snapshots {
+		if snap.Status != "failed" || settled[snap.BeadID] || queued[snap.BeadID] {
+			continue
+		}
+		queued[snap.BeadID] = true
+		residual = append(residual, bv.BeadPreview{ID: snap.BeadID, Title: snap.BeadTitle})
+	}
+	for _, bead := range ready {
+		if settled[bead.ID] || queued[bead.ID] {
+			continue
+		}
+		queued[bead.ID] = true
+		residual = append(residual, bead)
+	}
+
+	var free []assignAgentInfo
+	for _, agent := range agents {
+		if busyIDs[agent.pane.ID] || busyIndexes[agent.pane.Index] {
+			continue
+		}
+		free = append(free, agent)
+	}
+	if len(residual) == 0 || len(free) == 0 {
+		return snapshots, nil
+	}
+
+	items := generateAssignmentsEnhanced(ctx, free, residual, opts)
+	reassigned := make(map[string]bool, len(items))
+	for _, item := range items {
+		reassigned[item.BeadID] = true
+	}
+	updated := make([]checkpoint.AssignmentSnapshot, 0, len(snapshots)+len(items))
+	for _, snap := range snapshots {
+		if snap.Status == "failed" && reassigned[snap.BeadID] {
+			continue
+		}
+		updated = append(updated, snap)
+	}
+	return append(updated, assignmentSnapshotsFromItems(items)...), items
+}
+
+// runAssignRebalance implements `ntm assign rebalance`.
+func runAssignRebalance(ctx context.Context, session string, opts *AssignCommandOptions) error {
+	data, err := loadAndRebalanceAssignments(ctx, session, opts)
+	if IsJSONOutput() {
+		envelope := AssignEnvelope[AssignRebalanceData]{
+			Command:    "assign",
+			Subcommand: "rebalance",
+			Session:    session,
+			Timestamp:  time.Now().UTC().Format(time.RFC3339),
+			Success:    err == nil,
+			Data:       data,
+			Warnings:   []string{},
+		}
+		if err != nil {
+			code := "REBALANCE_ERROR"
+			if errors.Is(err, checkpoint.ErrNoSavedAssignments) {
+				code = "NO_ASSIGNMENTS"
+			}
+			envelope.Error = &AssignError{Code: code, Message: err.Error()}
+			return emitJSONFailureEnvelope(envelope)
+		}
+		return json.NewEncoder(os.Stdout).Encode(envelope)
+	}
+	if err != nil {
+		if errors.Is(err, checkpoint.ErrNoSavedAssignments) {
+			return fmt.Errorf("no saved assignments for session %q; run 'ntm assign' first", session)
+		}
+		return err
+	}
+
+	th := theme.Current()
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(th.Primary)
+	subtitleStyle := lipgloss.NewStyle().Foreground(th.Subtext)
+	counts := func(c AssignRebalanceCounts) string {
+		return fmt.Sprintf("%d total (%d working, %d assigned, %d failed)", c.Total, c.Working, c.Assigned, c.Failed)
+	}
+
+	fmt.Println()
+	fmt.Println(titleStyle.Render(fmt.Sprintf("Assignment Rebalance for %s", session)))
+	fmt.Println(strings.Repeat("━", 50))
+	fmt.Printf("Strategy: %s\n", data.Strategy)
+	fmt.Printf("Before: %s\n", counts(data.Before))
+	fmt.Printf("Newly assigned: %d\n", data.NewlyAssigned)
+	for _, item := range data.Assignments {
+		agentBadge := getAgentStyle(item.AgentType, th).Render(fmt.Sprintf("[%s pane %d]", item.AgentType, item.Pane))
+		fmt.Printf("  %s → %s\n", agentBadge, item.BeadID)
+	}
+	fmt.Printf("After: %s\n", counts(data.After))
+	if data.NewlyAssigned == 0 && data.After.Failed > 0 {
+		fmt.Println(subtitleStyle.Render("No idle agent could take the failed beads."))
+	}
+	return nil
+}
+
+// loadAndRebalanceAssignments reconciles the session's saved assignments with
+// its live panes, rebalances them onto idle agents, and saves the result.
+func loadAndRebalanceAssignments(ctx context.Context, session string, opts *AssignCommandOptions) (*AssignRebalanceData, error) {
+	resumed, err := loadResumedAssignments(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := assignmentSnapshotsFromItems(resumed.Assignments)
+	agents, err := getIdleAgents(ctx, session, opts.AgentTypeFilter, opts.Verbose)
+	if err != nil {
+		return nil, err
+	}
+
+	ready, err := loadRebalanceReadyBeads(ctx, session, opts)
+	if err != nil {
+		slog.Default().Warn("assign rebalance: requeueing failed beads only; ready work unavailable", "session", session, "error", err)
+	}
+	updated, items := rebalanceAssignments(ctx, snapshots, ready, agents, opts)
+	if len(items) > 0 {
+		if err := assignCheckpointStorage().SaveAssignments(session, updated); err != nil {
+			return nil, fmt.Errorf("saving rebalanced assignments: %w", err)
+		}
+	}
+	if items == nil {
+		items = []AssignmentItem{}
+	}
+	return &AssignRebalanceData{
+		Strategy:      opts.Strategy,
+		Before:        assignRebalanceCounts(snapshots),
+		After:         assignRebalanceCounts(updated),
+		NewlyAssigned: len(items),
+		Assignments:   items,
+	}, nil
+}
+
+// loadRebalanceReadyBeads returns the session's actionable beads that no
+// active assignment holds, the same candidates a plain `ntm assign` draws
+// from (dependency cycles excluded), capped at 50.
+func loadRebalanceReadyBeads(ctx context.Context, session string, opts *AssignCommandOptions) ([]bv.BeadPreview, error) {
+	projectDir := strings.TrimSpace(opts.ProjectDir)
+	if projectDir == "" {
+		var err error
+		if projectDir, err = resolveAssignProjectDir(ctx, session); err != nil {
+			return nil, fmt.Errorf("resolve assignment project: %w", err)
+		}
+	}
+	recs, err := loadActionableRecommendationsForAssignment(ctx, projectDir)
+	if err != nil {
+		return nil, err
+	}
+	active, err := loadActiveAssignmentBeadIDs(session)
+	if err != nil {
+		return nil, err
+	}
+	ready, _ := partitionActionableRecommendationsForAssignment(recs, active, func(label string) bool {
+		return bv.IsOperatorGatedLabelForProject(projectDir, label)
+	})
+	cycles, err := CheckCycles(ctx, projectDir, false)
+	if err != nil {
+		return nil, fmt.Errorf("inspect assignment dependency cycles: %w", err)
+	}
+	if len(cycles) > 0 {
+		ready = slices.DeleteFunc(ready, func(bead bv.BeadPreview) bool { return IsBeadInCycle(bead.ID, cycles) })
+	}
+	if len(ready) > 50 {
+		ready = ready[:50]
+	}
+	return ready, nil
+}
+
+// runResumeAssignments implements --resume.
+func runResumeAssignments(ctx context.Context, session string) error {
+	data, err := loadResumedAssignments(ctx, session)
+	if IsJSONOutput() {
+		envelope := AssignEnvelope[AssignResumeData]{
+			Command:    "assign",
+			Subcommand: "resume",
+			Session:    session,
+			Timestamp:  time.Now().UTC().Format(time.RFC3339),
+			Success:    err == nil,
+			Data:       data,
+			Warnings:   []string{},
+		}
+		if err != nil {
+			code := "RESUME_ERROR"
+			if errors.Is(err, checkpoint.ErrNoSavedAssignments) {
+				code = "NO_ASSIGNMENTS"
+			}
+			envelope.Error = &AssignError{Code: code, Message: err.Error()}
+			return emitJSONFailureEnvelope(envelope)
+		}
+		return json.NewEncoder(os.Stdout).Encode(envelope)
+	}
+	if err != nil {
+		if errors.Is(err, checkpoint.ErrNoSavedAssignments) {
+			return fmt.Errorf("no saved assignments for session %q", session)
+		}
+		return err
+	}
+
+	th := theme.Current()
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(th.Primary)
+	subtitleStyle := lipgloss.NewStyle().Foreground(th.Subtext)
+
+	fmt.Println()
+	fmt.Println(titleStyle.Render(fmt.Sprintf("Saved Assignments for %s", session)))
+	fmt.Println(strings.Repeat("━", 50))
+	fmt.Println(subtitleStyle.Render(fmt.Sprintf("Saved at %s", data.SavedAt)))
+	fmt.Println()
+	if len(data.Assignments) == 0 {
+		fmt.Println(subtitleStyle.Render("No assignments were saved."))
+		return nil
+	}
+	for _, item := range data.Assignments {
+		sent := "prompt not sent"
+		if item.PromptSent {
+			sent = "prompt sent"
+		}
+		agentBadge := getAgentStyle(item.AgentType, th).Render(fmt.Sprintf("[%s pane %d]", item.AgentType, item.Pane))
+		fmt.Printf("  %s → %s (%s, %s)\n", agentBadge, item.BeadID, item.Status, sent)
+		fmt.Printf("     %s\n", item.BeadTitle)
+		if item.AssignedAt != "" {
+			fmt.Printf("     %s\n", subtitleStyle.Render("assigned "+item.AssignedAt))
+		}
+	}
+	if data.MarkedFailed > 0 {
+		fmt.Println()
+		warnStyle := lipgloss.NewStyle().Foreground(th.Warning)
+		fmt.Println(warnStyle.Render(fmt.Sprintf("Marked %d assignment(s) failed: their pane no longer exists.", data.MarkedFailed)))
+		fmt.Println(subtitleStyle.Render("Use 'ntm assign --retry-failed' to requeue them."))
+	}
+	return nil
+}
+
 func newCLIAtomicAssignmentCoordinator(store *assignment.AssignmentStore, projectDir string, reservationMgr *assign.FileReservationManager, allowBusy ...bool) *assignment.AtomicCoordinator {
 	operatorGatedLabels := bv.OperatorGatedLabelsForProject(projectDir)
 	claimPort := assignment.ClaimFunc(func(ctx context.Context, beadID, actor string) (assignment.ClaimReceipt, error) {
@@ -6446,12 +7278,10 @@ func getIdleAgents(ctx context.Context, session, agentTypeFilter string, verbose
 	if err := ctx.Err(); err != nil {
 		return nil, fmt.Errorf("idle-agent observation canceled: %w", err)
 	}
-	normalizedFilter := normalizeAgentTypeAlias(agentTypeFilter)
 	// normalizedFilter == "" means "no provider filter" (raw was "", any, all, *).
-	// Reject only the sentinel values robot.ResolveAgentType emits for unknown
-	// or non-agent inputs.
-	if agentTypeFilter != "" && (normalizedFilter == "unknown" || normalizedFilter == "user") {
-		return nil, fmt.Errorf("invalid agent type filter %q", agentTypeFilter)
+	normalizedFilter, err := parseAssignAgentTypeFilter(agentTypeFilter)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get panes from tmux
@@ -6482,7 +7312,7 @@ func getIdleAgents(ctx context.Context, session, agentTypeFilter string, verbose
 		}
 
 		// Apply agent type filter
-		if normalizedFilter != "" && agentType != normalizedFilter {
+		if normalizedFilter != "" && agent.AgentType(agentType).Canonical() != agent.AgentType(normalizedFilter) {
 			continue
 		}
 
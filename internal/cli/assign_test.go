@@ -22,6 +22,7 @@ import (
 	assignpkg "github.com/Dicklesworthstone/ntm/internal/assign"
 	"github.com/Dicklesworthstone/ntm/internal/assignment"
 	"github.com/Dicklesworthstone/ntm/internal/bv"
+	"github.com/Dicklesworthstone/ntm/internal/checkpoint"
 	"github.com/Dicklesworthstone/ntm/internal/completion"
 	"github.com/Dicklesworthstone/ntm/internal/config"
 	dispatchsvc "github.com/Dicklesworthstone/ntm/internal/dispatch"
@@ -2429,11 +2430,12 @@ func TestNormalizeBeadStatus(t *testing.T) {
 	}
 }
 
-func TestNormalizeAgentTypeAlias(t *testing.T) {
+func TestParseAssignAgentTypeFilter(t *testing.T) {
 	tests := []struct {
-		name string
-		raw  string
-		want string
+		name    string
+		raw     string
+		want    string
+		wantErr bool
 	}{
 		{name: "empty string is no filter", raw: "", want: ""},
 		{name: "any is no filter", raw: "any", want: ""},
@@ -2441,16 +2443,21 @@ func TestNormalizeAgentTypeAlias(t *testing.T) {
 		{name: "all is no filter", raw: "all", want: ""},
 		{name: "star is no filter", raw: "*", want: ""},
 		{name: "whitespace around any", raw: "  any  ", want: ""},
-		{name: "claude resolves to claude", raw: "claude", want: "claude"},
-		{name: "codex alias cod resolves", raw: "cod", want: "codex"},
-		{name: "gemini alias gmi resolves", raw: "gmi", want: "gemini"},
+		{name: "claude resolves to cc", raw: "claude", want: "cc"},
+		{name: "codex alias cod resolves", raw: "cod", want: "cod"},
+		{name: "gemini resolves to gmi", raw: "gemini", want: "gmi"},
+		{name: "user panes are rejected", raw: "user", wantErr: true},
+		{name: "unknown alias is rejected", raw: "claudette", wantErr: true},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			got := normalizeAgentTypeAlias(tc.raw)
+			got, err := parseAssignAgentTypeFilter(tc.raw)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("parseAssignAgentTypeFilter(%q) error = %v, wantErr %v", tc.raw, err, tc.wantErr)
+			}
 			if got != tc.want {
-				t.Fatalf("normalizeAgentTypeAlias(%q) = %q, want %q", tc.raw, got, tc.want)
+				t.Fatalf("parseAssignAgentTypeFilter(%q) = %q, want %q", tc.raw, got, tc.want)
 			}
 		})
 	}
@@ -3855,7 +3862,7 @@ func TestGenerateAssignmentsLegacyPreservesMultiWindowPaneIdentity(t *testing.T)
 		{pane: tmux.Pane{ID: "%51", WindowIndex: 1, Index: 1}, agentType: "claude", state: "idle"},
 	}
 	beads := []bv.BeadPreview{{ID: "ntm-a", Title: "First"}, {ID: "ntm-b", Title: "Second"}}
-	items := generateAssignmentsLegacy(agents, beads, &AssignCommandOptions{Session: "multi", Strategy: "speed"})
+	items, _ := generateAssignmentsLegacy(agents, beads, &AssignCommandOptions{Session: "multi", Strategy: "speed"})
 	if len(items) != 2 {
 		t.Fatalf("assignments=%d, want 2", len(items))
 	}
@@ -4290,3 +4297,120 @@ func TestClassifyRetryOutcomeTreatsEverySkipAsFailure(t *testing.T) {
 		})
 	}
 }
+
+func TestPersistAssignmentsResumeMarksVanishedPanesFailed(t *testing.T) {
+	storage := checkpoint.NewStorageWithDir(t.TempDir())
+	previous := assignCheckpointStorage
+	assignCheckpointStorage = func() *checkpoint.Storage { return storage }
+	t.Cleanup(func() { assignCheckpointStorage = previous })
+
+	session := "ntm-test-resume-gone"
+	assignedAt := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC).Format(time.RFC3339)
+	persistAssignments(session, []AssignmentItem{
+		{BeadID: "bd-1", BeadTitle: "Fix login", Pane: 1, PaneID: "%1", AgentType: "claude", Status: "working", PromptSent: true, AssignedAt: assignedAt},
+		{BeadID: "bd-2", BeadTitle: "Write docs", Pane: 2, AgentType: "codex", Status: "completed", AssignedAt: assignedAt},
+	})
+
+	// The session does not exist, so every pane has vanished.
+	data, err := loadResumedAssignments(t.Context(), session)
+	if err != nil {
+		t.Fatalf("loadResumedAssignments: %v", err)
+	}
+	if data.MarkedFailed != 1 {
+		t.Errorf("MarkedFailed = %d, want 1", data.MarkedFailed)
+	}
+	if len(data.Assignments) != 2 {
+		t.Fatalf("got %d assignments, want 2", len(data.Assignments))
+	}
+	first := data.Assignments[0]
+	if first.Status != "failed" || !first.PromptSent || first.AssignedAt != assignedAt || first.PaneID != "%1" {
+		t.Errorf("first assignment = %+v, want failed with prompt_sent and assigned_at intact", first)
+	}
+	if data.Assignments[1].Status != "completed" {
+		t.Errorf("completed assignment status = %q, want completed", data.Assignments[1].Status)
+	}
+
+	// The reconciled status is saved back.
+	saved, err := storage.LoadAssignments(session)
+	if err != nil {
+		t.Fatalf("LoadAssignments: %v", err)
+	}
+	if saved.Assignments[0].Status != "failed" {
+		t.Errorf("saved status = %q, want failed", saved.Assignments[0].Status)
+	}
+
+	if _, err := loadResumedAssignments(t.Context(), "ntm-test-resume-none"); !errors.Is(err, checkpoint.ErrNoSavedAssignments) {
+		t.Errorf("resume without saved assignments: err = %v, want ErrNoSavedAssignments", err)
+	}
+}
+
+func TestRebalanceAssignmentsRequeuesFailedBeadsOntoFreePanes(t *testing.T) {
+	snapshots := []checkpoint.AssignmentSnapshot{
+		{BeadID: "bd-work", Pane: 0, AgentType: "claude", Status: "working"},
+		{BeadID: "bd-done", Pane: 1, AgentType: "codex", Status: "completed"},
+		{BeadID: "bd-fail", BeadTitle: "Fix crash", Pane: 3, AgentType: "codex", Status: "failed"},
+		{BeadID: "bd-fail2", Pane: 3, AgentType: "codex", Status: "failed"},
+		{BeadID: "bd-fail2", Pane: 4, AgentType: "codex", Status: "failed"},
+		{BeadID: "bd-work", Pane: 2, AgentType: "gemini", Status: "failed"},
+	}
+	agents := []assignAgentInfo{makeTestAgent(0, "claude"), makeTestAgent(1, "codex"), makeTestAgent(2, "gemini")}
+
+	updated, items := rebalanceAssignments(t.Context(), snapshots, nil, agents, &AssignCommandOptions{Strategy: "round-robin"})
+
+	// Pane 0 is working; panes 1 and 2 take the two distinct failed beads.
+	// bd-work is in flight elsewhere, so its old failure is not requeued.
+	if len(items) != 2 {
+		t.Fatalf("got %d new assignments, want 2: %+v", len(items), items)
+	}
+	for i, want := range []struct {
+		bead string
+		pane int
+	}{{"bd-fail", 1}, {"bd-fail2", 2}} {
+		if items[i].BeadID != want.bead || items[i].Pane != want.pane {
+			t.Errorf("items[%d] = %s on pane %d, want %s on pane %d", i, items[i].BeadID, items[i].Pane, want.bead, want.pane)
+		}
+	}
+
+	before := assignRebalanceCounts(snapshots)
+	after := assignRebalanceCounts(updated)
+	if before.Failed != 4 || after.Failed != 1 || after.Assigned != 2 || after.Working != 1 {
+		t.Errorf("before = %+v, after = %+v", before, after)
+	}
+	if updated[0].BeadID != "bd-work" || updated[0].Status != "working" {
+		t.Errorf("working assignment disturbed: %+v", updated[0])
+	}
+}
+
+func TestRebalanceAssignmentsAssignsUnheldReadyBeads(t *testing.T) {
+	snapshots := []checkpoint.AssignmentSnapshot{
+		{BeadID: "bd-work", Pane: 0, AgentType: "claude", Status: "working"},
+		{BeadID: "bd-done", Pane: 1, AgentType: "codex", Status: "completed"},
+		{BeadID: "bd-fail", Pane: 3, AgentType: "codex", Status: "failed"},
+	}
+	ready := []bv.BeadPreview{
+		{ID: "bd-work", Title: "In flight"},
+		{ID: "bd-done", Title: "Already finished"},
+		{ID: "bd-fail", Title: "Failed earlier"},
+		{ID: "bd-new", Title: "Fresh work", Priority: "P1"},
+		{ID: "bd-extra", Title: "More work", Priority: "P2"},
+	}
+	agents := []assignAgentInfo{makeTestAgent(0, "claude"), makeTestAgent(1, "codex"), makeTestAgent(2, "gemini")}
+
+	updated, items := rebalanceAssignments(t.Context(), snapshots, ready, agents, &AssignCommandOptions{Strategy: "round-robin"})
+
+	// Panes 1 and 2 are free: the failed bead goes first, then the ready
+	// beads nobody holds. Held and completed beads are not reassigned.
+	var got []string
+	for _, item := range items {
+		got = append(got, item.BeadID)
+		if item.Pane == 0 {
+			t.Errorf("%s assigned to the working pane 0", item.BeadID)
+		}
+	}
+	if strings.Join(got, ",") != "bd-fail,bd-new,bd-extra" {
+		t.Fatalf("assigned beads = %v, want bd-fail, bd-new, bd-extra", got)
+	}
+	if after := assignRebalanceCounts(updated); after.Assigned != 3 || after.Failed != 0 || after.Total != 5 {
+		t.Errorf("after = %+v, want the new beads assigned alongside the kept rows", after)
+	}
+}
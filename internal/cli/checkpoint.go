@@ -13,6 +13,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/Dicklesworthstone/ntm/internal/checkpoint"
+	"github.com/Dicklesworthstone/ntm/internal/config"
+	"github.com/Dicklesworthstone/ntm/internal/encryption"
+	"github.com/Dicklesworthstone/ntm/internal/output"
 	sessionPkg "github.com/Dicklesworthstone/ntm/internal/session"
 	"github.com/Dicklesworthstone/ntm/internal/tmux"
 	"github.com/Dicklesworthstone/ntm/internal/tui/theme"
@@ -97,9 +100,13 @@ Examples:
 	cmd.AddCommand(newCheckpointShowCmd())
 	cmd.AddCommand(newCheckpointRestoreCmd())
 	cmd.AddCommand(newCheckpointDeleteCmd())
+	cmd.AddCommand(newCheckpointAnnotateCmd())
+	cmd.AddCommand(newCheckpointDeltaCmd())
+	cmd.AddCommand(newCheckpointDiffCmd())
 	cmd.AddCommand(newCheckpointVerifyCmd())
 	cmd.AddCommand(newCheckpointExportCmd())
 	cmd.AddCommand(newCheckpointImportCmd())
+	cmd.AddCommand(newCheckpointImportAllCmd())
 
 	return cmd
 }
@@ -108,6 +115,8 @@ func newCheckpointSaveCmd() *cobra.Command {
 	var description string
 	var scrollbackLines int
 	var noGit bool
+	var incremental bool
+	var tags []string
 
 	cmd := &cobra.Command{
 		Use:   "save <session>",
@@ -120,11 +129,21 @@ The checkpoint includes:
 - Git repository state (branch, commit, dirty status)
 - Diff patch of uncommitted changes (optional)
 
+With --incremental the checkpoint is saved against the session's latest
+checkpoint and stores only the scrollback and git files that changed since;
+the rest are read back through that parent. Deleting a parent folds its
+files into the checkpoints that depend on it, and exports are always full.
+
+Tags label the checkpoint for later discovery with 'checkpoint list --tag';
+they can be changed afterwards with 'checkpoint annotate'.
+
 Examples:
   ntm checkpoint save myproject
   ntm checkpoint save myproject -m "Before major refactor"
   ntm checkpoint save myproject --scrollback=500
-  ntm checkpoint save myproject --no-git`,
+  ntm checkpoint save myproject --no-git
+  ntm checkpoint save myproject --incremental
+  ntm checkpoint save myproject --tag pre-refactor --tag known-good`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			session, err := resolveCheckpointLiveSessionArg(args[0], cmd.OutOrStdout())
@@ -145,6 +164,18 @@ Examples:
 			if description != "" {
 				opts = append(opts, checkpoint.WithDescription(description))
 			}
+			if len(tags) > 0 {
+				opts = append(opts, checkpoint.WithTags(tags...))
+			}
+			if incremental {
+				latest, err := checkpoint.NewStorage().GetLatest(session)
+				if err != nil && !errors.Is(err, checkpoint.ErrNoCheckpoints) {
+					return fmt.Errorf("finding parent checkpoint: %w", err)
+				}
+				if latest != nil {
+					opts = append(opts, checkpoint.WithParent(latest.ID))
+				}
+			}
 
 			capturer := checkpoint.NewCapturer()
 			cp, err := capturer.Create(session, "", opts...)
@@ -158,11 +189,14 @@ Examples:
 					"session":           session,
 					"created_at":        cp.CreatedAt,
 					"description":       cp.Description,
+					"tags":              cp.Tags,
 					"pane_count":        cp.PaneCount,
 					"has_git":           cp.Git.Commit != "",
 					"assignments_count": len(cp.Assignments),
 					"assignments":       cp.Assignments,
 					"bv_summary":        cp.BVSummary,
+					"parent_id":         cp.ParentID,
+					"changed_files":     cp.ChangedFiles,
 				})
 			}
 
@@ -170,6 +204,9 @@ Examples:
 			fmt.Printf("%s\u2713%s Checkpoint created: %s\n", colorize(t.Success), "\033[0m", cp.ID)
 			fmt.Printf("  Session: %s\n", session)
 			fmt.Printf("  Panes: %d\n", cp.PaneCount)
+			if cp.IsIncremental() {
+				fmt.Printf("  Incremental: %d changed file(s) since %s\n", len(cp.ChangedFiles), cp.ParentID)
+			}
 			if cp.Git.Commit != "" {
 				commitPreview := cp.Git.Commit
 				if len(commitPreview) > 8 {
@@ -184,6 +221,9 @@ Examples:
 			if cp.Description != "" {
 				fmt.Printf("  Description: %s\n", cp.Description)
 			}
+			if len(cp.Tags) > 0 {
+				fmt.Printf("  Tags: %s\n", strings.Join(cp.Tags, ", "))
+			}
 			if summary := summarizeAssignmentCounts(cp.Assignments); summary.total > 0 {
 				fmt.Printf("  Assignments: %d total (%d working, %d assigned, %d failed)\n",
 					summary.total, summary.working, summary.assigned, summary.failed)
@@ -200,19 +240,24 @@ Examples:
 	cmd.Flags().StringVarP(&description, "message", "m", "", "checkpoint description")
 	cmd.Flags().IntVar(&scrollbackLines, "scrollback", 1000, "lines of scrollback to capture per pane")
 	cmd.Flags().BoolVar(&noGit, "no-git", false, "skip capturing git state")
+	cmd.Flags().BoolVar(&incremental, "incremental", false, "store only files changed since the latest checkpoint")
+	cmd.Flags().StringSliceVar(&tags, "tag", nil, "tag to attach (repeatable or comma-separated)")
 
 	return cmd
 }
 
 func newCheckpointListCmd() *cobra.Command {
+	var tag string
+
 	cmd := &cobra.Command{
 		Use:   "list [session]",
 		Short: "List checkpoints",
-		Long: `List all checkpoints, optionally filtered by session.
+		Long: `List all checkpoints, optionally filtered by session or tag.
 
 Examples:
-  ntm checkpoint list              # List all checkpoints
-  ntm checkpoint list myproject    # List checkpoints for session`,
+  ntm checkpoint list                            # List all checkpoints
+  ntm checkpoint list myproject                  # List checkpoints for session
+  ntm checkpoint list --tag known-good-baseline  # Only checkpoints with this tag`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			storage := checkpoint.NewStorage()
@@ -223,7 +268,7 @@ Examples:
 				if err != nil {
 					return err
 				}
-				return listSessionCheckpoints(storage, session)
+				return listSessionCheckpoints(storage, session, tag)
 			}
 
 			// List all sessions with checkpoints
@@ -256,6 +301,12 @@ Examples:
 					if err != nil {
 						return fmt.Errorf("listing checkpoints for session %q: %w", sess, err)
 					}
+					if tag != "" {
+						cps = filterCheckpointsByTag(cps, tag)
+						if len(cps) == 0 {
+							continue
+						}
+					}
 					hasCandidates, err := storage.HasCheckpointCandidates(sess)
 					if err != nil {
 						return fmt.Errorf("checking checkpoint candidates for session %q: %w", sess, err)
@@ -286,6 +337,12 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("listing checkpoints for session %q: %w", sess, err)
 				}
+				if tag != "" {
+					cps = filterCheckpointsByTag(cps, tag)
+					if len(cps) == 0 {
+						continue
+					}
+				}
 				hasCandidates, err := storage.HasCheckpointCandidates(sess)
 				if err != nil {
 					return fmt.Errorf("checking checkpoint candidates for session %q: %w", sess, err)
@@ -316,7 +373,11 @@ Examples:
 					if cp.Description != "" {
 						desc = fmt.Sprintf(" - %s", truncateStr(cp.Description, 30))
 					}
-					fmt.Printf("    %s (%s)%s%s\n", cp.ID, age, gitMark, desc)
+					tagMark := ""
+					if len(cp.Tags) > 0 {
+						tagMark = fmt.Sprintf(" {%s}", strings.Join(cp.Tags, ","))
+					}
+					fmt.Printf("    %s (%s)%s%s%s\n", cp.ID, age, gitMark, tagMark, desc)
 				}
 				if len(invalidIDs) > 0 {
 					fmt.Printf("    invalid entries: %s\n", strings.Join(invalidIDs, ", "))
@@ -328,9 +389,22 @@ Examples:
 		},
 	}
 
+	cmd.Flags().StringVar(&tag, "tag", "", "only list checkpoints with this tag")
+
 	return cmd
 }
 
+// filterCheckpointsByTag keeps the checkpoints carrying tag.
+func filterCheckpointsByTag(cps []*checkpoint.Checkpoint, tag string) []*checkpoint.Checkpoint {
+	var filtered []*checkpoint.Checkpoint
+	for _, cp := range cps {
+		if cp.HasTag(tag) {
+			filtered = append(filtered, cp)
+		}
+	}
+	return filtered
+}
+
 // listCheckpointSessions lists all session names that have checkpoints.
 func listCheckpointSessions(storage *checkpoint.Storage) ([]string, error) {
 	entries, err := os.ReadDir(storage.BaseDir)
@@ -353,11 +427,25 @@ func listCheckpointSessions(storage *checkpoint.Storage) ([]string, error) {
 	return sessions, nil
 }
 
-func listSessionCheckpoints(storage *checkpoint.Storage, session string) error {
+func listSessionCheckpoints(storage *checkpoint.Storage, session, tag string) error {
 	cps, err := storage.List(session)
 	if err != nil {
 		return fmt.Errorf("listing checkpoints: %w", err)
 	}
+	if tag != "" {
+		cps = filterCheckpointsByTag(cps, tag)
+		if len(cps) == 0 {
+			if jsonOutput {
+				return json.NewEncoder(os.Stdout).Encode(map[string]interface{}{
+					"session":     session,
+					"checkpoints": []interface{}{},
+					"count":       0,
+				})
+			}
+			fmt.Printf("No checkpoints tagged %q for session %q.\n", tag, session)
+			return nil
+		}
+	}
 	invalidIDs, err := storage.InvalidCheckpointIDs(session)
 	if err != nil {
 		return fmt.Errorf("listing invalid checkpoints: %w", err)
@@ -407,13 +495,20 @@ func listSessionCheckpoints(storage *checkpoint.Storage, session string) error {
 		if cp.Git.Commit != "" {
 			gitMark = fmt.Sprintf(" %s[git]%s", colorize(t.Info), "\033[0m")
 		}
+		tagMark := ""
+		if len(cp.Tags) > 0 {
+			tagMark = fmt.Sprintf(" %s{%s}%s", colorize(t.Info), strings.Join(cp.Tags, ","), "\033[0m")
+		}
 		desc := ""
 		if cp.Description != "" {
 			desc = fmt.Sprintf("\n    %s%s%s", "\033[2m", cp.Description, "\033[0m")
 		}
-		fmt.Printf("  %s%s%s  %s  %d pane(s)%s%s\n",
+		if cp.Note != "" {
+			desc += fmt.Sprintf("\n    %snote: %s%s", "\033[2m", cp.Note, "\033[0m")
+		}
+		fmt.Printf("  %s%s%s  %s  %d pane(s)%s%s%s\n",
 			colorize(t.Primary), cp.ID, "\033[0m",
-			age, cp.PaneCount, gitMark, desc)
+			age, cp.PaneCount, gitMark, tagMark, desc)
 	}
 	if len(invalidIDs) > 0 {
 		fmt.Printf("\nInvalid checkpoint entries: %s\n", strings.Join(invalidIDs, ", "))
@@ -458,6 +553,12 @@ Examples:
 			if cp.Description != "" {
 				fmt.Printf("  Description: %s\n", cp.Description)
 			}
+			if len(cp.Tags) > 0 {
+				fmt.Printf("  Tags: %s\n", strings.Join(cp.Tags, ", "))
+			}
+			if cp.Note != "" {
+				fmt.Printf("  Note: %s\n", cp.Note)
+			}
 			fmt.Println()
 
 			fmt.Printf("  %sPanes (%d):%s\n", "\033[1m", len(cp.Session.Panes), "\033[0m")
@@ -586,6 +687,247 @@ Examples:
 	return cmd
 }
 
+func newCheckpointAnnotateCmd() *cobra.Command {
+	var (
+		tags   []string
+		untags []string
+		note   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "annotate <session> <id>",
+		Short: "Tag a checkpoint or attach a note to it",
+		Long: `Add or remove tags and set a note on a checkpoint. Tags and the note are
+stored in the checkpoint metadata. Use 'ntm checkpoint list --tag' to find
+tagged checkpoints; tagged auto-checkpoints are never rotated away.
+
+Examples:
+  ntm checkpoint annotate myproject 20251210-143052 --tag known-good-baseline
+  ntm checkpoint annotate myproject 20251210-143052 --note "before auth refactor"
+  ntm checkpoint annotate myproject 20251210-143052 --untag wip`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			noteChanged := cmd.Flags().Changed("note")
+			if len(tags) == 0 && len(untags) == 0 && !noteChanged {
+				return fmt.Errorf("nothing to annotate: pass --tag, --untag, or --note")
+			}
+			session, err := resolveCheckpointStorageSessionArg(args[0])
+			if err != nil {
+				return err
+			}
+			id := args[1]
+
+			var notePtr *string
+			if noteChanged {
+				notePtr = &note
+			}
+			storage := checkpoint.NewStorage()
+			cp, err := storage.Annotate(session, id, tags, untags, notePtr)
+			if err != nil {
+				return fmt.Errorf("annotating checkpoint: %w", err)
+			}
+
+			if jsonOutput {
+				return json.NewEncoder(os.Stdout).Encode(map[string]interface{}{
+					"session": session,
+					"id":      cp.ID,
+					"tags":    cp.Tags,
+					"note":    cp.Note,
+				})
+			}
+
+			t := theme.Current()
+			fmt.Printf("%s\u2713%s Annotated checkpoint: %s\n", colorize(t.Success), "\033[0m", cp.ID)
+			if len(cp.Tags) > 0 {
+				fmt.Printf("  Tags: %s\n", strings.Join(cp.Tags, ", "))
+			}
+			if cp.Note != "" {
+				fmt.Printf("  Note: %s\n", cp.Note)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&tags, "tag", nil, "tag to add (repeatable or comma-separated)")
+	cmd.Flags().StringSliceVar(&untags, "untag", nil, "tag to remove (repeatable or comma-separated)")
+	cmd.Flags().StringVar(&note, "note", "", "note to attach (empty string clears it)")
+
+	return cmd
+}
+
+func newCheckpointDeltaCmd() *cobra.Command {
+	var dir string
+
+	cmd := &cobra.Command{
+		Use:   "delta <session> <id>",
+		Short: "Show what changed in the repository since a checkpoint",
+		Long: `Compare a checkpoint's captured git state with the repository as it is now:
+branch and commit movement, commits made since, current status counts, and
+every file that differs from the checkpoint commit (including untracked
+files). Files that already had uncommitted changes when the checkpoint was
+taken are marked.
+
+The checkpoint's working directory is used unless --dir is given.
+
+Examples:
+  ntm checkpoint delta myproject 20251210-143052
+  ntm checkpoint delta myproject 20251210-143052 --dir ~/src/myproject
+  ntm checkpoint delta myproject 20251210-143052 --json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session, err := resolveCheckpointStorageSessionArg(args[0])
+			if err != nil {
+				return err
+			}
+			id := args[1]
+
+			storage := checkpoint.NewStorage()
+			delta, err := storage.GitDelta(session, id, util.ExpandPath(dir))
+			if err != nil {
+				return fmt.Errorf("computing checkpoint delta: %w", err)
+			}
+
+			if jsonOutput {
+				return json.NewEncoder(os.Stdout).Encode(delta)
+			}
+
+			t := theme.Current()
+			fmt.Printf("%sSince checkpoint: %s%s\n", "\033[1m", delta.CheckpointID, "\033[0m")
+			fmt.Printf("%s%s%s\n\n", "\033[2m", strings.Repeat("\u2500", 50), "\033[0m")
+
+			fmt.Printf("  Working Dir: %s\n", delta.WorkingDir)
+			if delta.BranchChanged() {
+				fmt.Printf("  Branch: %s \u2192 %s%s%s\n", delta.Checkpoint.Branch, colorize(t.Warning), delta.Current.Branch, "\033[0m")
+			} else {
+				fmt.Printf("  Branch: %s\n", delta.Current.Branch)
+			}
+			fmt.Printf("  Commit: %s \u2192 %s (%d commit(s) since)\n",
+				shortCommit(delta.Checkpoint.Commit), shortCommit(delta.Current.Commit), delta.CommitsSince)
+			fmt.Printf("  Uncommitted: %d staged, %d unstaged, %d untracked (was %d, %d, %d)\n",
+				delta.Current.StagedCount, delta.Current.UnstagedCount, delta.Current.UntrackedCount,
+				delta.Checkpoint.StagedCount, delta.Checkpoint.UnstagedCount, delta.Checkpoint.UntrackedCount)
+			fmt.Println()
+
+			if len(delta.Files) == 0 {
+				fmt.Printf("  %sNo changes since checkpoint%s\n", colorize(t.Success), "\033[0m")
+				return nil
+			}
+			fmt.Printf("  %sFiles changed (%d):%s\n", "\033[1m", len(delta.Files), "\033[0m")
+			for _, f := range delta.Files {
+				marker := ""
+				if f.DirtyAtCheckpoint {
+					marker = " (already dirty at checkpoint)"
+				}
+				fmt.Printf("    %-10s %s%s\n", f.Status, f.Path, marker)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dir, "dir", "", "repository to compare (default: the checkpoint's working directory)")
+
+	return cmd
+}
+
+func newCheckpointDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff <session> <from-id> <to-id>",
+		Short: "Compare two checkpoints of a session",
+		Long: `Compare two saved checkpoints: panes added, removed, resized, or otherwise
+changed (paired by tmux pane ID, then by window and pane index), git branch,
+commit, and status-count changes, and checkpoint files (scrollback, git
+patch and status) whose checksums differ.
+
+Examples:
+  ntm checkpoint diff myproject 20251210-143052 20251211-091500
+  ntm checkpoint diff myproject 20251210-143052 20251211-091500 --json`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session, err := resolveCheckpointStorageSessionArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			storage := checkpoint.NewStorage()
+			from, err := storage.Load(session, args[1])
+			if err != nil {
+				return fmt.Errorf("loading checkpoint %s: %w", args[1], err)
+			}
+			to, err := storage.Load(session, args[2])
+			if err != nil {
+				return fmt.Errorf("loading checkpoint %s: %w", args[2], err)
+			}
+			diff, err := storage.Diff(from, to)
+			if err != nil {
+				return fmt.Errorf("comparing checkpoints: %w", err)
+			}
+
+			if jsonOutput {
+				return json.NewEncoder(os.Stdout).Encode(diff)
+			}
+			renderCheckpointDiff(os.Stdout, diff)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func renderCheckpointDiff(w io.Writer, diff *checkpoint.CheckpointDiff) {
+	t := theme.Current()
+	fmt.Fprintf(w, "%sCheckpoint diff: %s \u2192 %s%s\n", "\033[1m", diff.From, diff.To, "\033[0m")
+	fmt.Fprintf(w, "%s%s%s\n\n", "\033[2m", strings.Repeat("\u2500", 50), "\033[0m")
+
+	if diff.Empty() {
+		fmt.Fprintf(w, "  %sNo differences%s\n", colorize(t.Success), "\033[0m")
+		return
+	}
+	formatFields := func(fields []checkpoint.FieldChange) string {
+		parts := make([]string, 0, len(fields))
+		for _, f := range fields {
+			parts = append(parts, fmt.Sprintf("%s %s \u2192 %s", f.Field, f.From, f.To))
+		}
+		return strings.Join(parts, ", ")
+	}
+
+	if len(diff.Panes) > 0 {
+		fmt.Fprintf(w, "  %sPanes (%d):%s\n", "\033[1m", len(diff.Panes), "\033[0m")
+		for _, p := range diff.Panes {
+			line := fmt.Sprintf("    %-8s %s (%d.%d)", p.Change, p.ID, p.WindowIndex, p.Index)
+			if len(p.Fields) > 0 {
+				line += ": " + formatFields(p.Fields)
+			}
+			fmt.Fprintln(w, line)
+		}
+		fmt.Fprintln(w)
+	}
+	if len(diff.Git) > 0 {
+		fmt.Fprintf(w, "  %sGit:%s\n", "\033[1m", "\033[0m")
+		for _, c := range diff.Git {
+			from, to := c.From, c.To
+			if c.Field == "commit" {
+				from, to = shortCommit(from), shortCommit(to)
+			}
+			fmt.Fprintf(w, "    %s: %s \u2192 %s\n", c.Field, from, to)
+		}
+		fmt.Fprintln(w)
+	}
+	if len(diff.Files) > 0 {
+		fmt.Fprintf(w, "  %sFiles (%d):%s\n", "\033[1m", len(diff.Files), "\033[0m")
+		for _, f := range diff.Files {
+			fmt.Fprintf(w, "    %-8s %s\n", f.Change, f.Path)
+		}
+	}
+}
+
+// shortCommit abbreviates a commit SHA for display.
+func shortCommit(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
+
 func newCheckpointRestoreCmd() *cobra.Command {
 	var (
 		force           bool
@@ -709,6 +1051,9 @@ Examples:
 				}
 				return fmt.Errorf("restoring checkpoint: %w", err)
 			}
+			if !dryRun {
+				recordProficiencyAction((*config.ProficiencyConfig).IncrementCheckpointRestore)
+			}
 
 			if jsonOutput {
 				return json.NewEncoder(os.Stdout).Encode(map[string]interface{}{
@@ -1009,10 +1354,15 @@ func newCheckpointExportCmd() *cobra.Command {
 		redactSecrets bool
 		noScrollback  bool
 		noGitPatch    bool
+		noSign        bool
+		includeReadme bool
+		encrypt       bool
+		allDir        string
+		newerThan     string
 	)
 
 	cmd := &cobra.Command{
-		Use:   "export <session> <id>",
+		Use:   "export <session> [id]",
 		Short: "Export a checkpoint to a shareable archive",
 		Long: `Export a checkpoint to a tar.gz or zip archive for sharing.
 
@@ -1022,24 +1372,89 @@ The exported archive contains all checkpoint data:
 - Git patches (uncommitted changes)
 - MANIFEST.json with SHA256 checksums
 
+When an encryption key is configured ([encryption] in config), the manifest
+is also signed with HMAC-SHA256 so importers holding the same key can detect
+tampering. Use --no-sign to skip signing.
+
+Use --encrypt to seal the whole archive with AES-256-GCM using the key from
+the [encryption] key source (NTM_ENCRYPTION_KEY by default). Import detects
+encrypted archives and decrypts them with the same configured key or keyring.
+
 Use --redact-secrets to remove sensitive data (API keys, tokens) from
 scrollback files before sharing.
 
+Use --readme to add a README.md that summarizes the checkpoint (session,
+panes, git state) and explains how to import it, for recipients who have
+not used ntm checkpoints before.
+
+Use --all <dir> instead of a checkpoint ID to export every checkpoint of the
+session into <dir> as <session>_<id>.tar.gz (or .zip), reporting each result
+and the total size. Add --newer-than to export only recent checkpoints.
+
 Examples:
   ntm checkpoint export myproject 20251210-143052
+  ntm checkpoint export myproject --all ./backup
+  ntm checkpoint export myproject --all ./backup --newer-than 7d --format=zip
   ntm checkpoint export myproject 20251210-143052 --output=backup.tar.gz
   ntm checkpoint export myproject 20251210-143052 --format=zip
-  ntm checkpoint export myproject 20251210-143052 --redact-secrets`,
-		Args: cobra.ExactArgs(2),
+  ntm checkpoint export myproject 20251210-143052 --redact-secrets
+  ntm checkpoint export myproject 20251210-143052 --encrypt
+  ntm checkpoint export myproject 20251210-143052 --readme`,
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if allDir != "" && (len(args) > 1 || output != "") {
+				return fmt.Errorf("--all cannot be combined with a checkpoint ID or --output")
+			}
+			if allDir == "" && newerThan != "" {
+				return fmt.Errorf("--newer-than requires --all")
+			}
+			if allDir == "" && len(args) < 2 {
+				return fmt.Errorf("checkpoint ID required (or use --all <dir>)")
+			}
 			session, err := resolveCheckpointStorageSessionArg(args[0])
 			if err != nil {
 				return err
 			}
-			id := args[1]
+
+			// Build options
+			opts := checkpoint.DefaultExportOptions()
+			if format == "zip" {
+				opts.Format = checkpoint.FormatZip
+			}
+			opts.RedactSecrets = redactSecrets
+			opts.IncludeScrollback = !noScrollback
+			opts.IncludeGitPatch = !noGitPatch
+			opts.IncludeReadme = includeReadme
+			if !noSign {
+				signingKey, _, err := resolveCheckpointSigningKeys()
+				if err != nil {
+					return err
+				}
+				opts.SigningKey = signingKey
+			}
+			if encrypt {
+				key, err := encryption.ResolveKey(checkpointArchiveKeyConfig())
+				if err != nil {
+					return fmt.Errorf("resolving checkpoint encryption key: %w", err)
+				}
+				opts.EncryptionKey = key
+			}
 
 			storage := checkpoint.NewStorage()
 
+			if allDir != "" {
+				var since time.Time
+				if newerThan != "" {
+					age, err := util.ParseDuration(newerThan)
+					if err != nil {
+						return fmt.Errorf("invalid --newer-than %q: %w", newerThan, err)
+					}
+					since = time.Now().Add(-age)
+				}
+				return runCheckpointExportAll(storage, session, allDir, since, opts)
+			}
+			id := args[1]
+
 			// Verify checkpoint exists and is loadable. Invalid exact-ID entries should
 			// be reported as load failures, not as "not found".
 			if _, err := storage.Load(session, id); err != nil {
@@ -1063,15 +1478,6 @@ Examples:
 				outputPath = fmt.Sprintf("%s_%s%s", session, id, ext)
 			}
 
-			// Build options
-			opts := checkpoint.DefaultExportOptions()
-			if format == "zip" {
-				opts.Format = checkpoint.FormatZip
-			}
-			opts.RedactSecrets = redactSecrets
-			opts.IncludeScrollback = !noScrollback
-			opts.IncludeGitPatch = !noGitPatch
-
 			manifest, err := storage.Export(session, id, outputPath, opts)
 			if err != nil {
 				return fmt.Errorf("exporting checkpoint: %w", err)
@@ -1085,6 +1491,9 @@ Examples:
 					"checkpoint_name": manifest.CheckpointName,
 					"file_count":      len(manifest.Files),
 					"exported_at":     manifest.ExportedAt,
+					"signed":          manifest.Signature != "",
+					"encrypted":       manifest.Encrypted,
+					"cipher":          manifest.Cipher,
 				})
 			}
 
@@ -1093,6 +1502,12 @@ Examples:
 			fmt.Printf("  Session: %s\n", manifest.SessionName)
 			fmt.Printf("  Checkpoint: %s\n", manifest.CheckpointID)
 			fmt.Printf("  Files: %d\n", len(manifest.Files))
+			if manifest.Signature != "" {
+				fmt.Printf("  Signed: yes\n")
+			}
+			if manifest.Encrypted {
+				fmt.Printf("  Encrypted: %s\n", manifest.Cipher)
+			}
 
 			return nil
 		},
@@ -1103,16 +1518,56 @@ Examples:
 	cmd.Flags().BoolVar(&redactSecrets, "redact-secrets", false, "remove sensitive data before export")
 	cmd.Flags().BoolVar(&noScrollback, "no-scrollback", false, "exclude scrollback buffers")
 	cmd.Flags().BoolVar(&noGitPatch, "no-git-patch", false, "exclude git patch file")
+	cmd.Flags().BoolVar(&noSign, "no-sign", false, "do not sign the manifest even when a key is configured")
+	cmd.Flags().BoolVar(&includeReadme, "readme", false, "include a generated README.md describing the checkpoint and how to import it")
+	cmd.Flags().BoolVar(&encrypt, "encrypt", false, "encrypt the archive with the configured encryption key (AES-256-GCM)")
+	cmd.Flags().StringVar(&allDir, "all", "", "export every checkpoint of the session into this directory")
+	cmd.Flags().StringVar(&newerThan, "newer-than", "", "with --all, only export checkpoints newer than this age (e.g. 12h, 7d)")
 
 	return cmd
 }
 
+// runCheckpointExportAll exports a session's checkpoints into dir and reports
+// each one; it fails if any checkpoint could not be exported.
+func runCheckpointExportAll(storage *checkpoint.Storage, session, dir string, since time.Time, opts checkpoint.ExportOptions) error {
+	result, err := storage.ExportAll(session, dir, since, opts)
+	if err != nil {
+		return fmt.Errorf("exporting checkpoints: %w", err)
+	}
+
+	if jsonOutput {
+		if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+			return err
+		}
+	} else {
+		t := theme.Current()
+		if len(result.Checkpoints) == 0 {
+			fmt.Printf("No checkpoints to export for session %s.\n", session)
+			return nil
+		}
+		for _, entry := range result.Checkpoints {
+			if entry.Error != "" {
+				fmt.Printf("%s\u2717%s %s: %s\n", colorize(t.Error), "\033[0m", entry.CheckpointID, entry.Error)
+				continue
+			}
+			fmt.Printf("%s\u2713%s %s -> %s (%s)\n", colorize(t.Success), "\033[0m", entry.CheckpointID, entry.Archive, formatBytes(entry.Bytes))
+		}
+		fmt.Printf("\nExported %d, failed %d; total %s in %s\n", result.Exported, result.Failed, formatBytes(result.TotalBytes), dir)
+	}
+
+	if result.Failed > 0 {
+		return fmt.Errorf("%d of %d checkpoint(s) failed to export", result.Failed, len(result.Checkpoints))
+	}
+	return nil
+}
+
 func newCheckpointImportCmd() *cobra.Command {
 	var (
-		targetSession  string
-		targetDir      string
-		skipVerify     bool
-		allowOverwrite bool
+		targetSession    string
+		targetDir        string
+		skipVerify       bool
+		allowOverwrite   bool
+		requireSignature bool
 	)
 
 	cmd := &cobra.Command{
@@ -1126,11 +1581,19 @@ metadata.json and session data.
 Use --session to import into a different session name.
 Use --target-dir to override the working directory path.
 
+When an encryption key is configured, the manifest signature is verified and
+a missing or invalid signature produces a warning. Use --require-signature to
+fail the import instead.
+
+Archives exported with --encrypt are decrypted automatically using the
+[encryption] key source or keyring; the import fails if no key matches.
+
 Examples:
   ntm checkpoint import backup.tar.gz
   ntm checkpoint import backup.zip --session=restored-session
   ntm checkpoint import backup.tar.gz --target-dir=/new/path/to/project
-  ntm checkpoint import backup.tar.gz --skip-verify`,
+  ntm checkpoint import backup.tar.gz --skip-verify
+  ntm checkpoint import backup.tar.gz --require-signature`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			archivePath := args[0]
@@ -1142,11 +1605,25 @@ Examples:
 
 			storage := checkpoint.NewStorage()
 
+			_, verifyKeys, err := resolveCheckpointSigningKeys()
+			if err != nil && requireSignature {
+				return err
+			}
+			if err != nil {
+				output.PrintWarningf("checkpoint signature not verified: %v", err)
+			}
+
 			opts := checkpoint.ImportOptions{
-				TargetSession:   targetSession,
-				TargetDir:       targetDir,
-				VerifyChecksums: !skipVerify,
-				AllowOverwrite:  allowOverwrite,
+				TargetSession:    targetSession,
+				TargetDir:        targetDir,
+				VerifyChecksums:  !skipVerify,
+				AllowOverwrite:   allowOverwrite,
+				SignatureKeys:    verifyKeys,
+				RequireSignature: requireSignature,
+				OnSignatureWarning: func(err error) {
+					output.PrintWarningf("%v", err)
+				},
+				DecryptionKeys: resolveCheckpointArchiveKeyring,
 			}
 
 			cp, err := storage.Import(archivePath, opts)
@@ -1184,10 +1661,144 @@ Examples:
 	cmd.Flags().StringVar(&targetDir, "target-dir", "", "override working directory path")
 	cmd.Flags().BoolVar(&skipVerify, "skip-verify", false, "skip checksum verification")
 	cmd.Flags().BoolVar(&allowOverwrite, "overwrite", false, "overwrite existing checkpoint")
+	cmd.Flags().BoolVar(&requireSignature, "require-signature", false, "fail if the manifest signature is missing or invalid")
+
+	return cmd
+}
+
+func newCheckpointImportAllCmd() *cobra.Command {
+	var (
+		targetSession    string
+		skipVerify       bool
+		allowOverwrite   bool
+		requireSignature bool
+		dedupe           bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import-all <dir>",
+		Short: "Import every checkpoint archive in a directory",
+		Long: `Import each .tar.gz, .tgz, and .zip archive directly inside a directory,
+in name order. Every archive gets the same path and checksum validation as
+'ntm checkpoint import'; a failing archive is reported and the rest are still
+imported.
+
+Use --dedupe-across-sessions to store files that are identical across the
+imported checkpoints only once (as hard links), and report the space saved.
+
+Examples:
+  ntm checkpoint import-all ./from-teammate
+  ntm checkpoint import-all ./from-teammate --dedupe-across-sessions`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := args[0]
+			storage := checkpoint.NewStorage()
+
+			_, verifyKeys, err := resolveCheckpointSigningKeys()
+			if err != nil && requireSignature {
+				return err
+			}
+			if err != nil {
+				output.PrintWarningf("checkpoint signature not verified: %v", err)
+			}
+
+			opts := checkpoint.ImportOptions{
+				TargetSession:    targetSession,
+				VerifyChecksums:  !skipVerify,
+				AllowOverwrite:   allowOverwrite,
+				SignatureKeys:    verifyKeys,
+				RequireSignature: requireSignature,
+				OnSignatureWarning: func(err error) {
+					output.PrintWarningf("%v", err)
+				},
+				DecryptionKeys: resolveCheckpointArchiveKeyring,
+			}
+
+			result, err := storage.ImportAll(dir, opts, dedupe)
+			if err != nil {
+				return fmt.Errorf("importing checkpoints: %w", err)
+			}
+
+			if jsonOutput {
+				if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+					return err
+				}
+			} else {
+				t := theme.Current()
+				if len(result.Archives) == 0 {
+					fmt.Printf("No checkpoint archives found in %s.\n", dir)
+					return nil
+				}
+				for _, entry := range result.Archives {
+					if entry.Error != "" {
+						fmt.Printf("%s\u2717%s %s: %s\n", colorize(t.Error), "\033[0m", entry.Archive, entry.Error)
+						continue
+					}
+					dedupeNote := ""
+					if entry.DedupedFiles > 0 {
+						dedupeNote = fmt.Sprintf(" (%d file(s) deduplicated, %s saved)", entry.DedupedFiles, formatBytes(entry.BytesSaved))
+					}
+					fmt.Printf("%s\u2713%s %s -> %s/%s%s\n", colorize(t.Success), "\033[0m", entry.Archive, entry.SessionName, entry.CheckpointID, dedupeNote)
+				}
+				fmt.Printf("\nImported %d, failed %d", result.Imported, result.Failed)
+				if dedupe {
+					fmt.Printf("; dedup saved %s across %d file(s)", formatBytes(result.BytesSaved), result.DedupedFiles)
+				}
+				fmt.Println()
+			}
+
+			if result.Failed > 0 {
+				return fmt.Errorf("%d of %d archive(s) failed to import", result.Failed, len(result.Archives))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&targetSession, "session", "", "import every checkpoint into this session name")
+	cmd.Flags().BoolVar(&skipVerify, "skip-verify", false, "skip checksum verification")
+	cmd.Flags().BoolVar(&allowOverwrite, "overwrite", false, "overwrite existing checkpoints")
+	cmd.Flags().BoolVar(&requireSignature, "require-signature", false, "fail an archive whose manifest signature is missing or invalid")
+	cmd.Flags().BoolVar(&dedupe, "dedupe-across-sessions", false, "hard-link files identical across the imported checkpoints")
 
 	return cmd
 }
 
+// resolveCheckpointSigningKeys returns the key used to sign exported
+// manifests and the keyring used to verify imported ones. Both are nil when
+// no encryption key is configured.
+func resolveCheckpointSigningKeys() ([]byte, [][]byte, error) {
+	if cfg == nil || !cfg.Encryption.Enabled {
+		return nil, nil, nil
+	}
+	keyCfg := encryptionKeyConfig(cfg.Encryption)
+	signingKey, err := encryption.ResolveKey(keyCfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("resolving checkpoint signing key: %w", err)
+	}
+	verifyKeys, err := encryption.ResolveKeyring(keyCfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("resolving checkpoint signing keyring: %w", err)
+	}
+	return signingKey, verifyKeys, nil
+}
+
+// checkpointArchiveKeyConfig returns the key source used to encrypt and
+// decrypt checkpoint archives. Unlike manifest signing it does not require
+// encryption to be enabled: --encrypt and an encrypted archive are explicit.
+func checkpointArchiveKeyConfig() encryption.KeyConfig {
+	encCfg := config.DefaultEncryptionConfig()
+	if cfg != nil {
+		encCfg = cfg.Encryption
+	}
+	return encryptionKeyConfig(encCfg)
+}
+
+// resolveCheckpointArchiveKeyring returns the keys to try on an encrypted
+// checkpoint archive.
+func resolveCheckpointArchiveKeyring() ([][]byte, error) {
+	return encryption.ResolveKeyring(checkpointArchiveKeyConfig())
+}
+
 func summarizeAssignmentCounts(assignments []checkpoint.AssignmentSnapshot) assignmentSummary {
 	var summary assignmentSummary
 	summary.total = len(assignments)
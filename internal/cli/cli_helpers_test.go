@@ -249,22 +249,25 @@ func TestResolveSpawnAssignAgentType(t *testing.T) {
 		ccOnly, codOnly, gmiOnly, agyOnly bool
 		want                              string
 	}{
-		{"explicit agent", "Claude", false, false, false, false, "claude"},
-		{"explicit short code", "CC", false, false, false, false, "claude"},
-		{"explicit cli alias", "codex-cli", false, false, false, false, "codex"},
-		{"explicit spaced alias", " google-gemini ", false, false, false, false, "gemini"},
-		{"cc only flag", "", true, false, false, false, "claude"},
-		{"cod only flag", "", false, true, false, false, "codex"},
-		{"gmi only flag", "", false, false, true, false, "gemini"},
-		{"agy only flag", "", false, false, false, true, "antigravity"},
+		{"explicit agent", "Claude", false, false, false, false, "cc"},
+		{"explicit short code", "CC", false, false, false, false, "cc"},
+		{"explicit cli alias", "codex-cli", false, false, false, false, "cod"},
+		{"explicit spaced alias", " google-gemini ", false, false, false, false, "gmi"},
+		{"cc only flag", "", true, false, false, false, "cc"},
+		{"cod only flag", "", false, true, false, false, "cod"},
+		{"gmi only flag", "", false, false, true, false, "gmi"},
+		{"agy only flag", "", false, false, false, true, "agy"},
 		{"no agent no flags", "", false, false, false, false, ""},
-		{"agent takes precedence", "codex", true, false, false, false, "codex"},
+		{"agent takes precedence", "codex", true, false, false, false, "cod"},
 		{"whitespace agent", "  ", false, false, false, false, ""},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			got := resolveSpawnAssignAgentType(tc.agent, tc.ccOnly, tc.codOnly, tc.gmiOnly, tc.agyOnly)
+			got, err := resolveSpawnAssignAgentType(tc.agent, tc.ccOnly, tc.codOnly, tc.gmiOnly, tc.agyOnly)
+			if err != nil {
+				t.Fatalf("resolveSpawnAssignAgentType(%q) error: %v", tc.agent, err)
+			}
 			if got != tc.want {
 				t.Errorf("resolveSpawnAssignAgentType(%q, %v, %v, %v, %v) = %q; want %q",
 					tc.agent, tc.ccOnly, tc.codOnly, tc.gmiOnly, tc.agyOnly, got, tc.want)
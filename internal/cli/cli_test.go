@@ -302,6 +302,7 @@ func resetFlags() {
 	noColor = false
 	redactMode = ""
 	allowSecret = false
+	configProfile = ""
 	robotHelp = false
 	robotStatus = false
 	robotVersion = false
@@ -485,6 +486,32 @@ func TestSuppressRobotDiagnosticsRestoresWriters(t *testing.T) {
 	}
 }
 
+func TestOpenTeeOutputDuplicatesCommandOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.json")
+	teeFile = path
+	t.Cleanup(func() {
+		closeTeeOutput()
+		teeFile = ""
+	})
+
+	root := &cobra.Command{Use: "root"}
+	child := &cobra.Command{Use: "child"}
+	root.AddCommand(child)
+	if err := openTeeOutput(child); err != nil {
+		t.Fatalf("openTeeOutput: %v", err)
+	}
+	fmt.Fprintln(child.OutOrStdout(), `{"teed":true}`)
+	closeTeeOutput()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read tee file: %v", err)
+	}
+	if string(data) != "{\"teed\":true}\n" {
+		t.Fatalf("tee file = %q", data)
+	}
+}
+
 func TestMaybeRunStartupCleanupMarksOnlyCompleteSuccess(t *testing.T) {
 	originalCfgFile := cfgFile
 	t.Cleanup(func() { cfgFile = originalCfgFile })
@@ -1955,6 +1982,53 @@ func TestRunEnsembleStatus_UsesPersistedStateWhenSessionOffline(t *testing.T) {
 	}
 }
 
+func TestRunEnsembleAbort_MarksOfflineStateAborted(t *testing.T) {
+	isolateSessionAgentStorage(t)
+	ensemble.CloseDefaultStateStore()
+	t.Cleanup(ensemble.CloseDefaultStateStore)
+
+	state := &ensemble.EnsembleSession{
+		SessionName: "offline-ensemble-abort",
+		Question:    "Wrong question",
+		Status:      ensemble.EnsembleActive,
+		CreatedAt:   time.Now().UTC(),
+		Assignments: []ensemble.ModeAssignment{
+			{ModeID: "deductive", PaneName: "pane-1", AgentType: "cc", Status: ensemble.AssignmentActive},
+		},
+	}
+	if err := ensemble.SaveSession("", state); err != nil {
+		t.Fatalf("SaveSession error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := runEnsembleAbort(&buf, state.SessionName, "json"); err != nil {
+		t.Fatalf("runEnsembleAbort error: %v", err)
+	}
+	var out ensembleStopOutput
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("unmarshal abort output: %v", err)
+	}
+	if !out.Success || out.FinalStatus != ensemble.EnsembleAborted.String() {
+		t.Fatalf("abort output = %+v, want success with final status aborted", out)
+	}
+
+	saved, err := ensemble.LoadSession(state.SessionName)
+	if err != nil {
+		t.Fatalf("LoadSession error: %v", err)
+	}
+	if saved.Status != ensemble.EnsembleAborted || !saved.Status.IsTerminal() {
+		t.Fatalf("saved status = %q, want terminal %q", saved.Status, ensemble.EnsembleAborted)
+	}
+
+	buf.Reset()
+	if err := runEnsembleAbort(&buf, state.SessionName, "json"); err != nil {
+		t.Fatalf("second runEnsembleAbort error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "already in terminal state: aborted") {
+		t.Fatalf("second abort output = %s", buf.String())
+	}
+}
+
 func TestRunEnsembleStatus_AllErrorSessionNotSynthesisReady(t *testing.T) {
 	isolateSessionAgentStorage(t)
 	ensemble.CloseDefaultStateStore()
@@ -1991,6 +2065,49 @@ func TestRunEnsembleStatus_AllErrorSessionNotSynthesisReady(t *testing.T) {
 	}
 }
 
+func TestRunEnsembleStatus_ReportsBudgetCutoff(t *testing.T) {
+	isolateSessionAgentStorage(t)
+	ensemble.CloseDefaultStateStore()
+	t.Cleanup(ensemble.CloseDefaultStateStore)
+
+	state := &ensemble.EnsembleSession{
+		SessionName:       "offline-ensemble-cutoff",
+		Question:          "How much did we spend?",
+		Status:            ensemble.EnsembleActive,
+		SynthesisStrategy: ensemble.StrategyConsensus,
+		CreatedAt:         time.Now().UTC(),
+		BudgetEstimate:    6000,
+		BudgetCutoff:      8000,
+		Assignments: []ensemble.ModeAssignment{
+			{ModeID: "deductive", PaneName: "pane-1", AgentType: "cc", Status: ensemble.AssignmentActive},
+			{ModeID: "bayesian", PaneName: "pane-2", AgentType: "cc", Status: ensemble.AssignmentError, Error: ensemble.OverBudgetSkipReason},
+		},
+	}
+	if err := ensemble.SaveSession("", state); err != nil {
+		t.Fatalf("SaveSession error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := runEnsembleStatus(&buf, state.SessionName, ensembleStatusOptions{Format: "json"}); err != nil {
+		t.Fatalf("runEnsembleStatus error: %v", err)
+	}
+	var out ensembleStatusOutput
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("unmarshal status output: %v", err)
+	}
+	if out.Budget.Cutoff != 8000 || out.Budget.InjectedEstimate != 6000 || out.Budget.CutoffSkipped != 1 {
+		t.Fatalf("budget = %+v, want cutoff 8000, estimate 6000, 1 skipped", out.Budget)
+	}
+
+	buf.Reset()
+	if err := runEnsembleStatus(&buf, state.SessionName, ensembleStatusOptions{Format: "text"}); err != nil {
+		t.Fatalf("runEnsembleStatus text error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "~6000 / 8000 estimated tokens injected, 1 modes skipped") {
+		t.Fatalf("text output missing cutoff line:\n%s", buf.String())
+	}
+}
+
 func TestRunEnsembleStop_MarksOfflineActiveStateStopped(t *testing.T) {
 	isolateSessionAgentStorage(t)
 	ensemble.CloseDefaultStateStore()
@@ -2036,6 +2153,305 @@ func TestRunEnsembleStop_MarksOfflineActiveStateStopped(t *testing.T) {
 	}
 }
 
+func TestRunEnsembleSetStrategy_PersistsValidatedStrategy(t *testing.T) {
+	isolateSessionAgentStorage(t)
+	ensemble.CloseDefaultStateStore()
+	t.Cleanup(ensemble.CloseDefaultStateStore)
+
+	state := &ensemble.EnsembleSession{
+		SessionName:       "set-strategy-ensemble",
+		Question:          "Switch strategy mid-run",
+		Status:            ensemble.EnsembleActive,
+		SynthesisStrategy: ensemble.StrategyConsensus,
+		CreatedAt:         time.Now().UTC(),
+	}
+	if err := ensemble.SaveSession("", state); err != nil {
+		t.Fatalf("SaveSession error: %v", err)
+	}
+
+	if err := runEnsembleSetStrategy(io.Discard, state.SessionName, "not-a-strategy", "json"); err == nil {
+		t.Fatal("expected error for unknown strategy")
+	}
+	if err := runEnsembleSetStrategy(io.Discard, state.SessionName, "creative", "xml"); err == nil || !strings.Contains(err.Error(), "invalid format") {
+		t.Fatalf("invalid format error = %v", err)
+	}
+	if saved, err := ensemble.LoadSession(state.SessionName); err != nil || saved.SynthesisStrategy != ensemble.StrategyConsensus {
+		t.Fatalf("strategy after rejected format = %v (err %v), want consensus unchanged", saved, err)
+	}
+
+	var buf bytes.Buffer
+	if err := runEnsembleSetStrategy(&buf, state.SessionName, "creative", "json"); err != nil {
+		t.Fatalf("runEnsembleSetStrategy error: %v", err)
+	}
+	var out ensembleSetStrategyOutput
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("unmarshal set-strategy output: %v", err)
+	}
+	if out.OldStrategy != "consensus" || out.NewStrategy != "creative" || !out.Changed {
+		t.Fatalf("unexpected output: %+v", out)
+	}
+
+	saved, err := ensemble.LoadSession(state.SessionName)
+	if err != nil {
+		t.Fatalf("LoadSession error: %v", err)
+	}
+	if saved.SynthesisStrategy != ensemble.StrategyCreative {
+		t.Fatalf("saved strategy = %q, want creative", saved.SynthesisStrategy)
+	}
+}
+
+func TestRunEnsemblePrompts_ShowsRecordedPrompts(t *testing.T) {
+	isolateSessionAgentStorage(t)
+	ensemble.CloseDefaultStateStore()
+	t.Cleanup(ensemble.CloseDefaultStateStore)
+
+	state := &ensemble.EnsembleSession{
+		SessionName:       "prompts-ensemble",
+		Question:          "Why is the cache cold?",
+		Status:            ensemble.EnsembleActive,
+		SynthesisStrategy: ensemble.StrategyConsensus,
+		CreatedAt:         time.Now().UTC(),
+		Assignments: []ensemble.ModeAssignment{
+			{ModeID: "deductive", PaneName: "prompts-ensemble__cc_1", AgentType: "cc", Status: ensemble.AssignmentActive, Prompt: "Reason deductively.\n\nWhy is the cache cold?"},
+			{ModeID: "abductive", PaneName: "prompts-ensemble__cod_1", AgentType: "cod", Status: ensemble.AssignmentActive},
+		},
+	}
+	if err := ensemble.SaveSession("", state); err != nil {
+		t.Fatalf("SaveSession error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := runEnsemblePrompts(&buf, state.SessionName, "", "json"); err != nil {
+		t.Fatalf("runEnsemblePrompts error: %v", err)
+	}
+	var out ensemblePromptsOutput
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("unmarshal prompts output: %v", err)
+	}
+	if len(out.Prompts) != 2 || out.Prompts[0].Prompt != state.Assignments[0].Prompt {
+		t.Fatalf("unexpected prompts output: %+v", out)
+	}
+
+	buf.Reset()
+	if err := runEnsemblePrompts(&buf, state.SessionName, "DEDUCTIVE", "text"); err != nil {
+		t.Fatalf("runEnsemblePrompts --mode error: %v", err)
+	}
+	text := buf.String()
+	if !strings.Contains(text, "Why is the cache cold?") || strings.Contains(text, "abductive") {
+		t.Fatalf("unexpected filtered text output:\n%s", text)
+	}
+
+	if err := runEnsemblePrompts(io.Discard, state.SessionName, "no-such-mode", "text"); err == nil {
+		t.Fatal("expected error for unknown mode")
+	}
+}
+
+func TestRunEnsembleSnapshot_WritesOfflineState(t *testing.T) {
+	isolateSessionAgentStorage(t)
+	ensemble.CloseDefaultStateStore()
+	t.Cleanup(ensemble.CloseDefaultStateStore)
+
+	state := &ensemble.EnsembleSession{
+		SessionName:       "snapshot-ensemble",
+		Question:          "Save my progress",
+		Status:            ensemble.EnsembleActive,
+		SynthesisStrategy: ensemble.StrategyConsensus,
+		CreatedAt:         time.Now().UTC(),
+		Assignments: []ensemble.ModeAssignment{
+			{ModeID: "deductive", PaneName: "snapshot-ensemble__cc_1", AgentType: "cc", Status: ensemble.AssignmentActive},
+		},
+	}
+	if err := ensemble.SaveSession("", state); err != nil {
+		t.Fatalf("SaveSession error: %v", err)
+	}
+
+	dir := t.TempDir()
+	var buf bytes.Buffer
+	if err := runEnsembleSnapshot(&buf, state.SessionName, dir, "json"); err != nil {
+		t.Fatalf("runEnsembleSnapshot error: %v", err)
+	}
+	var out ensembleSnapshotOutput
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("unmarshal snapshot output: %v", err)
+	}
+	if out.Live || filepath.Dir(out.Path) != dir {
+		t.Fatalf("unexpected snapshot output: %+v", out)
+	}
+
+	snap, err := ensemble.LoadSnapshot(out.Path)
+	if err != nil {
+		t.Fatalf("LoadSnapshot error: %v", err)
+	}
+	if snap.Session.Question != state.Question || len(snap.Session.Assignments) != 1 {
+		t.Fatalf("unexpected snapshot session: %+v", snap.Session)
+	}
+
+	saved, err := ensemble.LoadSession(state.SessionName)
+	if err != nil {
+		t.Fatalf("LoadSession error: %v", err)
+	}
+	if saved.Status != ensemble.EnsembleActive {
+		t.Fatalf("snapshot changed session status to %q", saved.Status)
+	}
+}
+
+func TestRunEnsembleSnapshotRestore_ReplaysOutputsForSynthesis(t *testing.T) {
+	isolateSessionAgentStorage(t)
+	ensemble.CloseDefaultStateStore()
+	t.Cleanup(ensemble.CloseDefaultStateStore)
+
+	now := time.Now().UTC()
+	state := &ensemble.EnsembleSession{
+		SessionName:       "restore-ensemble",
+		Question:          "Why did it crash?",
+		Status:            ensemble.EnsembleActive,
+		SynthesisStrategy: ensemble.StrategyConsensus,
+		CreatedAt:         now,
+		Assignments: []ensemble.ModeAssignment{
+			{ModeID: "deductive", PaneName: "restore-ensemble__cc_1", AgentType: "cc", Status: ensemble.AssignmentActive},
+			{ModeID: "abductive", PaneName: "restore-ensemble__cod_1", AgentType: "cod", Status: ensemble.AssignmentActive},
+		},
+	}
+	parsed := &ensemble.ModeOutput{
+		ModeID:     "deductive",
+		Thesis:     "The worker ran out of memory",
+		Confidence: 0.7,
+		TopFindings: []ensemble.Finding{
+			{Finding: "Heap grows without bound", Impact: ensemble.ImpactHigh, Confidence: 0.8},
+		},
+	}
+	snap, err := ensemble.NewSnapshot(state, []ensemble.CapturedOutput{{ModeID: "deductive", Parsed: parsed, CapturedAt: now}}, now)
+	if err != nil {
+		t.Fatalf("NewSnapshot error: %v", err)
+	}
+	path, err := ensemble.WriteSnapshot(t.TempDir(), snap)
+	if err != nil {
+		t.Fatalf("WriteSnapshot error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := runEnsembleSnapshotRestore(&buf, path, "json"); err != nil {
+		t.Fatalf("runEnsembleSnapshotRestore error: %v", err)
+	}
+	var out ensembleSnapshotRestoreOutput
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("unmarshal restore output: %v", err)
+	}
+	if out.Session != state.SessionName || len(out.Restored) != 1 || out.Restored[0] != "deductive" {
+		t.Fatalf("unexpected restore output: %+v", out)
+	}
+
+	saved, err := ensemble.LoadSession(state.SessionName)
+	if err != nil {
+		t.Fatalf("LoadSession error: %v", err)
+	}
+	outputs, err := loadEnsembleModeOutputs(saved, false)
+	if err != nil {
+		t.Fatalf("loadEnsembleModeOutputs error: %v", err)
+	}
+	if len(outputs) != 1 || outputs[0].Thesis != parsed.Thesis {
+		t.Fatalf("restored outputs = %+v, want the snapshot output", outputs)
+	}
+
+	if err := runEnsembleSnapshotRestore(io.Discard, path, "xml"); err == nil {
+		t.Fatal("expected error for invalid format")
+	}
+}
+
+func TestRunEnsembleStatus_JUnitFormat(t *testing.T) {
+	isolateSessionAgentStorage(t)
+	ensemble.CloseDefaultStateStore()
+	t.Cleanup(ensemble.CloseDefaultStateStore)
+
+	now := time.Now().UTC()
+	state := &ensemble.EnsembleSession{
+		SessionName:       "junit-ensemble",
+		Question:          "Report me",
+		Status:            ensemble.EnsembleActive,
+		SynthesisStrategy: ensemble.StrategyConsensus,
+		CreatedAt:         now,
+		Assignments: []ensemble.ModeAssignment{
+			{ModeID: "deductive", PaneName: "junit-ensemble__cc_1", AgentType: "cc", Status: ensemble.AssignmentDone, AssignedAt: now, CompletedAt: &now},
+			{ModeID: "abductive", PaneName: "junit-ensemble__cod_1", AgentType: "cod", Status: ensemble.AssignmentError, Error: "boom", AssignedAt: now, CompletedAt: &now},
+		},
+	}
+	if err := ensemble.SaveSession("", state); err != nil {
+		t.Fatalf("SaveSession error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := runEnsembleStatus(&buf, state.SessionName, ensembleStatusOptions{Format: "junit"}); err != nil {
+		t.Fatalf("runEnsembleStatus error: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{`<testsuite name="ensemble.junit-ensemble" tests="2" failures="1"`, `<testcase name="deductive"`, `<failure message="boom"`} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("junit output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRetryRateLimitedModes_ReinjectsAndPersistsRetries(t *testing.T) {
+	isolateSessionAgentStorage(t)
+	ensemble.CloseDefaultStateStore()
+	t.Cleanup(ensemble.CloseDefaultStateStore)
+
+	oldSleep, oldReinject := ensembleRetrySleep, ensembleRetryReinject
+	t.Cleanup(func() { ensembleRetrySleep, ensembleRetryReinject = oldSleep, oldReinject })
+	ensembleRetrySleep = func(context.Context, time.Duration) error { return nil }
+	var reinjected []string
+	ensembleRetryReinject = func(target string, a *ensemble.ModeAssignment) error {
+		reinjected = append(reinjected, a.ModeID)
+		return nil
+	}
+
+	state := &ensemble.EnsembleSession{
+		SessionName:       "retry-rate-limited-ensemble",
+		Question:          "Survive quota errors",
+		Status:            ensemble.EnsembleActive,
+		SynthesisStrategy: ensemble.StrategyConsensus,
+		CreatedAt:         time.Now().UTC(),
+		Assignments: []ensemble.ModeAssignment{
+			{ModeID: "deductive", PaneName: "retry__cc_1", AgentType: "cc", Status: ensemble.AssignmentActive, Prompt: "deduce"},
+			{ModeID: "abductive", PaneName: "retry__cc_2", AgentType: "cc", Status: ensemble.AssignmentActive, Prompt: "abduce", RateLimitRetries: 1},
+		},
+	}
+	if err := ensemble.SaveSession("", state); err != nil {
+		t.Fatalf("SaveSession error: %v", err)
+	}
+	captured := []ensemble.CapturedOutput{
+		{ModeID: "deductive", RawOutput: "API error: 429 Too Many Requests"},
+		{ModeID: "abductive", RawOutput: "Quota exceeded for this model"},
+	}
+	policy := ensemble.RateLimitRetryPolicy{MaxRetries: 1}
+
+	var buf bytes.Buffer
+	if err := retryRateLimitedModes(t.Context(), &buf, state, captured, policy, false, "json"); err != nil {
+		t.Fatalf("retryRateLimitedModes error: %v", err)
+	}
+	var out ensembleRetryRateLimitedOutput
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("unmarshal retry output: %v", err)
+	}
+	if out.RateLimited != 2 || out.Retried != 1 || out.Exhausted != 1 {
+		t.Fatalf("unexpected retry summary: %+v", out)
+	}
+	if len(reinjected) != 1 || reinjected[0] != "deductive" {
+		t.Fatalf("reinjected = %v, want [deductive]", reinjected)
+	}
+
+	saved, err := ensemble.LoadSession(state.SessionName)
+	if err != nil {
+		t.Fatalf("LoadSession error: %v", err)
+	}
+	if saved.Assignments[0].RateLimitRetries != 1 {
+		t.Fatalf("saved retries = %d, want 1", saved.Assignments[0].RateLimitRetries)
+	}
+	if saved.Assignments[1].Status != ensemble.AssignmentError {
+		t.Fatalf("exhausted mode status = %q, want error", saved.Assignments[1].Status)
+	}
+}
+
 func TestRunEnsembleSynthesize_UsesSavedOutputsWhenSessionOffline(t *testing.T) {
 	isolateSessionAgentStorage(t)
 	ensemble.CloseDefaultStateStore()
@@ -2084,6 +2500,68 @@ func TestRunEnsembleSynthesize_UsesSavedOutputsWhenSessionOffline(t *testing.T)
 	}
 }
 
+func TestRunEnsembleSynthesize_JSONReportsSynthesisOnlyModes(t *testing.T) {
+	isolateSessionAgentStorage(t)
+	ensemble.CloseDefaultStateStore()
+	t.Cleanup(ensemble.CloseDefaultStateStore)
+
+	dir := t.TempDir()
+	state := &ensemble.EnsembleSession{
+		SessionName:       "offline-ensemble-only-modes",
+		Question:          "Synthesize a subset of modes",
+		Status:            ensemble.EnsembleStopped,
+		SynthesisStrategy: ensemble.StrategyConsensus,
+		CreatedAt:         time.Now().UTC(),
+	}
+	for i, modeID := range []string{"deductive", "inductive"} {
+		outputPath := filepath.Join(dir, modeID+".json")
+		data, err := json.Marshal(ensemble.ModeOutput{
+			ModeID: modeID,
+			Thesis: "Thesis from " + modeID,
+			TopFindings: []ensemble.Finding{{
+				Finding:    "Finding from " + modeID,
+				Impact:     ensemble.ImpactMedium,
+				Confidence: 0.8,
+			}},
+			Confidence:  0.8,
+			GeneratedAt: time.Now().UTC(),
+		})
+		if err != nil {
+			t.Fatalf("marshal mode output: %v", err)
+		}
+		if err := os.WriteFile(outputPath, data, 0o644); err != nil {
+			t.Fatalf("write mode output: %v", err)
+		}
+		state.Assignments = append(state.Assignments, ensemble.ModeAssignment{
+			ModeID: modeID, PaneName: fmt.Sprintf("pane-%d", i+1), AgentType: "cc", Status: ensemble.AssignmentDone, OutputPath: outputPath,
+		})
+	}
+	if err := ensemble.SaveSession("", state); err != nil {
+		t.Fatalf("SaveSession error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	opts := synthesizeOptions{Format: "json", SynthesisOnlyModes: []string{"deductive"}}
+	if err := runEnsembleSynthesize(t.Context(), &buf, state.SessionName, opts); err != nil {
+		t.Fatalf("runEnsembleSynthesize error: %v", err)
+	}
+	var payload struct {
+		Synthesis struct {
+			IncludedModes []string `json:"included_modes"`
+			ExcludedModes []string `json:"excluded_modes"`
+		} `json:"synthesis"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &payload); err != nil {
+		t.Fatalf("unmarshal synthesis JSON: %v\n%s", err, buf.String())
+	}
+	if got := payload.Synthesis.IncludedModes; len(got) != 1 || got[0] != "deductive" {
+		t.Errorf("included_modes = %v, want [deductive]", got)
+	}
+	if got := payload.Synthesis.ExcludedModes; len(got) != 1 || got[0] != "inductive" {
+		t.Errorf("excluded_modes = %v, want [inductive]", got)
+	}
+}
+
 func TestRunEnsembleSynthesize_RejectsResumeWithoutStream(t *testing.T) {
 	var buf bytes.Buffer
 	err := runEnsembleSynthesize(t.Context(), &buf, "missing-session", synthesizeOptions{
@@ -3373,6 +3851,58 @@ func TestConfigShowCmdExecutes(t *testing.T) {
 	}
 }
 
+func TestConfigShowProfile(t *testing.T) {
+	resetFlags()
+	t.Setenv("NTM_CONFIG", "")
+	t.Setenv(config.ProfileEnvVar, "")
+	previousCfg := cfg
+	previousCfgFile := cfgFile
+	t.Cleanup(func() {
+		cfg = previousCfg
+		cfgFile = previousCfgFile
+		startup.ResetConfig()
+		if showCmd, _, err := rootCmd.Find([]string{"config", "show"}); err == nil {
+			_ = showCmd.Flags().Set("profile", "")
+		}
+	})
+
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	body := "[agents]\nclaude = \"claude\"\n\n[profiles.ci.agents]\nclaude = \"claude --ci\"\n"
+	if err := os.WriteFile(configPath, []byte(body), 0o600); err != nil {
+		t.Fatalf("WriteFile(config) failed: %v", err)
+	}
+	cfg = nil
+	startup.ResetConfig()
+
+	out, err := captureStdout(t, func() error {
+		rootCmd.SetArgs([]string{"--json", "--config", configPath, "config", "show", "--profile", "ci"})
+		return rootCmd.Execute()
+	})
+	if err != nil {
+		t.Fatalf("Execute() failed: %v", err)
+	}
+	var parsed struct {
+		Profile string            `json:"profile"`
+		Agents  map[string]string `json:"agents"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, out)
+	}
+	if parsed.Profile != "ci" || parsed.Agents["claude"] != "claude --ci" {
+		t.Errorf("config show --profile ci = profile %q, claude %q", parsed.Profile, parsed.Agents["claude"])
+	}
+
+	cfg = nil
+	startup.ResetConfig()
+	_, err = captureStdout(t, func() error {
+		rootCmd.SetArgs([]string{"--config", configPath, "config", "show", "--profile", "staging"})
+		return rootCmd.Execute()
+	})
+	if !errors.Is(err, config.ErrProfileNotFound) {
+		t.Errorf("missing profile error = %v, want ErrProfileNotFound", err)
+	}
+}
+
 func TestConfigShowJSONIncludesSafetyProfile(t *testing.T) {
 	resetFlags()
 	t.Setenv("NTM_CONFIG", "")
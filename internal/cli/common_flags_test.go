@@ -115,17 +115,17 @@ func TestAgentTypeFilterEquivalence(t *testing.T) {
 		want    string
 	}{
 		{false, false, false, "", ""},
-		{true, false, false, "", "claude"},
-		{false, true, false, "", "codex"},
-		{false, false, true, "", "gemini"},
-		{false, false, false, "claude", "claude"},
-		{false, false, false, "CC", "claude"},
-		{false, false, false, "codex", "codex"},
-		{false, false, false, "codex-cli", "codex"},
-		{false, false, false, "gemini", "gemini"},
-		{false, false, false, " google-gemini ", "gemini"},
+		{true, false, false, "", "cc"},
+		{false, true, false, "", "cod"},
+		{false, false, true, "", "gmi"},
+		{false, false, false, "claude", "cc"},
+		{false, false, false, "CC", "cc"},
+		{false, false, false, "codex", "cod"},
+		{false, false, false, "codex-cli", "cod"},
+		{false, false, false, "gemini", "gmi"},
+		{false, false, false, " google-gemini ", "gmi"},
 		// --agent flag takes precedence
-		{true, false, false, "codex", "codex"},
+		{true, false, false, "codex", "cod"},
 	}
 
 	for _, tc := range tests {
@@ -141,7 +141,10 @@ func TestAgentTypeFilterEquivalence(t *testing.T) {
 		assignGmiOnly = tc.gmiOnly
 		assignAgentType = tc.agent
 
-		got := resolveAgentTypeFilter()
+		got, err := resolveAgentTypeFilter()
+		if err != nil {
+			t.Errorf("resolveAgentTypeFilter(agent=%q) error: %v", tc.agent, err)
+		}
 		if got != tc.want {
 			t.Errorf("resolveAgentTypeFilter(cc=%v, cod=%v, gmi=%v, agent=%q) = %q, want %q",
 				tc.ccOnly, tc.codOnly, tc.gmiOnly, tc.agent, got, tc.want)
@@ -166,22 +169,25 @@ func TestSpawnAssignAgentTypeAliases(t *testing.T) {
 		want    string
 	}{
 		{false, false, false, false, "", ""},
-		{true, false, false, false, "", "claude"},
-		{false, true, false, false, "", "codex"},
-		{false, false, true, false, "", "gemini"},
-		{false, false, false, true, "", "antigravity"},
-		{false, false, false, false, "claude", "claude"},
-		{false, false, false, false, "CC", "claude"},
-		{false, false, false, false, "Codex", "codex"},
-		{false, false, false, false, "codex-cli", "codex"},
-		{false, false, false, false, "GEMINI", "gemini"},
-		{false, false, false, false, " google-gemini ", "gemini"},
+		{true, false, false, false, "", "cc"},
+		{false, true, false, false, "", "cod"},
+		{false, false, true, false, "", "gmi"},
+		{false, false, false, true, "", "agy"},
+		{false, false, false, false, "claude", "cc"},
+		{false, false, false, false, "CC", "cc"},
+		{false, false, false, false, "Codex", "cod"},
+		{false, false, false, false, "codex-cli", "cod"},
+		{false, false, false, false, "GEMINI", "gmi"},
+		{false, false, false, false, " google-gemini ", "gmi"},
 		// --assign-agent should take precedence
-		{true, false, false, false, "codex", "codex"},
+		{true, false, false, false, "codex", "cod"},
 	}
 
 	for _, tc := range tests {
-		got := resolveSpawnAssignAgentType(tc.agent, tc.ccOnly, tc.codOnly, tc.gmiOnly, tc.agyOnly)
+		got, err := resolveSpawnAssignAgentType(tc.agent, tc.ccOnly, tc.codOnly, tc.gmiOnly, tc.agyOnly)
+		if err != nil {
+			t.Errorf("resolveSpawnAssignAgentType(agent=%q) error: %v", tc.agent, err)
+		}
 		if got != tc.want {
 			t.Errorf("resolveSpawnAssignAgentType(agent=%q, cc=%v, cod=%v, gmi=%v, agy=%v) = %q, want %q",
 				tc.agent, tc.ccOnly, tc.codOnly, tc.gmiOnly, tc.agyOnly, got, tc.want)
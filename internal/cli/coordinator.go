@@ -752,7 +752,10 @@ func runCoordinatorAssign(cmd *cobra.Command, args []string, dryRun bool) error
 	}
 
 	// Resolve agent type filter from flags
-	agentTypeFilter := resolveAgentTypeFilter()
+	agentTypeFilter, err := resolveAgentTypeFilter()
+	if err != nil {
+		return err
+	}
 
 	timeout := assignTimeout
 	if timeout == 0 {
@@ -801,7 +804,7 @@ func runCoordinatorAssign(cmd *cobra.Command, args []string, dryRun bool) error
 	}
 
 	if !assignQuiet {
-		displayAssignOutputEnhanced(assignOutput, assignVerbose)
+		displayAssignOutputEnhanced(assignOutput, assignVerbose, false)
 	}
 
 	if dryRun || len(assignOutput.Assignments) == 0 {
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -692,6 +694,71 @@ func TestCalculateMatchConfidence_TestingTask(t *testing.T) {
 	}
 }
 
+func TestCalculateMatchFactors_Breakdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		agent    string
+		bead     bv.BeadPreview
+		strategy string
+		affinity float64
+		boost    float64
+		modifier float64
+		taskType string
+	}{
+		{"balanced is pure affinity", "codex", bv.BeadPreview{Title: "Implement login", Priority: "P2"}, "balanced", 0.9, 0, 0, "feature"},
+		{"speed pulls toward 0.9", "claude", bv.BeadPreview{Title: "Generic task", Priority: "P2"}, "speed", 0.7, 0, 0.1, "task"},
+		{"dependency boosts P0", "claude", bv.BeadPreview{Title: "Generic task", Priority: "P0"}, "dependency", 0.7, 0.1, 0, "task"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := calculateMatchFactors(tt.agent, tt.bead, tt.strategy)
+			if f.TaskType != tt.taskType {
+				t.Errorf("TaskType = %q, want %q", f.TaskType, tt.taskType)
+			}
+			for _, c := range []struct {
+				name      string
+				got, want float64
+			}{{"Affinity", f.Affinity, tt.affinity}, {"PriorityBoost", f.PriorityBoost, tt.boost}, {"StrategyModifier", f.StrategyModifier, tt.modifier}} {
+				if diff := c.got - c.want; diff > 1e-9 || diff < -1e-9 {
+					t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+				}
+			}
+			if got := calculateMatchConfidence(tt.agent, tt.bead, tt.strategy); f.Confidence != got {
+				t.Errorf("Confidence = %v, calculateMatchConfidence = %v", f.Confidence, got)
+			}
+		})
+	}
+}
+
+func TestGenerateAssignmentsEnhanced_ExplainAttachesFactors(t *testing.T) {
+	agents := []assignAgentInfo{makeTestAgent(0, "claude")}
+	beads := []bv.BeadPreview{makeTestBead("b1", "Generic task", "P0")}
+
+	plain := generateAssignmentsEnhanced(t.Context(), agents, beads, &AssignCommandOptions{Strategy: "dependency"})
+	if len(plain) != 1 || plain[0].Factors != nil {
+		t.Fatalf("without --explain: assignments = %+v, want one with no factors", plain)
+	}
+
+	got := generateAssignmentsEnhanced(t.Context(), agents, beads, &AssignCommandOptions{Strategy: "dependency", Explain: true})
+	if len(got) != 1 || got[0].Factors == nil {
+		t.Fatalf("with --explain: assignments = %+v, want one with factors", got)
+	}
+	if got[0].Factors.Confidence != got[0].Score {
+		t.Errorf("factors confidence = %v, want score %v", got[0].Factors.Confidence, got[0].Score)
+	}
+	data, err := json.Marshal(got[0])
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if !strings.Contains(string(data), `"factors":{"task_type":"task","affinity":0.7,"priority_boost"`) {
+		t.Errorf("JSON = %s, want nested factors object", data)
+	}
+	lines := assignmentFactorLines(got[0])
+	if len(lines) != 5 || !strings.Contains(lines[2], "priority boost") {
+		t.Errorf("factor lines = %q", lines)
+	}
+}
+
 // parsePriorityString already tested in assign_test.go
 
 // =============================================================================
@@ -897,8 +964,87 @@ func TestGenerateAssignmentsEnhanced_RoundRobin_SingleAgent(t *testing.T) {
 	}
 }
 
+func TestGenerateAssignmentsEnhanced_RoundRobin_MaxConcurrent(t *testing.T) {
+	busy := makeTestAgent(1, "codex")
+	busy.activeAssignments = 1
+	agents := []assignAgentInfo{makeTestAgent(0, "claude"), busy, makeTestAgent(2, "gemini")}
+	var beads []bv.BeadPreview
+	for i := 1; i <= 6; i++ {
+		beads = append(beads, makeTestBead(fmt.Sprintf("b%d", i), fmt.Sprintf("Task %d", i), "P2"))
+	}
+	opts := &AssignCommandOptions{Strategy: "round-robin", MaxConcurrent: 2}
+	got, _, skipped := generateAssignmentsEnhancedWithPlan(t.Context(), agents, beads, opts, true)
+
+	// Capped agents pass their turn on: b5 skips pane 1, b6 finds no room.
+	expectedPanes := []int{0, 1, 2, 0, 2}
+	if len(got) != len(expectedPanes) {
+		t.Fatalf("round-robin with cap: got %d assignments, want %d", len(got), len(expectedPanes))
+	}
+	for i, a := range got {
+		if a.Pane != expectedPanes[i] {
+			t.Errorf("assignment[%d].Pane = %d, want %d", i, a.Pane, expectedPanes[i])
+		}
+	}
+	if len(skipped) != 1 || skipped[0].BeadID != "b6" || skipped[0].Reason != "agent_capacity_reached" {
+		t.Fatalf("skipped = %+v, want b6 with agent_capacity_reached", skipped)
+	}
+}
+
+func TestGenerateAssignmentsEnhanced_MaxConcurrentAcrossStrategies(t *testing.T) {
+	for _, strategy := range []string{"round-robin", "quality", "speed", "dependency", "balanced"} {
+		t.Run(strategy, func(t *testing.T) {
+			full := makeTestAgent(0, "claude")
+			full.activeAssignments = 1
+			agents := []assignAgentInfo{full, makeTestAgent(1, "codex")}
+			beads := []bv.BeadPreview{
+				makeTestBead("b1", "Implement feature", "P1"),
+				makeTestBead("b2", "Fix bug", "P1"),
+				makeTestBead("b3", "Write docs", "P2"),
+			}
+			opts := &AssignCommandOptions{Strategy: strategy, MaxConcurrent: 1}
+			got, plan, _ := generateAssignmentsEnhancedWithPlan(t.Context(), agents, beads, opts, true)
+
+			held := map[int]int{0: full.activeAssignments}
+			for _, a := range got {
+				held[a.Pane]++
+			}
+			for pane, n := range held {
+				if n > opts.MaxConcurrent {
+					t.Errorf("pane %d holds %d beads, cap is %d", pane, n, opts.MaxConcurrent)
+				}
+			}
+			// The planner may defer under host pressure; the cap still holds.
+			if plan != nil && plan.Decision == assign.AllocationDecisionDefer {
+				return
+			}
+			if len(got) != 1 || got[0].Pane != 1 {
+				t.Errorf("assignments = %+v, want exactly one bead on pane 1", got)
+			}
+		})
+	}
+}
+
 // --- Quality strategy ---
 
+func TestGenerateAssignmentsEnhanced_MinConfidenceLeavesBeadUnassigned(t *testing.T) {
+	agents := []assignAgentInfo{makeTestAgent(0, "codex")}
+	beads := []bv.BeadPreview{
+		makeTestBead("b1", "Update readme docs", "P3"),    // codex has no documentation strength: 0.7
+		makeTestBead("b2", "Implement new feature", "P3"), // codex feature strength: 0.9
+	}
+	opts := &AssignCommandOptions{Strategy: "dependency", MinConfidence: 0.8}
+	got, _, skipped := generateAssignmentsEnhancedWithPlan(t.Context(), agents, beads, opts, true)
+	if len(got) != 1 || got[0].BeadID != "b2" {
+		t.Fatalf("assignments = %+v, want only b2", got)
+	}
+	if len(skipped) != 1 {
+		t.Fatalf("skipped = %+v, want one low-confidence bead", skipped)
+	}
+	if skipped[0].BeadID != "b1" || skipped[0].Reason != "no_confident_match" || skipped[0].BestAgentType != "codex" || skipped[0].BestScore != 0.7 {
+		t.Fatalf("unexpected skipped item: %+v", skipped[0])
+	}
+}
+
 func TestGenerateAssignmentsEnhanced_Quality_BestMatch(t *testing.T) {
 	agents := []assignAgentInfo{
 		makeTestAgent(0, "claude"),
@@ -1146,7 +1292,7 @@ func TestGenerateAssignmentsEnhanced_BalancedPressurePrefersHeadroom(t *testing.
 		makeTestBead("b1", "Performance benchmark load test", "P1"),
 	}
 
-	got, plan := generateAssignmentsEnhancedWithPlan(t.Context(), agents, beads, &AssignCommandOptions{Strategy: "balanced"}, true)
+	got, plan, _ := generateAssignmentsEnhancedWithPlan(t.Context(), agents, beads, &AssignCommandOptions{Strategy: "balanced"}, true)
 	if plan == nil {
 		t.Fatal("balanced pressure assignment should return an allocation plan")
 	}
@@ -1173,7 +1319,7 @@ func TestGenerateAssignmentsEnhanced_BalancedPressureUnavailableFallsBack(t *tes
 	agents := []assignAgentInfo{makeTestAgent(0, "claude")}
 	beads := []bv.BeadPreview{makeTestBead("b1", "Analyze flaky assignment path", "P1")}
 
-	got, plan := generateAssignmentsEnhancedWithPlan(t.Context(), agents, beads, &AssignCommandOptions{Strategy: "balanced"}, true)
+	got, plan, _ := generateAssignmentsEnhancedWithPlan(t.Context(), agents, beads, &AssignCommandOptions{Strategy: "balanced"}, true)
 	if plan == nil {
 		t.Fatal("balanced assignment should return an allocation plan")
 	}
@@ -1198,7 +1344,7 @@ func TestGenerateAssignmentsEnhanced_BalancedBVUnavailableMarksMissing(t *testin
 	agents := []assignAgentInfo{makeTestAgent(0, "claude")}
 	beads := []bv.BeadPreview{makeTestBead("b1", "Investigate degraded triage path", "P1")}
 
-	got, plan := generateAssignmentsEnhancedWithPlan(t.Context(), agents, beads, &AssignCommandOptions{Strategy: "balanced"}, false)
+	got, plan, _ := generateAssignmentsEnhancedWithPlan(t.Context(), agents, beads, &AssignCommandOptions{Strategy: "balanced"}, false)
 	if plan == nil {
 		t.Fatal("balanced assignment should return an allocation plan")
 	}
@@ -1223,7 +1369,7 @@ func TestGenerateAssignmentsEnhanced_BalancedCriticalPressureDefers(t *testing.T
 	agents := []assignAgentInfo{makeTestAgent(0, "codex")}
 	beads := []bv.BeadPreview{makeTestBead("b1", "Implement large benchmark suite", "P0")}
 
-	got, plan := generateAssignmentsEnhancedWithPlan(t.Context(), agents, beads, &AssignCommandOptions{Strategy: "balanced"}, true)
+	got, plan, _ := generateAssignmentsEnhancedWithPlan(t.Context(), agents, beads, &AssignCommandOptions{Strategy: "balanced"}, true)
 	if len(got) != 0 {
 		t.Fatalf("critical pressure: got %d assignments, want 0", len(got))
 	}
@@ -1263,6 +1409,93 @@ func TestGenerateAssignmentsEnhanced_DefaultIsBalanced(t *testing.T) {
 	}
 }
 
+// --- Load strategy ---
+
+func TestGenerateAssignmentsEnhanced_Load(t *testing.T) {
+	agent := func(pane int, state string, active int) assignAgentInfo {
+		a := makeTestAgent(pane, "claude")
+		a.state = state
+		a.activeAssignments = active
+		return a
+	}
+	tests := []struct {
+		name       string
+		agents     []assignAgentInfo
+		beads      int
+		wantPanes  []int
+		wantScores []float64
+		wantReason string
+	}{
+		{
+			name:       "all idle spreads by held beads",
+			agents:     []assignAgentInfo{agent(0, "idle", 1), agent(1, "idle", 0)},
+			beads:      3,
+			wantPanes:  []int{1, 0, 1},
+			wantScores: []float64{1, 0.5, 0.5},
+			wantReason: "idle agent",
+		},
+		{
+			name:       "all busy picks least loaded",
+			agents:     []assignAgentInfo{agent(0, "working", 2), agent(1, "working", 0)},
+			beads:      2,
+			wantPanes:  []int{1, 1},
+			wantScores: []float64{0.5, 1.0 / 3},
+			wantReason: "least-loaded",
+		},
+		{
+			name:       "mixed prefers idle agent",
+			agents:     []assignAgentInfo{agent(0, "working", 0), agent(1, "idle", 0)},
+			beads:      2,
+			wantPanes:  []int{1, 0},
+			wantScores: []float64{1, 0.5},
+			wantReason: "idle agent",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var beads []bv.BeadPreview
+			for i := 1; i <= tt.beads; i++ {
+				beads = append(beads, makeTestBead(fmt.Sprintf("b%d", i), fmt.Sprintf("Task %d", i), "P2"))
+			}
+			got := generateAssignmentsEnhanced(t.Context(), tt.agents, beads, &AssignCommandOptions{Strategy: "load"})
+			if len(got) != len(tt.wantPanes) {
+				t.Fatalf("got %d assignments, want %d", len(got), len(tt.wantPanes))
+			}
+			for i, a := range got {
+				if a.Pane != tt.wantPanes[i] {
+					t.Errorf("assignment[%d].Pane = %d, want %d", i, a.Pane, tt.wantPanes[i])
+				}
+				if a.Score != tt.wantScores[i] {
+					t.Errorf("assignment[%d].Score = %v, want %v", i, a.Score, tt.wantScores[i])
+				}
+			}
+			if !strings.Contains(got[0].Reasoning, tt.wantReason) {
+				t.Errorf("Reasoning = %q, want it to mention %q", got[0].Reasoning, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestGenerateAssignmentsEnhanced_LoadWithoutStateFallsBackToBalanced(t *testing.T) {
+	agents := []assignAgentInfo{makeTestAgent(0, "claude"), makeTestAgent(1, "codex")}
+	for i := range agents {
+		agents[i].state = ""
+	}
+	beads := []bv.BeadPreview{
+		makeTestBead("b1", "Task 1", "P1"),
+		makeTestBead("b2", "Task 2", "P2"),
+	}
+	got := generateAssignmentsEnhanced(t.Context(), agents, beads, &AssignCommandOptions{Strategy: "load"})
+	if len(got) != 2 {
+		t.Fatalf("got %d assignments, want 2", len(got))
+	}
+	for _, a := range got {
+		if !strings.Contains(a.Reasoning, "balanced workload") {
+			t.Errorf("Reasoning = %q, want balanced fallback", a.Reasoning)
+		}
+	}
+}
+
 // --- Common field verification ---
 
 func TestGenerateAssignmentsEnhanced_CommonFields(t *testing.T) {
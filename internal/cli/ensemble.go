@@ -2,7 +2,10 @@ package cli
 
 import (
 	"bufio"
+	"bytes"
+	"cmp"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +16,7 @@ import (
 	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -40,22 +44,172 @@ type ensembleStatusCounts struct {
 	Error   int `json:"error" yaml:"error"`
 }
 
+// ensembleAgentRow joins one assignment with its live tmux pane for
+// status --agents-table.
+type ensembleAgentRow struct {
+	ModeID       string     `json:"mode_id" yaml:"mode_id"`
+	AgentType    string     `json:"agent_type" yaml:"agent_type"`
+	PaneName     string     `json:"pane_name,omitempty" yaml:"pane_name,omitempty"`
+	Status       string     `json:"status" yaml:"status"`
+	PaneID       string     `json:"pane_id,omitempty" yaml:"pane_id,omitempty"`
+	PaneState    string     `json:"pane_state" yaml:"pane_state"` // alive, idle, dead
+	LastActivity *time.Time `json:"last_activity,omitempty" yaml:"last_activity,omitempty"`
+	// Mismatch explains a disagreement between the assignment status and the
+	// pane, such as a working mode whose pane is gone.
+	Mismatch string `json:"mismatch,omitempty" yaml:"mismatch,omitempty"`
+}
+
+// ensembleAgentIdleThreshold is how long a pane may go without output before
+// --agents-table reports it as idle.
+const ensembleAgentIdleThreshold = 5 * time.Minute
+
+// ensembleStatusGroup is one category/tier branch of status --tree.
+type ensembleStatusGroup struct {
+	Category string   `json:"category" yaml:"category"`
+	Tier     string   `json:"tier" yaml:"tier"`
+	Total    int      `json:"total" yaml:"total"`
+	Done     int      `json:"done" yaml:"done"`
+	Error    int      `json:"error" yaml:"error"`
+	Modes    []string `json:"modes" yaml:"modes"`
+}
+
 type ensembleBudgetSummary struct {
 	MaxTokensPerMode     int `json:"max_tokens_per_mode" yaml:"max_tokens_per_mode"`
 	MaxTotalTokens       int `json:"max_total_tokens" yaml:"max_total_tokens"`
 	EstimatedTotalTokens int `json:"estimated_total_tokens" yaml:"estimated_total_tokens"`
+	SpentTokens          int `json:"spent_tokens,omitempty" yaml:"spent_tokens,omitempty"`
+	StrictLimit          int `json:"strict_limit,omitempty" yaml:"strict_limit,omitempty"`
+	// InjectedEstimate is the estimated tokens of the modes spawn injected.
+	InjectedEstimate int `json:"injected_estimate,omitempty" yaml:"injected_estimate,omitempty"`
+	// Cutoff is the total budget at which --over-budget=skip stopped
+	// injecting; CutoffSkipped counts the modes it skipped.
+	Cutoff        int `json:"cutoff,omitempty" yaml:"cutoff,omitempty"`
+	CutoffSkipped int `json:"cutoff_skipped,omitempty" yaml:"cutoff_skipped,omitempty"`
 }
 
 type ensembleAssignmentRow struct {
 	ModeID        string `json:"mode_id" yaml:"mode_id"`
 	ModeCode      string `json:"mode_code,omitempty" yaml:"mode_code,omitempty"`
 	ModeName      string `json:"mode_name,omitempty" yaml:"mode_name,omitempty"`
+	Category      string `json:"category,omitempty" yaml:"category,omitempty"`
+	Tier          string `json:"tier,omitempty" yaml:"tier,omitempty"`
 	AgentType     string `json:"agent_type" yaml:"agent_type"`
 	Status        string `json:"status" yaml:"status"`
 	TokenEstimate int    `json:"token_estimate" yaml:"token_estimate"`
 	PaneName      string `json:"pane_name,omitempty" yaml:"pane_name,omitempty"`
+	// AssignedAt is nil until the mode has been assigned to a pane.
+	AssignedAt *time.Time `json:"assigned_at,omitempty" yaml:"assigned_at,omitempty"`
+	// Budget is set by --compare-budget for modes with a collected output.
+	Budget *ensemble.ModeBudgetComparison `json:"budget,omitempty" yaml:"budget,omitempty"`
+	// OutputLength is set by --output-lengths for active and finished modes.
+	OutputLength *ensemble.ModeOutputLength `json:"output_length,omitempty" yaml:"output_length,omitempty"`
+}
+
+// tableColumn describes one selectable column of a table renderer.
+type tableColumn[T any] struct {
+	Name   string
+	Header string
+	Value  func(T) string
+}
+
+// parseTableColumns validates a comma-separated --columns value against the
+// known columns and returns the selected names in order. An empty spec
+// returns nil, meaning the renderer's default columns.
+func parseTableColumns[T any](spec string, known []tableColumn[T]) ([]string, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, nil
+	}
+	valid := make(map[string]bool, len(known))
+	names := make([]string, 0, len(known))
+	for _, col := range known {
+		valid[col.Name] = true
+		names = append(names, col.Name)
+	}
+	var selected []string
+	for _, part := range strings.Split(spec, ",") {
+		name := strings.ToLower(strings.TrimSpace(part))
+		if name == "" {
+			continue
+		}
+		if !valid[name] {
+			return nil, fmt.Errorf("unknown column %q (valid: %s)", name, strings.Join(names, ", "))
+		}
+		selected = append(selected, name)
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("--columns must name at least one column")
+	}
+	return selected, nil
+}
+
+// renderColumnTable renders rows using the named columns, or defaults when
+// names is empty.
+func renderColumnTable[T any](w io.Writer, known []tableColumn[T], defaults, names []string, rows []T) {
+	if len(names) == 0 {
+		names = defaults
+	}
+	byName := make(map[string]tableColumn[T], len(known))
+	for _, col := range known {
+		byName[col.Name] = col
+	}
+	cols := make([]tableColumn[T], 0, len(names))
+	headers := make([]string, 0, len(names))
+	for _, name := range names {
+		if col, ok := byName[name]; ok {
+			cols = append(cols, col)
+			headers = append(headers, col.Header)
+		}
+	}
+	table := output.NewTable(w, headers...)
+	for _, row := range rows {
+		values := make([]string, len(cols))
+		for i, col := range cols {
+			values[i] = col.Value(row)
+		}
+		table.AddRow(values...)
+	}
+	table.Render()
 }
 
+var ensembleStatusColumns = []tableColumn[ensembleAssignmentRow]{
+	{Name: "mode", Header: "MODE", Value: func(r ensembleAssignmentRow) string { return r.ModeID }},
+	{Name: "code", Header: "CODE", Value: func(r ensembleAssignmentRow) string { return r.ModeCode }},
+	{Name: "name", Header: "NAME", Value: func(r ensembleAssignmentRow) string { return r.ModeName }},
+	{Name: "agent", Header: "AGENT", Value: func(r ensembleAssignmentRow) string { return r.AgentType }},
+	{Name: "status", Header: "STATUS", Value: func(r ensembleAssignmentRow) string { return r.Status }},
+	{Name: "tokens", Header: "TOKENS", Value: func(r ensembleAssignmentRow) string { return fmt.Sprintf("%d", r.TokenEstimate) }},
+	{Name: "pane", Header: "PANE", Value: func(r ensembleAssignmentRow) string { return r.PaneName }},
+	{Name: "age", Header: "AGE", Value: func(r ensembleAssignmentRow) string {
+		if r.AssignedAt == nil {
+			return "-"
+		}
+		return formatAge(*r.AssignedAt)
+	}},
+	{Name: "budget", Header: "BUDGET", Value: func(r ensembleAssignmentRow) string {
+		if r.Budget == nil {
+			return "-"
+		}
+		cell := fmt.Sprintf("%d/%d (%.0f%%)", r.Budget.ActualTokens, r.Budget.EstimatedTokens, r.Budget.Ratio*100)
+		if r.Budget.OverBudget {
+			cell = "OVER " + cell
+		}
+		return cell
+	}},
+	{Name: "output", Header: "OUTPUT", Value: func(r ensembleAssignmentRow) string {
+		if r.OutputLength == nil {
+			return "-"
+		}
+		cell := fmt.Sprintf("%d chars/%d lines", r.OutputLength.Chars, r.OutputLength.Lines)
+		if r.OutputLength.Short {
+			cell = "SHORT " + cell
+		}
+		return cell
+	}},
+}
+
+var ensembleStatusDefaultColumns = []string{"mode", "code", "agent", "status", "tokens", "pane"}
+
 type ensembleStatusOutput struct {
 	GeneratedAt    time.Time                    `json:"generated_at" yaml:"generated_at"`
 	Session        string                       `json:"session" yaml:"session"`
@@ -64,36 +218,140 @@ type ensembleStatusOutput struct {
 	Question       string                       `json:"question,omitempty" yaml:"question,omitempty"`
 	StartedAt      time.Time                    `json:"started_at,omitempty" yaml:"started_at,omitempty"`
 	Status         string                       `json:"status,omitempty" yaml:"status,omitempty"`
+	Seed           int64                        `json:"seed,omitempty" yaml:"seed,omitempty"`
 	SynthesisReady bool                         `json:"synthesis_ready,omitempty" yaml:"synthesis_ready,omitempty"`
 	Synthesis      string                       `json:"synthesis,omitempty" yaml:"synthesis,omitempty"`
 	Budget         ensembleBudgetSummary        `json:"budget,omitempty" yaml:"budget,omitempty"`
 	StatusCounts   ensembleStatusCounts         `json:"status_counts,omitempty" yaml:"status_counts,omitempty"`
 	Assignments    []ensembleAssignmentRow      `json:"assignments,omitempty" yaml:"assignments,omitempty"`
 	Contributions  *ensemble.ContributionReport `json:"contributions,omitempty" yaml:"contributions,omitempty"`
+	Health         []ensemble.ModeHealth        `json:"health,omitempty" yaml:"health,omitempty"`
+	Estimate       *ensemble.CompletionEstimate `json:"estimate,omitempty" yaml:"estimate,omitempty"`
+	FailedModes    []string                     `json:"failed_modes,omitempty" yaml:"failed_modes,omitempty"`
+	Groups         []ensembleStatusGroup        `json:"groups,omitempty" yaml:"groups,omitempty"`
+	Agents         []ensembleAgentRow           `json:"agents,omitempty" yaml:"agents,omitempty"`
 }
 
-func normalizeEnsembleAgentType(value string) string {
-	switch agentpkg.AgentType(value).Canonical() {
-	case agentpkg.AgentTypeClaudeCode:
-		return "cc"
-	case agentpkg.AgentTypeCodex:
-		return "cod"
-	case agentpkg.AgentTypeGemini:
-		return "gmi"
-	case agentpkg.AgentTypeAntigravity:
-		return "agy"
-	case agentpkg.AgentTypeCursor:
-		return "cursor"
-	case agentpkg.AgentTypeWindsurf:
-		return "windsurf"
-	case agentpkg.AgentTypeAider:
-		return "aider"
-	case agentpkg.AgentTypeOpencode:
-		return "oc"
-	case agentpkg.AgentTypeOllama:
-		return "ollama"
+// ensembleContributionsOutput is the payload of status --contributions-only.
+type ensembleContributionsOutput struct {
+	GeneratedAt   time.Time                    `json:"generated_at" yaml:"generated_at"`
+	Session       string                       `json:"session" yaml:"session"`
+	Contributions *ensemble.ContributionReport `json:"contributions" yaml:"contributions"`
+}
+
+func renderEnsembleContributions(w io.Writer, payload ensembleContributionsOutput, format string) error {
+	switch format {
+	case "json":
+		return output.WriteJSON(w, payload, true)
+	case "yaml", "yml":
+		return renderYAML(w, payload)
+	case "csv":
+		return writeEnsembleContributionsCSV(w, payload.Contributions)
 	default:
+		fmt.Fprintf(w, "Session:   %s\n\n", payload.Session)
+		renderEnsembleContributionTable(w, payload.Contributions)
+		return nil
+	}
+}
+
+func writeEnsembleContributionsCSV(w io.Writer, report *ensemble.ContributionReport) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"rank", "mode_id", "mode_name", "score", "findings", "original_findings", "unique_insights", "citations", "risks", "recommendations"}); err != nil {
+		return err
+	}
+	for _, score := range report.Scores {
+		if err := cw.Write([]string{
+			strconv.Itoa(score.Rank),
+			score.ModeID,
+			score.ModeName,
+			strconv.FormatFloat(score.Score, 'f', 1, 64),
+			strconv.Itoa(score.FindingsCount),
+			strconv.Itoa(score.OriginalFindings),
+			strconv.Itoa(score.UniqueInsights),
+			strconv.Itoa(score.CitationCount),
+			strconv.Itoa(score.RisksCount),
+			strconv.Itoa(score.RecommendationsCount),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeEnsembleStatusCSV writes the assignments as RFC 4180 CSV. Contribution
+// scores, when present, follow as a second CSV section after a blank line.
+func writeEnsembleStatusCSV(w io.Writer, payload ensembleStatusOutput) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"mode_id", "mode_code", "agent_type", "status", "token_estimate", "pane_name"}); err != nil {
+		return err
+	}
+	for _, row := range payload.Assignments {
+		if err := cw.Write([]string{
+			row.ModeID,
+			row.ModeCode,
+			row.AgentType,
+			row.Status,
+			strconv.Itoa(row.TokenEstimate),
+			row.PaneName,
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+
+	if payload.Contributions == nil || len(payload.Contributions.Scores) == 0 {
+		return nil
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	return writeEnsembleContributionsCSV(w, payload.Contributions)
+}
+
+func renderEnsembleContributionTable(w io.Writer, report *ensemble.ContributionReport) {
+	fmt.Fprintf(w, "Mode Contributions\n")
+	fmt.Fprintf(w, "------------------\n")
+	fmt.Fprintf(w, "Total Findings: %d (deduped: %d)  Overlap: %.1f%%  Diversity: %.2f\n\n",
+		report.TotalFindings,
+		report.DedupedFindings,
+		report.OverlapRate*100,
+		report.DiversityScore,
+	)
+
+	ctable := output.NewTable(w, "RANK", "MODE", "SCORE", "FINDINGS", "UNIQUE", "CITATIONS")
+	for _, score := range report.Scores {
+		name := score.ModeName
+		if name == "" {
+			name = score.ModeID
+		}
+		ctable.AddRow(
+			fmt.Sprintf("#%d", score.Rank),
+			name,
+			fmt.Sprintf("%.1f", score.Score),
+			fmt.Sprintf("%d/%d", score.FindingsCount, score.OriginalFindings),
+			fmt.Sprintf("%d", score.UniqueInsights),
+			fmt.Sprintf("%d", score.CitationCount),
+		)
+	}
+	ctable.Render()
+}
+
+// normalizeEnsembleAgentType resolves an agent alias to the short form used
+// in agent mixes, or "" for unknown types and types ensembles cannot drive.
+func normalizeEnsembleAgentType(value string) string {
+	agentType, ok := agentpkg.NormalizeAgentType(value)
+	if !ok {
+		return ""
+	}
+	switch agentType {
+	case agentpkg.AgentTypeGrok, agentpkg.AgentTypeUser:
 		return ""
+	default:
+		return string(agentType)
 	}
 }
 
@@ -109,9 +367,20 @@ func newEnsembleCmd() *cobra.Command {
 
 Primary usage:
   ntm ensemble <ensemble-name> "<question>"
+
+Use --format json (or --json) to print a spawn result with the session name,
+resolved preset, mode assignments (agent type and pane), and budget, so
+scripts can launch an ensemble and poll it later with 'ntm ensemble status'.
+
+Custom reasoning modes are loaded from ~/.ntm/modes/*.yaml and from each
+--modes-file. A file holds one mode or a "modes" list; every mode needs id,
+code, name, category, tier, and short_desc, and may not reuse the ID or code
+of an existing mode. Custom modes can be used in presets and are listed by
+'ntm ensemble modes'.
 `,
 		Example: `  ntm ensemble project-diagnosis "What are the main issues?"
   ntm ensemble idea-forge "What features should we add next?"
+  ntm ensemble project-diagnosis "What are the main issues?" --format json
   ntm ensemble spawn mysession --preset project-diagnosis --question "..."`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 {
@@ -121,9 +390,10 @@ Primary usage:
 				return fmt.Errorf("ensemble name and question required (usage: ntm ensemble <ensemble-name> <question>)")
 			}
 
+			machineJSON := IsJSONOutput() || strings.EqualFold(strings.TrimSpace(opts.Format), "json")
 			projectDir, err := resolveEnsembleProjectDir(opts.Project)
 			if err != nil {
-				if IsJSONOutput() {
+				if machineJSON {
 					return emitJSONFailureEnvelopeWithCause(output.NewError(err.Error()), err)
 				}
 				return err
@@ -131,7 +401,7 @@ Primary usage:
 			opts.Project = projectDir
 
 			if err := tmux.EnsureInstalled(); err != nil {
-				if IsJSONOutput() {
+				if machineJSON {
 					return emitJSONFailureEnvelopeWithCause(output.NewError(err.Error()), err)
 				}
 				return err
@@ -147,19 +417,30 @@ Primary usage:
 	}
 
 	bindEnsembleSharedFlags(cmd, &opts)
+	cmd.PersistentFlags().StringArray("modes-file", nil, "Load additional reasoning modes from a YAML file (repeatable)")
 	cmd.AddCommand(newEnsembleSpawnCmd())
 	cmd.AddCommand(newEnsemblePresetsCmd())
+	cmd.AddCommand(newEnsembleModesCmd())
 	cmd.AddCommand(newEnsembleExportCmd())
 	cmd.AddCommand(newEnsembleImportCmd())
 	cmd.AddCommand(newEnsembleStatusCmd())
+	cmd.AddCommand(newEnsembleVerifyOutputsCmd())
+	cmd.AddCommand(newEnsembleRetryRateLimitedCmd())
+	cmd.AddCommand(newEnsemblePromptsCmd())
 	cmd.AddCommand(newEnsembleStopCmd())
+	cmd.AddCommand(newEnsembleAbortCmd())
+	cmd.AddCommand(newEnsembleSnapshotCmd())
 	cmd.AddCommand(newEnsembleSuggestCmd())
 	cmd.AddCommand(newEnsembleEstimateCmd())
 	cmd.AddCommand(newEnsembleSynthesizeCmd())
+	cmd.AddCommand(newEnsembleSetStrategyCmd())
 	cmd.AddCommand(newEnsembleCacheCmd())
 	cmd.AddCommand(newEnsembleExportFindingsCmd())
 	cmd.AddCommand(newEnsembleProvenanceCmd())
 	cmd.AddCommand(newEnsembleCompareCmd())
+	cmd.AddCommand(newEnsembleMergeRunsCmd())
+	cmd.AddCommand(newEnsembleDiffContributionsCmd())
+	cmd.AddCommand(newEnsembleDiffCmd())
 	cmd.AddCommand(newEnsembleResumeCmd())
 	cmd.AddCommand(newEnsembleRerunModeCmd())
 	cmd.AddCommand(newEnsembleCleanCheckpointsCmd())
@@ -170,11 +451,32 @@ Primary usage:
 type ensembleStatusOptions struct {
 	Format            string
 	ShowContributions bool
+	ContributionsOnly bool
+	Health            bool
+	EstimateRemaining bool
+	CompareBudget     bool
+	OutputLengths     bool
+	Tree              bool
+	AgentsTable       bool
+	ExportJSON        string
+	RawOutput         string
+	FailIfErrored     bool
+	PollUntilError    bool
+	PollInterval      time.Duration
+	Watch             bool
+	Columns           string
+	Sort              string
+	// NoContributionsCSV drops the contributions section from --format=csv.
+	NoContributionsCSV bool
+	// RefreshContributions recomputes contributions instead of reusing the
+	// report cached in the session's checkpoint run.
+	RefreshContributions bool
 }
 
 func newEnsembleStatusCmd() *cobra.Command {
 	opts := ensembleStatusOptions{
-		Format: "table",
+		Format:       "table",
+		PollInterval: 10 * time.Second,
 	}
 	cmd := &cobra.Command{
 		Use:   "status [session]",
@@ -185,8 +487,74 @@ Formats:
   --format=table (default)
   --format=json
   --format=yaml
-
-Use --show-contributions to include mode contribution scores (requires completed outputs).`,
+  --format=csv    (assignment rows; contribution scores follow as a second
+                   section after a blank line unless --no-contributions-csv)
+  --format=junit  (one testcase per mode, for CI dashboards)
+
+Use --show-contributions to include mode contribution scores (requires completed outputs).
+Use --contributions-only to print just the contribution scorecard, without the
+assignment table; it also accepts --format=csv.
+Contribution scores are cached in the session's latest checkpoint run and
+reused until the captured outputs change; use --refresh-contributions to
+recompute them anyway.
+
+Use --health to compare each running mode's elapsed time with its estimated
+runtime; modes past 3x their estimate are flagged as possibly stuck.
+
+Use --estimate-remaining to project when synthesis will be ready, from the
+runtime model calibrated by modes that already finished, panes running in
+parallel, and the timebox deadline as an upper bound.
+
+Use --compare-budget to add a BUDGET column comparing each finished mode's
+output tokens with the cost model's estimate for it; modes past 150% of the
+estimate are marked OVER.
+
+Use --output-lengths to capture each active or finished mode's pane and add an
+OUTPUT column with its character and line count. Captures under 400 characters
+or 5 lines are marked SHORT, an early sign that a mode replied with almost
+nothing. Requires the tmux session to be running.
+
+Use --agents-table to add an Agents table joining each assignment with its
+live tmux pane: whether the pane is alive, idle (no output for 5 minutes) or
+dead, and when it last produced output. A working mode whose pane is dead or
+idle is flagged.
+
+Use --export-json <file> to also write the full status as JSON to a file,
+whatever --format the terminal shows, e.g. a table on screen plus a JSON
+snapshot for later comparison.
+
+Use --raw-output <mode> to print one mode's full captured pane output
+verbatim (no table, no truncation) and exit. The mode may be given by ID or
+code; it fails if the mode is not in the ensemble or has no output yet.
+
+Use --tree to group assignments under their mode's catalog category and tier,
+with per-group completion counts, instead of one flat table.
+
+Use --fail-if-errored to exit non-zero when any mode errored, for CI gating.
+Modes the timebox or budget deliberately skipped do not count as failures.
+
+Use --poll-until-error to keep polling (every --interval) and exit non-zero as
+soon as any mode errors, naming the failed modes; it exits zero once every
+mode has finished without errors. Timebox and budget skips are not errors.
+
+While the session is running, status is also what records progress: each
+call captures the panes, marks modes whose output parses as done, applies
+--strict-budget, saves the state, and posts any transitions to the
+ensemble webhook_url (all together, bounded by one 5s timeout). Nothing
+advances between status calls; use --watch to keep it going.
+
+Use --watch to redraw the status table in place every --interval (default 3s)
+with the elapsed watch time in the header, until every mode has finished or
+you press Ctrl-C. It exits zero once synthesis is ready and non-zero if every
+mode finished without one completing. Only --format=table can be watched.
+
+Use --columns to pick and order assignment table columns:
+  mode, code, name, agent, status, tokens, pane, age, budget, output
+Default: mode,code,agent,status,tokens,pane
+
+Use --sort to order assignments by mode, status, tokens, or agent, with ties
+broken by mode ID; prefix the key with - to sort descending (--sort=-tokens).
+JSON and YAML output follow the same order. Default: assignment order.`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			machineJSON := IsJSONOutput() || strings.EqualFold(strings.TrimSpace(opts.Format), "json")
@@ -202,12 +570,42 @@ Use --show-contributions to include mode contribution scores (requires completed
 				return nil
 			}
 			res.ExplainIfInferredForOutput(os.Stderr, machineJSON)
+			if opts.PollUntilError {
+				if opts.Watch {
+					return fmt.Errorf("--watch and --poll-until-error are mutually exclusive")
+				}
+				return pollEnsembleUntilError(cmd.Context(), cmd.OutOrStdout(), res.Session, opts.PollInterval, machineJSON, ensemble.LoadSession)
+			}
+			if opts.Watch {
+				interval := opts.PollInterval
+				if !cmd.Flags().Changed("interval") {
+					interval = ensembleStatusWatchInterval
+				}
+				return watchEnsembleStatus(cmd.Context(), cmd.OutOrStdout(), res.Session, opts, interval, ensemble.LoadSession)
+			}
 			return runEnsembleStatus(cmd.OutOrStdout(), res.Session, opts)
 		},
 	}
 
-	cmd.Flags().StringVarP(&opts.Format, "format", "f", "table", "Output format: table, json, yaml")
+	cmd.Flags().StringVarP(&opts.Format, "format", "f", "table", "Output format: table, json, yaml, csv, junit")
 	cmd.Flags().BoolVar(&opts.ShowContributions, "show-contributions", false, "Include mode contribution scores")
+	cmd.Flags().BoolVar(&opts.ContributionsOnly, "contributions-only", false, "Show only the contribution scorecard (table, json, yaml, csv)")
+	cmd.Flags().BoolVar(&opts.RefreshContributions, "refresh-contributions", false, "Recompute contribution scores instead of using the cached report")
+	cmd.Flags().BoolVar(&opts.Health, "health", false, "Flag running modes that exceed 3x their estimated runtime")
+	cmd.Flags().BoolVar(&opts.EstimateRemaining, "estimate-remaining", false, "Project when all modes finish and synthesis is ready")
+	cmd.Flags().BoolVar(&opts.CompareBudget, "compare-budget", false, "Compare finished modes' output tokens with their estimate (flags >150%)")
+	cmd.Flags().BoolVar(&opts.OutputLengths, "output-lengths", false, "Show captured output size per active/done mode (flags short output)")
+	cmd.Flags().BoolVar(&opts.AgentsTable, "agents-table", false, "Cross-reference assignments with live tmux pane health and activity")
+	cmd.Flags().StringVar(&opts.ExportJSON, "export-json", "", "Also write the full status JSON to this file, independent of --format")
+	cmd.Flags().StringVar(&opts.RawOutput, "raw-output", "", "Print one mode's full captured output verbatim and exit (mode ID or code)")
+	cmd.Flags().BoolVar(&opts.Tree, "tree", false, "Group assignments by mode category and tier with per-group completion counts")
+	cmd.Flags().BoolVar(&opts.FailIfErrored, "fail-if-errored", false, "Exit non-zero if any mode errored (timebox/budget skips excluded)")
+	cmd.Flags().BoolVar(&opts.PollUntilError, "poll-until-error", false, "Poll until a mode errors (exit non-zero) or all modes finish (exit zero)")
+	cmd.Flags().BoolVar(&opts.Watch, "watch", false, "Redraw the status table every --interval until all modes finish (table format only)")
+	cmd.Flags().DurationVar(&opts.PollInterval, "interval", 10*time.Second, "Polling interval for --poll-until-error; --watch uses 3s unless set")
+	cmd.Flags().StringVar(&opts.Columns, "columns", "", "Comma-separated assignment table columns (e.g. mode,status,pane)")
+	cmd.Flags().BoolVar(&opts.NoContributionsCSV, "no-contributions-csv", false, "Omit the contributions section from --format=csv")
+	cmd.Flags().StringVar(&opts.Sort, "sort", "", "Sort assignments by mode, status, tokens, or agent (prefix - for descending)")
 	cmd.ValidArgsFunction = completeSessionArgs
 	return cmd
 }
@@ -462,69 +860,45 @@ func runEnsembleStop(w io.Writer, session string, opts ensembleStopOptions) erro
 	return renderEnsembleStopOutput(w, result, format, opts.Quiet)
 }
 
-func renderEnsembleStopOutput(w io.Writer, payload ensembleStopOutput, format string, quiet bool) error {
-	switch format {
-	case "json":
-		if err := output.WriteJSON(w, payload, true); err != nil {
-			return err
-		}
-		if !payload.Success {
-			return jsonFailureExit()
-		}
-		return nil
-	case "yaml", "yml":
-		data, err := yaml.Marshal(payload)
-		if err != nil {
-			return fmt.Errorf("marshal yaml: %w", err)
-		}
-		if _, err := w.Write(data); err != nil {
-			return err
-		}
-		if len(data) == 0 || data[len(data)-1] != '\n' {
-			_, err = w.Write([]byte("\n"))
-			return err
-		}
-		return nil
-	case "text", "table":
-		if quiet {
-			if payload.Success {
-				fmt.Fprintf(w, "stopped\n")
-			} else {
-				fmt.Fprintf(w, "error: %s\n", payload.Error)
-			}
-			return nil
-		}
+func newEnsembleAbortCmd() *cobra.Command {
+	format := "text"
 
-		if payload.Error != "" {
-			fmt.Fprintf(w, "Error: %s\n", payload.Error)
-		}
-		fmt.Fprintf(w, "Session:  %s\n", payload.Session)
-		fmt.Fprintf(w, "Status:   %s\n", payload.FinalStatus)
-		fmt.Fprintf(w, "Stopped:  %d panes\n", payload.Stopped)
-		if payload.Captured > 0 {
-			fmt.Fprintf(w, "Captured: %d outputs\n", payload.Captured)
-		}
-		if payload.Message != "" {
-			fmt.Fprintf(w, "\n%s\n", payload.Message)
-		}
-		return nil
-	default:
-		return fmt.Errorf("invalid format %q (expected text, json, yaml)", format)
+	cmd := &cobra.Command{
+		Use:   "abort <session>",
+		Short: "Kill an ensemble immediately without collecting outputs",
+		Long: `Emergency stop for an ensemble run: kill the tmux session at once and mark
+the ensemble state 'aborted'. Unlike 'ensemble stop', agents get no interrupt
+or grace period, no partial outputs are collected, and there is no
+confirmation prompt. 'ensemble status' afterwards reports the run as aborted,
+not stopped or complete.
+
+An ensemble that already reached a terminal state keeps that status; its
+session is still killed if it is running.`,
+		Example: `  ntm ensemble abort my-ensemble-session
+  ntm ensemble abort my-ensemble-session --format json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			machineJSON := IsJSONOutput() || strings.EqualFold(strings.TrimSpace(format), "json")
+			res, err := resolveEnsembleStateCommandSessionForOutput(args[0], cmd.OutOrStdout(), machineJSON)
+			if err != nil {
+				return err
+			}
+			if res.Session == "" {
+				return nil
+			}
+			return runEnsembleAbort(cmd.OutOrStdout(), res.Session, format)
+		},
 	}
-}
 
-func renderEnsembleStopFailureOutput(w io.Writer, payload ensembleStopOutput, format string, quiet bool, cause error) error {
-	err := renderEnsembleStopOutput(w, payload, format, quiet)
-	if cause != nil && errors.Is(err, errJSONFailure) {
-		return errors.Join(err, cause)
-	}
-	return err
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text, json, yaml")
+	cmd.ValidArgsFunction = completeSessionArgs
+	return cmd
 }
 
-func runEnsembleStatus(w io.Writer, session string, opts ensembleStatusOptions) error {
-	format := strings.ToLower(strings.TrimSpace(opts.Format))
+func runEnsembleAbort(w io.Writer, session, format string) error {
+	format = strings.ToLower(strings.TrimSpace(format))
 	if format == "" {
-		format = "table"
+		format = "text"
 	}
 	if jsonOutput {
 		format = "json"
@@ -536,112 +910,885 @@ func runEnsembleStatus(w io.Writer, session string, opts ensembleStatusOptions)
 			if !sessionLive {
 				return fmt.Errorf("session '%s' not found", session)
 			}
-			return renderEnsembleStatus(w, ensembleStatusOutput{
-				GeneratedAt: output.Timestamp(),
-				Session:     session,
-				Exists:      false,
-			}, format)
+			return fmt.Errorf("no ensemble running in session '%s'", session)
 		}
-		return err
+		return fmt.Errorf("load session: %w", err)
 	}
 
-	if sessionLive {
-		queryStart := time.Now()
-		panes, err := tmux.GetPanes(session)
-		queryDuration := time.Since(queryStart)
-		if err != nil {
-			return err
+	result := ensembleStopOutput{
+		GeneratedAt: output.Timestamp(),
+		Session:     session,
+		Success:     true,
+	}
+	fail := func(err error) error {
+		result.Success = false
+		result.Errors = 1
+		result.Error = err.Error()
+		result.FinalStatus = state.Status.String()
+		if renderErr := renderEnsembleStopFailureOutput(w, result, format, false, err); renderErr != nil {
+			return renderErr
 		}
-		slog.Default().Info("ensemble status tmux query",
-			"session", session,
-			"panes", len(panes),
-			"duration_ms", queryDuration.Milliseconds(),
-		)
-	} else {
-		slog.Default().Info("ensemble status using persisted state without live tmux session",
-			"session", session,
-			"status", state.Status,
-		)
+		return err
 	}
-
-	catalog, _ := ensemble.GlobalCatalog()
-	preset, budget := resolveEnsembleBudget(state)
-	assignments, counts := buildEnsembleAssignments(state, catalog, budget.MaxTokensPerMode)
-
-	totalEstimate := budget.MaxTokensPerMode * len(assignments)
-	synthesisReady := counts.Done > 0 && counts.Pending == 0 && counts.Working == 0
-
-	slog.Default().Info("ensemble status counts",
-		"session", session,
-		"pending", counts.Pending,
-		"working", counts.Working,
-		"done", counts.Done,
-		"error", counts.Error,
-	)
-
-	outputData := ensembleStatusOutput{
-		GeneratedAt:    output.Timestamp(),
-		Session:        session,
-		Exists:         true,
-		EnsembleName:   preset,
-		Question:       state.Question,
-		StartedAt:      state.CreatedAt,
-		Status:         state.Status.String(),
-		SynthesisReady: synthesisReady,
-		Synthesis:      state.SynthesisStrategy.String(),
-		Budget: ensembleBudgetSummary{
-			MaxTokensPerMode:     budget.MaxTokensPerMode,
-			MaxTotalTokens:       budget.MaxTotalTokens,
-			EstimatedTotalTokens: totalEstimate,
-		},
-		StatusCounts: counts,
-		Assignments:  assignments,
+	if sessionLive {
+		panes, _ := tmux.GetPanes(session)
+		if err := tmux.KillSession(session); err != nil {
+			return fail(fmt.Errorf("kill session: %w", err))
+		}
+		result.Stopped = len(panes)
 	}
 
-	// Compute contributions if requested and there are completed outputs
-	if opts.ShowContributions && counts.Done > 0 {
-		contributions, err := computeContributions(state, catalog)
-		if err != nil {
-			slog.Default().Warn("failed to compute contributions", "error", err)
-		} else {
-			outputData.Contributions = contributions
+	if state.Status.IsTerminal() {
+		result.FinalStatus = state.Status.String()
+		result.Message = fmt.Sprintf("Ensemble already in terminal state: %s", state.Status)
+		if sessionLive {
+			result.Message += fmt.Sprintf("; killed session (%d panes)", result.Stopped)
 		}
+		return renderEnsembleStopOutput(w, result, format, false)
 	}
 
-	return renderEnsembleStatus(w, outputData, format)
-}
-
-func ensembleSessionRuntimeExists(session string) bool {
-	if strings.TrimSpace(session) == "" {
-		return false
+	prevStatus := state.Status
+	state.Status = ensemble.EnsembleAborted
+	if err := ensemble.SaveSession(session, state); err != nil {
+		state.Status = prevStatus
+		return fail(fmt.Errorf("save aborted state: %w", err))
 	}
-	if !tmux.IsInstalled() {
-		return false
+	slog.Default().Info("ensemble aborted", "session", session, "previous_status", prevStatus, "panes", result.Stopped)
+
+	result.FinalStatus = ensemble.EnsembleAborted.String()
+	if sessionLive {
+		result.Message = fmt.Sprintf("Ensemble aborted: %d panes killed, no outputs collected", result.Stopped)
+	} else {
+		result.Message = fmt.Sprintf("Ensemble session already absent; marked state aborted (previously %s)", prevStatus)
 	}
-	return tmux.SessionExists(session)
+	return renderEnsembleStopOutput(w, result, format, false)
 }
 
-func loadEnsembleStateWithRuntimePresence(session string) (*ensemble.EnsembleSession, bool, error) {
-	state, err := ensemble.LoadSession(session)
-	if err == nil {
-		return state, ensembleSessionRuntimeExists(session), nil
-	}
-	if errors.Is(err, os.ErrNotExist) {
-		return nil, ensembleSessionRuntimeExists(session), err
-	}
-	return nil, false, err
+type ensembleSnapshotOutput struct {
+	GeneratedAt time.Time `json:"generated_at" yaml:"generated_at"`
+	Session     string    `json:"session" yaml:"session"`
+	Path        string    `json:"path" yaml:"path"`
+	Status      string    `json:"status" yaml:"status"`
+	Live        bool      `json:"live" yaml:"live"`
+	Captured    int       `json:"captured" yaml:"captured"`
+	Parsed      int       `json:"parsed" yaml:"parsed"`
 }
 
-func capturedModeOutputs(captured []ensemble.CapturedOutput) []ensemble.ModeOutput {
-	outputs := make([]ensemble.ModeOutput, 0, len(captured))
-	for _, cap := range captured {
-		if cap.Parsed == nil {
-			continue
-		}
-		parsed := *cap.Parsed
-		if parsed.ModeID == "" {
-			parsed.ModeID = cap.ModeID
-		}
+func newEnsembleSnapshotCmd() *cobra.Command {
+	var (
+		format string
+		dir    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "snapshot [session]",
+		Short: "Save the ensemble state and captured outputs without stopping it",
+		Long: `Write a point-in-time snapshot of an ensemble run: the session state plus
+every mode output captured so far. The session keeps running.
+
+Snapshots are single timestamped JSON files, written to
+.ntm/ensemble-snapshots/<session>-<timestamp>.json unless --dir is given.
+When the tmux session is gone, the snapshot holds the saved state only.
+Use 'ntm ensemble snapshot restore <file>' to replay a crashed run from one.
+
+Formats:
+  --format=text (default)
+  --format=json
+  --format=yaml`,
+		Example: `  ntm ensemble snapshot my-session
+  ntm ensemble snapshot my-session --dir ~/ensemble-saves
+  ntm ensemble snapshot --format json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			machineJSON := IsJSONOutput() || strings.EqualFold(strings.TrimSpace(format), "json")
+			session := ""
+			if len(args) > 0 {
+				session = args[0]
+			}
+			res, err := resolveEnsembleStateCommandSessionForOutput(session, cmd.OutOrStdout(), machineJSON)
+			if err != nil {
+				return err
+			}
+			if res.Session == "" {
+				return nil
+			}
+			res.ExplainIfInferredForOutput(os.Stderr, machineJSON)
+			return runEnsembleSnapshot(cmd.OutOrStdout(), res.Session, dir, format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text, json, yaml")
+	cmd.Flags().StringVar(&dir, "dir", "", "Directory to write the snapshot to (default .ntm/ensemble-snapshots)")
+	cmd.ValidArgsFunction = completeSessionArgs
+	cmd.AddCommand(newEnsembleSnapshotRestoreCmd())
+	return cmd
+}
+
+type ensembleSnapshotRestoreOutput struct {
+	GeneratedAt time.Time `json:"generated_at" yaml:"generated_at"`
+	Session     string    `json:"session" yaml:"session"`
+	Snapshot    string    `json:"snapshot" yaml:"snapshot"`
+	SnapshotAt  time.Time `json:"snapshot_at" yaml:"snapshot_at"`
+	OutputDir   string    `json:"output_dir" yaml:"output_dir"`
+	Restored    []string  `json:"restored" yaml:"restored"`
+}
+
+func newEnsembleSnapshotRestoreCmd() *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "restore <snapshot-file>",
+		Short: "Restore a crashed ensemble run from a snapshot",
+		Long: `Replace the saved state of the snapshot's session with the snapshot and
+write every parsed mode output next to it (<snapshot>-outputs/<mode>.yaml),
+so 'ntm ensemble synthesize <session>' can run from it after the tmux
+session is gone. Modes that had not produced output are marked errored.
+
+The session must not be running; stop it first.`,
+		Example: `  ntm ensemble snapshot restore .ntm/ensemble-snapshots/my-session-20260304T050607Z.json
+  ntm ensemble synthesize my-session`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEnsembleSnapshotRestore(cmd.OutOrStdout(), config.ExpandHome(args[0]), format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text, json, yaml")
+	return cmd
+}
+
+func runEnsembleSnapshotRestore(w io.Writer, path, format string) error {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = "text"
+	}
+	if jsonOutput {
+		format = "json"
+	}
+	switch format {
+	case "text", "json", "yaml", "yml":
+	default:
+		return fmt.Errorf("invalid format %q (expected text, json, yaml)", format)
+	}
+
+	snap, err := ensemble.LoadSnapshot(path)
+	if err != nil {
+		return err
+	}
+	session := snap.Session.SessionName
+	if ensembleSessionRuntimeExists(session) {
+		return fmt.Errorf("session '%s' is still running; stop it before restoring a snapshot", session)
+	}
+
+	outputDir := strings.TrimSuffix(path, filepath.Ext(path)) + "-outputs"
+	state, restored, err := ensemble.RestoreSnapshot(snap, outputDir)
+	if err != nil {
+		return err
+	}
+	if err := ensemble.SaveSession(session, state); err != nil {
+		return fmt.Errorf("save session: %w", err)
+	}
+
+	payload := ensembleSnapshotRestoreOutput{
+		GeneratedAt: output.Timestamp(),
+		Session:     session,
+		Snapshot:    path,
+		SnapshotAt:  snap.CreatedAt,
+		OutputDir:   outputDir,
+		Restored:    restored,
+	}
+	slog.Default().Info("ensemble snapshot restored",
+		"session", session,
+		"snapshot", path,
+		"restored", restored,
+	)
+
+	switch format {
+	case "json":
+		return output.WriteJSON(w, payload, true)
+	case "yaml", "yml":
+		return renderYAML(w, payload)
+	default:
+		fmt.Fprintf(w, "Restored session %s from snapshot taken %s\n", session, payload.SnapshotAt.Format(time.RFC3339))
+		fmt.Fprintf(w, "Restored %d mode output(s) to %s\n", len(restored), outputDir)
+		if len(restored) > 0 {
+			fmt.Fprintf(w, "Run 'ntm ensemble synthesize %s' to synthesize them\n", session)
+		}
+		return nil
+	}
+}
+
+func runEnsembleSnapshot(w io.Writer, session, dir, format string) error {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = "text"
+	}
+	if jsonOutput {
+		format = "json"
+	}
+	switch format {
+	case "text", "json", "yaml", "yml":
+	default:
+		return fmt.Errorf("invalid format %q (expected text, json, yaml)", format)
+	}
+
+	state, sessionLive, err := loadEnsembleStateWithRuntimePresence(session)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("no ensemble state found for session '%s'", session)
+		}
+		return fmt.Errorf("load session: %w", err)
+	}
+
+	var captured []ensemble.CapturedOutput
+	if sessionLive {
+		capture := ensemble.NewOutputCapture(tmux.DefaultClient)
+		captured, err = capture.CaptureAll(state)
+		if err != nil {
+			slog.Default().Warn("ensemble snapshot capture incomplete",
+				"session", session,
+				"error", err,
+			)
+		}
+	}
+
+	snap, err := ensemble.NewSnapshot(state, captured, time.Now())
+	if err != nil {
+		return err
+	}
+	if dir == "" {
+		dir, err = ensemble.DefaultSnapshotDir("")
+		if err != nil {
+			return err
+		}
+	} else {
+		dir = config.ExpandHome(dir)
+	}
+	path, err := ensemble.WriteSnapshot(dir, snap)
+	if err != nil {
+		return err
+	}
+
+	payload := ensembleSnapshotOutput{
+		GeneratedAt: output.Timestamp(),
+		Session:     session,
+		Path:        path,
+		Status:      state.Status.String(),
+		Live:        sessionLive,
+		Captured:    len(snap.Outputs),
+		Parsed:      len(snap.ModeOutputs()),
+	}
+	slog.Default().Info("ensemble snapshot written",
+		"session", session,
+		"path", path,
+		"captured", payload.Captured,
+	)
+
+	switch format {
+	case "json":
+		return output.WriteJSON(w, payload, true)
+	case "yaml", "yml":
+		return renderYAML(w, payload)
+	default:
+		fmt.Fprintf(w, "Snapshot written: %s\n", payload.Path)
+		if payload.Live {
+			fmt.Fprintf(w, "Captured %d mode output(s), %d parsed\n", payload.Captured, payload.Parsed)
+		} else {
+			fmt.Fprintln(w, "Session not running; snapshot holds saved state only")
+		}
+		return nil
+	}
+}
+
+func renderEnsembleStopOutput(w io.Writer, payload ensembleStopOutput, format string, quiet bool) error {
+	switch format {
+	case "json":
+		if err := output.WriteJSON(w, payload, true); err != nil {
+			return err
+		}
+		if !payload.Success {
+			return jsonFailureExit()
+		}
+		return nil
+	case "yaml", "yml":
+		data, err := yaml.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("marshal yaml: %w", err)
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		if len(data) == 0 || data[len(data)-1] != '\n' {
+			_, err = w.Write([]byte("\n"))
+			return err
+		}
+		return nil
+	case "text", "table":
+		if quiet {
+			if payload.Success {
+				fmt.Fprintf(w, "stopped\n")
+			} else {
+				fmt.Fprintf(w, "error: %s\n", payload.Error)
+			}
+			return nil
+		}
+
+		if payload.Error != "" {
+			fmt.Fprintf(w, "Error: %s\n", payload.Error)
+		}
+		fmt.Fprintf(w, "Session:  %s\n", payload.Session)
+		fmt.Fprintf(w, "Status:   %s\n", payload.FinalStatus)
+		fmt.Fprintf(w, "Stopped:  %d panes\n", payload.Stopped)
+		if payload.Captured > 0 {
+			fmt.Fprintf(w, "Captured: %d outputs\n", payload.Captured)
+		}
+		if payload.Message != "" {
+			fmt.Fprintf(w, "\n%s\n", payload.Message)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid format %q (expected text, json, yaml)", format)
+	}
+}
+
+func renderEnsembleStopFailureOutput(w io.Writer, payload ensembleStopOutput, format string, quiet bool, cause error) error {
+	err := renderEnsembleStopOutput(w, payload, format, quiet)
+	if cause != nil && errors.Is(err, errJSONFailure) {
+		return errors.Join(err, cause)
+	}
+	return err
+}
+
+func runEnsembleStatus(w io.Writer, session string, opts ensembleStatusOptions) error {
+	format := strings.ToLower(strings.TrimSpace(opts.Format))
+	if format == "" {
+		format = "table"
+	}
+	if jsonOutput {
+		format = "json"
+	}
+	if opts.ContributionsOnly {
+		switch format {
+		case "table", "text", "json", "yaml", "yml", "csv":
+		default:
+			return fmt.Errorf("invalid format %q for --contributions-only (expected table, json, yaml, csv)", format)
+		}
+	} else {
+		switch format {
+		case "table", "text", "json", "yaml", "yml", "csv", "junit":
+		default:
+			return fmt.Errorf("invalid format %q (expected table, json, yaml, csv, junit)", format)
+		}
+	}
+	exportPath := strings.TrimSpace(opts.ExportJSON)
+	if exportPath != "" && (opts.ContributionsOnly || format == "junit") {
+		return fmt.Errorf("--export-json cannot be combined with --contributions-only or --format=junit")
+	}
+	columns, err := parseTableColumns(opts.Columns, ensembleStatusColumns)
+	if err != nil {
+		return err
+	}
+	sortKey, sortDesc, err := parseEnsembleAssignmentSort(opts.Sort)
+	if err != nil {
+		return err
+	}
+	if opts.CompareBudget && !slices.Contains(columns, "budget") {
+		if len(columns) == 0 {
+			columns = slices.Clone(ensembleStatusDefaultColumns)
+		}
+		columns = append(columns, "budget")
+	}
+	if opts.OutputLengths && !slices.Contains(columns, "output") {
+		if len(columns) == 0 {
+			columns = slices.Clone(ensembleStatusDefaultColumns)
+		}
+		columns = append(columns, "output")
+	}
+
+	state, sessionLive, err := loadEnsembleStateWithRuntimePresence(session)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			if !sessionLive {
+				return fmt.Errorf("session '%s' not found", session)
+			}
+			if format == "junit" || strings.TrimSpace(opts.RawOutput) != "" {
+				return fmt.Errorf("no ensemble state found for session '%s'", session)
+			}
+			payload := ensembleStatusOutput{
+				GeneratedAt: output.Timestamp(),
+				Session:     session,
+				Exists:      false,
+			}
+			if err := exportEnsembleStatusJSON(exportPath, payload); err != nil {
+				return err
+			}
+			return renderEnsembleStatus(w, payload, format, columns)
+		}
+		return err
+	}
+	if rawMode := strings.TrimSpace(opts.RawOutput); rawMode != "" {
+		if !sessionLive {
+			return fmt.Errorf("--raw-output needs the tmux session '%s' to be running", session)
+		}
+		// Capture the whole scrollback, not the default tail used for parsing.
+		capture := ensemble.NewOutputCapture(tmux.DefaultClient)
+		capture.SetMaxLines(tmux.DefaultHistoryLimit)
+		catalog, _ := ensemble.GlobalCatalog()
+		return printEnsembleRawOutput(w, state, catalog, rawMode, capture)
+	}
+	if sessionLive {
+		refreshEnsembleProgress(context.Background(), state)
+	}
+
+	failed, skipped := ensemble.ErroredModes(state)
+	var failErr error
+	if opts.FailIfErrored && len(failed) > 0 {
+		failErr = fmt.Errorf("%d mode(s) errored: %s", len(failed), strings.Join(failed, ", "))
+	}
+	if format == "junit" {
+		report, err := ensemble.NewJUnitReport(state, time.Now())
+		if err != nil {
+			return err
+		}
+		if err := report.WriteJUnit(w); err != nil {
+			return err
+		}
+		return failErr
+	}
+
+	var paneActivity []tmux.PaneActivity
+	if sessionLive {
+		queryStart := time.Now()
+		paneCount := 0
+		if opts.AgentsTable {
+			activity, err := tmux.GetPanesWithActivity(session)
+			if err != nil {
+				return err
+			}
+			paneActivity = activity
+			paneCount = len(activity)
+		} else {
+			panes, err := tmux.GetPanes(session)
+			if err != nil {
+				return err
+			}
+			paneCount = len(panes)
+		}
+		queryDuration := time.Since(queryStart)
+		slog.Default().Info("ensemble status tmux query",
+			"session", session,
+			"panes", paneCount,
+			"duration_ms", queryDuration.Milliseconds(),
+		)
+	} else {
+		slog.Default().Info("ensemble status using persisted state without live tmux session",
+			"session", session,
+			"status", state.Status,
+		)
+	}
+
+	catalog, _ := ensemble.GlobalCatalog()
+	if opts.ContributionsOnly {
+		report, err := computeContributions(state, catalog, opts.RefreshContributions)
+		if err != nil {
+			return fmt.Errorf("contributions unavailable for session '%s': %w", session, err)
+		}
+		if err := renderEnsembleContributions(w, ensembleContributionsOutput{
+			GeneratedAt:   output.Timestamp(),
+			Session:       session,
+			Contributions: report,
+		}, format); err != nil {
+			return err
+		}
+		return failErr
+	}
+
+	preset, budget := resolveEnsembleBudget(state)
+	assignments, counts := buildEnsembleAssignments(state, catalog, budget.MaxTokensPerMode)
+	sortEnsembleAssignments(assignments, sortKey, sortDesc)
+
+	totalEstimate := budget.MaxTokensPerMode * len(assignments)
+	synthesisReady := counts.Done > 0 && counts.Pending == 0 && counts.Working == 0
+
+	slog.Default().Info("ensemble status counts",
+		"session", session,
+		"pending", counts.Pending,
+		"working", counts.Working,
+		"done", counts.Done,
+		"error", counts.Error,
+		"skipped", skipped,
+	)
+
+	outputData := ensembleStatusOutput{
+		GeneratedAt:    output.Timestamp(),
+		Session:        session,
+		Exists:         true,
+		EnsembleName:   preset,
+		Question:       state.Question,
+		StartedAt:      state.CreatedAt,
+		Status:         state.Status.String(),
+		Seed:           state.Seed,
+		SynthesisReady: synthesisReady,
+		Synthesis:      state.SynthesisStrategy.String(),
+		Budget: ensembleBudgetSummary{
+			MaxTokensPerMode:     budget.MaxTokensPerMode,
+			MaxTotalTokens:       budget.MaxTotalTokens,
+			EstimatedTotalTokens: totalEstimate,
+			SpentTokens:          state.BudgetSpent,
+			StrictLimit:          state.BudgetLimit,
+			InjectedEstimate:     state.BudgetEstimate,
+			Cutoff:               state.BudgetCutoff,
+			CutoffSkipped:        countBudgetCutoffSkips(state.Assignments),
+		},
+		StatusCounts: counts,
+		Assignments:  assignments,
+	}
+
+	// Compute contributions if requested and there are completed outputs
+	if opts.ShowContributions && counts.Done > 0 {
+		contributions, err := computeContributions(state, catalog, opts.RefreshContributions)
+		if err != nil {
+			slog.Default().Warn("failed to compute contributions", "error", err)
+		} else {
+			outputData.Contributions = contributions
+		}
+	}
+
+	if opts.Health {
+		outputData.Health = ensemble.AssessModeHealth(state, catalog, time.Now())
+		if outputData.Health == nil {
+			outputData.Health = []ensemble.ModeHealth{}
+		}
+	}
+
+	if opts.EstimateRemaining {
+		estimate := ensemble.EstimateRemaining(state, catalog, budget.TotalTimeout, time.Now())
+		outputData.Estimate = &estimate
+	}
+
+	if opts.CompareBudget && counts.Done > 0 {
+		outputs, err := loadEnsembleModeOutputs(state, sessionLive)
+		if err != nil {
+			slog.Default().Warn("failed to load outputs for budget comparison", "error", err)
+		} else {
+			annotateEnsembleBudgets(outputData.Assignments, ensemble.CompareModeBudgets(outputs, catalog))
+		}
+	}
+
+	if opts.OutputLengths {
+		if !sessionLive {
+			slog.Default().Warn("output lengths need a live tmux session", "session", session)
+		} else if captured, err := ensemble.NewOutputCapture(tmux.DefaultClient).CaptureAll(state); len(captured) == 0 && err != nil {
+			slog.Default().Warn("failed to capture outputs for length check", "error", err)
+		} else {
+			annotateEnsembleOutputLengths(outputData.Assignments, ensemble.MeasureOutputLengths(captured))
+		}
+	}
+
+	if opts.FailIfErrored {
+		outputData.FailedModes = failed
+	}
+
+	if opts.Tree {
+		outputData.Groups = buildEnsembleStatusGroups(outputData.Assignments)
+	}
+
+	if opts.AgentsTable {
+		outputData.Agents = buildEnsembleAgentRows(outputData.Assignments, paneActivity, time.Now())
+	}
+
+	if err := exportEnsembleStatusJSON(exportPath, outputData); err != nil {
+		return err
+	}
+	if format == "csv" && opts.NoContributionsCSV {
+		outputData.Contributions = nil
+	}
+	if err := renderEnsembleStatus(w, outputData, format, columns); err != nil {
+		return err
+	}
+	return failErr
+}
+
+// ensembleRawOutputCapturer captures one mode's pane for --raw-output.
+type ensembleRawOutputCapturer interface {
+	CaptureMode(session *ensemble.EnsembleSession, modeID string) (ensemble.CapturedOutput, error)
+}
+
+// printEnsembleRawOutput writes the captured pane output of one mode to w
+// exactly as tmux returned it. ref may be a mode ID or a catalog code.
+func printEnsembleRawOutput(w io.Writer, state *ensemble.EnsembleSession, catalog *ensemble.ModeCatalog, ref string, capturer ensembleRawOutputCapturer) error {
+	var assignment *ensemble.ModeAssignment
+	for i := range state.Assignments {
+		a := &state.Assignments[i]
+		if strings.EqualFold(a.ModeID, ref) {
+			assignment = a
+			break
+		}
+		if catalog != nil {
+			if mode := catalog.GetMode(a.ModeID); mode != nil && strings.EqualFold(mode.Code, ref) {
+				assignment = a
+				break
+			}
+		}
+	}
+	if assignment == nil {
+		return fmt.Errorf("mode %q not found in ensemble session %s", ref, state.SessionName)
+	}
+	if assignment.Status == ensemble.AssignmentPending || assignment.Status == ensemble.AssignmentInjecting {
+		return fmt.Errorf("mode %s has no output yet (status: %s)", assignment.ModeID, assignment.Status)
+	}
+
+	captured, err := capturer.CaptureMode(state, assignment.ModeID)
+	if err != nil {
+		return fmt.Errorf("capture output for mode %s: %w", assignment.ModeID, err)
+	}
+	if strings.TrimSpace(captured.RawOutput) == "" {
+		return fmt.Errorf("mode %s has no output yet", assignment.ModeID)
+	}
+	_, err = io.WriteString(w, captured.RawOutput)
+	return err
+}
+
+// exportEnsembleStatusJSON writes payload to path as the same JSON that
+// --format=json prints. An empty path is a no-op.
+func exportEnsembleStatusJSON(path string, payload ensembleStatusOutput) error {
+	if path == "" {
+		return nil
+	}
+	var buf bytes.Buffer
+	if err := output.WriteJSON(&buf, payload, true); err != nil {
+		return fmt.Errorf("encode status json: %w", err)
+	}
+	if err := util.AtomicWriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("write status json: %w", err)
+	}
+	return nil
+}
+
+// ensemblePollFailure is one errored mode reported by --poll-until-error.
+type ensemblePollFailure struct {
+	ModeID   string `json:"mode_id" yaml:"mode_id"`
+	PaneName string `json:"pane_name" yaml:"pane_name"`
+	Error    string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+type ensemblePollOutput struct {
+	GeneratedAt time.Time             `json:"generated_at" yaml:"generated_at"`
+	Session     string                `json:"session" yaml:"session"`
+	Polls       int                   `json:"polls" yaml:"polls"`
+	Skipped     int                   `json:"skipped" yaml:"skipped"`
+	Failed      []ensemblePollFailure `json:"failed" yaml:"failed"`
+}
+
+// pollEnsembleUntilError reloads the ensemble state every interval until a
+// mode errors, which returns an error naming the failed modes, or every mode
+// has finished cleanly. Timebox and budget skips never trigger the exit.
+func pollEnsembleUntilError(ctx context.Context, w io.Writer, session string, interval time.Duration, machineJSON bool, load func(string) (*ensemble.EnsembleSession, error)) error {
+	if interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if !machineJSON {
+		fmt.Fprintf(os.Stderr, "Watching ensemble %s for mode errors every %s (Ctrl-C to stop)\n", session, interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	payload := ensemblePollOutput{Session: session, Failed: []ensemblePollFailure{}}
+	for {
+		state, err := load(session)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("no ensemble state found for session '%s'", session)
+			}
+			return fmt.Errorf("load session: %w", err)
+		}
+		payload.Polls++
+
+		failed, skipped := ensemble.ErroredModes(state)
+		payload.Skipped = skipped
+		finished := ensembleRunFinished(state)
+		if len(failed) > 0 || finished {
+			for _, a := range state.Assignments {
+				if slices.Contains(failed, a.ModeID) && a.Status == ensemble.AssignmentError {
+					payload.Failed = append(payload.Failed, ensemblePollFailure{ModeID: a.ModeID, PaneName: a.PaneName, Error: a.Error})
+				}
+			}
+			payload.GeneratedAt = output.Timestamp()
+			var failErr error
+			if len(failed) > 0 {
+				failErr = fmt.Errorf("%d mode(s) errored: %s", len(failed), strings.Join(failed, ", "))
+			}
+			if machineJSON {
+				if failErr != nil {
+					return emitJSONFailureEnvelopeToWithCause(w, payload, failErr)
+				}
+				return output.WriteJSON(w, payload, true)
+			}
+			if failErr == nil {
+				fmt.Fprintf(w, "All modes finished without errors (%d skipped)\n", skipped)
+				return nil
+			}
+			for _, f := range payload.Failed {
+				msg := f.Error
+				if msg == "" {
+					msg = "no error message recorded"
+				}
+				fmt.Fprintf(w, "Mode %s errored on pane %s: %s\n", f.ModeID, f.PaneName, msg)
+			}
+			return failErr
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("polling canceled: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// ensembleRunFinished reports whether the ensemble itself or every one of its
+// assignments has reached a terminal status.
+func ensembleRunFinished(state *ensemble.EnsembleSession) bool {
+	if state.Status.IsTerminal() {
+		return true
+	}
+	for _, a := range state.Assignments {
+		if !a.Status.IsTerminal() {
+			return false
+		}
+	}
+	return true
+}
+
+// ensembleStatusWatchInterval is the --watch refresh interval when
+// --interval is not given.
+const ensembleStatusWatchInterval = 3 * time.Second
+
+// watchEnsembleStatus clears the screen and re-renders the status table every
+// interval until every assignment is terminal or ctx is cancelled (Ctrl-C),
+// which returns nil. Once finished it returns nil if synthesis is ready and an
+// error if no mode completed.
+func watchEnsembleStatus(ctx context.Context, w io.Writer, session string, opts ensembleStatusOptions, interval time.Duration, load func(string) (*ensemble.EnsembleSession, error)) error {
+	format := strings.ToLower(strings.TrimSpace(opts.Format))
+	if jsonOutput || (format != "" && format != "table") {
+		return fmt.Errorf("--watch requires --format=table")
+	}
+	if interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	started := time.Now()
+	for {
+		fmt.Fprint(w, "\033[H\033[2J")
+		fmt.Fprintf(w, "Watching %s | elapsed %s | every %s (Ctrl-C to stop)\n\n",
+			session, time.Since(started).Truncate(time.Second), interval)
+		if err := runEnsembleStatus(w, session, opts); err != nil {
+			return err
+		}
+
+		state, err := load(session)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("no ensemble state found for session '%s'", session)
+			}
+			return fmt.Errorf("load session: %w", err)
+		}
+		if ensembleRunFinished(state) {
+			for _, a := range state.Assignments {
+				if a.Status == ensemble.AssignmentDone {
+					fmt.Fprintln(w, "\nAll modes finished; synthesis is ready.")
+					return nil
+				}
+			}
+			return fmt.Errorf("all modes finished but none completed; synthesis is not ready")
+		}
+
+		select {
+		case <-ctx.Done():
+			fmt.Fprintln(w, "\nStopping ensemble status watch...")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func ensembleSessionRuntimeExists(session string) bool {
+	if strings.TrimSpace(session) == "" {
+		return false
+	}
+	if !tmux.IsInstalled() {
+		return false
+	}
+	return tmux.SessionExists(session)
+}
+
+func loadEnsembleStateWithRuntimePresence(session string) (*ensemble.EnsembleSession, bool, error) {
+	state, err := ensemble.LoadSession(session)
+	if err == nil {
+		return state, ensembleSessionRuntimeExists(session), nil
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ensembleSessionRuntimeExists(session), err
+	}
+	return nil, false, err
+}
+
+// ensembleWebhookURL returns the configured ensemble status webhook, if any.
+func ensembleWebhookURL() string {
+	if cfg == nil {
+		return ""
+	}
+	return strings.TrimSpace(cfg.Ensemble.WebhookURL)
+}
+
+// refreshEnsembleProgress records modes that finished since the state was
+// last saved, capturing panes the same way resume does, re-measures
+// --strict-budget spend (skipping pending modes once it crosses the cap),
+// and persists the state when anything changed. Failures are logged: status
+// must still render.
+func refreshEnsembleProgress(ctx context.Context, state *ensemble.EnsembleSession) {
+	monitor := &ensemble.ProgressMonitor{
+		Capture:    ensembleResumeCapture,
+		WebhookURL: ensembleWebhookURL(),
+	}
+	update, err := monitor.Refresh(ctx, state)
+	if err != nil {
+		slog.Default().Warn("ensemble progress refresh failed", "session", state.SessionName, "error", err)
+		return
+	}
+	if !update.Changed() {
+		return
+	}
+	slog.Default().Info("ensemble progress refreshed",
+		"session", state.SessionName,
+		"completed", update.Completed,
+		"skipped", update.Skipped,
+		"spent", update.Spent,
+		"limit", state.BudgetLimit,
+	)
+	if err := ensemble.SaveSession(state.SessionName, state); err != nil {
+		slog.Default().Warn("ensemble state save failed", "session", state.SessionName, "error", err)
+	}
+}
+
+func capturedModeOutputs(captured []ensemble.CapturedOutput) []ensemble.ModeOutput {
+	outputs := make([]ensemble.ModeOutput, 0, len(captured))
+	for _, cap := range captured {
+		if cap.Parsed == nil {
+			continue
+		}
+		parsed := *cap.Parsed
+		if parsed.ModeID == "" {
+			parsed.ModeID = cap.ModeID
+		}
 		outputs = append(outputs, parsed)
 	}
 	return outputs
@@ -684,7 +1831,9 @@ func loadEnsembleModeOutputs(state *ensemble.EnsembleSession, sessionLive bool)
 }
 
 // computeContributions collects outputs and computes mode contribution scores.
-func computeContributions(state *ensemble.EnsembleSession, catalog *ensemble.ModeCatalog) (*ensemble.ContributionReport, error) {
+// The report is cached in the session's latest checkpoint run, keyed by a hash
+// of the outputs, and reused while they are unchanged unless refresh is set.
+func computeContributions(state *ensemble.EnsembleSession, catalog *ensemble.ModeCatalog, refresh bool) (*ensemble.ContributionReport, error) {
 	outputs, err := loadEnsembleModeOutputs(state, ensembleSessionRuntimeExists(state.SessionName))
 	if err != nil {
 		return nil, err
@@ -693,6 +1842,50 @@ func computeContributions(state *ensemble.EnsembleSession, catalog *ensemble.Mod
 		return nil, fmt.Errorf("no valid outputs to analyze")
 	}
 
+	store, runID := ensembleContributionsCacheRun(state.SessionName)
+	hash, hashErr := ensemble.HashModeOutputs(outputs)
+	if store != nil && hashErr == nil && !refresh {
+		if cached, err := store.LoadContributions(runID); err == nil && cached.OutputsHash == hash && cached.Report != nil {
+			slog.Default().Debug("using cached contributions", "session", state.SessionName, "run_id", runID)
+			return cached.Report, nil
+		}
+	}
+
+	report := buildContributionReport(outputs, catalog)
+	if store != nil && hashErr == nil {
+		if err := store.SaveContributions(runID, ensemble.ContributionsCheckpoint{
+			OutputsHash: hash,
+			Report:      report,
+		}); err != nil {
+			slog.Default().Warn("failed to cache contributions", "session", state.SessionName, "run_id", runID, "error", err)
+		}
+	}
+	return report, nil
+}
+
+// ensembleContributionsCacheRun returns the checkpoint store and the newest
+// run recorded for session, or a nil store when the session has no run to
+// cache contributions in.
+func ensembleContributionsCacheRun(session string) (*ensemble.CheckpointStore, string) {
+	store, err := newEnsembleCheckpointStoreForSession(context.Background(), session)
+	if err != nil {
+		slog.Default().Debug("contributions cache unavailable", "session", session, "error", err)
+		return nil, ""
+	}
+	runs, err := store.ListRuns()
+	if err != nil {
+		slog.Default().Debug("contributions cache unavailable", "session", session, "error", err)
+		return nil, ""
+	}
+	for _, run := range runs {
+		if run.SessionName == session {
+			return store, run.RunID
+		}
+	}
+	return nil, ""
+}
+
+func buildContributionReport(outputs []ensemble.ModeOutput, catalog *ensemble.ModeCatalog) *ensemble.ContributionReport {
 	// Create contribution tracker
 	tracker := ensemble.NewContributionTracker()
 
@@ -714,7 +1907,18 @@ func computeContributions(state *ensemble.EnsembleSession, catalog *ensemble.Mod
 		}
 	}
 
-	return tracker.GenerateReport(), nil
+	return tracker.GenerateReport()
+}
+
+// countBudgetCutoffSkips counts assignments skipped by --over-budget=skip.
+func countBudgetCutoffSkips(assignments []ensemble.ModeAssignment) int {
+	count := 0
+	for _, assignment := range assignments {
+		if assignment.Status == ensemble.AssignmentError && assignment.Error == ensemble.OverBudgetSkipReason {
+			count++
+		}
+	}
+	return count
 }
 
 func resolveEnsembleBudget(state *ensemble.EnsembleSession) (string, ensemble.BudgetConfig) {
@@ -772,10 +1976,14 @@ func buildEnsembleAssignments(state *ensemble.EnsembleSession, catalog *ensemble
 	for _, assignment := range state.Assignments {
 		modeCode := ""
 		modeName := ""
+		category := ""
+		tier := ""
 		if catalog != nil {
 			if mode := catalog.GetMode(assignment.ModeID); mode != nil {
 				modeCode = mode.Code
 				modeName = mode.Name
+				category = mode.Category.String()
+				tier = mode.Tier.String()
 			}
 		}
 
@@ -793,21 +2001,264 @@ func buildEnsembleAssignments(state *ensemble.EnsembleSession, catalog *ensemble
 			counts.Pending++
 		}
 
+		var assignedAt *time.Time
+		if !assignment.AssignedAt.IsZero() {
+			t := assignment.AssignedAt
+			assignedAt = &t
+		}
+
 		rows = append(rows, ensembleAssignmentRow{
 			ModeID:        assignment.ModeID,
 			ModeCode:      modeCode,
 			ModeName:      modeName,
+			Category:      category,
+			Tier:          tier,
 			AgentType:     assignment.AgentType,
 			Status:        status,
 			TokenEstimate: tokenEstimate,
 			PaneName:      assignment.PaneName,
+			AssignedAt:    assignedAt,
 		})
 	}
 
 	return rows, counts
 }
 
-func renderEnsembleStatus(w io.Writer, payload ensembleStatusOutput, format string) error {
+// ensembleAssignmentStatusRank orders assignment statuses by lifecycle for
+// --sort=status; unknown statuses sort last.
+var ensembleAssignmentStatusRank = map[string]int{
+	ensemble.AssignmentPending.String():   0,
+	ensemble.AssignmentInjecting.String(): 1,
+	ensemble.AssignmentActive.String():    2,
+	ensemble.AssignmentDone.String():      3,
+	ensemble.AssignmentError.String():     4,
+}
+
+// parseEnsembleAssignmentSort parses a --sort value such as "tokens" or
+// "-tokens". An empty spec keeps assignment order and returns key "".
+func parseEnsembleAssignmentSort(spec string) (key string, desc bool, err error) {
+	key = strings.ToLower(strings.TrimSpace(spec))
+	if rest, ok := strings.CutPrefix(key, "-"); ok {
+		key, desc = rest, true
+	}
+	switch key {
+	case "":
+		if desc {
+			return "", false, fmt.Errorf("invalid --sort %q (expected mode, status, tokens, or agent)", spec)
+		}
+		return "", false, nil
+	case "mode", "status", "tokens", "agent":
+		return key, desc, nil
+	default:
+		return "", false, fmt.Errorf("invalid --sort %q (expected mode, status, tokens, or agent)", spec)
+	}
+}
+
+// sortEnsembleAssignments reorders rows in place by key, breaking ties by
+// mode ID. The tie-break is always ascending so equal rows stay predictable
+// in either direction. An empty key leaves rows untouched.
+func sortEnsembleAssignments(rows []ensembleAssignmentRow, key string, desc bool) {
+	if key == "" {
+		return
+	}
+	compare := func(a, b ensembleAssignmentRow) int {
+		switch key {
+		case "status":
+			ra, oka := ensembleAssignmentStatusRank[a.Status]
+			rb, okb := ensembleAssignmentStatusRank[b.Status]
+			if !oka {
+				ra = len(ensembleAssignmentStatusRank)
+			}
+			if !okb {
+				rb = len(ensembleAssignmentStatusRank)
+			}
+			if c := cmp.Compare(ra, rb); c != 0 {
+				return c
+			}
+			return strings.Compare(a.Status, b.Status)
+		case "tokens":
+			return cmp.Compare(a.TokenEstimate, b.TokenEstimate)
+		case "agent":
+			return strings.Compare(a.AgentType, b.AgentType)
+		default:
+			return strings.Compare(a.ModeID, b.ModeID)
+		}
+	}
+	slices.SortStableFunc(rows, func(a, b ensembleAssignmentRow) int {
+		c := compare(a, b)
+		if desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+		return strings.Compare(a.ModeID, b.ModeID)
+	})
+}
+
+// buildEnsembleAgentRows matches each assignment to its pane by title, the
+// same way output capture does. Panes that are missing from the session are
+// dead; panes without output for ensembleAgentIdleThreshold are idle.
+func buildEnsembleAgentRows(rows []ensembleAssignmentRow, panes []tmux.PaneActivity, now time.Time) []ensembleAgentRow {
+	byTitle := make(map[string]tmux.PaneActivity, len(panes))
+	for _, pane := range panes {
+		if pane.Pane.Title != "" {
+			byTitle[pane.Pane.Title] = pane
+		}
+	}
+
+	agents := make([]ensembleAgentRow, 0, len(rows))
+	for _, row := range rows {
+		agent := ensembleAgentRow{
+			ModeID:    row.ModeID,
+			AgentType: row.AgentType,
+			PaneName:  row.PaneName,
+			Status:    row.Status,
+			PaneState: "dead",
+		}
+		if pane, ok := byTitle[row.PaneName]; ok {
+			agent.PaneID = pane.Pane.ID
+			agent.PaneState = "alive"
+			if !pane.LastActivity.IsZero() {
+				last := pane.LastActivity
+				agent.LastActivity = &last
+				if now.Sub(last) >= ensembleAgentIdleThreshold {
+					agent.PaneState = "idle"
+				}
+			}
+		}
+		if row.Status == ensemble.AssignmentActive.String() || row.Status == ensemble.AssignmentInjecting.String() {
+			switch agent.PaneState {
+			case "dead":
+				agent.Mismatch = row.Status + " but pane is dead"
+			case "idle":
+				agent.Mismatch = row.Status + " but pane is idle"
+			}
+		}
+		agents = append(agents, agent)
+	}
+	return agents
+}
+
+// buildEnsembleStatusGroups groups rows by category and tier, ordered the way
+// the catalog lists them. Modes missing from the catalog land in a trailing
+// "uncategorized" group.
+func buildEnsembleStatusGroups(rows []ensembleAssignmentRow) []ensembleStatusGroup {
+	categoryRank := make(map[string]int)
+	for i, category := range ensemble.AllCategories() {
+		categoryRank[category.String()] = i
+	}
+	tierRank := map[string]int{
+		ensemble.TierCore.String():         0,
+		ensemble.TierAdvanced.String():     1,
+		ensemble.TierExperimental.String(): 2,
+	}
+	rank := func(m map[string]int, key string) int {
+		if r, ok := m[key]; ok {
+			return r
+		}
+		return len(m)
+	}
+
+	index := make(map[[2]string]int)
+	var groups []ensembleStatusGroup
+	for _, row := range rows {
+		category := row.Category
+		if category == "" {
+			category = "uncategorized"
+		}
+		key := [2]string{category, row.Tier}
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, ensembleStatusGroup{Category: category, Tier: row.Tier})
+		}
+		group := &groups[i]
+		group.Total++
+		switch row.Status {
+		case ensemble.AssignmentDone.String():
+			group.Done++
+		case ensemble.AssignmentError.String():
+			group.Error++
+		}
+		group.Modes = append(group.Modes, row.ModeID)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		ci, cj := rank(categoryRank, groups[i].Category), rank(categoryRank, groups[j].Category)
+		if ci != cj {
+			return ci < cj
+		}
+		return rank(tierRank, groups[i].Tier) < rank(tierRank, groups[j].Tier)
+	})
+	return groups
+}
+
+// renderEnsembleStatusTree prints each group with its completion count and
+// then its modes as branches.
+func renderEnsembleStatusTree(w io.Writer, groups []ensembleStatusGroup, rows []ensembleAssignmentRow) {
+	byMode := make(map[string]ensembleAssignmentRow, len(rows))
+	for _, row := range rows {
+		byMode[row.ModeID] = row
+	}
+	for gi, group := range groups {
+		if gi > 0 {
+			fmt.Fprintln(w)
+		}
+		label := group.Category
+		if group.Tier != "" {
+			label += " / " + group.Tier
+		}
+		summary := fmt.Sprintf("%d/%d done", group.Done, group.Total)
+		if group.Error > 0 {
+			summary += fmt.Sprintf(", %d error", group.Error)
+		}
+		fmt.Fprintf(w, "%s  (%s)\n", label, summary)
+		for i, modeID := range group.Modes {
+			branch := "├─"
+			if i == len(group.Modes)-1 {
+				branch = "└─"
+			}
+			row := byMode[modeID]
+			line := fmt.Sprintf("%s %-24s %-10s", branch, modeID, row.Status)
+			if row.ModeCode != "" {
+				line += " " + row.ModeCode
+			}
+			if row.PaneName != "" {
+				line += "  " + row.PaneName
+			}
+			fmt.Fprintln(w, strings.TrimRight(line, " "))
+		}
+	}
+}
+
+// annotateEnsembleOutputLengths attaches output lengths to active and
+// finished rows; pending and errored panes have nothing worth measuring.
+func annotateEnsembleOutputLengths(rows []ensembleAssignmentRow, lengths map[string]ensemble.ModeOutputLength) {
+	for i := range rows {
+		if rows[i].Status != ensemble.AssignmentActive.String() && rows[i].Status != ensemble.AssignmentDone.String() {
+			continue
+		}
+		if length, ok := lengths[rows[i].ModeID]; ok {
+			rows[i].OutputLength = &length
+		}
+	}
+}
+
+// annotateEnsembleBudgets attaches budget comparisons to finished rows.
+func annotateEnsembleBudgets(rows []ensembleAssignmentRow, comparisons map[string]ensemble.ModeBudgetComparison) {
+	for i := range rows {
+		if rows[i].Status != ensemble.AssignmentDone.String() {
+			continue
+		}
+		if cmp, ok := comparisons[rows[i].ModeID]; ok {
+			rows[i].Budget = &cmp
+		}
+	}
+}
+
+func renderEnsembleStatus(w io.Writer, payload ensembleStatusOutput, format string, columns []string) error {
 	switch format {
 	case "json":
 		return output.WriteJSON(w, payload, true)
@@ -824,6 +2275,8 @@ func renderEnsembleStatus(w io.Writer, payload ensembleStatusOutput, format stri
 			return err
 		}
 		return nil
+	case "csv":
+		return writeEnsembleStatusCSV(w, payload)
 	case "table", "text":
 		if !payload.Exists {
 			fmt.Fprintf(w, "No ensemble running for session %s\n", payload.Session)
@@ -844,56 +2297,101 @@ func renderEnsembleStatus(w io.Writer, payload ensembleStatusOutput, format stri
 		if payload.Synthesis != "" {
 			fmt.Fprintf(w, "Synthesis: %s\n", payload.Synthesis)
 		}
+		if payload.Seed != 0 {
+			fmt.Fprintf(w, "Seed:      %d\n", payload.Seed)
+		}
 		fmt.Fprintf(w, "Ready:     %t\n", payload.SynthesisReady)
 		fmt.Fprintf(w, "Budget:    %d per mode, %d total (est %d)\n",
 			payload.Budget.MaxTokensPerMode,
 			payload.Budget.MaxTotalTokens,
 			payload.Budget.EstimatedTotalTokens,
 		)
-		fmt.Fprintf(w, "Counts:    pending=%d working=%d done=%d error=%d\n\n",
+		if payload.Budget.StrictLimit > 0 {
+			fmt.Fprintf(w, "Spent:     %d / %d tokens (strict)\n", payload.Budget.SpentTokens, payload.Budget.StrictLimit)
+		}
+		if payload.Budget.Cutoff > 0 {
+			fmt.Fprintf(w, "Cutoff:    ~%d / %d estimated tokens injected, %d modes skipped\n",
+				payload.Budget.InjectedEstimate, payload.Budget.Cutoff, payload.Budget.CutoffSkipped)
+		}
+		fmt.Fprintf(w, "Counts:    pending=%d working=%d done=%d error=%d\n",
 			payload.StatusCounts.Pending,
 			payload.StatusCounts.Working,
 			payload.StatusCounts.Done,
 			payload.StatusCounts.Error,
 		)
+		if est := payload.Estimate; est != nil {
+			switch {
+			case est.Unfinished == 0:
+				fmt.Fprintf(w, "ETA:       all modes finished\n")
+			case est.CappedByDeadline:
+				fmt.Fprintf(w, "ETA:       ~%s, at timebox deadline %s (%d unfinished)\n",
+					est.Remaining, est.ReadyAt.Format(time.Kitchen), est.Unfinished)
+			default:
+				fmt.Fprintf(w, "ETA:       ~%s, about %s (%d unfinished, calibration %.2fx from %d done)\n",
+					est.Remaining, est.ReadyAt.Format(time.Kitchen), est.Unfinished, est.Calibration, est.CompletedSamples)
+			}
+		}
+		fmt.Fprintln(w)
 
-		table := output.NewTable(w, "MODE", "CODE", "AGENT", "STATUS", "TOKENS", "PANE")
-		for _, row := range payload.Assignments {
-			table.AddRow(row.ModeID, row.ModeCode, row.AgentType, row.Status, fmt.Sprintf("%d", row.TokenEstimate), row.PaneName)
+		if payload.Groups != nil {
+			renderEnsembleStatusTree(w, payload.Groups, payload.Assignments)
+		} else {
+			renderColumnTable(w, ensembleStatusColumns, ensembleStatusDefaultColumns, columns, payload.Assignments)
 		}
-		table.Render()
 
 		// Render contribution report if present
 		if payload.Contributions != nil && len(payload.Contributions.Scores) > 0 {
-			fmt.Fprintf(w, "\nMode Contributions\n")
-			fmt.Fprintf(w, "------------------\n")
-			fmt.Fprintf(w, "Total Findings: %d (deduped: %d)  Overlap: %.1f%%  Diversity: %.2f\n\n",
-				payload.Contributions.TotalFindings,
-				payload.Contributions.DedupedFindings,
-				payload.Contributions.OverlapRate*100,
-				payload.Contributions.DiversityScore,
-			)
+			fmt.Fprintln(w)
+			renderEnsembleContributionTable(w, payload.Contributions)
+		}
+
+		if len(payload.Agents) > 0 {
+			fmt.Fprintf(w, "\nAgents\n")
+			fmt.Fprintf(w, "------\n")
+			atable := output.NewTable(w, "MODE", "AGENT", "PANE", "STATUS", "PANE STATE", "LAST ACTIVITY", "NOTE")
+			for _, a := range payload.Agents {
+				last := "-"
+				if a.LastActivity != nil {
+					last = formatAge(*a.LastActivity)
+				}
+				pane := a.PaneName
+				if pane == "" {
+					pane = "-"
+				}
+				note := a.Mismatch
+				if note == "" {
+					note = "-"
+				}
+				atable.AddRow(a.ModeID, a.AgentType, pane, a.Status, a.PaneState, last, note)
+			}
+			atable.Render()
+		}
 
-			ctable := output.NewTable(w, "RANK", "MODE", "SCORE", "FINDINGS", "UNIQUE", "CITATIONS")
-			for _, score := range payload.Contributions.Scores {
-				name := score.ModeName
-				if name == "" {
-					name = score.ModeID
+		if payload.Health != nil {
+			fmt.Fprintf(w, "\nMode Health\n")
+			fmt.Fprintf(w, "-----------\n")
+			if len(payload.Health) == 0 {
+				fmt.Fprintln(w, "No running modes")
+				return nil
+			}
+			htable := output.NewTable(w, "MODE", "STATUS", "ELAPSED", "ESTIMATE", "HEALTH")
+			var stuck []ensemble.ModeHealth
+			for _, h := range payload.Health {
+				health := string(h.Health)
+				if h.Health == ensemble.ModePossiblyStuck {
+					health = fmt.Sprintf("possibly stuck (%.1fx)", h.Ratio)
+					stuck = append(stuck, h)
 				}
-				ctable.AddRow(
-					fmt.Sprintf("#%d", score.Rank),
-					name,
-					fmt.Sprintf("%.1f", score.Score),
-					fmt.Sprintf("%d/%d", score.FindingsCount, score.OriginalFindings),
-					fmt.Sprintf("%d", score.UniqueInsights),
-					fmt.Sprintf("%d", score.CitationCount),
-				)
+				htable.AddRow(h.ModeID, h.Status, h.Elapsed.String(), h.Estimated.String(), health)
+			}
+			htable.Render()
+			for _, h := range stuck {
+				fmt.Fprintf(w, "  %s: %s\n", h.ModeID, h.Suggestion)
 			}
-			ctable.Render()
 		}
 		return nil
 	default:
-		return fmt.Errorf("invalid format %q (expected table, json, yaml)", format)
+		return fmt.Errorf("invalid format %q (expected table, json, yaml, csv, junit)", format)
 	}
 }
 
@@ -909,6 +2407,11 @@ type synthesizeOptions struct {
 	Resume   bool
 	UseCache bool
 	NoCache  bool
+	// SynthesisOnlyModes restricts which collected outputs feed synthesis.
+	SynthesisOnlyModes []string
+	// OutputDir and Formats write one file per format from a single run.
+	OutputDir string
+	Formats   []string
 }
 
 func newEnsembleSynthesizeCmd() *cobra.Command {
@@ -934,7 +2437,15 @@ Streaming:
   --stream                    - Emit incremental chunks (use --format=json or --json for JSONL)
   --resume --run-id=<id>      - Resume a streamed run from the last chunk index
 
-Use --force to synthesize even if some agents haven't completed.`,
+Use --force to synthesize even if some agents haven't completed.
+
+Use --synthesis-only-modes to synthesize a subset of the modes (e.g. to drop a
+noisy one). All outputs are still collected and cached; only the listed modes
+are passed to the synthesizer.
+
+Use --output-dir with --formats=markdown,json,yaml to write synthesis.md,
+synthesis.json, and synthesis.yaml from one synthesis run. The directory is
+created if missing. Without --formats, only --format is written.`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := validateSynthesizeOptions(opts); err != nil {
@@ -964,7 +2475,7 @@ Use --force to synthesize even if some agents haven't completed.`,
 		},
 	}
 
-	cmd.Flags().StringVar(&opts.Strategy, "strategy", "", "Override synthesis strategy")
+	cmd.Flags().StringVar(&opts.Strategy, "strategy", "", "Override synthesis strategy (e.g. consensus, debate)")
 	cmd.Flags().StringVarP(&opts.Output, "output", "o", "", "Output file path (default: stdout)")
 	cmd.Flags().StringVarP(&opts.Format, "format", "f", "markdown", "Output format: markdown, json, yaml")
 	cmd.Flags().BoolVar(&opts.Force, "force", false, "Synthesize even if some agents incomplete")
@@ -975,6 +2486,9 @@ Use --force to synthesize even if some agents haven't completed.`,
 	cmd.Flags().BoolVar(&opts.Resume, "resume", false, "Resume streaming from checkpoint run ID")
 	cmd.Flags().BoolVar(&opts.UseCache, "use-cache", true, "Use cached mode outputs when available")
 	cmd.Flags().BoolVar(&opts.NoCache, "no-cache", false, "Bypass cached mode outputs")
+	cmd.Flags().StringSliceVar(&opts.SynthesisOnlyModes, "synthesis-only-modes", nil, "Only feed these mode IDs into synthesis (comma-separated)")
+	cmd.Flags().StringVar(&opts.OutputDir, "output-dir", "", "Write synthesis.<ext> files into this directory")
+	cmd.Flags().StringSliceVar(&opts.Formats, "formats", nil, "Formats to write with --output-dir (markdown, json, yaml)")
 	cmd.ValidArgsFunction = completeSessionArgs
 	return cmd
 }
@@ -987,10 +2501,95 @@ func validateSynthesizeOptions(opts synthesizeOptions) error {
 	if opts.Resume && runID == "" {
 		return fmt.Errorf("--resume requires --run-id")
 	}
-	if !opts.Stream && runID != "" {
-		return fmt.Errorf("--run-id requires --stream")
+	if !opts.Stream && runID != "" {
+		return fmt.Errorf("--run-id requires --stream")
+	}
+	if strategy := strings.TrimSpace(opts.Strategy); strategy != "" {
+		if _, err := ensemble.ValidateOrMigrateStrategy(strategy); err != nil {
+			return fmt.Errorf("--strategy: %w", err)
+		}
+	}
+	outputDir := strings.TrimSpace(opts.OutputDir)
+	if outputDir != "" && strings.TrimSpace(opts.Output) != "" {
+		return fmt.Errorf("--output and --output-dir are mutually exclusive")
+	}
+	if len(opts.Formats) > 0 && outputDir == "" {
+		return fmt.Errorf("--formats requires --output-dir")
+	}
+	if outputDir != "" && opts.Stream {
+		return fmt.Errorf("--output-dir cannot be combined with --stream")
+	}
+	for _, format := range opts.Formats {
+		if _, _, err := synthesisOutputFormat(format); err != nil {
+			return fmt.Errorf("--formats: %w", err)
+		}
+	}
+	return nil
+}
+
+// synthesisOutputFormat maps a --format/--formats value to the formatter
+// format and the file extension used by --output-dir.
+func synthesisOutputFormat(format string) (ensemble.OutputFormat, string, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "markdown", "md":
+		return ensemble.FormatMarkdown, ".md", nil
+	case "json":
+		return ensemble.FormatJSON, ".json", nil
+	case "yaml", "yml":
+		return ensemble.FormatYAML, ".yaml", nil
+	default:
+		return "", "", fmt.Errorf("invalid format %q (expected markdown, json, yaml)", format)
+	}
+}
+
+// synthesisFilesOutput reports the files written by --output-dir.
+type synthesisFilesOutput struct {
+	Session   string   `json:"session"`
+	OutputDir string   `json:"output_dir"`
+	Files     []string `json:"files"`
+}
+
+// writeSynthesisFiles renders one synthesis.<ext> file per format into dir,
+// creating dir if needed. Every format is attempted; on failure the error
+// names the files that were written and the formats that failed.
+func writeSynthesisFiles(dir string, formats []string, render func(io.Writer, ensemble.OutputFormat) error) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create output dir: %w", err)
+	}
+
+	var written, failed []string
+	seen := make(map[ensemble.OutputFormat]bool, len(formats))
+	for _, format := range formats {
+		outputFormat, ext, err := synthesisOutputFormat(format)
+		if err != nil {
+			return written, err
+		}
+		if seen[outputFormat] {
+			continue
+		}
+		seen[outputFormat] = true
+
+		path := filepath.Join(dir, "synthesis"+ext)
+		var buf bytes.Buffer
+		if err := render(&buf, outputFormat); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", path, err))
+			continue
+		}
+		if err := util.AtomicWriteFile(path, buf.Bytes(), 0o644); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", path, err))
+			continue
+		}
+		written = append(written, path)
+	}
+
+	if len(failed) > 0 {
+		succeeded := "none"
+		if len(written) > 0 {
+			succeeded = strings.Join(written, ", ")
+		}
+		return written, fmt.Errorf("write synthesis files: %s (written: %s)", strings.Join(failed, "; "), succeeded)
 	}
-	return nil
+	return written, nil
 }
 
 func runEnsembleSynthesize(ctx context.Context, w io.Writer, session string, opts synthesizeOptions) error {
@@ -1181,6 +2780,25 @@ func runEnsembleSynthesize(ctx context.Context, w io.Writer, session string, opt
 		"errors", collector.ErrorCount(),
 	)
 
+	var included, excluded []string
+	if len(opts.SynthesisOnlyModes) > 0 {
+		included, excluded, err = collector.RestrictTo(opts.SynthesisOnlyModes)
+		if err != nil {
+			return fmt.Errorf("--synthesis-only-modes: %w", err)
+		}
+		logger.Info("ensemble synthesis restricted to modes",
+			"session", session,
+			"included", included,
+			"excluded", excluded,
+		)
+		if format != "json" {
+			fmt.Fprintf(os.Stderr, "Synthesizing %d mode(s): %s\n", len(included), strings.Join(included, ", "))
+			if len(excluded) > 0 {
+				fmt.Fprintf(os.Stderr, "Excluded from synthesis: %s\n", strings.Join(excluded, ", "))
+			}
+		}
+	}
+
 	// Determine synthesis strategy
 	strategy := state.SynthesisStrategy
 	if opts.Strategy != "" {
@@ -1216,6 +2834,8 @@ func runEnsembleSynthesize(ctx context.Context, w io.Writer, session string, opt
 	if err != nil {
 		return fmt.Errorf("synthesis failed: %w", err)
 	}
+	result.IncludedModes = included
+	result.ExcludedModes = excluded
 
 	slog.Default().Info("ensemble synthesis completed",
 		"session", session,
@@ -1225,6 +2845,35 @@ func runEnsembleSynthesize(ctx context.Context, w io.Writer, session string, opt
 		"confidence", float64(result.Confidence),
 	)
 
+	render := func(out io.Writer, outputFormat ensemble.OutputFormat) error {
+		formatter := ensemble.NewSynthesisFormatter(outputFormat)
+		formatter.Verbose = opts.Verbose
+		formatter.IncludeAudit = true
+		formatter.IncludeExplanation = opts.Explain
+		return formatter.FormatResult(out, result, input.AuditReport)
+	}
+
+	if outputDir := strings.TrimSpace(opts.OutputDir); outputDir != "" {
+		formats := opts.Formats
+		if len(formats) == 0 {
+			formats = []string{format}
+		}
+		files, err := writeSynthesisFiles(outputDir, formats, render)
+		for _, path := range files {
+			logger.Info("ensemble synthesis written", "session", session, "path", path)
+		}
+		if err != nil {
+			return err
+		}
+		if IsJSONOutput() {
+			return output.WriteJSON(w, synthesisFilesOutput{Session: session, OutputDir: outputDir, Files: files}, true)
+		}
+		for _, path := range files {
+			fmt.Fprintf(w, "Wrote %s\n", path)
+		}
+		return nil
+	}
+
 	// Format output
 	outputFormat := ensemble.FormatMarkdown
 	switch format {
@@ -1234,11 +2883,6 @@ func runEnsembleSynthesize(ctx context.Context, w io.Writer, session string, opt
 		outputFormat = ensemble.FormatYAML
 	}
 
-	formatter := ensemble.NewSynthesisFormatter(outputFormat)
-	formatter.Verbose = opts.Verbose
-	formatter.IncludeAudit = true
-	formatter.IncludeExplanation = opts.Explain
-
 	// Determine output destination
 	out := io.Writer(w)
 	if opts.Output != "" {
@@ -1250,7 +2894,7 @@ func runEnsembleSynthesize(ctx context.Context, w io.Writer, session string, opt
 		out = f
 	}
 
-	if err := formatter.FormatResult(out, result, input.AuditReport); err != nil {
+	if err := render(out, outputFormat); err != nil {
 		return fmt.Errorf("format output: %w", err)
 	}
 
@@ -2358,18 +4002,44 @@ func countAgentStates(state *ensemble.EnsembleSession) (ready, pending, working
 // Provenance command types
 
 type provenanceOptions struct {
-	Format  string
-	Session string
-	All     bool
-	Stats   bool
+	Format        string
+	Session       string
+	All           bool
+	Stats         bool
+	Columns       string
+	MinConfidence float64
+	Mode          string
+}
+
+var provenanceColumns = []tableColumn[*ensemble.ProvenanceChain]{
+	{Name: "id", Header: "ID", Value: func(c *ensemble.ProvenanceChain) string { return c.FindingID }},
+	{Name: "mode", Header: "MODE", Value: func(c *ensemble.ProvenanceChain) string { return c.SourceMode }},
+	{Name: "impact", Header: "IMPACT", Value: func(c *ensemble.ProvenanceChain) string { return string(c.Impact) }},
+	{Name: "conf", Header: "CONF", Value: func(c *ensemble.ProvenanceChain) string { return c.Confidence.String() }},
+	{Name: "text", Header: "TEXT", Value: func(c *ensemble.ProvenanceChain) string {
+		text := c.CurrentText
+		if len(text) > 60 {
+			text = text[:57] + "..."
+		}
+		return text
+	}},
+	{Name: "age", Header: "AGE", Value: func(c *ensemble.ProvenanceChain) string {
+		if c.CreatedAt.IsZero() {
+			return "-"
+		}
+		return formatAge(c.CreatedAt)
+	}},
 }
 
+var provenanceDefaultColumns = []string{"id", "mode", "impact", "conf", "text"}
+
 type provenanceOutput struct {
 	GeneratedAt time.Time                   `json:"generated_at" yaml:"generated_at"`
 	FindingID   string                      `json:"finding_id,omitempty" yaml:"finding_id,omitempty"`
 	Chain       *ensemble.ProvenanceChain   `json:"chain,omitempty" yaml:"chain,omitempty"`
 	Stats       *ensemble.ProvenanceStats   `json:"stats,omitempty" yaml:"stats,omitempty"`
 	Chains      []*ensemble.ProvenanceChain `json:"chains,omitempty" yaml:"chains,omitempty"`
+	Filtered    int                         `json:"filtered,omitempty" yaml:"filtered,omitempty"`
 	Error       string                      `json:"error,omitempty" yaml:"error,omitempty"`
 }
 
@@ -2391,11 +4061,22 @@ Use --stats to show provenance statistics.
 Formats:
   --format=text (default) - Human-readable timeline
   --format=json           - Machine-readable JSON
-  --format=yaml           - YAML format`,
+  --format=yaml           - YAML format
+  --format=dot            - Graphviz digraph of the chain (or, with --all,
+                            every chain); nodes are steps colored by impact
+
+Use --columns with --all to pick and order table columns:
+  id, mode, impact, conf, text, age
+Default: id,mode,impact,conf,text
+
+Use --min-confidence and --mode with --all to drop low-confidence findings or
+keep only one source mode; both filters must match.`,
 		Example: `  ntm ensemble provenance abc123def456
   ntm ensemble provenance --all
   ntm ensemble provenance --stats
-  ntm ensemble provenance --all --format=json`,
+  ntm ensemble provenance --all --format=json
+  ntm ensemble provenance --all --min-confidence 0.7 --mode deductive
+  ntm ensemble provenance --all --format=dot | dot -Tsvg > provenance.svg`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			machineJSON := IsJSONOutput() || strings.EqualFold(strings.TrimSpace(opts.Format), "json")
@@ -2419,10 +4100,13 @@ Formats:
 		},
 	}
 
-	cmd.Flags().StringVarP(&opts.Format, "format", "f", "text", "Output format: text, json, yaml")
+	cmd.Flags().StringVarP(&opts.Format, "format", "f", "text", "Output format: text, json, yaml, dot")
 	cmd.Flags().StringVarP(&opts.Session, "session", "s", "", "Session name (default: current)")
 	cmd.Flags().BoolVar(&opts.All, "all", false, "List all tracked findings")
 	cmd.Flags().BoolVar(&opts.Stats, "stats", false, "Show provenance statistics")
+	cmd.Flags().StringVar(&opts.Columns, "columns", "", "Comma-separated table columns for --all (e.g. id,mode,age)")
+	cmd.Flags().Float64Var(&opts.MinConfidence, "min-confidence", 0, "With --all, only show findings at or above this confidence (0.0-1.0)")
+	cmd.Flags().StringVar(&opts.Mode, "mode", "", "With --all, only show findings from this source mode ID")
 	cmd.ValidArgsFunction = completeSessionArgs
 	return cmd
 }
@@ -2600,6 +4284,17 @@ func runEnsembleProvenance(w io.Writer, session, findingID string, opts provenan
 	if jsonOutput {
 		format = "json"
 	}
+	columns, err := parseTableColumns(opts.Columns, provenanceColumns)
+	if err != nil {
+		return err
+	}
+	if opts.MinConfidence < 0 || opts.MinConfidence > 1 {
+		return fmt.Errorf("--min-confidence must be between 0 and 1, got %v", opts.MinConfidence)
+	}
+	opts.Mode = strings.TrimSpace(opts.Mode)
+	if !opts.All && (opts.MinConfidence > 0 || opts.Mode != "") {
+		return fmt.Errorf("--min-confidence and --mode require --all")
+	}
 
 	state, sessionLive, err := loadEnsembleStateWithRuntimePresence(session)
 	if err != nil {
@@ -2650,16 +4345,18 @@ func runEnsembleProvenance(w io.Writer, session, findingID string, opts provenan
 		return renderProvenanceOutput(w, provenanceOutput{
 			GeneratedAt: output.Timestamp(),
 			Stats:       &stats,
-		}, format)
+		}, format, columns)
 	}
 
 	// Handle all mode
 	if opts.All {
 		chains := tracker.ListChains()
+		kept := filterProvenanceChains(chains, opts.MinConfidence, opts.Mode)
 		return renderProvenanceOutput(w, provenanceOutput{
 			GeneratedAt: output.Timestamp(),
-			Chains:      chains,
-		}, format)
+			Chains:      kept,
+			Filtered:    len(chains) - len(kept),
+		}, format, columns)
 	}
 
 	// Handle single finding lookup
@@ -2685,17 +4382,36 @@ func runEnsembleProvenance(w io.Writer, session, findingID string, opts provenan
 			GeneratedAt: output.Timestamp(),
 			FindingID:   findingID,
 			Error:       fmt.Sprintf("finding '%s' not found", findingID),
-		}, format)
+		}, format, columns)
 	}
 
 	return renderProvenanceOutput(w, provenanceOutput{
 		GeneratedAt: output.Timestamp(),
 		FindingID:   findingID,
 		Chain:       chain,
-	}, format)
+	}, format, columns)
+}
+
+// filterProvenanceChains keeps chains at or above minConfidence and, when
+// mode is set, discovered by that mode.
+func filterProvenanceChains(chains []*ensemble.ProvenanceChain, minConfidence float64, mode string) []*ensemble.ProvenanceChain {
+	if minConfidence <= 0 && mode == "" {
+		return chains
+	}
+	kept := make([]*ensemble.ProvenanceChain, 0, len(chains))
+	for _, chain := range chains {
+		if float64(chain.Confidence) < minConfidence {
+			continue
+		}
+		if mode != "" && chain.SourceMode != mode {
+			continue
+		}
+		kept = append(kept, chain)
+	}
+	return kept
 }
 
-func renderProvenanceOutput(w io.Writer, payload provenanceOutput, format string) error {
+func renderProvenanceOutput(w io.Writer, payload provenanceOutput, format string, columns []string) error {
 	switch format {
 	case "json":
 		return output.WriteJSON(w, payload, true)
@@ -2712,6 +4428,20 @@ func renderProvenanceOutput(w io.Writer, payload provenanceOutput, format string
 			return err
 		}
 		return nil
+	case "dot":
+		if payload.Error != "" {
+			return errors.New(payload.Error)
+		}
+		switch {
+		case payload.Chain != nil:
+			_, err := io.WriteString(w, ensemble.FormatProvenanceDOT([]*ensemble.ProvenanceChain{payload.Chain}))
+			return err
+		case payload.Stats != nil:
+			return errors.New("--format=dot requires a finding-id or --all")
+		default:
+			_, err := io.WriteString(w, ensemble.FormatProvenanceDOT(payload.Chains))
+			return err
+		}
 	case "text", "table":
 		if payload.Error != "" {
 			fmt.Fprintf(w, "Error: %s\n", payload.Error)
@@ -2736,25 +4466,14 @@ func renderProvenanceOutput(w io.Writer, payload provenanceOutput, format string
 			return nil
 		}
 
-		if len(payload.Chains) > 0 {
+		if len(payload.Chains) > 0 || payload.Filtered > 0 {
 			fmt.Fprintf(w, "Tracked Findings (%d)\n", len(payload.Chains))
+			if payload.Filtered > 0 {
+				fmt.Fprintf(w, "%d findings filtered out by --min-confidence/--mode\n", payload.Filtered)
+			}
 			fmt.Fprintf(w, "====================\n\n")
 
-			table := output.NewTable(w, "ID", "MODE", "IMPACT", "CONF", "TEXT")
-			for _, chain := range payload.Chains {
-				text := chain.CurrentText
-				if len(text) > 60 {
-					text = text[:57] + "..."
-				}
-				table.AddRow(
-					chain.FindingID,
-					chain.SourceMode,
-					string(chain.Impact),
-					chain.Confidence.String(),
-					text,
-				)
-			}
-			table.Render()
+			renderColumnTable(w, provenanceColumns, provenanceDefaultColumns, columns, payload.Chains)
 			return nil
 		}
 
@@ -2766,7 +4485,7 @@ func renderProvenanceOutput(w io.Writer, payload provenanceOutput, format string
 		fmt.Fprintf(w, "No provenance data available\n")
 		return nil
 	default:
-		return fmt.Errorf("invalid format %q (expected text, json, yaml)", format)
+		return fmt.Errorf("invalid format %q (expected text, json, yaml, dot)", format)
 	}
 }
 
@@ -2779,14 +4498,19 @@ type checkpointListOutput struct {
 }
 
 type checkpointResumeOutput struct {
-	GeneratedAt time.Time `json:"generated_at" yaml:"generated_at"`
-	RunID       string    `json:"run_id" yaml:"run_id"`
-	Session     string    `json:"session" yaml:"session"`
-	Success     bool      `json:"success" yaml:"success"`
-	Message     string    `json:"message,omitempty" yaml:"message,omitempty"`
-	Resumed     int       `json:"resumed,omitempty" yaml:"resumed,omitempty"`
-	Skipped     int       `json:"skipped,omitempty" yaml:"skipped,omitempty"`
-	Error       string    `json:"error,omitempty" yaml:"error,omitempty"`
+	GeneratedAt time.Time              `json:"generated_at" yaml:"generated_at"`
+	RunID       string                 `json:"run_id" yaml:"run_id"`
+	Session     string                 `json:"session" yaml:"session"`
+	Success     bool                   `json:"success" yaml:"success"`
+	DryRun      bool                   `json:"dry_run,omitempty" yaml:"dry_run,omitempty"`
+	Message     string                 `json:"message,omitempty" yaml:"message,omitempty"`
+	Resumed     int                    `json:"resumed,omitempty" yaml:"resumed,omitempty"`
+	Skipped     int                    `json:"skipped,omitempty" yaml:"skipped,omitempty"`
+	Completed   int                    `json:"completed,omitempty" yaml:"completed,omitempty"`
+	TimedOut    int                    `json:"timed_out,omitempty" yaml:"timed_out,omitempty"`
+	Failed      int                    `json:"failed,omitempty" yaml:"failed,omitempty"`
+	Modes       []ensemble.ResumedMode `json:"modes,omitempty" yaml:"modes,omitempty"`
+	Error       string                 `json:"error,omitempty" yaml:"error,omitempty"`
 }
 
 type checkpointCleanOutput struct {
@@ -2795,12 +4519,27 @@ type checkpointCleanOutput struct {
 	Message     string    `json:"message" yaml:"message"`
 }
 
+type ensembleResumeOptions struct {
+	Format   string
+	Quiet    bool
+	SkipDone bool
+	DryRun   bool
+}
+
+// ensembleResumeRestorePanes, ensembleResumeCapture and ensembleResumeSleep
+// (nil waits on a real timer) are replaced in tests.
+var (
+	ensembleResumeRestorePanes = func(ctx context.Context, state *ensemble.EnsembleSession, modeIDs []string, projectDir string) (map[string]string, error) {
+		return ensemble.RestoreModePanes(ctx, tmux.DefaultClient, state, modeIDs, projectDir)
+	}
+	ensembleResumeCapture = func(state *ensemble.EnsembleSession, modeID string) (ensemble.CapturedOutput, error) {
+		return ensemble.NewOutputCapture(tmux.DefaultClient).CaptureMode(state, modeID)
+	}
+	ensembleResumeSleep func(ctx context.Context, d time.Duration) error
+)
+
 func newEnsembleResumeCmd() *cobra.Command {
-	var (
-		format   string
-		quiet    bool
-		skipDone bool
-	)
+	opts := ensembleResumeOptions{Format: "text", SkipDone: true}
 
 	cmd := &cobra.Command{
 		Use:   "resume <run-id>",
@@ -2809,37 +4548,49 @@ func newEnsembleResumeCmd() *cobra.Command {
 
 The resume command loads the checkpoint state and:
   1. Identifies which modes completed successfully
-  2. Re-runs any pending or errored modes
-  3. Continues with synthesis when all modes complete
+  2. Recreates the session's panes if the tmux session is gone
+  3. Re-sends the recorded prompt of every pending or errored mode
+  4. Waits up to the preset budget's timeout_per_mode for their output and
+     checkpoints each mode as it completes
+
+Modes that produce no output in time are checkpointed as errors, so running
+resume again picks them up. Use --skip-done=false to re-run completed modes
+too, and --dry-run to only report what would be resumed.
 
 Use 'ntm ensemble list-checkpoints' to see available runs.`,
 		Example: `  ntm ensemble resume my-ensemble-run
-  ntm ensemble resume my-ensemble-run --skip-done
+  ntm ensemble resume my-ensemble-run --dry-run
+  ntm ensemble resume my-ensemble-run --skip-done=false
   ntm ensemble resume my-ensemble-run --format json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			runID := args[0]
-			return runEnsembleResume(cmd.OutOrStdout(), runID, format, quiet, skipDone)
+			return runEnsembleResume(cmd.Context(), cmd.OutOrStdout(), runID, opts)
 		},
 	}
 
-	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text, json, yaml")
-	cmd.Flags().BoolVarP(&quiet, "quiet", "q", false, "Minimal output")
-	cmd.Flags().BoolVar(&skipDone, "skip-done", true, "Skip already completed modes (default: true)")
+	cmd.Flags().StringVarP(&opts.Format, "format", "f", "text", "Output format: text, json, yaml")
+	cmd.Flags().BoolVarP(&opts.Quiet, "quiet", "q", false, "Minimal output")
+	cmd.Flags().BoolVar(&opts.SkipDone, "skip-done", true, "Skip already completed modes (default: true)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Report the modes that would be resumed without re-injecting them")
 
 	return cmd
 }
 
-func runEnsembleResume(w io.Writer, runID, format string, quiet, skipDone bool) error {
-	format = strings.ToLower(strings.TrimSpace(format))
+func runEnsembleResume(ctx context.Context, w io.Writer, runID string, opts ensembleResumeOptions) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	format := strings.ToLower(strings.TrimSpace(opts.Format))
 	if format == "" {
 		format = "text"
 	}
 	if jsonOutput {
 		format = "json"
 	}
+	quiet := opts.Quiet
 
-	store, _, err := resolveEnsembleCheckpointStoreForRunID(runID)
+	store, projectDir, err := resolveEnsembleCheckpointStoreForRunID(runID)
 	if err != nil {
 		result := checkpointResumeOutput{
 			GeneratedAt: output.Timestamp(),
@@ -2880,13 +4631,14 @@ func runEnsembleResume(w io.Writer, runID, format string, quiet, skipDone bool)
 		"completed", len(meta.CompletedIDs),
 		"pending", len(meta.PendingIDs),
 		"errors", len(meta.ErrorIDs),
+		"dry_run", opts.DryRun,
 	)
 
 	// Calculate modes to run
 	toRun := append([]string{}, meta.PendingIDs...)
 	toRun = append(toRun, meta.ErrorIDs...)
 	skipped := len(meta.CompletedIDs)
-	if !skipDone {
+	if !opts.SkipDone {
 		toRun = append(toRun, meta.CompletedIDs...)
 		skipped = 0
 	}
@@ -2896,15 +4648,86 @@ func runEnsembleResume(w io.Writer, runID, format string, quiet, skipDone bool)
 		RunID:       runID,
 		Session:     meta.SessionName,
 		Success:     true,
-		Resumed:     len(toRun),
+		DryRun:      opts.DryRun,
 		Skipped:     skipped,
-		Message:     fmt.Sprintf("Resume initiated: %d modes to run, %d already complete", len(toRun), skipped),
 	}
 
 	if len(toRun) == 0 {
 		result.Message = "All modes already completed - no resume needed"
+		return renderCheckpointResumeOutput(w, result, format, quiet)
+	}
+
+	if opts.DryRun {
+		result.Resumed = len(toRun)
+		result.Message = fmt.Sprintf("Dry run: %d modes to run, %d already complete", len(toRun), skipped)
+		return renderCheckpointResumeOutput(w, result, format, quiet)
+	}
+
+	failure := func(cause error) error {
+		result.Success = false
+		result.Error = cause.Error()
+		return renderCheckpointResumeFailureOutput(w, result, format, quiet, cause)
+	}
+
+	state, err := ensemble.LoadSession(meta.SessionName)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return failure(fmt.Errorf("no ensemble state found for session '%s'", meta.SessionName))
+		}
+		return failure(fmt.Errorf("load session: %w", err))
+	}
+
+	targets, err := ensembleResumeRestorePanes(ctx, state, toRun, projectDir)
+	if err != nil {
+		return failure(fmt.Errorf("restore panes: %w", err))
+	}
+
+	_, budget := resolveEnsembleBudget(state)
+	resumer := &ensemble.ModeResumer{
+		Checkpoints: ensemble.NewCheckpointManager(store, runID),
+		ContextHash: meta.ContextHash,
+		Timeout:     budget.TimeoutPerMode,
+		Reinject: func(a *ensemble.ModeAssignment) error {
+			target := targets[a.PaneName]
+			if target == "" {
+				target = a.PaneName
+			}
+			return ensembleRetryReinject(target, a)
+		},
+		Capture:    ensembleResumeCapture,
+		Sleep:      ensembleResumeSleep,
+		WebhookURL: ensembleWebhookURL(),
+	}
+	modes, resumeErr := resumer.Resume(ctx, state, toRun)
+	result.Modes = modes
+	for _, mode := range modes {
+		switch {
+		case mode.Outcome == ensemble.ResumeCompleted:
+			result.Completed++
+		case mode.Outcome == ensemble.ResumeTimedOut:
+			result.TimedOut++
+		case !mode.Restarted():
+			result.Failed++
+		}
+		if mode.Restarted() {
+			result.Resumed++
+		}
+	}
+	if result.Resumed > 0 {
+		state.Status = ensemble.EnsembleActive
+	}
+	if saveErr := ensemble.SaveSession(state.SessionName, state); saveErr != nil {
+		return failure(fmt.Errorf("save session: %w", saveErr))
+	}
+	if resumeErr != nil {
+		return failure(resumeErr)
 	}
 
+	result.Message = fmt.Sprintf("Resumed %d of %d modes (%d completed, %d timed out), %d already complete",
+		result.Resumed, len(toRun), result.Completed, result.TimedOut, skipped)
+	if result.Failed > 0 {
+		return failure(fmt.Errorf("%d of %d modes could not be restarted", result.Failed, len(toRun)))
+	}
 	return renderCheckpointResumeOutput(w, result, format, quiet)
 }
 
@@ -2946,8 +4769,19 @@ func renderCheckpointResumeOutput(w io.Writer, payload checkpointResumeOutput, f
 
 		fmt.Fprintf(w, "Ensemble Resume: %s\n", payload.RunID)
 		fmt.Fprintf(w, "  Session: %s\n", payload.Session)
-		fmt.Fprintf(w, "  Modes to run: %d\n", payload.Resumed)
+		if len(payload.Modes) == 0 {
+			fmt.Fprintf(w, "  Modes to run: %d\n", payload.Resumed)
+		} else {
+			fmt.Fprintf(w, "  Modes resumed: %d\n", payload.Resumed)
+		}
 		fmt.Fprintf(w, "  Already done: %d\n", payload.Skipped)
+		for _, mode := range payload.Modes {
+			line := fmt.Sprintf("    %-24s %s", mode.ModeID, mode.Outcome)
+			if mode.Error != "" {
+				line += ": " + mode.Error
+			}
+			fmt.Fprintln(w, line)
+		}
 		fmt.Fprintf(w, "  %s\n", payload.Message)
 		return nil
 	}
@@ -3257,6 +5091,239 @@ func renderCheckpointCleanOutput(w io.Writer, payload checkpointCleanOutput, for
 	}
 }
 
+type ensembleSetStrategyOutput struct {
+	GeneratedAt time.Time `json:"generated_at" yaml:"generated_at"`
+	Session     string    `json:"session" yaml:"session"`
+	OldStrategy string    `json:"old_strategy" yaml:"old_strategy"`
+	NewStrategy string    `json:"new_strategy" yaml:"new_strategy"`
+	Changed     bool      `json:"changed" yaml:"changed"`
+}
+
+func newEnsembleSetStrategyCmd() *cobra.Command {
+	format := "text"
+	cmd := &cobra.Command{
+		Use:   "set-strategy <session> <strategy>",
+		Short: "Change the stored synthesis strategy for an ensemble",
+		Long: `Update the synthesis strategy saved with an ensemble session.
+
+Subsequent 'ntm ensemble synthesize' runs use the stored strategy without
+needing --strategy each time. The strategy is validated against the known
+synthesis strategies; deprecated names are rejected with their replacement.`,
+		Example: `  ntm ensemble set-strategy my-session weighted
+  ntm ensemble set-strategy my-session consensus --format json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEnsembleSetStrategy(cmd.OutOrStdout(), args[0], args[1], format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text, json, yaml")
+	cmd.ValidArgsFunction = completeSessionArgs
+	return cmd
+}
+
+func runEnsembleSetStrategy(w io.Writer, session, strategyName, format string) error {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = "text"
+	}
+	if jsonOutput {
+		format = "json"
+	}
+	switch format {
+	case "json", "yaml", "yml", "text", "table":
+	default:
+		return fmt.Errorf("invalid format %q (expected text, json, yaml)", format)
+	}
+
+	strategy, err := ensemble.ValidateOrMigrateStrategy(strings.TrimSpace(strategyName))
+	if err != nil {
+		return err
+	}
+
+	state, err := ensemble.LoadSession(session)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("no ensemble state found for session '%s'", session)
+		}
+		return fmt.Errorf("load session: %w", err)
+	}
+
+	result := ensembleSetStrategyOutput{
+		GeneratedAt: output.Timestamp(),
+		Session:     session,
+		OldStrategy: state.SynthesisStrategy.String(),
+		NewStrategy: strategy.String(),
+		Changed:     state.SynthesisStrategy != strategy,
+	}
+	if result.Changed {
+		state.SynthesisStrategy = strategy
+		if err := ensemble.SaveSession(session, state); err != nil {
+			return fmt.Errorf("save session: %w", err)
+		}
+		slog.Default().Info("ensemble synthesis strategy changed",
+			"session", session,
+			"old", result.OldStrategy,
+			"new", result.NewStrategy,
+		)
+	}
+
+	switch format {
+	case "json":
+		return output.WriteJSON(w, result, true)
+	case "yaml", "yml":
+		return renderYAML(w, result)
+	default:
+		if !result.Changed {
+			fmt.Fprintf(w, "Synthesis strategy for '%s' is already %s\n", session, result.NewStrategy)
+			return nil
+		}
+		old := result.OldStrategy
+		if old == "" {
+			old = "(unset)"
+		}
+		fmt.Fprintf(w, "Synthesis strategy for '%s': %s -> %s\n", session, old, result.NewStrategy)
+	}
+	return nil
+}
+
+type ensemblePromptEntry struct {
+	ModeID    string `json:"mode_id" yaml:"mode_id"`
+	ModeCode  string `json:"mode_code,omitempty" yaml:"mode_code,omitempty"`
+	PaneName  string `json:"pane_name" yaml:"pane_name"`
+	AgentType string `json:"agent_type" yaml:"agent_type"`
+	Status    string `json:"status" yaml:"status"`
+	Prompt    string `json:"prompt" yaml:"prompt"`
+}
+
+type ensemblePromptsOutput struct {
+	GeneratedAt time.Time             `json:"generated_at" yaml:"generated_at"`
+	Session     string                `json:"session" yaml:"session"`
+	Question    string                `json:"question,omitempty" yaml:"question,omitempty"`
+	Prompts     []ensemblePromptEntry `json:"prompts" yaml:"prompts"`
+}
+
+func newEnsemblePromptsCmd() *cobra.Command {
+	var (
+		format string
+		mode   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "prompts [session]",
+		Short: "Show the exact prompt injected into each mode",
+		Long: `Print the final prompt each mode received at injection time: the rendered
+mode preamble, any additional context, and the question.
+
+Prompts are recorded in the ensemble state when modes are injected; sessions
+spawned before prompts were recorded show no prompt text.
+
+Formats:
+  --format=text (default)
+  --format=json
+  --format=yaml`,
+		Example: `  ntm ensemble prompts my-session
+  ntm ensemble prompts my-session --mode deductive
+  ntm ensemble prompts my-session --mode A1 --format json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			machineJSON := IsJSONOutput() || strings.EqualFold(strings.TrimSpace(format), "json")
+			session := ""
+			if len(args) > 0 {
+				session = args[0]
+			}
+			res, err := resolveEnsembleStateCommandSessionForOutput(session, cmd.OutOrStdout(), machineJSON)
+			if err != nil {
+				return err
+			}
+			if res.Session == "" {
+				return nil
+			}
+			res.ExplainIfInferredForOutput(os.Stderr, machineJSON)
+			return runEnsemblePrompts(cmd.OutOrStdout(), res.Session, mode, format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text, json, yaml")
+	cmd.Flags().StringVar(&mode, "mode", "", "Only show the prompt for this mode (ID or code)")
+	cmd.ValidArgsFunction = completeSessionArgs
+	return cmd
+}
+
+func runEnsemblePrompts(w io.Writer, session, modeRef, format string) error {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = "text"
+	}
+	if jsonOutput {
+		format = "json"
+	}
+
+	state, err := ensemble.LoadSession(session)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("no ensemble state found for session '%s'", session)
+		}
+		return fmt.Errorf("load session: %w", err)
+	}
+
+	catalog, _ := ensemble.GlobalCatalog()
+	modeRef = strings.TrimSpace(modeRef)
+	payload := ensemblePromptsOutput{
+		GeneratedAt: output.Timestamp(),
+		Session:     session,
+		Question:    state.Question,
+		Prompts:     make([]ensemblePromptEntry, 0, len(state.Assignments)),
+	}
+	for _, assignment := range state.Assignments {
+		entry := ensemblePromptEntry{
+			ModeID:    assignment.ModeID,
+			PaneName:  assignment.PaneName,
+			AgentType: assignment.AgentType,
+			Status:    assignment.Status.String(),
+			Prompt:    assignment.Prompt,
+		}
+		if catalog != nil {
+			if m := catalog.GetMode(assignment.ModeID); m != nil {
+				entry.ModeCode = m.Code
+			}
+		}
+		if modeRef != "" && !strings.EqualFold(entry.ModeID, modeRef) && !strings.EqualFold(entry.ModeCode, modeRef) {
+			continue
+		}
+		payload.Prompts = append(payload.Prompts, entry)
+	}
+	if modeRef != "" && len(payload.Prompts) == 0 {
+		return fmt.Errorf("mode %q not found in session '%s'", modeRef, session)
+	}
+
+	switch format {
+	case "json":
+		return output.WriteJSON(w, payload, true)
+	case "yaml", "yml":
+		return renderYAML(w, payload)
+	case "text", "table":
+		for i, entry := range payload.Prompts {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			label := entry.ModeID
+			if entry.ModeCode != "" {
+				label = fmt.Sprintf("%s (%s)", entry.ModeID, entry.ModeCode)
+			}
+			fmt.Fprintf(w, "=== %s — %s [%s] ===\n", label, entry.PaneName, entry.AgentType)
+			if entry.Prompt == "" {
+				fmt.Fprintln(w, "(prompt not recorded)")
+				continue
+			}
+			fmt.Fprintln(w, strings.TrimRight(entry.Prompt, "\n"))
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid format %q (expected text, json, yaml)", format)
+	}
+}
+
 type ensembleCacheStatsOutput struct {
 	GeneratedAt time.Time                     `json:"generated_at" yaml:"generated_at"`
 	ProjectDir  string                        `json:"project_dir" yaml:"project_dir"`
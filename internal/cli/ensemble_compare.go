@@ -1,7 +1,6 @@
 package cli
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -229,6 +228,7 @@ func buildCompareInput(runID, question string, modeIDs []string, outputs []ensem
 
 	return &ensemble.CompareInput{
 		RunID:           runID,
+		Question:        question,
 		ModeIDs:         modeIDs,
 		Outputs:         outputs,
 		Provenance:      provenance,
@@ -299,9 +299,7 @@ func writeCompareResult(w io.Writer, result *ensemble.ComparisonResult, opts com
 			Summary:     result.Summary,
 			Result:      result,
 		}
-		enc := json.NewEncoder(w)
-		enc.SetIndent("", "  ")
-		return enc.Encode(out)
+		return output.WriteJSON(w, out, true)
 
 	case "yaml":
 		out := compareOutput{
@@ -411,3 +409,430 @@ func writeCompareError(w io.Writer, runAID, runBID string, err error, format str
 		return err
 	}
 }
+
+// mergeRunsOptions holds CLI flags for ensemble merge-runs.
+type mergeRunsOptions struct {
+	Format   string
+	Strategy string
+	Verbose  bool
+}
+
+// mergeRunsOutput is the JSON/YAML output structure for merge-runs.
+type mergeRunsOutput struct {
+	Success     bool                        `json:"success" yaml:"success"`
+	GeneratedAt string                      `json:"generated_at" yaml:"generated_at"`
+	RunA        string                      `json:"run_a" yaml:"run_a"`
+	RunB        string                      `json:"run_b" yaml:"run_b"`
+	Question    string                      `json:"question,omitempty" yaml:"question,omitempty"`
+	Strategy    string                      `json:"strategy,omitempty" yaml:"strategy,omitempty"`
+	Sources     []ensemble.MergedModeSource `json:"sources,omitempty" yaml:"sources,omitempty"`
+	Synthesis   *ensemble.SynthesisResult   `json:"synthesis,omitempty" yaml:"synthesis,omitempty"`
+	Error       string                      `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+func newEnsembleMergeRunsCmd() *cobra.Command {
+	opts := mergeRunsOptions{
+		Format:   "markdown",
+		Strategy: string(ensemble.StrategyConsensus),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "merge-runs <run-a> <run-b>",
+		Short: "Synthesize the combined outputs of two ensemble runs",
+		Long: `Load the mode outputs of two ensemble runs (sessions or checkpoint runs),
+combine them, and synthesize over the union.
+
+Each mode contributes one output. When both runs produced the same mode, the
+output with higher confidence is kept (run A wins ties). The report lists
+which run each mode came from.
+
+Formats:
+  --format=markdown (default) - Source table followed by the synthesis report
+  --format=json               - Machine-readable JSON
+  --format=yaml               - YAML format`,
+		Example: `  ntm ensemble merge-runs run-monday run-tuesday
+  ntm ensemble merge-runs laptop-run server-run --strategy=dialectical --format=json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEnsembleMergeRuns(cmd.OutOrStdout(), args[0], args[1], opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Format, "format", "f", "markdown", "Output format: markdown, json, yaml")
+	cmd.Flags().StringVar(&opts.Strategy, "strategy", opts.Strategy, "Synthesis strategy for the merged outputs")
+	cmd.Flags().BoolVarP(&opts.Verbose, "verbose", "v", false, "Include verbose details in the synthesis report")
+	cmd.ValidArgsFunction = completeSessionArgs
+	return cmd
+}
+
+func runEnsembleMergeRuns(w io.Writer, runAID, runBID string, opts mergeRunsOptions) error {
+	format := strings.ToLower(strings.TrimSpace(opts.Format))
+	if format == "" {
+		format = "markdown"
+	}
+	if jsonOutput {
+		format = "json"
+	}
+	switch format {
+	case "markdown", "md", "text", "json", "yaml", "yml":
+	default:
+		return fmt.Errorf("invalid format %q (expected markdown, json, yaml)", format)
+	}
+	if format == "yml" {
+		format = "yaml"
+	}
+
+	fail := func(err error) error {
+		if format == "markdown" || format == "md" || format == "text" {
+			return err
+		}
+		return writeCompareError(w, runAID, runBID, err, format)
+	}
+
+	if strings.TrimSpace(runAID) == strings.TrimSpace(runBID) {
+		return fail(fmt.Errorf("merge-runs needs two different runs"))
+	}
+	strategy, err := ensemble.ValidateOrMigrateStrategy(strings.TrimSpace(opts.Strategy))
+	if err != nil {
+		return fail(err)
+	}
+
+	inputA, err := loadCompareInputForOutput(runAID, format == "json")
+	if err != nil {
+		return fail(fmt.Errorf("load run A (%s): %w", runAID, err))
+	}
+	inputB, err := loadCompareInputForOutput(runBID, format == "json")
+	if err != nil {
+		return fail(fmt.Errorf("load run B (%s): %w", runBID, err))
+	}
+
+	question := inputA.Question
+	if question == "" {
+		question = inputB.Question
+	} else if inputB.Question != "" && inputB.Question != question {
+		slog.Warn("merging ensemble runs with different questions; using run A's question",
+			"run_a", runAID,
+			"run_b", runBID,
+		)
+	}
+
+	merged, sources := ensemble.MergeRunOutputs(*inputA, *inputB)
+	if len(merged) == 0 {
+		return fail(fmt.Errorf("no mode outputs found in %s or %s", runAID, runBID))
+	}
+
+	collector := ensemble.NewOutputCollector(ensemble.DefaultOutputCollectorConfig())
+	for _, modeOutput := range merged {
+		if err := collector.Add(modeOutput); err != nil {
+			return fail(fmt.Errorf("add output %s: %w", modeOutput.ModeID, err))
+		}
+	}
+
+	synthConfig := ensemble.SynthesisConfig{
+		Strategy:      strategy,
+		MaxFindings:   20,
+		MinConfidence: 0.3,
+	}
+	synth, err := ensemble.NewSynthesizer(synthConfig)
+	if err != nil {
+		return fail(fmt.Errorf("create synthesizer: %w", err))
+	}
+	input, err := collector.BuildSynthesisInput(question, nil, synthConfig)
+	if err != nil {
+		return fail(fmt.Errorf("build synthesis input: %w", err))
+	}
+	result, err := synth.Synthesize(input)
+	if err != nil {
+		return fail(fmt.Errorf("synthesis failed: %w", err))
+	}
+
+	slog.Info("merged ensemble runs",
+		"run_a", runAID,
+		"run_b", runBID,
+		"modes", len(sources),
+		"findings", len(result.Findings),
+	)
+
+	switch format {
+	case "json", "yaml":
+		out := mergeRunsOutput{
+			Success:     true,
+			GeneratedAt: output.Timestamp().Format(time.RFC3339),
+			RunA:        runAID,
+			RunB:        runBID,
+			Question:    question,
+			Strategy:    strategy.String(),
+			Sources:     sources,
+			Synthesis:   result,
+		}
+		if format == "json" {
+			return output.WriteJSON(w, out, true)
+		}
+		return yaml.NewEncoder(w).Encode(out)
+	default:
+		fmt.Fprintf(w, "# Merged Runs: %s + %s\n\n", runAID, runBID)
+		fmt.Fprintf(w, "| Mode | Source | Confidence | Both Runs |\n")
+		fmt.Fprintf(w, "|------|--------|------------|-----------|\n")
+		for _, src := range sources {
+			both := ""
+			if src.Conflict {
+				both = "yes"
+			}
+			fmt.Fprintf(w, "| %s | %s | %.2f | %s |\n", src.ModeID, src.Run, float64(src.Confidence), both)
+		}
+		fmt.Fprintln(w)
+
+		formatter := ensemble.NewSynthesisFormatter(ensemble.FormatMarkdown)
+		formatter.Verbose = opts.Verbose
+		formatter.IncludeAudit = true
+		if err := formatter.FormatResult(w, result, input.AuditReport); err != nil {
+			return fmt.Errorf("format output: %w", err)
+		}
+		return nil
+	}
+}
+
+// diffContributionsOutput is the JSON/YAML output of ensemble diff-contributions.
+type diffContributionsOutput struct {
+	Success     bool                             `json:"success" yaml:"success"`
+	GeneratedAt string                           `json:"generated_at" yaml:"generated_at"`
+	RunA        string                           `json:"run_a" yaml:"run_a"`
+	RunB        string                           `json:"run_b" yaml:"run_b"`
+	Diff        *ensemble.ContributionReportDiff `json:"diff,omitempty" yaml:"diff,omitempty"`
+	Error       string                           `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+func newEnsembleDiffContributionsCmd() *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "diff-contributions <run-a> <run-b>",
+		Short: "Compare mode contribution reports of two runs",
+		Long: `Compute the contribution report of two runs of the same question (sessions or
+checkpoint runs) and report how run B differs from run A: total and deduped
+findings, overlap, diversity, and the unique findings of modes present in only
+one run.
+
+Run it with A as the baseline and B as the run with an extra mode to see
+whether that mode earns its cost.
+
+Formats:
+  --format=text (default) - Human-readable report
+  --format=json           - Machine-readable JSON
+  --format=yaml           - YAML format`,
+		Example: `  ntm ensemble diff-contributions baseline-run with-bayesian-run
+  ntm ensemble diff-contributions run-a run-b --format=json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEnsembleDiffContributions(cmd.OutOrStdout(), args[0], args[1], format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text, json, yaml")
+	cmd.ValidArgsFunction = completeSessionArgs
+	return cmd
+}
+
+func runEnsembleDiffContributions(w io.Writer, runAID, runBID, format string) error {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = "text"
+	}
+	if jsonOutput {
+		format = "json"
+	}
+	switch format {
+	case "text", "json", "yaml", "yml":
+	default:
+		return fmt.Errorf("invalid format %q (expected text, json, yaml)", format)
+	}
+	if format == "yml" {
+		format = "yaml"
+	}
+
+	inputA, err := loadCompareInputForOutput(runAID, format == "json")
+	if err != nil {
+		return writeCompareError(w, runAID, runBID, fmt.Errorf("load run A (%s): %w", runAID, err), format)
+	}
+	inputB, err := loadCompareInputForOutput(runBID, format == "json")
+	if err != nil {
+		return writeCompareError(w, runAID, runBID, fmt.Errorf("load run B (%s): %w", runBID, err), format)
+	}
+	for _, input := range []*ensemble.CompareInput{inputA, inputB} {
+		if input.Contributions == nil {
+			return writeCompareError(w, runAID, runBID, fmt.Errorf("run %s has no contribution report (no completed mode outputs)", input.RunID), format)
+		}
+	}
+	if inputA.Question != "" && inputB.Question != "" && inputA.Question != inputB.Question {
+		slog.Warn("diffing contributions of runs with different questions",
+			"run_a", runAID,
+			"run_b", runBID,
+		)
+	}
+
+	diff := ensemble.DiffContributionReports(inputA.Contributions, inputB.Contributions)
+
+	switch format {
+	case "json", "yaml":
+		out := diffContributionsOutput{
+			Success:     true,
+			GeneratedAt: output.Timestamp().Format(time.RFC3339),
+			RunA:        runAID,
+			RunB:        runBID,
+			Diff:        &diff,
+		}
+		if format == "json" {
+			return output.WriteJSON(w, out, true)
+		}
+		return yaml.NewEncoder(w).Encode(out)
+	default:
+		fmt.Fprintf(w, "Contribution diff: %s (A) → %s (B)\n\n", runAID, runBID)
+		table := output.NewTable(w, "METRIC", "A", "B", "DELTA")
+		table.AddRow("total findings", fmt.Sprintf("%d", diff.TotalFindingsA), fmt.Sprintf("%d", diff.TotalFindingsB), fmt.Sprintf("%+d", diff.TotalFindingsDelta))
+		table.AddRow("deduped findings", fmt.Sprintf("%d", diff.DedupedFindingsA), fmt.Sprintf("%d", diff.DedupedFindingsB), fmt.Sprintf("%+d", diff.DedupedDelta))
+		table.AddRow("overlap", fmt.Sprintf("%.1f%%", diff.OverlapRateA*100), fmt.Sprintf("%.1f%%", diff.OverlapRateB*100), fmt.Sprintf("%+.1f%%", diff.OverlapDelta*100))
+		table.AddRow("diversity", fmt.Sprintf("%.2f", diff.DiversityScoreA), fmt.Sprintf("%.2f", diff.DiversityScoreB), fmt.Sprintf("%+.2f", diff.DiversityDelta))
+		table.Render()
+
+		writeModes := func(title string, scores []ensemble.ContributionScore) {
+			if len(scores) == 0 {
+				return
+			}
+			fmt.Fprintf(w, "\n%s\n", title)
+			mt := output.NewTable(w, "MODE", "UNIQUE", "FINDINGS", "SCORE")
+			for _, s := range scores {
+				mt.AddRow(s.ModeID, fmt.Sprintf("%d", s.UniqueInsights), fmt.Sprintf("%d/%d", s.FindingsCount, s.OriginalFindings), fmt.Sprintf("%.1f", s.Score))
+			}
+			mt.Render()
+		}
+		writeModes("Modes only in B", diff.AddedModes)
+		writeModes("Modes only in A", diff.RemovedModes)
+		fmt.Fprintf(w, "\nMarginal unique findings: %+d\n", diff.MarginalUniqueFindings)
+		return nil
+	}
+}
+
+// diffRunsOutput is the JSON/YAML output of ensemble diff.
+type diffRunsOutput struct {
+	Success     bool              `json:"success" yaml:"success"`
+	GeneratedAt string            `json:"generated_at" yaml:"generated_at"`
+	RunA        string            `json:"run_a" yaml:"run_a"`
+	RunB        string            `json:"run_b" yaml:"run_b"`
+	Summary     string            `json:"summary" yaml:"summary"`
+	Diff        *ensemble.RunDiff `json:"diff,omitempty" yaml:"diff,omitempty"`
+	Error       string            `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+func newEnsembleDiffCmd() *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "diff <run-a> <run-b>",
+		Short: "Diff the synthesized findings of two checkpoint runs",
+		Long: `Load two completed checkpoint runs, merge each run's mode outputs into its
+synthesized findings, and report how run B differs from run A: modes added
+or removed, findings that appeared or disappeared, and the confidence delta
+of every finding present in both.
+
+Findings are matched across runs by normalized text similarity, the same
+measure used to deduplicate findings when outputs are merged, so reworded
+findings still line up. Use compare for an exact, per-mode finding diff.
+
+Formats:
+  --format=text (default) - Human-readable report
+  --format=json           - Machine-readable JSON
+  --format=yaml           - YAML format`,
+		Example: `  ntm ensemble diff run-20240101 run-20240102
+  ntm ensemble diff run-a run-b --format=json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEnsembleDiff(cmd.OutOrStdout(), args[0], args[1], format, loadCheckpointCompareInput)
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text, json, yaml")
+	return cmd
+}
+
+func runEnsembleDiff(w io.Writer, runAID, runBID, format string, load func(string) (*ensemble.CompareInput, error)) error {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = "text"
+	}
+	if jsonOutput {
+		format = "json"
+	}
+	switch format {
+	case "text", "json", "yaml", "yml":
+	default:
+		return fmt.Errorf("invalid format %q (expected text, json, yaml)", format)
+	}
+	if format == "yml" {
+		format = "yaml"
+	}
+
+	inputA, err := load(runAID)
+	if err != nil {
+		return writeCompareError(w, runAID, runBID, fmt.Errorf("load run A (%s): %w", runAID, err), format)
+	}
+	inputB, err := load(runBID)
+	if err != nil {
+		return writeCompareError(w, runAID, runBID, fmt.Errorf("load run B (%s): %w", runBID, err), format)
+	}
+
+	diff := ensemble.DiffRuns(*inputA, *inputB)
+
+	switch format {
+	case "json", "yaml":
+		out := diffRunsOutput{
+			Success:     true,
+			GeneratedAt: output.Timestamp().Format(time.RFC3339),
+			RunA:        runAID,
+			RunB:        runBID,
+			Summary:     diff.Summary(),
+			Diff:        &diff,
+		}
+		if format == "json" {
+			return output.WriteJSON(w, out, true)
+		}
+		return yaml.NewEncoder(w).Encode(out)
+	default:
+		fmt.Fprintf(w, "Run diff: %s (A) → %s (B)\n", runAID, runBID)
+		fmt.Fprintf(w, "%s\n", diff.Summary())
+
+		if diff.ModeDiff.AddedCount > 0 || diff.ModeDiff.RemovedCount > 0 {
+			fmt.Fprintf(w, "\nModes\n")
+			for _, m := range diff.ModeDiff.Added {
+				fmt.Fprintf(w, "  + %s\n", m)
+			}
+			for _, m := range diff.ModeDiff.Removed {
+				fmt.Fprintf(w, "  - %s\n", m)
+			}
+		}
+
+		if len(diff.Added) > 0 {
+			fmt.Fprintf(w, "\nFindings only in B\n")
+			for _, f := range diff.Added {
+				fmt.Fprintf(w, "  + %s (%.2f, %s)\n", f.Finding.Finding, float64(f.Finding.Confidence), strings.Join(f.SourceModes, ", "))
+			}
+		}
+		if len(diff.Removed) > 0 {
+			fmt.Fprintf(w, "\nFindings only in A\n")
+			for _, f := range diff.Removed {
+				fmt.Fprintf(w, "  - %s (%.2f, %s)\n", f.Finding.Finding, float64(f.Finding.Confidence), strings.Join(f.SourceModes, ", "))
+			}
+		}
+		if diff.ChangedCount > 0 {
+			fmt.Fprintf(w, "\nConfidence changes\n")
+			table := output.NewTable(w, "FINDING", "A", "B", "DELTA")
+			for _, m := range diff.Matched {
+				if !m.Changed() {
+					continue
+				}
+				table.AddRow(truncateWithEllipsis(m.TextB, 60), fmt.Sprintf("%.2f", float64(m.ConfidenceA)), fmt.Sprintf("%.2f", float64(m.ConfidenceB)), fmt.Sprintf("%+.2f", m.Delta))
+			}
+			table.Render()
+		}
+		return nil
+	}
+}
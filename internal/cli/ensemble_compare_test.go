@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/Dicklesworthstone/ntm/internal/config"
 	"github.com/Dicklesworthstone/ntm/internal/ensemble"
+	"github.com/Dicklesworthstone/ntm/internal/output"
 )
 
 type testError struct {
@@ -160,6 +162,20 @@ func TestWriteCompareResult_JSON(t *testing.T) {
 	t.Log("TEST: TestWriteCompareResult_JSON - assertion: JSON output is valid")
 }
 
+func TestWriteCompareResult_JSONHonorsCompactJSON(t *testing.T) {
+	output.SetCompactJSON(true)
+	t.Cleanup(func() { output.SetCompactJSON(false) })
+
+	result := &ensemble.ComparisonResult{RunA: "session-a", RunB: "session-b", GeneratedAt: time.Now()}
+	var buf bytes.Buffer
+	if err := writeCompareResult(&buf, result, compareOptions{}, "json"); err != nil {
+		t.Fatalf("writeCompareResult returned error: %v", err)
+	}
+	if lines := strings.Count(strings.TrimSpace(buf.String()), "\n"); lines != 0 {
+		t.Fatalf("--compact-json output spans %d extra lines: %s", lines, buf.String())
+	}
+}
+
 func TestWriteCompareResult_Text(t *testing.T) {
 	t.Log("TEST: TestWriteCompareResult_Text - starting")
 
@@ -349,6 +365,9 @@ func TestLoadCompareInput_LoadsCheckpointRunFromProjectRoot(t *testing.T) {
 	if input.RunID != meta.RunID {
 		t.Fatalf("RunID = %q, want %q", input.RunID, meta.RunID)
 	}
+	if input.Question != meta.Question {
+		t.Fatalf("Question = %q, want %q", input.Question, meta.Question)
+	}
 	if len(input.ModeIDs) != 1 || input.ModeIDs[0] != "mode-a" {
 		t.Fatalf("ModeIDs = %v, want [mode-a]", input.ModeIDs)
 	}
@@ -809,7 +828,7 @@ func TestRunEnsembleResumeUsesConfiguredRunProjectWhenCWDDiffers(t *testing.T) {
 	defer os.Chdir(oldWd)
 
 	var buf bytes.Buffer
-	if err := runEnsembleResume(&buf, meta.RunID, "json", false, true); err != nil {
+	if err := runEnsembleResume(t.Context(), &buf, meta.RunID, ensembleResumeOptions{Format: "json", SkipDone: true, DryRun: true}); err != nil {
 		t.Fatalf("runEnsembleResume() error = %v", err)
 	}
 
@@ -860,7 +879,7 @@ func TestRunEnsembleResumeSkipDoneFalseIncludesCompletedModes(t *testing.T) {
 	}
 
 	var buf bytes.Buffer
-	if err := runEnsembleResume(&buf, meta.RunID, "json", false, false); err != nil {
+	if err := runEnsembleResume(t.Context(), &buf, meta.RunID, ensembleResumeOptions{Format: "json", DryRun: true}); err != nil {
 		t.Fatalf("runEnsembleResume() error = %v", err)
 	}
 
@@ -879,6 +898,110 @@ func TestRunEnsembleResumeSkipDoneFalseIncludesCompletedModes(t *testing.T) {
 	}
 }
 
+func TestRunEnsembleResumeReinjectsPendingAndErroredModes(t *testing.T) {
+	isolateSessionAgentStorage(t)
+	ensemble.CloseDefaultStateStore()
+	t.Cleanup(ensemble.CloseDefaultStateStore)
+
+	projectDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(projectDir, ".ntm"), 0o755); err != nil {
+		t.Fatalf("mkdir .ntm: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(projectDir, ".ntm", "config.toml"), []byte(""), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	if err := os.Chdir(projectDir); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	defer os.Chdir(oldWd)
+
+	store, err := newEnsembleCheckpointStore()
+	if err != nil {
+		t.Fatalf("newEnsembleCheckpointStore() error = %v", err)
+	}
+	meta := ensemble.CheckpointMetadata{
+		RunID:        "resume-reinject-run",
+		SessionName:  "resume-reinject",
+		Question:     "Resume me",
+		Status:       ensemble.EnsembleActive,
+		CompletedIDs: []string{"mode-a"},
+		PendingIDs:   []string{"mode-b"},
+		ErrorIDs:     []string{"mode-c"},
+		TotalModes:   3,
+	}
+	if err := store.SaveMetadata(meta); err != nil {
+		t.Fatalf("SaveMetadata() error = %v", err)
+	}
+
+	state := &ensemble.EnsembleSession{
+		SessionName: meta.SessionName,
+		Question:    meta.Question,
+		Status:      ensemble.EnsembleError,
+		Assignments: []ensemble.ModeAssignment{
+			{ModeID: "mode-a", PaneName: "pane-a", AgentType: "cc", Status: ensemble.AssignmentDone, Prompt: "a"},
+			{ModeID: "mode-b", PaneName: "pane-b", AgentType: "cc", Status: ensemble.AssignmentActive, Prompt: "b"},
+			{ModeID: "mode-c", PaneName: "pane-c", AgentType: "cod", Status: ensemble.AssignmentError, Prompt: "c"},
+		},
+	}
+	if err := ensemble.SaveSession("", state); err != nil {
+		t.Fatalf("SaveSession error: %v", err)
+	}
+
+	oldRestore, oldReinject, oldCapture := ensembleResumeRestorePanes, ensembleRetryReinject, ensembleResumeCapture
+	t.Cleanup(func() {
+		ensembleResumeRestorePanes, ensembleRetryReinject, ensembleResumeCapture = oldRestore, oldReinject, oldCapture
+	})
+	var restored []string
+	ensembleResumeRestorePanes = func(_ context.Context, _ *ensemble.EnsembleSession, modeIDs []string, _ string) (map[string]string, error) {
+		restored = modeIDs
+		return map[string]string{"pane-b": "%2", "pane-c": "%3"}, nil
+	}
+	var targets []string
+	ensembleRetryReinject = func(target string, _ *ensemble.ModeAssignment) error {
+		targets = append(targets, target)
+		return nil
+	}
+	ensembleResumeCapture = func(_ *ensemble.EnsembleSession, modeID string) (ensemble.CapturedOutput, error) {
+		return ensemble.CapturedOutput{ModeID: modeID, Parsed: &ensemble.ModeOutput{Thesis: modeID}}, nil
+	}
+
+	var buf bytes.Buffer
+	if err := runEnsembleResume(t.Context(), &buf, meta.RunID, ensembleResumeOptions{Format: "json", SkipDone: true}); err != nil {
+		t.Fatalf("runEnsembleResume() error = %v", err)
+	}
+
+	var payload checkpointResumeOutput
+	if err := json.Unmarshal(buf.Bytes(), &payload); err != nil {
+		t.Fatalf("unmarshal resume output: %v", err)
+	}
+	if !payload.Success || payload.Resumed != 2 || payload.Completed != 2 || payload.Skipped != 1 {
+		t.Fatalf("payload = %+v, want 2 resumed and completed, 1 skipped", payload)
+	}
+	if strings.Join(restored, ",") != "mode-b,mode-c" {
+		t.Fatalf("restored panes for %v, want [mode-b mode-c]", restored)
+	}
+	if strings.Join(targets, ",") != "%2,%3" {
+		t.Fatalf("reinjected into %v, want [%%2 %%3]", targets)
+	}
+
+	updated, err := store.LoadMetadata(meta.RunID)
+	if err != nil {
+		t.Fatalf("LoadMetadata() error = %v", err)
+	}
+	if len(updated.CompletedIDs) != 3 || len(updated.PendingIDs) != 0 || len(updated.ErrorIDs) != 0 {
+		t.Fatalf("metadata = %+v, want all modes completed", updated)
+	}
+	saved, err := ensemble.LoadSession(meta.SessionName)
+	if err != nil {
+		t.Fatalf("LoadSession() error = %v", err)
+	}
+	if saved.Status != ensemble.EnsembleActive || saved.Assignments[2].Status != ensemble.AssignmentDone {
+		t.Fatalf("saved session = %+v, want active with mode-c done", saved)
+	}
+}
+
 func TestRunEnsembleResume_InvalidMetadataReturnsStructuredFailure(t *testing.T) {
 	projectDir := t.TempDir()
 	if err := os.MkdirAll(filepath.Join(projectDir, ".ntm", "ensemble-checkpoints", "resume-invalid-meta-run"), 0o755); err != nil {
@@ -898,7 +1021,7 @@ func TestRunEnsembleResume_InvalidMetadataReturnsStructuredFailure(t *testing.T)
 	defer os.Chdir(oldWd)
 
 	var buf bytes.Buffer
-	if err := runEnsembleResume(&buf, "resume-invalid-meta-run", "json", false, true); err != nil && !errors.Is(err, errJSONFailure) {
+	if err := runEnsembleResume(t.Context(), &buf, "resume-invalid-meta-run", ensembleResumeOptions{Format: "json", SkipDone: true}); err != nil && !errors.Is(err, errJSONFailure) {
 		t.Fatalf("runEnsembleResume() error = %v", err)
 	}
 
@@ -930,7 +1053,7 @@ func TestRunEnsembleResume_InvalidRunIDReturnsStructuredFailure(t *testing.T) {
 	defer os.Chdir(oldWd)
 
 	var buf bytes.Buffer
-	if err := runEnsembleResume(&buf, "../escape", "json", false, true); err != nil && !errors.Is(err, errJSONFailure) {
+	if err := runEnsembleResume(t.Context(), &buf, "../escape", ensembleResumeOptions{Format: "json", SkipDone: true}); err != nil && !errors.Is(err, errJSONFailure) {
 		t.Fatalf("runEnsembleResume() error = %v", err)
 	}
 
@@ -1994,3 +2117,68 @@ func TestCompareOutput_Struct(t *testing.T) {
 
 	t.Log("TEST: TestCompareOutput_Struct - assertion: compareOutput marshals correctly")
 }
+
+func TestRunEnsembleDiff(t *testing.T) {
+	runs := map[string]*ensemble.CompareInput{
+		"run-a": {
+			RunID:   "run-a",
+			ModeIDs: []string{"deductive"},
+			Outputs: []ensemble.ModeOutput{{ModeID: "deductive", Confidence: 0.8, TopFindings: []ensemble.Finding{
+				{Finding: "Retry loop ignores context cancellation", Impact: ensemble.ImpactMedium, Confidence: 0.5},
+			}}},
+		},
+		"run-b": {
+			RunID:   "run-b",
+			ModeIDs: []string{"deductive", "bayesian"},
+			Outputs: []ensemble.ModeOutput{
+				{ModeID: "deductive", Confidence: 0.8, TopFindings: []ensemble.Finding{
+					{Finding: "Retry loop ignores context cancellation", Impact: ensemble.ImpactMedium, Confidence: 0.8},
+				}},
+				{ModeID: "bayesian", Confidence: 0.8, TopFindings: []ensemble.Finding{
+					{Finding: "Config reload races with request handling", Impact: ensemble.ImpactHigh, Confidence: 0.7},
+				}},
+			},
+		},
+	}
+	load := func(id string) (*ensemble.CompareInput, error) {
+		if in, ok := runs[id]; ok {
+			return in, nil
+		}
+		return nil, errors.New("not found")
+	}
+
+	var buf bytes.Buffer
+	if err := runEnsembleDiff(&buf, "run-a", "run-b", "text", load); err != nil {
+		t.Fatalf("runEnsembleDiff text: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"1 added, 0 removed, 1 changed findings",
+		"+ bayesian",
+		"+ Config reload races with request handling",
+		"+0.30",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("text output missing %q:\n%s", want, out)
+		}
+	}
+
+	buf.Reset()
+	if err := runEnsembleDiff(&buf, "run-a", "run-b", "json", load); err != nil {
+		t.Fatalf("runEnsembleDiff json: %v", err)
+	}
+	var doc diffRunsOutput
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("decode json: %v\n%s", err, buf.String())
+	}
+	if !doc.Success || doc.Diff == nil || doc.Diff.AddedCount != 1 || doc.Diff.ChangedCount != 1 {
+		t.Fatalf("json output = %+v", doc)
+	}
+
+	if err := runEnsembleDiff(&buf, "run-a", "missing", "text", load); err == nil || !strings.Contains(err.Error(), "load run B") {
+		t.Fatalf("missing run error = %v", err)
+	}
+	if err := runEnsembleDiff(&buf, "run-a", "run-b", "xml", load); err == nil {
+		t.Fatal("expected invalid format error")
+	}
+}
@@ -0,0 +1,270 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Dicklesworthstone/ntm/internal/ensemble"
+	"github.com/Dicklesworthstone/ntm/internal/output"
+	"github.com/Dicklesworthstone/ntm/internal/swarm"
+	"github.com/Dicklesworthstone/ntm/internal/tmux"
+)
+
+type ensembleRetryRateLimitedOptions struct {
+	Format     string
+	MaxRetries int
+	DryRun     bool
+}
+
+type ensembleRetryRateLimitedOutput struct {
+	GeneratedAt time.Time                 `json:"generated_at" yaml:"generated_at"`
+	Session     string                    `json:"session" yaml:"session"`
+	MaxRetries  int                       `json:"max_retries" yaml:"max_retries"`
+	DryRun      bool                      `json:"dry_run,omitempty" yaml:"dry_run,omitempty"`
+	RateLimited int                       `json:"rate_limited" yaml:"rate_limited"`
+	Retried     int                       `json:"retried" yaml:"retried"`
+	Exhausted   int                       `json:"exhausted" yaml:"exhausted"`
+	Failed      int                       `json:"failed" yaml:"failed"`
+	Modes       []ensemble.RateLimitRetry `json:"modes" yaml:"modes"`
+}
+
+// ensembleRetrySleep (nil waits on a real timer) and ensembleRetryReinject
+// are replaced in tests.
+var (
+	ensembleRetrySleep    func(ctx context.Context, d time.Duration) error
+	ensembleRetryReinject = reinjectEnsembleModePrompt
+)
+
+func newEnsembleRetryRateLimitedCmd() *cobra.Command {
+	opts := ensembleRetryRateLimitedOptions{Format: "text", MaxRetries: -1}
+
+	cmd := &cobra.Command{
+		Use:   "retry-rate-limited [session]",
+		Short: "Re-inject modes whose agents hit rate-limit or quota errors",
+		Long: `Capture every mode's pane, detect rate-limit and quota errors in modes that
+have not produced output yet, and re-send their recorded prompt after an
+exponential backoff (30s doubling, capped at 10m, or longer when the agent
+says how long to wait).
+
+Each mode gets up to the preset budget's max_retries re-injections; after that
+it is marked as errored. Retry counts are kept in the ensemble state, and
+detection only looks at pane output written after a mode's last retry, so the
+command can be run repeatedly while a long ensemble is in progress.
+
+Retries are manual: nothing in ensemble spawn or status runs this command, so
+a rate-limited mode waits until you run it (for example under watch).
+
+Formats:
+  --format=text (default)
+  --format=json
+  --format=yaml`,
+		Example: `  ntm ensemble retry-rate-limited my-session
+  ntm ensemble retry-rate-limited my-session --dry-run
+  ntm ensemble retry-rate-limited my-session --max-retries 4 --format json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			machineJSON := IsJSONOutput() || strings.EqualFold(strings.TrimSpace(opts.Format), "json")
+			session := ""
+			if len(args) > 0 {
+				session = args[0]
+			}
+			res, err := resolveEnsembleStateCommandSessionForOutput(session, cmd.OutOrStdout(), machineJSON)
+			if err != nil {
+				return err
+			}
+			if res.Session == "" {
+				return nil
+			}
+			res.ExplainIfInferredForOutput(os.Stderr, machineJSON)
+			return runEnsembleRetryRateLimited(cmd.Context(), cmd.OutOrStdout(), res.Session, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Format, "format", "f", "text", "Output format: text, json, yaml")
+	cmd.Flags().IntVar(&opts.MaxRetries, "max-retries", -1, "Retries per mode (default: the preset budget's max_retries)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Report rate-limited modes without waiting or re-injecting")
+	cmd.ValidArgsFunction = completeSessionArgs
+	return cmd
+}
+
+func runEnsembleRetryRateLimited(ctx context.Context, w io.Writer, session string, opts ensembleRetryRateLimitedOptions) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	format := strings.ToLower(strings.TrimSpace(opts.Format))
+	if format == "" {
+		format = "text"
+	}
+	if jsonOutput {
+		format = "json"
+	}
+	switch format {
+	case "text", "json", "yaml", "yml":
+	default:
+		return fmt.Errorf("invalid format %q (expected text, json, yaml)", format)
+	}
+
+	state, sessionLive, err := loadEnsembleStateWithRuntimePresence(session)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("no ensemble state found for session '%s'", session)
+		}
+		return fmt.Errorf("load session: %w", err)
+	}
+	if !sessionLive {
+		return fmt.Errorf("session '%s' is not running; retry-rate-limited captures live panes", session)
+	}
+
+	_, budget := resolveEnsembleBudget(state)
+	policy := ensemble.DefaultRateLimitRetryPolicy(budget)
+	if opts.MaxRetries >= 0 {
+		policy.MaxRetries = opts.MaxRetries
+	}
+
+	capture := ensemble.NewOutputCapture(tmux.DefaultClient)
+	captured, err := capture.CaptureAll(state)
+	if err != nil {
+		slog.Default().Warn("ensemble retry-rate-limited capture incomplete",
+			"session", session,
+			"error", err,
+		)
+	}
+	return retryRateLimitedModes(ctx, w, state, captured, policy, opts.DryRun, format)
+}
+
+func retryRateLimitedModes(ctx context.Context, w io.Writer, state *ensemble.EnsembleSession, captured []ensemble.CapturedOutput, policy ensemble.RateLimitRetryPolicy, dryRun bool, format string) error {
+	limited := ensemble.DetectRateLimitedModes(state, captured)
+	payload := ensembleRetryRateLimitedOutput{
+		GeneratedAt: output.Timestamp(),
+		Session:     state.SessionName,
+		MaxRetries:  policy.MaxRetries,
+		DryRun:      dryRun,
+		RateLimited: len(limited),
+		Modes:       []ensemble.RateLimitRetry{},
+	}
+
+	if dryRun {
+		for _, mode := range limited {
+			a := state.Assignments[mode.AssignmentIndex]
+			entry := ensemble.RateLimitRetry{
+				ModeID:     a.ModeID,
+				PaneName:   a.PaneName,
+				AgentType:  a.AgentType,
+				Attempt:    a.RateLimitRetries,
+				MaxRetries: policy.MaxRetries,
+			}
+			if a.RateLimitRetries < policy.MaxRetries {
+				entry.Attempt++
+				entry.SetBackoff(policy.Backoff(a.RateLimitRetries, mode.Detection.WaitSeconds))
+			}
+			payload.Modes = append(payload.Modes, entry)
+		}
+		return renderEnsembleRetryRateLimited(w, payload, format)
+	}
+
+	if len(limited) > 0 {
+		targets := ensembleRetryPaneTargets(state.SessionName)
+		reinject := func(a *ensemble.ModeAssignment) error {
+			target := targets[a.PaneName]
+			if target == "" {
+				target = a.PaneName
+			}
+			return ensembleRetryReinject(target, a)
+		}
+		results, err := ensemble.RetryRateLimitedModes(ctx, state, limited, policy, reinject, ensembleRetrySleep)
+		payload.Modes = append(payload.Modes, results...)
+		if saveErr := ensemble.SaveSession(state.SessionName, state); saveErr != nil {
+			return fmt.Errorf("save session: %w", saveErr)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, mode := range payload.Modes {
+		switch mode.Outcome {
+		case ensemble.RateLimitRetried:
+			payload.Retried++
+		case ensemble.RateLimitExhausted:
+			payload.Exhausted++
+		default:
+			payload.Failed++
+		}
+	}
+	return renderEnsembleRetryRateLimited(w, payload, format)
+}
+
+func ensembleRetryPaneTargets(session string) map[string]string {
+	targets := make(map[string]string)
+	panes, err := tmux.GetPanes(session)
+	if err != nil {
+		slog.Default().Warn("ensemble retry-rate-limited pane lookup failed",
+			"session", session,
+			"error", err,
+		)
+		return targets
+	}
+	for _, pane := range panes {
+		if pane.Title != "" && pane.ID != "" {
+			targets[pane.Title] = pane.ID
+		}
+	}
+	return targets
+}
+
+func reinjectEnsembleModePrompt(target string, assignment *ensemble.ModeAssignment) error {
+	return swarm.NewPromptInjector().InjectPrompt(target, assignment.AgentType, assignment.Prompt)
+}
+
+func renderEnsembleRetryRateLimited(w io.Writer, payload ensembleRetryRateLimitedOutput, format string) error {
+	switch format {
+	case "json":
+		return output.WriteJSON(w, payload, true)
+	case "yaml", "yml":
+		return renderYAML(w, payload)
+	default:
+		fmt.Fprintf(w, "Session: %s\n", payload.Session)
+		if payload.RateLimited == 0 {
+			fmt.Fprintln(w, "No rate-limited modes detected")
+			return nil
+		}
+		if payload.DryRun {
+			fmt.Fprintf(w, "Rate-limited modes: %d (dry run, max retries %d)\n\n", payload.RateLimited, payload.MaxRetries)
+		} else {
+			fmt.Fprintf(w, "Rate-limited modes: %d — %d retried, %d exhausted, %d failed\n\n",
+				payload.RateLimited, payload.Retried, payload.Exhausted, payload.Failed)
+		}
+
+		table := output.NewTable(w, "MODE", "PANE", "ATTEMPT", "BACKOFF", "OUTCOME")
+		for _, mode := range payload.Modes {
+			backoff := "-"
+			if mode.Backoff > 0 {
+				backoff = mode.Backoff.String()
+			}
+			outcome := string(mode.Outcome)
+			if outcome == "" {
+				outcome = "pending"
+			}
+			if mode.Error != "" {
+				outcome += ": " + truncateWithEllipsis(mode.Error, 60)
+			}
+			table.AddRow(
+				mode.ModeID,
+				mode.PaneName,
+				fmt.Sprintf("%d/%d", mode.Attempt, mode.MaxRetries),
+				backoff,
+				outcome,
+			)
+		}
+		table.Render()
+		return nil
+	}
+}
@@ -35,27 +35,45 @@ type ensembleSpawnOptions struct {
 	BudgetPerMode    int
 	NoCache          bool
 	NoInject         bool
+	StrictBudget     bool
+	OverBudget       string
+	Seed             int64
 	Project          string
+	Format           string
 	DryRun           bool
 	ShowPreambles    bool
 	PreamblePreviewN int
 }
 
 type ensembleSpawnOutput struct {
-	Success     bool                  `json:"success"`
-	GeneratedAt time.Time             `json:"generated_at"`
-	Session     string                `json:"session"`
-	ProjectDir  string                `json:"project_dir"`
-	Question    string                `json:"question"`
-	Preset      string                `json:"preset,omitempty"`
-	Modes       []string              `json:"modes"`
-	Assignment  string                `json:"assignment"`
-	AgentMix    map[string]int        `json:"agent_mix,omitempty"`
-	Synthesis   string                `json:"synthesis"`
-	Budget      ensemble.BudgetConfig `json:"budget"`
-	Status      string                `json:"status"`
-	Injected    bool                  `json:"injected"`
-	Error       string                `json:"error,omitempty"`
+	Success        bool                  `json:"success"`
+	GeneratedAt    time.Time             `json:"generated_at"`
+	Session        string                `json:"session"`
+	ProjectDir     string                `json:"project_dir"`
+	Question       string                `json:"question"`
+	Preset         string                `json:"preset,omitempty"`
+	Modes          []string              `json:"modes"`
+	Assignment     string                `json:"assignment"`
+	AgentMix       map[string]int        `json:"agent_mix,omitempty"`
+	Synthesis      string                `json:"synthesis"`
+	Budget         ensemble.BudgetConfig `json:"budget"`
+	Assignments    []ensembleSpawnAssign `json:"assignments"`
+	StrictBudget   bool                  `json:"strict_budget,omitempty"`
+	OverBudget     string                `json:"over_budget,omitempty"`
+	Seed           int64                 `json:"seed,omitempty"`
+	BudgetSpent    int                   `json:"budget_spent,omitempty"`
+	BudgetEstimate int                   `json:"budget_estimate,omitempty"`
+	BudgetCutoff   int                   `json:"budget_cutoff,omitempty"`
+	Status         string                `json:"status"`
+	Injected       bool                  `json:"injected"`
+	Error          string                `json:"error,omitempty"`
+}
+
+type ensembleSpawnAssign struct {
+	ModeID    string `json:"mode_id"`
+	AgentType string `json:"agent_type"`
+	PaneName  string `json:"pane_name"`
+	Status    string `json:"status"`
 }
 
 func newEnsembleSpawnCmd() *cobra.Command {
@@ -102,7 +120,11 @@ func bindEnsembleSharedFlags(cmd *cobra.Command, opts *ensembleSpawnOptions) {
 	cmd.Flags().IntVar(&opts.BudgetPerMode, "budget-per-agent", 0, "Override per-agent token cap")
 	cmd.Flags().BoolVar(&opts.NoCache, "no-cache", false, "Bypass context pack cache")
 	cmd.Flags().BoolVar(&opts.NoInject, "no-inject", false, "Create session without injecting prompts")
+	cmd.Flags().BoolVar(&opts.StrictBudget, "strict-budget", false, "Skip remaining modes once measured output tokens approach the total budget (re-measured by ensemble status)")
+	cmd.Flags().StringVar(&opts.OverBudget, "over-budget", string(ensemble.OverBudgetSkip), "When estimated tokens exceed the total budget: allow, skip (remaining modes), or error")
+	cmd.Flags().Int64Var(&opts.Seed, "seed", 0, "Shuffle mode-to-pane assignment with this seed (reproducible; 0 = no shuffle)")
 	cmd.Flags().StringVar(&opts.Project, "project", "", "Project directory (default: current dir)")
+	cmd.Flags().StringVarP(&opts.Format, "format", "f", "text", "Output format: text, json")
 }
 
 func applyEnsembleConfigDefaults(cmd *cobra.Command, opts *ensembleSpawnOptions) {
@@ -173,6 +195,10 @@ func applyEnsembleConfigOverrides(target *ensemble.EnsembleConfig, ensCfg config
 		target.Budget.ContextReserveTokens = ensCfg.Budget.ContextPack
 	}
 
+	if target.WebhookURL == "" && strings.TrimSpace(ensCfg.WebhookURL) != "" {
+		target.WebhookURL = strings.TrimSpace(ensCfg.WebhookURL)
+	}
+
 	target.Cache.Enabled = ensCfg.Cache.Enabled
 	if ensCfg.Cache.TTLMinutes > 0 {
 		target.Cache.TTL = time.Duration(ensCfg.Cache.TTLMinutes) * time.Minute
@@ -198,13 +224,20 @@ func applyEnsembleConfigOverrides(target *ensemble.EnsembleConfig, ensCfg config
 }
 
 func runEnsembleSpawn(cmd *cobra.Command, opts ensembleSpawnOptions) error {
+	machineJSON := IsJSONOutput() || strings.EqualFold(strings.TrimSpace(opts.Format), "json")
 	outputError := func(err error) error {
-		if IsJSONOutput() {
+		if machineJSON {
 			return emitJSONFailureEnvelopeWithCause(output.NewError(err.Error()), err)
 		}
 		return err
 	}
 
+	switch strings.ToLower(strings.TrimSpace(opts.Format)) {
+	case "", "text", "json":
+	default:
+		return outputError(fmt.Errorf("invalid format %q (expected text, json)", opts.Format))
+	}
+
 	applyEnsembleConfigDefaults(cmd, &opts)
 
 	if err := tmux.EnsureInstalled(); err != nil {
@@ -249,6 +282,10 @@ func runEnsembleSpawn(cmd *cobra.Command, opts ensembleSpawnOptions) error {
 	if opts.BudgetPerMode < 0 || opts.BudgetTotal < 0 {
 		return outputError(fmt.Errorf("budget overrides must be non-negative"))
 	}
+	overBudget, err := ensemble.ParseOverBudgetPolicy(opts.OverBudget)
+	if err != nil {
+		return outputError(err)
+	}
 
 	projectDir, err := resolveEnsembleProjectDir(opts.Project)
 	if err != nil {
@@ -281,6 +318,9 @@ func runEnsembleSpawn(cmd *cobra.Command, opts ensembleSpawnOptions) error {
 		AgentMix:      agentMix,
 		Assignment:    assignment,
 		SkipInject:    opts.NoInject,
+		StrictBudget:  opts.StrictBudget,
+		OverBudget:    overBudget,
+		Seed:          opts.Seed,
 	}
 
 	ensDefaults := config.Default().Ensemble
@@ -318,9 +358,11 @@ func runEnsembleSpawn(cmd *cobra.Command, opts ensembleSpawnOptions) error {
 	if err != nil {
 		out.Success = false
 		out.Error = err.Error()
+	} else {
+		recordProficiencyAction((*config.ProficiencyConfig).IncrementEnsemble)
 	}
 
-	if IsJSONOutput() {
+	if machineJSON {
 		if !out.Success {
 			return emitJSONFailureEnvelopeWithCause(out, err)
 		}
@@ -363,14 +405,18 @@ func buildEnsembleManager(projectDir string) (*ensemble.EnsembleManager, error)
 
 func buildEnsembleSpawnOutput(state *ensemble.EnsembleSession, cfg *ensemble.EnsembleConfig, registry *ensemble.EnsembleRegistry) ensembleSpawnOutput {
 	out := ensembleSpawnOutput{
-		GeneratedAt: output.Timestamp(),
-		Modes:       []string{},
-		Injected:    !cfg.SkipInject,
-		ProjectDir:  cfg.ProjectDir,
-		Question:    cfg.Question,
-		Assignment:  cfg.Assignment,
-		AgentMix:    cfg.AgentMix,
-		Budget:      resolveEnsembleSpawnBudget(cfg, registry),
+		GeneratedAt:  output.Timestamp(),
+		Modes:        []string{},
+		Assignments:  []ensembleSpawnAssign{},
+		Injected:     !cfg.SkipInject,
+		ProjectDir:   cfg.ProjectDir,
+		Question:     cfg.Question,
+		Assignment:   cfg.Assignment,
+		AgentMix:     cfg.AgentMix,
+		Budget:       resolveEnsembleSpawnBudget(cfg, registry),
+		StrictBudget: cfg.StrictBudget,
+		OverBudget:   string(cfg.OverBudget),
+		Seed:         cfg.Seed,
 	}
 
 	if state == nil {
@@ -384,6 +430,17 @@ func buildEnsembleSpawnOutput(state *ensemble.EnsembleSession, cfg *ensemble.Ens
 	out.Status = state.Status.String()
 	out.Synthesis = state.SynthesisStrategy.String()
 	out.Modes = modesFromAssignments(state.Assignments)
+	for _, a := range state.Assignments {
+		out.Assignments = append(out.Assignments, ensembleSpawnAssign{
+			ModeID:    a.ModeID,
+			AgentType: a.AgentType,
+			PaneName:  a.PaneName,
+			Status:    a.Status.String(),
+		})
+	}
+	out.BudgetSpent = state.BudgetSpent
+	out.BudgetEstimate = state.BudgetEstimate
+	out.BudgetCutoff = state.BudgetCutoff
 	if out.Preset == "" {
 		out.Preset = cfg.Ensemble
 	}
@@ -433,12 +490,21 @@ func renderEnsembleSpawnText(w io.Writer, out ensembleSpawnOutput) error {
 	if out.Assignment != "" {
 		_, _ = fmt.Fprintf(w, "Assignment: %s\n", out.Assignment)
 	}
+	if out.Seed != 0 {
+		_, _ = fmt.Fprintf(w, "Seed: %d\n", out.Seed)
+	}
 	if out.Synthesis != "" {
 		_, _ = fmt.Fprintf(w, "Synthesis: %s\n", out.Synthesis)
 	}
 	if out.Budget.MaxTokensPerMode > 0 || out.Budget.MaxTotalTokens > 0 {
 		_, _ = fmt.Fprintf(w, "Budget: per-mode=%d total=%d\n", out.Budget.MaxTokensPerMode, out.Budget.MaxTotalTokens)
 	}
+	if out.StrictBudget {
+		_, _ = fmt.Fprintf(w, "Budget spent: %d / %d tokens\n", out.BudgetSpent, out.Budget.MaxTotalTokens)
+	}
+	if out.BudgetCutoff > 0 {
+		_, _ = fmt.Fprintf(w, "Budget cutoff: ~%d / %d estimated tokens injected; remaining modes skipped\n", out.BudgetEstimate, out.BudgetCutoff)
+	}
 	if out.Status != "" {
 		_, _ = fmt.Fprintf(w, "Stage: %s\n", out.Status)
 	}
@@ -538,6 +604,7 @@ type ensembleDryRunOutput struct {
 	ProjectDir  string                   `json:"project_dir"`
 	Question    string                   `json:"question"`
 	Preset      string                   `json:"preset,omitempty"`
+	Seed        int64                    `json:"seed,omitempty"`
 	Modes       []ensembleDryRunMode     `json:"modes"`
 	Assignments []ensembleDryRunAssign   `json:"assignments"`
 	Budget      ensembleDryRunBudget     `json:"budget"`
@@ -595,8 +662,9 @@ type ensembleDryRunPreamble struct {
 }
 
 func runEnsembleDryRun(cmd *cobra.Command, opts ensembleSpawnOptions, manager *ensemble.EnsembleManager, agentMix map[string]int, projectDir string) error {
+	machineJSON := IsJSONOutput() || strings.EqualFold(strings.TrimSpace(opts.Format), "json")
 	outputError := func(err error) error {
-		if IsJSONOutput() {
+		if machineJSON {
 			return emitJSONFailureEnvelopeWithCause(ensembleDryRunOutput{
 				Success:     false,
 				DryRun:      true,
@@ -617,6 +685,7 @@ func runEnsembleDryRun(cmd *cobra.Command, opts ensembleSpawnOptions, manager *e
 		ProjectDir:    projectDir,
 		AgentMix:      agentMix,
 		Assignment:    opts.Assignment,
+		Seed:          opts.Seed,
 	}
 
 	// Apply config defaults
@@ -658,7 +727,7 @@ func runEnsembleDryRun(cmd *cobra.Command, opts ensembleSpawnOptions, manager *e
 
 	out := convertDryRunPlanToOutput(plan, projectDir)
 
-	if IsJSONOutput() {
+	if machineJSON {
 		if !out.Success {
 			validationErr := fmt.Errorf("ensemble dry-run validation failed")
 			if len(out.Validation.Errors) > 0 {
@@ -681,6 +750,7 @@ func convertDryRunPlanToOutput(plan *ensemble.DryRunPlan, projectDir string) ens
 		ProjectDir:  projectDir,
 		Question:    plan.Question,
 		Preset:      plan.PresetUsed,
+		Seed:        plan.Seed,
 		Modes:       make([]ensembleDryRunMode, 0, len(plan.Modes)),
 		Assignments: make([]ensembleDryRunAssign, 0, len(plan.Assignments)),
 		Budget: ensembleDryRunBudget{
@@ -748,6 +818,9 @@ func renderEnsembleDryRunText(w io.Writer, out ensembleDryRunOutput) error {
 	}
 	_, _ = fmt.Fprintf(w, "Question:   %s\n", out.Question)
 	_, _ = fmt.Fprintf(w, "Project:    %s\n", out.ProjectDir)
+	if out.Seed != 0 {
+		_, _ = fmt.Fprintf(w, "Seed:       %d\n", out.Seed)
+	}
 	_, _ = fmt.Fprintln(w)
 
 	// Budget summary
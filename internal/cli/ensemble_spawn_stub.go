@@ -36,6 +36,7 @@ type ensembleSpawnOptions struct {
 	NoCache       bool
 	NoInject      bool
 	Project       string
+	Format        string
 }
 
 func newEnsembleSpawnCmd() *cobra.Command {
@@ -55,6 +56,7 @@ This command is experimental and requires building with -tags ensemble_experimen
 
 func bindEnsembleSharedFlags(cmd *cobra.Command, opts *ensembleSpawnOptions) {
 	cmd.Flags().StringVar(&opts.Project, "project", "", "Project directory (default: current dir)")
+	cmd.Flags().StringVarP(&opts.Format, "format", "f", "text", "Output format: text, json")
 }
 
 func runEnsembleSpawn(cmd *cobra.Command, opts ensembleSpawnOptions) error {
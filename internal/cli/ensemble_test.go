@@ -3,11 +3,18 @@ package cli
 import (
 	"bytes"
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/Dicklesworthstone/ntm/internal/ensemble"
+	"github.com/Dicklesworthstone/ntm/internal/tmux"
 )
 
 func TestBuildEnsembleAssignmentsCounts(t *testing.T) {
@@ -43,6 +50,32 @@ func TestBuildEnsembleAssignmentsCounts(t *testing.T) {
 	}
 }
 
+func TestBuildEnsembleAssignmentsOmitsUnsetAssignedAt(t *testing.T) {
+	assigned := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	state := &ensemble.EnsembleSession{
+		Assignments: []ensemble.ModeAssignment{
+			{ModeID: "m1", AgentType: "cc", Status: ensemble.AssignmentPending},
+			{ModeID: "m2", AgentType: "cod", Status: ensemble.AssignmentActive, AssignedAt: assigned},
+		},
+	}
+
+	rows, _ := buildEnsembleAssignments(state, nil, 0)
+	data, err := json.Marshal(rows)
+	if err != nil {
+		t.Fatalf("marshal rows: %v", err)
+	}
+	var decoded []map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal rows: %v", err)
+	}
+	if _, ok := decoded[0]["assigned_at"]; ok {
+		t.Errorf("unassigned row has assigned_at: %s", data)
+	}
+	if got := decoded[1]["assigned_at"]; got != "2026-01-02T03:04:05Z" {
+		t.Errorf("assigned row assigned_at = %v, want 2026-01-02T03:04:05Z", got)
+	}
+}
+
 func TestMergeBudgetDefaults(t *testing.T) {
 	defaults := ensemble.BudgetConfig{
 		MaxTokensPerMode: 4000,
@@ -80,7 +113,7 @@ func TestRenderEnsembleStatusNoSession(t *testing.T) {
 	err := renderEnsembleStatus(&buf, ensembleStatusOutput{
 		Session: "demo",
 		Exists:  false,
-	}, "table")
+	}, "table", nil)
 	if err != nil {
 		t.Fatalf("renderEnsembleStatus error: %v", err)
 	}
@@ -89,6 +122,452 @@ func TestRenderEnsembleStatusNoSession(t *testing.T) {
 	}
 }
 
+func TestValidateSynthesizeOptionsOutputDir(t *testing.T) {
+	if err := validateSynthesizeOptions(synthesizeOptions{Output: "out.md", OutputDir: "dist"}); err == nil {
+		t.Fatal("--output with --output-dir should be rejected")
+	}
+	if err := validateSynthesizeOptions(synthesizeOptions{Formats: []string{"json"}}); err == nil {
+		t.Fatal("--formats without --output-dir should be rejected")
+	}
+	if err := validateSynthesizeOptions(synthesizeOptions{OutputDir: "dist", Formats: []string{"json", "toml"}}); err == nil {
+		t.Fatal("unknown format in --formats should be rejected")
+	}
+	if err := validateSynthesizeOptions(synthesizeOptions{OutputDir: "dist", Formats: []string{"markdown", "json", "yaml"}}); err != nil {
+		t.Fatalf("valid --output-dir options rejected: %v", err)
+	}
+}
+
+func TestValidateSynthesizeOptionsStrategy(t *testing.T) {
+	if err := validateSynthesizeOptions(synthesizeOptions{Strategy: "debate"}); err != nil {
+		t.Fatalf("--strategy debate rejected: %v", err)
+	}
+	if err := validateSynthesizeOptions(synthesizeOptions{Strategy: "weighted"}); err == nil || !strings.Contains(err.Error(), "deprecated") {
+		t.Fatalf("--strategy weighted error = %v, want deprecation", err)
+	}
+}
+
+func TestWriteSynthesisFilesRendersEachFormatOnce(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "release")
+	var rendered []ensemble.OutputFormat
+	render := func(w io.Writer, format ensemble.OutputFormat) error {
+		rendered = append(rendered, format)
+		_, err := io.WriteString(w, string(format)+"\n")
+		return err
+	}
+
+	files, err := writeSynthesisFiles(dir, []string{"markdown", "json", "yaml", "yml"}, render)
+	if err != nil {
+		t.Fatalf("writeSynthesisFiles: %v", err)
+	}
+	if len(files) != 3 || len(rendered) != 3 {
+		t.Fatalf("files = %v, rendered = %v; want 3 each", files, rendered)
+	}
+	for name, want := range map[string]string{"synthesis.md": "markdown", "synthesis.json": "json", "synthesis.yaml": "yaml"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		if strings.TrimSpace(string(data)) != want {
+			t.Fatalf("%s = %q, want %q", name, data, want)
+		}
+	}
+}
+
+func TestWriteSynthesisFilesReportsPartialFailure(t *testing.T) {
+	dir := t.TempDir()
+	// A directory in place of synthesis.json makes that one write fail.
+	if err := os.Mkdir(filepath.Join(dir, "synthesis.json"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	render := func(w io.Writer, format ensemble.OutputFormat) error {
+		_, err := io.WriteString(w, string(format))
+		return err
+	}
+
+	files, err := writeSynthesisFiles(dir, []string{"markdown", "json"}, render)
+	if err == nil {
+		t.Fatal("expected an error for the failed json write")
+	}
+	mdPath := filepath.Join(dir, "synthesis.md")
+	if len(files) != 1 || files[0] != mdPath {
+		t.Fatalf("files = %v, want only %s", files, mdPath)
+	}
+	if !strings.Contains(err.Error(), "synthesis.json") || !strings.Contains(err.Error(), "written: "+mdPath) {
+		t.Fatalf("error = %v, want failed and written files named", err)
+	}
+}
+
+func TestRenderEnsembleStatusCSV(t *testing.T) {
+	payload := ensembleStatusOutput{
+		Session: "demo",
+		Exists:  true,
+		Assignments: []ensembleAssignmentRow{
+			{ModeID: "deductive", ModeCode: "A1", AgentType: "cc", Status: "done", TokenEstimate: 4000, PaneName: "demo__cc_1"},
+			{ModeID: "odd,mode", AgentType: "cod", Status: "active", TokenEstimate: 4000, PaneName: "pane\nname"},
+		},
+		Contributions: &ensemble.ContributionReport{
+			Scores: []ensemble.ContributionScore{{ModeID: "deductive", ModeName: "Deductive", Score: 72.5, Rank: 1}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := renderEnsembleStatus(&buf, payload, "csv", nil); err != nil {
+		t.Fatalf("renderEnsembleStatus csv: %v", err)
+	}
+	sections := strings.SplitN(buf.String(), "\n\n", 2)
+	if len(sections) != 2 {
+		t.Fatalf("want assignments and contributions sections, got:\n%s", buf.String())
+	}
+
+	records, err := csv.NewReader(strings.NewReader(sections[0])).ReadAll()
+	if err != nil {
+		t.Fatalf("parse assignments csv: %v", err)
+	}
+	if got := strings.Join(records[0], ","); got != "mode_id,mode_code,agent_type,status,token_estimate,pane_name" {
+		t.Fatalf("header = %q", got)
+	}
+	if len(records) != 3 || records[2][0] != "odd,mode" || records[2][5] != "pane\nname" {
+		t.Fatalf("records = %q", records)
+	}
+	if !strings.HasPrefix(sections[1], "rank,mode_id,mode_name,score") {
+		t.Fatalf("contributions section = %q", sections[1])
+	}
+
+	buf.Reset()
+	payload.Contributions = nil
+	if err := renderEnsembleStatus(&buf, payload, "csv", nil); err != nil {
+		t.Fatalf("renderEnsembleStatus csv: %v", err)
+	}
+	if strings.Contains(buf.String(), "\n\n") || strings.Contains(buf.String(), "rank,") {
+		t.Fatalf("csv without contributions should have one section:\n%s", buf.String())
+	}
+
+	err = runEnsembleStatus(&buf, "demo", ensembleStatusOptions{Format: "xml"})
+	if err == nil || !strings.Contains(err.Error(), `invalid format "xml"`) {
+		t.Fatalf("runEnsembleStatus xml error = %v, want invalid format", err)
+	}
+}
+
+func TestSortEnsembleAssignments(t *testing.T) {
+	base := []ensembleAssignmentRow{
+		{ModeID: "gamma", AgentType: "cc", Status: "done", TokenEstimate: 300},
+		{ModeID: "alpha", AgentType: "cod", Status: "pending", TokenEstimate: 100},
+		{ModeID: "delta", AgentType: "cc", Status: "error", TokenEstimate: 300},
+		{ModeID: "beta", AgentType: "gmi", Status: "active", TokenEstimate: 200},
+	}
+	tests := []struct {
+		spec string
+		want string
+	}{
+		{"", "gamma,alpha,delta,beta"},
+		{"mode", "alpha,beta,delta,gamma"},
+		{"-mode", "gamma,delta,beta,alpha"},
+		{"status", "alpha,beta,gamma,delta"},
+		{"tokens", "alpha,beta,delta,gamma"},
+		{"-tokens", "delta,gamma,beta,alpha"},
+		{"agent", "delta,gamma,alpha,beta"},
+	}
+	for _, tt := range tests {
+		key, desc, err := parseEnsembleAssignmentSort(tt.spec)
+		if err != nil {
+			t.Fatalf("parseEnsembleAssignmentSort(%q) error: %v", tt.spec, err)
+		}
+		rows := slices.Clone(base)
+		sortEnsembleAssignments(rows, key, desc)
+		var got []string
+		for _, r := range rows {
+			got = append(got, r.ModeID)
+		}
+		if strings.Join(got, ",") != tt.want {
+			t.Errorf("--sort=%q order = %v, want %s", tt.spec, got, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"pane", "-", "--tokens"} {
+		if _, _, err := parseEnsembleAssignmentSort(bad); err == nil {
+			t.Errorf("parseEnsembleAssignmentSort(%q) = nil error, want invalid", bad)
+		}
+	}
+}
+
+func TestEnsembleStatusTreeGroupsByCategoryAndTier(t *testing.T) {
+	rows := []ensembleAssignmentRow{
+		{ModeID: "game-theory", Category: "Strategic", Tier: "core", Status: "active", PaneName: "demo__cc_2"},
+		{ModeID: "deductive", ModeCode: "A1", Category: "Formal", Tier: "core", Status: "done", PaneName: "demo__cc_1"},
+		{ModeID: "custom", Status: "pending"},
+		{ModeID: "modal-logic", Category: "Formal", Tier: "advanced", Status: "error"},
+		{ModeID: "proof", Category: "Formal", Tier: "core", Status: "done"},
+	}
+
+	groups := buildEnsembleStatusGroups(rows)
+	var labels []string
+	for _, g := range groups {
+		labels = append(labels, g.Category+"/"+g.Tier)
+	}
+	want := []string{"Formal/core", "Formal/advanced", "Strategic/core", "uncategorized/"}
+	if strings.Join(labels, ",") != strings.Join(want, ",") {
+		t.Fatalf("group order = %v, want %v", labels, want)
+	}
+	if groups[0].Total != 2 || groups[0].Done != 2 || strings.Join(groups[0].Modes, ",") != "deductive,proof" {
+		t.Fatalf("Formal/core group = %+v", groups[0])
+	}
+	if groups[1].Error != 1 {
+		t.Fatalf("Formal/advanced group = %+v", groups[1])
+	}
+
+	var buf bytes.Buffer
+	err := renderEnsembleStatus(&buf, ensembleStatusOutput{
+		Session:     "demo",
+		Exists:      true,
+		Assignments: rows,
+		Groups:      groups,
+	}, "table", nil)
+	if err != nil {
+		t.Fatalf("renderEnsembleStatus error: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{"Formal / core  (2/2 done)", "Formal / advanced  (0/1 done, 1 error)", "├─ deductive", "└─ proof", "uncategorized  (0/1 done)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("tree output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "TOKENS") {
+		t.Errorf("tree view should replace the flat table, got:\n%s", out)
+	}
+}
+
+func TestBuildEnsembleAgentRowsFlagsDeadAndIdlePanes(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	rows := []ensembleAssignmentRow{
+		{ModeID: "deductive", AgentType: "cc", PaneName: "demo__cc_1", Status: "active"},
+		{ModeID: "inductive", AgentType: "cod", PaneName: "demo__cod_1", Status: "active"},
+		{ModeID: "abductive", AgentType: "gmi", PaneName: "demo__gmi_1", Status: "active"},
+		{ModeID: "bayesian", AgentType: "cc", PaneName: "demo__cc_2", Status: "done"},
+	}
+	panes := []tmux.PaneActivity{
+		{Pane: tmux.Pane{ID: "%1", Title: "demo__cc_1"}, LastActivity: now.Add(-30 * time.Second)},
+		{Pane: tmux.Pane{ID: "%2", Title: "demo__cod_1"}, LastActivity: now.Add(-10 * time.Minute)},
+		{Pane: tmux.Pane{ID: "%4", Title: "demo__cc_2"}, LastActivity: now.Add(-time.Hour)},
+	}
+
+	agents := buildEnsembleAgentRows(rows, panes, now)
+	if len(agents) != 4 {
+		t.Fatalf("got %d agent rows, want 4", len(agents))
+	}
+	if a := agents[0]; a.PaneState != "alive" || a.PaneID != "%1" || a.Mismatch != "" || a.LastActivity == nil {
+		t.Errorf("live pane row = %+v", a)
+	}
+	if a := agents[1]; a.PaneState != "idle" || a.Mismatch != "active but pane is idle" {
+		t.Errorf("idle pane row = %+v", a)
+	}
+	if a := agents[2]; a.PaneState != "dead" || a.Mismatch != "active but pane is dead" || a.LastActivity != nil {
+		t.Errorf("dead pane row = %+v", a)
+	}
+	if a := agents[3]; a.PaneState != "idle" || a.Mismatch != "" {
+		t.Errorf("finished mode with idle pane should not be flagged, got %+v", a)
+	}
+
+	var buf bytes.Buffer
+	err := renderEnsembleStatus(&buf, ensembleStatusOutput{
+		Session:     "demo",
+		Exists:      true,
+		Assignments: rows,
+		Agents:      agents,
+	}, "table", nil)
+	if err != nil {
+		t.Fatalf("renderEnsembleStatus error: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{"Agents", "PANE STATE", "active but pane is dead"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("agents table missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestExportEnsembleStatusJSONWritesFullPayload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "status.json")
+	payload := ensembleStatusOutput{
+		Session:      "demo",
+		Exists:       true,
+		EnsembleName: "architecture-review",
+		StatusCounts: ensembleStatusCounts{Done: 1, Working: 1},
+		Assignments: []ensembleAssignmentRow{
+			{ModeID: "deductive", AgentType: "cc", Status: "done"},
+			{ModeID: "inductive", AgentType: "cod", Status: "active"},
+		},
+	}
+	if err := exportEnsembleStatusJSON(path, payload); err != nil {
+		t.Fatalf("exportEnsembleStatusJSON error: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	var got ensembleStatusOutput
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("export is not valid JSON: %v\n%s", err, data)
+	}
+	if got.Session != "demo" || got.EnsembleName != "architecture-review" || len(got.Assignments) != 2 || got.StatusCounts.Done != 1 {
+		t.Fatalf("exported payload = %+v", got)
+	}
+
+	if err := exportEnsembleStatusJSON("", payload); err != nil {
+		t.Fatalf("empty path should be a no-op, got %v", err)
+	}
+	err = runEnsembleStatus(&bytes.Buffer{}, "demo", ensembleStatusOptions{Format: "junit", ExportJSON: path})
+	if err == nil || !strings.Contains(err.Error(), "--export-json") {
+		t.Fatalf("expected --export-json/junit conflict, got %v", err)
+	}
+}
+
+func TestRenderEnsembleStatusColumns(t *testing.T) {
+	columns, err := parseTableColumns("status, MODE,age", ensembleStatusColumns)
+	if err != nil {
+		t.Fatalf("parseTableColumns error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	err = renderEnsembleStatus(&buf, ensembleStatusOutput{
+		Session: "demo",
+		Exists:  true,
+		Assignments: []ensembleAssignmentRow{
+			{ModeID: "deductive", Status: "working", TokenEstimate: 1234, PaneName: "demo__cc_1"},
+		},
+	}, "table", columns)
+	if err != nil {
+		t.Fatalf("renderEnsembleStatus error: %v", err)
+	}
+	out := buf.String()
+	statusIdx := strings.Index(out, "STATUS")
+	modeIdx := strings.Index(out, "MODE")
+	if statusIdx < 0 || modeIdx < 0 || statusIdx > modeIdx || !strings.Contains(out, "AGE") {
+		t.Fatalf("expected STATUS, MODE, AGE headers in order, got %q", out)
+	}
+	if strings.Contains(out, "TOKENS") || strings.Contains(out, "demo__cc_1") {
+		t.Fatalf("unselected columns should be omitted, got %q", out)
+	}
+
+	if _, err := parseTableColumns("mode,bogus", ensembleStatusColumns); err == nil || !strings.Contains(err.Error(), "bogus") {
+		t.Fatalf("expected unknown column error, got %v", err)
+	}
+	if columns, err := parseTableColumns("", provenanceColumns); err != nil || columns != nil {
+		t.Fatalf("empty spec should select defaults, got %v, %v", columns, err)
+	}
+}
+
+func TestRenderEnsembleContributionsCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := renderEnsembleContributions(&buf, ensembleContributionsOutput{
+		Session: "demo",
+		Contributions: &ensemble.ContributionReport{
+			Scores: []ensemble.ContributionScore{
+				{ModeID: "deductive", ModeName: "Deductive, formal", Rank: 1, Score: 72.46, FindingsCount: 4, OriginalFindings: 5, UniqueInsights: 2, CitationCount: 3},
+			},
+		},
+	}, "csv")
+	if err != nil {
+		t.Fatalf("renderEnsembleContributions error: %v", err)
+	}
+	want := "rank,mode_id,mode_name,score,findings,original_findings,unique_insights,citations,risks,recommendations\n" +
+		"1,deductive,\"Deductive, formal\",72.5,4,5,2,3,0,0\n"
+	if buf.String() != want {
+		t.Fatalf("csv output = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestPollEnsembleUntilErrorStopsOnFirstError(t *testing.T) {
+	polls := 0
+	load := func(string) (*ensemble.EnsembleSession, error) {
+		polls++
+		state := &ensemble.EnsembleSession{
+			SessionName: "demo",
+			Status:      ensemble.EnsembleActive,
+			Assignments: []ensemble.ModeAssignment{
+				{ModeID: "deductive", PaneName: "demo__cc_1", Status: ensemble.AssignmentActive},
+				{ModeID: "inductive", PaneName: "demo__cc_2", Status: ensemble.AssignmentError, Error: "skipped: timebox exceeded"},
+			},
+		}
+		if polls == 2 {
+			state.Assignments[0].Status = ensemble.AssignmentError
+			state.Assignments[0].Error = "agent crashed"
+		}
+		return state, nil
+	}
+
+	var buf bytes.Buffer
+	err := pollEnsembleUntilError(context.Background(), &buf, "demo", time.Millisecond, false, load)
+	if err == nil || !strings.Contains(err.Error(), "deductive") || strings.Contains(err.Error(), "inductive") {
+		t.Fatalf("err = %v, want failure naming only deductive", err)
+	}
+	if polls != 2 {
+		t.Fatalf("polls = %d, want 2", polls)
+	}
+	if !strings.Contains(buf.String(), "Mode deductive errored on pane demo__cc_1: agent crashed") {
+		t.Fatalf("output = %q", buf.String())
+	}
+}
+
+func TestWatchEnsembleStatusExitsWhenSynthesisReady(t *testing.T) {
+	isolateSessionAgentStorage(t)
+	ensemble.CloseDefaultStateStore()
+	t.Cleanup(ensemble.CloseDefaultStateStore)
+
+	state := &ensemble.EnsembleSession{
+		SessionName:       "watch-ensemble",
+		Question:          "Are we there yet?",
+		Status:            ensemble.EnsembleActive,
+		SynthesisStrategy: ensemble.StrategyConsensus,
+		CreatedAt:         time.Now().UTC(),
+		Assignments: []ensemble.ModeAssignment{
+			{ModeID: "deductive", PaneName: "pane-1", AgentType: "cc", Status: ensemble.AssignmentActive},
+		},
+	}
+	if err := ensemble.SaveSession("", state); err != nil {
+		t.Fatalf("SaveSession error: %v", err)
+	}
+
+	loads := 0
+	load := func(session string) (*ensemble.EnsembleSession, error) {
+		loads++
+		if loads == 2 {
+			state.Assignments[0].Status = ensemble.AssignmentDone
+			if err := ensemble.SaveSession("", state); err != nil {
+				return nil, err
+			}
+		}
+		return ensemble.LoadSession(session)
+	}
+
+	var buf bytes.Buffer
+	if err := watchEnsembleStatus(context.Background(), &buf, state.SessionName, ensembleStatusOptions{Format: "table"}, time.Millisecond, load); err != nil {
+		t.Fatalf("watchEnsembleStatus error: %v", err)
+	}
+	if loads != 2 {
+		t.Fatalf("loads = %d, want 2", loads)
+	}
+	out := buf.String()
+	if strings.Count(out, "\033[H\033[2J") != 2 || !strings.Contains(out, "Watching watch-ensemble | elapsed") {
+		t.Fatalf("expected two cleared renders with header, got %q", out)
+	}
+	if !strings.Contains(out, "synthesis is ready") {
+		t.Fatalf("output missing ready message: %q", out)
+	}
+
+	state.Assignments[0].Status = ensemble.AssignmentError
+	if err := ensemble.SaveSession("", state); err != nil {
+		t.Fatalf("SaveSession error: %v", err)
+	}
+	buf.Reset()
+	if err := watchEnsembleStatus(context.Background(), &buf, state.SessionName, ensembleStatusOptions{}, time.Millisecond, ensemble.LoadSession); err == nil {
+		t.Fatal("expected error when every mode finished without completing")
+	}
+
+	if err := watchEnsembleStatus(context.Background(), &buf, state.SessionName, ensembleStatusOptions{Format: "json"}, time.Millisecond, ensemble.LoadSession); err == nil || !strings.Contains(err.Error(), "--format=table") {
+		t.Fatalf("err = %v, want --format=table error", err)
+	}
+}
+
 func TestImpactToBeadPriority(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -480,3 +959,101 @@ func TestParseSelectionIndices(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeEnsembleAgentTypeAcceptsAliases(t *testing.T) {
+	cases := map[string]string{
+		"cc":          "cc",
+		"claude":      "cc",
+		"claude-code": "cc",
+		"openai":      "cod",
+		"gemini":      "gmi",
+		"opencode":    "oc",
+		"grok":        "",
+		"user":        "",
+		"bogus":       "",
+	}
+	for in, want := range cases {
+		if got := normalizeEnsembleAgentType(in); got != want {
+			t.Errorf("normalizeEnsembleAgentType(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+type fakeRawOutputCapturer struct {
+	outputs map[string]string
+	calls   []string
+}
+
+func (f *fakeRawOutputCapturer) CaptureMode(_ *ensemble.EnsembleSession, modeID string) (ensemble.CapturedOutput, error) {
+	f.calls = append(f.calls, modeID)
+	return ensemble.CapturedOutput{ModeID: modeID, RawOutput: f.outputs[modeID]}, nil
+}
+
+func TestPrintEnsembleRawOutputWritesVerbatim(t *testing.T) {
+	state := &ensemble.EnsembleSession{
+		SessionName: "demo",
+		Assignments: []ensemble.ModeAssignment{
+			{ModeID: "deductive", PaneName: "demo__cc_1", Status: ensemble.AssignmentDone},
+			{ModeID: "inductive", PaneName: "demo__cod_1", Status: ensemble.AssignmentActive},
+			{ModeID: "abductive", PaneName: "demo__gmi_1", Status: ensemble.AssignmentPending},
+		},
+	}
+	raw := "\x1b[1mheader\x1b[0m\n" + strings.Repeat("line of analysis\n", 500)
+	capturer := &fakeRawOutputCapturer{outputs: map[string]string{"deductive": raw, "inductive": "  \n"}}
+
+	var buf bytes.Buffer
+	if err := printEnsembleRawOutput(&buf, state, nil, "Deductive", capturer); err != nil {
+		t.Fatalf("printEnsembleRawOutput error: %v", err)
+	}
+	if buf.String() != raw {
+		t.Fatalf("raw output was altered: got %d bytes, want %d", buf.Len(), len(raw))
+	}
+
+	if err := printEnsembleRawOutput(&buf, state, nil, "inductive", capturer); err == nil || !strings.Contains(err.Error(), "no output yet") {
+		t.Fatalf("expected no-output error for blank capture, got %v", err)
+	}
+	if err := printEnsembleRawOutput(&buf, state, nil, "abductive", capturer); err == nil || !strings.Contains(err.Error(), "no output yet") {
+		t.Fatalf("expected no-output error for pending mode, got %v", err)
+	}
+	if err := printEnsembleRawOutput(&buf, state, nil, "bayesian", capturer); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected not-found error, got %v", err)
+	}
+	if len(capturer.calls) != 2 {
+		t.Fatalf("pending and unknown modes must not be captured, calls = %v", capturer.calls)
+	}
+}
+
+func TestFilterProvenanceChains(t *testing.T) {
+	chains := []*ensemble.ProvenanceChain{
+		{FindingID: "a", SourceMode: "deductive", Confidence: 0.9},
+		{FindingID: "b", SourceMode: "deductive", Confidence: 0.4},
+		{FindingID: "c", SourceMode: "inductive", Confidence: 0.8},
+	}
+
+	ids := func(cs []*ensemble.ProvenanceChain) string {
+		out := make([]string, 0, len(cs))
+		for _, c := range cs {
+			out = append(out, c.FindingID)
+		}
+		return strings.Join(out, ",")
+	}
+
+	if got := ids(filterProvenanceChains(chains, 0, "")); got != "a,b,c" {
+		t.Fatalf("no filters = %q, want a,b,c", got)
+	}
+	if got := ids(filterProvenanceChains(chains, 0.5, "")); got != "a,c" {
+		t.Fatalf("min confidence = %q, want a,c", got)
+	}
+	if got := ids(filterProvenanceChains(chains, 0.5, "deductive")); got != "a" {
+		t.Fatalf("min confidence and mode = %q, want a", got)
+	}
+
+	var buf bytes.Buffer
+	payload := provenanceOutput{Chains: chains[:1], Filtered: 2}
+	if err := renderProvenanceOutput(&buf, payload, "text", nil); err != nil {
+		t.Fatalf("renderProvenanceOutput: %v", err)
+	}
+	if !strings.Contains(buf.String(), "2 findings filtered out") {
+		t.Fatalf("text output missing filtered count:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,159 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Dicklesworthstone/ntm/internal/ensemble"
+	"github.com/Dicklesworthstone/ntm/internal/output"
+	"github.com/Dicklesworthstone/ntm/internal/tmux"
+)
+
+type ensembleVerifyOutput struct {
+	GeneratedAt time.Time                     `json:"generated_at" yaml:"generated_at"`
+	Session     string                        `json:"session" yaml:"session"`
+	Success     bool                          `json:"success" yaml:"success"`
+	Total       int                           `json:"total" yaml:"total"`
+	Valid       int                           `json:"valid" yaml:"valid"`
+	Invalid     int                           `json:"invalid" yaml:"invalid"`
+	Modes       []ensemble.OutputVerification `json:"modes" yaml:"modes"`
+}
+
+func newEnsembleVerifyOutputsCmd() *cobra.Command {
+	format := "table"
+	cmd := &cobra.Command{
+		Use:   "verify-outputs [session]",
+		Short: "Check that every mode's captured output parses and passes the schema",
+		Long: `Capture the output of every mode in a running ensemble, parse it into the
+structured mode output schema, and report per mode whether it parsed, how many
+findings it contains, and any schema issues.
+
+Exits non-zero when any mode fails verification, so it can gate synthesis:
+
+  ntm ensemble verify-outputs mysession && ntm ensemble synthesize mysession
+
+Formats:
+  --format=table (default)
+  --format=json
+  --format=yaml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			machineJSON := IsJSONOutput() || strings.EqualFold(strings.TrimSpace(format), "json")
+			session := ""
+			if len(args) > 0 {
+				session = args[0]
+			}
+			res, err := resolveEnsembleStateCommandSessionForOutput(session, cmd.OutOrStdout(), machineJSON)
+			if err != nil {
+				return err
+			}
+			if res.Session == "" {
+				return nil
+			}
+			res.ExplainIfInferredForOutput(os.Stderr, machineJSON)
+			return runEnsembleVerifyOutputs(cmd.OutOrStdout(), res.Session, format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", "table", "Output format: table, json, yaml")
+	cmd.ValidArgsFunction = completeSessionArgs
+	return cmd
+}
+
+func runEnsembleVerifyOutputs(w io.Writer, session, format string) error {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = "table"
+	}
+	if jsonOutput {
+		format = "json"
+	}
+
+	state, sessionLive, err := loadEnsembleStateWithRuntimePresence(session)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("no ensemble state found for session '%s'", session)
+		}
+		return fmt.Errorf("load session: %w", err)
+	}
+	if !sessionLive {
+		return fmt.Errorf("session '%s' is not running; verify-outputs captures live panes", session)
+	}
+
+	capture := ensemble.NewOutputCapture(tmux.DefaultClient)
+	captured, err := capture.CaptureAll(state)
+	if err != nil {
+		slog.Default().Warn("ensemble verify-outputs capture incomplete",
+			"session", session,
+			"error", err,
+		)
+	}
+
+	payload := ensembleVerifyOutput{
+		GeneratedAt: output.Timestamp(),
+		Session:     session,
+		Modes:       ensemble.VerifyCapturedOutputs(captured),
+	}
+	for _, mode := range payload.Modes {
+		payload.Total++
+		if mode.Valid {
+			payload.Valid++
+		} else {
+			payload.Invalid++
+		}
+	}
+	payload.Success = payload.Total > 0 && payload.Invalid == 0
+
+	if err := renderEnsembleVerifyOutputs(w, payload, format); err != nil {
+		return err
+	}
+	if payload.Success {
+		return nil
+	}
+	if format == "json" {
+		return jsonFailureExit()
+	}
+	if payload.Total == 0 {
+		return fmt.Errorf("no mode outputs captured for session '%s'", session)
+	}
+	return fmt.Errorf("%d of %d mode output(s) failed verification", payload.Invalid, payload.Total)
+}
+
+func renderEnsembleVerifyOutputs(w io.Writer, payload ensembleVerifyOutput, format string) error {
+	switch format {
+	case "json":
+		return output.WriteJSON(w, payload, true)
+	case "yaml", "yml":
+		return renderYAML(w, payload)
+	case "table", "text":
+		fmt.Fprintf(w, "Session: %s\n", payload.Session)
+		fmt.Fprintf(w, "Outputs: %d valid, %d invalid (%d total)\n\n", payload.Valid, payload.Invalid, payload.Total)
+
+		table := output.NewTable(w, "MODE", "PANE", "PARSED", "VALID", "FINDINGS", "ISSUES")
+		for _, mode := range payload.Modes {
+			issues := "-"
+			if len(mode.Issues) > 0 {
+				issues = truncateWithEllipsis(strings.Join(mode.Issues, "; "), 80)
+			}
+			table.AddRow(
+				mode.ModeID,
+				mode.PaneName,
+				fmt.Sprintf("%t", mode.Parsed),
+				fmt.Sprintf("%t", mode.Valid),
+				fmt.Sprintf("%d", mode.FindingCount),
+				issues,
+			)
+		}
+		table.Render()
+		return nil
+	default:
+		return fmt.Errorf("invalid format %q (expected table, json, yaml)", format)
+	}
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/ntm/internal/ensemble"
+)
+
+func TestRenderEnsembleVerifyOutputsTable(t *testing.T) {
+	payload := ensembleVerifyOutput{
+		Session: "demo",
+		Total:   2,
+		Valid:   1,
+		Invalid: 1,
+		Modes: []ensemble.OutputVerification{
+			{ModeID: "deductive", PaneName: "demo__cc_1", Parsed: true, Valid: true, FindingCount: 3},
+			{ModeID: "bayesian", PaneName: "demo__cod_1", Issues: []string{"thesis: required field is missing"}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := renderEnsembleVerifyOutputs(&buf, payload, "table"); err != nil {
+		t.Fatalf("renderEnsembleVerifyOutputs error: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{"1 valid, 1 invalid (2 total)", "deductive", "thesis: required field is missing"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderEnsembleVerifyOutputsRejectsUnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := renderEnsembleVerifyOutputs(&buf, ensembleVerifyOutput{}, "xml"); err == nil {
+		t.Fatal("expected error for unknown format")
+	}
+}
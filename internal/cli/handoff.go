@@ -5,17 +5,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"html"
 	"log/slog"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/Dicklesworthstone/ntm/internal/agentmail"
+	"github.com/Dicklesworthstone/ntm/internal/checkpoint"
 	"github.com/Dicklesworthstone/ntm/internal/handoff"
+	"github.com/Dicklesworthstone/ntm/internal/robot"
 )
 
 func newHandoffCmd() *cobra.Command {
@@ -34,13 +38,19 @@ Examples:
   ntm handoff create myproject --goal "Implemented auth" --now "Add tests"
   ntm handoff create myproject --auto            # Generate from agent output
   ntm handoff list myproject                     # List recent handoffs
-  ntm handoff show path/to/handoff.yaml          # View a specific handoff`,
+  ntm handoff show path/to/handoff.yaml          # View a specific handoff
+  ntm handoff validate myproject                 # Check the latest handoff
+  ntm handoff draft myproject -o draft.md        # Draft from checkpoints
+  ntm handoff merge day1.yaml day2.yaml          # Combine a sequence`,
 	}
 
 	cmd.AddCommand(newHandoffCreateCmd())
 	cmd.AddCommand(newHandoffListCmd())
 	cmd.AddCommand(newHandoffShowCmd())
 	cmd.AddCommand(newHandoffLedgerCmd())
+	cmd.AddCommand(newHandoffValidateCmd())
+	cmd.AddCommand(newHandoffDraftCmd())
+	cmd.AddCommand(newHandoffMergeCmd())
 
 	return cmd
 }
@@ -70,6 +80,7 @@ Examples:
   ntm handoff create myproject --goal "Completed auth" --now "Add tests"
   ntm handoff create myproject --auto
   ntm handoff create myproject --auto --output - --format markdown  # Output to stdout as markdown
+  ntm handoff create myproject --auto --output - --format html > handoff.html
   ntm handoff create myproject --from-file handoff.yaml
   ntm handoff create                     # Interactive mode`,
 		Args: cobra.MaximumNArgs(1),
@@ -88,7 +99,7 @@ Examples:
 	cmd.Flags().BoolVar(&auto, "auto", false, "Generate from agent output")
 	cmd.Flags().StringVar(&description, "description", "", "Short description for filename")
 	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file path (use '-' for stdout)")
-	cmd.Flags().StringVar(&format, "format", "yaml", "Output format: yaml, json, or markdown")
+	cmd.Flags().StringVar(&format, "format", "yaml", "Output format: yaml, json, markdown, or html")
 	cmd.Flags().BoolVar(&includeGit, "include-git", true, "Include git state in handoff")
 
 	return cmd
@@ -126,22 +137,30 @@ Examples:
 }
 
 func newHandoffShowCmd() *cobra.Command {
+	var format string
+
 	cmd := &cobra.Command{
 		Use:   "show <path>",
 		Short: "Show a specific handoff",
 		Long: `Display the full contents of a handoff file.
 
 The path can be absolute or relative to the current directory.
+Use --format to render it as markdown, JSON (the full handoff, which
+decodes back into the same structure), or a standalone HTML page for
+viewing in a browser.
 
 Examples:
   ntm handoff show .ntm/handoffs/myproject/2026-01-19_14-30_auth.yaml
-  ntm handoff show /full/path/to/handoff.yaml`,
+  ntm handoff show /full/path/to/handoff.yaml
+  ntm handoff show handoff.yaml --format html > handoff.html`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runHandoffShow(cmd, args[0], false)
+			return runHandoffShowFormat(cmd, args[0], format)
 		},
 	}
 
+	cmd.Flags().StringVar(&format, "format", "text", "Output format: text, markdown, json, or html")
+
 	return cmd
 }
 
@@ -170,6 +189,347 @@ Examples:
 	return cmd
 }
 
+func newHandoffValidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate <file-or-session>",
+		Short: "Check a handoff against the schema",
+		Long: `Check a handoff file, or a session's latest handoff, against the schema.
+
+Errors: session, status, outcome, goal, and now are required; status and
+outcome must be known values. Warnings: a partial handoff with empty next
+steps or blockers. Exits non-zero when there are errors.
+
+Examples:
+  ntm handoff validate .ntm/handoffs/myproject/2026-01-19_14-30_auth.yaml
+  ntm handoff validate myproject          # Latest handoff for myproject
+  ntm handoff validate myproject --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHandoffValidate(cmd, args[0], false)
+		},
+	}
+
+	return cmd
+}
+
+func newHandoffDraftCmd() *cobra.Command {
+	var (
+		output      string
+		checkpoints int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "draft <session>",
+		Short: "Draft a handoff from checkpoints",
+		Long: `Draft a Markdown handoff from the session's saved checkpoints.
+
+File changes come from the git state captured in the latest checkpoint, and
+recent manual checkpoints become suggested "Done This Session" entries. Goal,
+Now, and status are left as TODO for you to fill in. No git commands are run;
+save a checkpoint first ('ntm checkpoint save') to capture the current tree.
+
+Examples:
+  ntm handoff draft myproject                   # Print the draft
+  ntm handoff draft myproject -o handoff.md     # Write it to a file
+  ntm handoff draft myproject --checkpoints 10  # Look further back`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHandoffDraft(cmd, checkpoint.NewStorage(), args[0], checkpoints, output)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Write the draft to this file instead of stdout")
+	cmd.Flags().IntVar(&checkpoints, "checkpoints", 5, "Number of recent checkpoints to draw completed work from")
+	cmd.ValidArgsFunction = completeSessionArgs
+
+	return cmd
+}
+
+func runHandoffDraft(cmd *cobra.Command, storage *checkpoint.Storage, sessionName string, limit int, output string) error {
+	if limit <= 0 {
+		return fmt.Errorf("--checkpoints must be greater than 0")
+	}
+	if _, err := requireHandoffCommandContext(cmd, "draft"); err != nil {
+		return err
+	}
+
+	h, err := draftHandoffFromCheckpoints(storage, sessionName, limit)
+	if err != nil {
+		return err
+	}
+
+	if IsJSONOutput() {
+		return outputHandoffJSON(cmd, h)
+	}
+	draft := formatHandoffMarkdown(h)
+	if output == "" {
+		_, err := fmt.Fprint(cmd.OutOrStdout(), draft)
+		return err
+	}
+	if err := os.WriteFile(output, []byte(draft), 0644); err != nil {
+		return fmt.Errorf("write draft: %w", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Wrote handoff draft to %s\n", output)
+	return nil
+}
+
+// draftHandoffFromCheckpoints builds an editable handoff from the session's
+// most recent checkpoints. It reads the git status each checkpoint already
+// captured instead of inspecting the working tree again.
+func draftHandoffFromCheckpoints(storage *checkpoint.Storage, sessionName string, limit int) (*handoff.Handoff, error) {
+	cps, err := storage.List(sessionName)
+	if err != nil {
+		return nil, fmt.Errorf("list checkpoints: %w", err)
+	}
+	if len(cps) == 0 {
+		return nil, fmt.Errorf("no checkpoints for session %s; run 'ntm checkpoint save %s' first", sessionName, sessionName)
+	}
+	if len(cps) > limit {
+		cps = cps[:limit]
+	}
+	latest, oldest := cps[0], cps[len(cps)-1]
+
+	h := handoff.New(sessionName)
+	h.Goal = "TODO: what this session accomplished"
+	h.Now = "TODO: what the next session should do first"
+	h.Status = "TODO"
+	h.Outcome = "TODO"
+
+	status, err := storage.LoadGitStatus(sessionName, latest.ID)
+	if err != nil {
+		return nil, fmt.Errorf("load git status from checkpoint %s: %w", latest.ID, err)
+	}
+	h.Files.Created, h.Files.Modified, h.Files.Deleted = checkpoint.ParseGitStatusFiles(status)
+
+	// Oldest first, so the list reads in the order the work happened.
+	for i := len(cps) - 1; i >= 0; i-- {
+		cp := cps[i]
+		if strings.HasPrefix(cp.Name, checkpoint.AutoCheckpointPrefix+"-") {
+			continue
+		}
+		task := cp.Description
+		if task == "" {
+			task = cp.Name
+		}
+		h.DoneThisSession = append(h.DoneThisSession, handoff.TaskRecord{Task: task})
+	}
+
+	if latest.Git.Branch != "" {
+		h.AddFinding("git_branch", latest.Git.Branch)
+	}
+	if oldest.Git.Commit != "" && latest.Git.Commit != oldest.Git.Commit {
+		h.AddFinding("commits", shortCommit(oldest.Git.Commit)+".."+shortCommit(latest.Git.Commit))
+	}
+	return h, nil
+}
+
+func newHandoffMergeCmd() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "merge <file-or-session>...",
+		Short: "Merge a sequence of handoffs into one",
+		Long: `Combine several handoffs, oldest first, into one Markdown handoff.
+
+File changes are unioned, completed tasks concatenated, and goal, now,
+status, next steps, and blockers come from the last handoff that sets them.
+Later decisions win; an overridden decision is noted next to its new value.
+A session name stands for that session's latest handoff.
+
+Examples:
+  ntm handoff merge day1.yaml day2.yaml day3.yaml
+  ntm handoff merge api web -o project-handoff.md
+  ntm handoff merge day1.yaml day2.yaml --json`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHandoffMerge(cmd, args, output)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Write the merged handoff to this file instead of stdout")
+
+	return cmd
+}
+
+// HandoffMergeResult is the JSON output of `ntm handoff merge`.
+type HandoffMergeResult struct {
+	Sources   []string                   `json:"sources"`
+	Handoff   *handoff.Handoff           `json:"handoff"`
+	Conflicts []handoff.DecisionConflict `json:"conflicts"`
+}
+
+func runHandoffMerge(cmd *cobra.Command, targets []string, output string) error {
+	ctx, err := requireHandoffCommandContext(cmd, "merge")
+	if err != nil {
+		return err
+	}
+
+	handoffs := make([]*handoff.Handoff, 0, len(targets))
+	sources := make([]string, 0, len(targets))
+	for _, target := range targets {
+		h, path, err := resolveHandoffTarget(ctx, target)
+		if err != nil {
+			return err
+		}
+		handoffs = append(handoffs, h)
+		sources = append(sources, path)
+	}
+	if err := requireLiveHandoffContext(ctx, "merge"); err != nil {
+		return err
+	}
+
+	merged, conflicts := handoff.Merge(handoffs...)
+	slog.Debug("handoff merge",
+		"sources", len(sources),
+		"conflicts", len(conflicts),
+	)
+
+	if IsJSONOutput() {
+		if conflicts == nil {
+			conflicts = []handoff.DecisionConflict{}
+		}
+		return outputHandoffJSON(cmd, HandoffMergeResult{Sources: sources, Handoff: merged, Conflicts: conflicts})
+	}
+	for _, c := range conflicts {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: decision %q changed from %q to %q\n", c.Key, c.Previous, c.Value)
+	}
+	text := formatHandoffMarkdown(merged)
+	if output == "" {
+		_, err := fmt.Fprint(cmd.OutOrStdout(), text)
+		return err
+	}
+	if err := os.WriteFile(output, []byte(text), 0644); err != nil {
+		return fmt.Errorf("write merged handoff: %w", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Merged %d handoffs into %s\n", len(handoffs), output)
+	return nil
+}
+
+// HandoffValidationIssue is one error or warning reported by `ntm handoff validate`.
+type HandoffValidationIssue struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	Value   string `json:"value,omitempty"`
+}
+
+// HandoffValidateResult is the JSON output of `ntm handoff validate`.
+type HandoffValidateResult struct {
+	Path     string                   `json:"path"`
+	Session  string                   `json:"session,omitempty"`
+	Valid    bool                     `json:"valid"`
+	Errors   []HandoffValidationIssue `json:"errors"`
+	Warnings []HandoffValidationIssue `json:"warnings"`
+}
+
+func handoffValidationIssues(errs handoff.ValidationErrors) []HandoffValidationIssue {
+	issues := make([]HandoffValidationIssue, 0, len(errs))
+	for _, e := range errs {
+		issue := HandoffValidationIssue{Field: e.Field, Message: e.Message}
+		if e.Value != nil {
+			issue.Value = fmt.Sprint(e.Value)
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// resolveHandoffTarget reads target as a handoff file when one exists
+// at that path, and otherwise as a session whose latest handoff is used.
+func resolveHandoffTarget(ctx context.Context, target string) (*handoff.Handoff, string, error) {
+	if info, err := os.Stat(target); err == nil && !info.IsDir() {
+		path, err := filepath.Abs(target)
+		if err != nil {
+			return nil, "", fmt.Errorf("resolve handoff path: %w", err)
+		}
+		h, err := handoff.NewReader(GetProjectRoot()).Read(path)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read handoff: %w", err)
+		}
+		return h, path, nil
+	}
+
+	sessionName, err := normalizeHandoffSession(target)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s is neither a handoff file nor a valid session name", target)
+	}
+	projectDir, err := resolveHandoffProjectDir(ctx, sessionName)
+	if err != nil {
+		return nil, "", err
+	}
+	h, path, err := handoff.NewReader(projectDir).FindLatest(sessionName)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to find handoff: %w", err)
+	}
+	if h == nil {
+		return nil, "", fmt.Errorf("no handoffs found for session: %s", sessionName)
+	}
+	return h, path, nil
+}
+
+func runHandoffValidate(cmd *cobra.Command, target string, jsonFormat bool) error {
+	if IsJSONOutput() {
+		jsonFormat = true
+	}
+	ctx, err := requireHandoffCommandContext(cmd, "validate")
+	if err != nil {
+		return err
+	}
+
+	h, path, err := resolveHandoffTarget(ctx, target)
+	if err != nil {
+		return err
+	}
+	if err := requireLiveHandoffContext(ctx, "validate"); err != nil {
+		return err
+	}
+
+	errs, warnings := h.ValidateSchema()
+	result := HandoffValidateResult{
+		Path:     path,
+		Session:  h.Session,
+		Valid:    len(errs) == 0,
+		Errors:   handoffValidationIssues(errs),
+		Warnings: handoffValidationIssues(warnings),
+	}
+
+	slog.Debug("handoff validate",
+		"path", path,
+		"errors", len(errs),
+		"warnings", len(warnings),
+	)
+
+	if jsonFormat {
+		if err := outputHandoffJSON(cmd, result); err != nil {
+			return err
+		}
+	} else {
+		w := cmd.OutOrStdout()
+		fmt.Fprintf(w, "Handoff: %s\n", path)
+		for _, issue := range result.Errors {
+			fmt.Fprintf(w, "  error:   %s: %s\n", issue.Field, formatHandoffValidationIssue(issue))
+		}
+		for _, issue := range result.Warnings {
+			fmt.Fprintf(w, "  warning: %s: %s\n", issue.Field, formatHandoffValidationIssue(issue))
+		}
+		if result.Valid {
+			fmt.Fprintf(w, "Valid (%d warning(s))\n", len(result.Warnings))
+		}
+	}
+
+	if !result.Valid {
+		cause := fmt.Errorf("handoff %s has %d validation error(s)", path, len(result.Errors))
+		return robot.ExitResultForCode(1, cause, jsonFormat)
+	}
+	return nil
+}
+
+func formatHandoffValidationIssue(issue HandoffValidationIssue) string {
+	if issue.Value == "" {
+		return issue.Message
+	}
+	return fmt.Sprintf("%s (got %q)", issue.Message, issue.Value)
+}
+
 func runHandoffCreate(cmd *cobra.Command, sessionName, goal, now, fromFile string, auto bool, description string, jsonFormat bool, output, format string, includeGit bool) error {
 	format = strings.ToLower(strings.TrimSpace(format))
 	if IsJSONOutput() || jsonFormat {
@@ -416,6 +776,8 @@ func outputHandoffToStdout(cmd *cobra.Command, h *handoff.Handoff, format string
 		fmt.Fprintln(cmd.OutOrStdout(), string(data))
 	case "markdown":
 		fmt.Fprintln(cmd.OutOrStdout(), formatHandoffMarkdown(h))
+	case "html":
+		fmt.Fprint(cmd.OutOrStdout(), formatHandoffHTML(h))
 	default: // yaml
 		data, err := handoff.MarshalYAML(h)
 		if err != nil {
@@ -426,67 +788,112 @@ func outputHandoffToStdout(cmd *cobra.Command, h *handoff.Handoff, format string
 	return nil
 }
 
-// formatHandoffMarkdown converts a handoff to human-readable markdown.
-func formatHandoffMarkdown(h *handoff.Handoff) string {
-	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("# Handoff: %s\n\n", h.Session))
-	sb.WriteString(fmt.Sprintf("**Status:** %s (%s)\n\n", h.Status, h.Outcome))
+// handoffSection is one titled block of a rendered handoff. Markdown and HTML
+// output both walk the same sections so the formats cannot drift apart.
+type handoffSection struct {
+	Title  string
+	Anchor string
+	Body   string               // paragraph text, for single-value sections
+	Items  []handoffSectionItem // bullet list
+	Groups []handoffSectionGroup
+}
 
-	sb.WriteString("## Goal\n")
-	sb.WriteString(h.Goal + "\n\n")
+// handoffSectionItem is a bullet; Label, when set, is rendered in bold before Text.
+type handoffSectionItem struct {
+	Label string
+	Text  string
+}
 
-	sb.WriteString("## Now\n")
-	sb.WriteString(h.Now + "\n\n")
+// handoffSectionGroup is a labeled sub-list, such as created files.
+type handoffSectionGroup struct {
+	Label string
+	Items []string
+}
+
+// handoffSections returns the renderable sections of h in display order,
+// omitting empty optional sections.
+func handoffSections(h *handoff.Handoff) []handoffSection {
+	sections := []handoffSection{
+		{Title: "Goal", Anchor: "goal", Body: h.Goal},
+		{Title: "Now", Anchor: "now", Body: h.Now},
+	}
 
 	if len(h.DoneThisSession) > 0 {
-		sb.WriteString("## Done This Session\n")
+		sec := handoffSection{Title: "Done This Session", Anchor: "done"}
 		for _, task := range h.DoneThisSession {
-			sb.WriteString(fmt.Sprintf("- %s\n", task.Task))
+			sec.Items = append(sec.Items, handoffSectionItem{Text: task.Task})
 		}
-		sb.WriteString("\n")
+		sections = append(sections, sec)
 	}
 
 	if len(h.Next) > 0 {
-		sb.WriteString("## Next Steps\n")
-		for _, item := range h.Next {
-			sb.WriteString(fmt.Sprintf("- %s\n", item))
-		}
-		sb.WriteString("\n")
+		sections = append(sections, handoffSection{Title: "Next Steps", Anchor: "next", Items: plainHandoffItems(h.Next)})
 	}
 
 	if len(h.Blockers) > 0 {
-		sb.WriteString("## Blockers\n")
-		for _, blocker := range h.Blockers {
-			sb.WriteString(fmt.Sprintf("- %s\n", blocker))
-		}
-		sb.WriteString("\n")
+		sections = append(sections, handoffSection{Title: "Blockers", Anchor: "blockers", Items: plainHandoffItems(h.Blockers)})
 	}
 
 	if len(h.Decisions) > 0 {
-		sb.WriteString("## Key Decisions\n")
-		for key, val := range h.Decisions {
-			sb.WriteString(fmt.Sprintf("- **%s:** %s\n", key, val))
+		sec := handoffSection{Title: "Key Decisions", Anchor: "decisions"}
+		keys := make([]string, 0, len(h.Decisions))
+		for key := range h.Decisions {
+			keys = append(keys, key)
 		}
-		sb.WriteString("\n")
+		sort.Strings(keys)
+		for _, key := range keys {
+			sec.Items = append(sec.Items, handoffSectionItem{Label: key, Text: h.Decisions[key]})
+		}
+		sections = append(sections, sec)
 	}
 
 	if h.TotalFileChanges() > 0 {
-		sb.WriteString("## File Changes\n")
-		if len(h.Files.Created) > 0 {
-			sb.WriteString("**Created:**\n")
-			for _, f := range h.Files.Created {
-				sb.WriteString(fmt.Sprintf("- %s\n", f))
+		sec := handoffSection{Title: "File Changes", Anchor: "files"}
+		for _, g := range []handoffSectionGroup{
+			{Label: "Created", Items: h.Files.Created},
+			{Label: "Modified", Items: h.Files.Modified},
+			{Label: "Deleted", Items: h.Files.Deleted},
+		} {
+			if len(g.Items) > 0 {
+				sec.Groups = append(sec.Groups, g)
 			}
 		}
-		if len(h.Files.Modified) > 0 {
-			sb.WriteString("**Modified:**\n")
-			for _, f := range h.Files.Modified {
-				sb.WriteString(fmt.Sprintf("- %s\n", f))
+		sections = append(sections, sec)
+	}
+
+	return sections
+}
+
+func plainHandoffItems(texts []string) []handoffSectionItem {
+	items := make([]handoffSectionItem, len(texts))
+	for i, text := range texts {
+		items[i] = handoffSectionItem{Text: text}
+	}
+	return items
+}
+
+// formatHandoffMarkdown converts a handoff to human-readable markdown.
+func formatHandoffMarkdown(h *handoff.Handoff) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Handoff: %s\n\n", h.Session))
+	sb.WriteString(fmt.Sprintf("**Status:** %s (%s)\n\n", h.Status, h.Outcome))
+
+	for _, sec := range handoffSections(h) {
+		sb.WriteString("## " + sec.Title + "\n")
+		if sec.Items == nil && sec.Groups == nil {
+			sb.WriteString(sec.Body + "\n\n")
+			continue
+		}
+		for _, item := range sec.Items {
+			if item.Label != "" {
+				sb.WriteString(fmt.Sprintf("- **%s:** %s\n", item.Label, item.Text))
+			} else {
+				sb.WriteString(fmt.Sprintf("- %s\n", item.Text))
 			}
 		}
-		if len(h.Files.Deleted) > 0 {
-			sb.WriteString("**Deleted:**\n")
-			for _, f := range h.Files.Deleted {
+		for _, g := range sec.Groups {
+			sb.WriteString(fmt.Sprintf("**%s:**\n", g.Label))
+			for _, f := range g.Items {
 				sb.WriteString(fmt.Sprintf("- %s\n", f))
 			}
 		}
@@ -496,6 +903,62 @@ func formatHandoffMarkdown(h *handoff.Handoff) string {
 	return sb.String()
 }
 
+const handoffHTMLStyle = `body{font-family:-apple-system,BlinkMacSystemFont,"Segoe UI",sans-serif;max-width:48rem;margin:2rem auto;padding:0 1rem;line-height:1.5;color:#1f2328}
+nav a{margin-right:.75rem}
+h2{border-bottom:1px solid #d0d7de;padding-bottom:.25rem}
+h2 a{color:inherit;text-decoration:none}
+.status{color:#57606a}
+code{font-family:ui-monospace,SFMono-Regular,Menlo,monospace}`
+
+// formatHandoffHTML renders a handoff as a standalone HTML page with the same
+// sections as formatHandoffMarkdown, each under an anchored heading.
+func formatHandoffHTML(h *handoff.Handoff) string {
+	esc := html.EscapeString
+	sections := handoffSections(h)
+
+	var sb strings.Builder
+	sb.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n<meta charset=\"utf-8\">\n")
+	sb.WriteString(fmt.Sprintf("<title>Handoff: %s</title>\n", esc(h.Session)))
+	sb.WriteString("<style>\n" + handoffHTMLStyle + "\n</style>\n</head>\n<body>\n")
+	sb.WriteString(fmt.Sprintf("<h1>Handoff: %s</h1>\n", esc(h.Session)))
+	sb.WriteString(fmt.Sprintf("<p class=\"status\"><strong>Status:</strong> %s (%s)</p>\n", esc(h.Status), esc(h.Outcome)))
+
+	sb.WriteString("<nav>")
+	for _, sec := range sections {
+		sb.WriteString(fmt.Sprintf("<a href=\"#%s\">%s</a>", sec.Anchor, esc(sec.Title)))
+	}
+	sb.WriteString("</nav>\n")
+
+	for _, sec := range sections {
+		sb.WriteString(fmt.Sprintf("<section id=\"%s\">\n<h2><a href=\"#%s\">%s</a></h2>\n", sec.Anchor, sec.Anchor, esc(sec.Title)))
+		if sec.Items == nil && sec.Groups == nil {
+			sb.WriteString("<p>" + esc(sec.Body) + "</p>\n")
+		}
+		if len(sec.Items) > 0 {
+			sb.WriteString("<ul>\n")
+			for _, item := range sec.Items {
+				if item.Label != "" {
+					sb.WriteString(fmt.Sprintf("<li><strong>%s:</strong> %s</li>\n", esc(item.Label), esc(item.Text)))
+				} else {
+					sb.WriteString(fmt.Sprintf("<li>%s</li>\n", esc(item.Text)))
+				}
+			}
+			sb.WriteString("</ul>\n")
+		}
+		for _, g := range sec.Groups {
+			sb.WriteString(fmt.Sprintf("<h3>%s</h3>\n<ul>\n", esc(g.Label)))
+			for _, f := range g.Items {
+				sb.WriteString(fmt.Sprintf("<li><code>%s</code></li>\n", esc(f)))
+			}
+			sb.WriteString("</ul>\n")
+		}
+		sb.WriteString("</section>\n")
+	}
+
+	sb.WriteString("</body>\n</html>\n")
+	return sb.String()
+}
+
 func runInteractiveHandoff(sessionName string) (*handoff.Handoff, error) {
 	reader := bufio.NewReader(os.Stdin)
 	h := handoff.New(sessionName)
@@ -683,9 +1146,22 @@ func runHandoffList(cmd *cobra.Command, sessionName string, limit int, jsonForma
 }
 
 func runHandoffShow(cmd *cobra.Command, path string, jsonFormat bool) error {
+	if jsonFormat {
+		return runHandoffShowFormat(cmd, path, "json")
+	}
+	return runHandoffShowFormat(cmd, path, "text")
+}
+
+func runHandoffShowFormat(cmd *cobra.Command, path, format string) error {
+	format = strings.ToLower(strings.TrimSpace(format))
 	// Check global JSON flag
 	if IsJSONOutput() {
-		jsonFormat = true
+		format = "json"
+	}
+	switch format {
+	case "text", "markdown", "json", "html":
+	default:
+		return fmt.Errorf("invalid --format %q (want text, markdown, json, or html)", format)
 	}
 	ctx, err := requireHandoffCommandContext(cmd, "show")
 	if err != nil {
@@ -716,8 +1192,11 @@ func runHandoffShow(cmd *cobra.Command, path string, jsonFormat bool) error {
 		"session", h.Session,
 	)
 
-	if jsonFormat {
+	switch format {
+	case "json":
 		return outputHandoffJSON(cmd, h)
+	case "markdown", "html":
+		return outputHandoffToStdout(cmd, h, format)
 	}
 
 	fmt.Fprintf(cmd.OutOrStdout(), "Handoff: %s\n", path)
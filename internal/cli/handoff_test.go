@@ -15,6 +15,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/Dicklesworthstone/ntm/internal/checkpoint"
 	"github.com/Dicklesworthstone/ntm/internal/config"
 	dispatchsvc "github.com/Dicklesworthstone/ntm/internal/dispatch"
 	"github.com/Dicklesworthstone/ntm/internal/handoff"
@@ -1518,3 +1519,202 @@ func TestRunHandoffCreateValidation(t *testing.T) {
 		t.Fatalf("runHandoffCreate() with goal and now should succeed: %v", err)
 	}
 }
+
+func TestRunHandoffValidate(t *testing.T) {
+	tmpDir := t.TempDir()
+	good := filepath.Join(tmpDir, "good.yaml")
+	if err := os.WriteFile(good, []byte("session: testsession\nstatus: partial\noutcome: PARTIAL_PLUS\ngoal: Ship auth\nnow: Add tests\nnext:\n  - Add tests\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	bad := filepath.Join(tmpDir, "bad.yaml")
+	if err := os.WriteFile(bad, []byte("session: testsession\nstatus: complete\noutcome: MOSTLY\nnow: Add tests\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetContext(t.Context())
+	cmd.SetOut(&buf)
+
+	if err := runHandoffValidate(cmd, good, false); err != nil {
+		t.Fatalf("runHandoffValidate(good) error: %v", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "warning: blockers:") || !strings.Contains(out, "Valid (1 warning(s))") {
+		t.Errorf("unexpected text output: %s", out)
+	}
+
+	buf.Reset()
+	err := runHandoffValidate(cmd, bad, true)
+	var exitErr *robot.ProcessExitError
+	if !errors.As(err, &exitErr) || !exitErr.JSONWritten() {
+		t.Fatalf("runHandoffValidate(bad) error = %v, want JSON-written exit error", err)
+	}
+	var result HandoffValidateResult
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, buf.String())
+	}
+	var fields []string
+	for _, issue := range result.Errors {
+		fields = append(fields, issue.Field)
+	}
+	if result.Valid || strings.Join(fields, ",") != "goal,outcome" {
+		t.Errorf("result = %+v, want invalid with goal and outcome errors", result)
+	}
+}
+
+func TestRunHandoffShowFormats(t *testing.T) {
+	h := handoff.New("testsession")
+	h.Goal = "Ship <auth>"
+	h.Now = "Add tests"
+	h.Status = handoff.StatusPartial
+	h.Outcome = handoff.OutcomePartialPlus
+	h.Next = []string{"Step 1"}
+	h.Blockers = []string{"API not ready"}
+	h.Decisions = map[string]string{"arch": "monolith"}
+	h.Files.Created = []string{"auth.go"}
+	path, err := handoff.NewWriter(t.TempDir()).Write(h, "formats")
+	if err != nil {
+		t.Fatalf("failed to write handoff: %v", err)
+	}
+
+	show := func(format string) string {
+		t.Helper()
+		var buf bytes.Buffer
+		cmd := &cobra.Command{}
+		cmd.SetContext(t.Context())
+		cmd.SetOut(&buf)
+		if err := runHandoffShowFormat(cmd, path, format); err != nil {
+			t.Fatalf("runHandoffShowFormat(%s) error: %v", format, err)
+		}
+		return buf.String()
+	}
+
+	var decoded handoff.Handoff
+	if err := json.Unmarshal([]byte(show("json")), &decoded); err != nil {
+		t.Fatalf("json output does not decode: %v", err)
+	}
+	if decoded.Goal != h.Goal || decoded.Outcome != h.Outcome || len(decoded.Next) != 1 || decoded.Files.Created[0] != "auth.go" {
+		t.Errorf("json round trip = %+v", decoded)
+	}
+
+	page := show("html")
+	for _, want := range []string{
+		"<!DOCTYPE html>",
+		"Ship &lt;auth&gt;",
+		`<section id="next">`,
+		`<section id="blockers">`,
+		`<section id="decisions">`,
+		`<section id="files">`,
+		`<a href="#blockers">Blockers</a>`,
+		"<li><strong>arch:</strong> monolith</li>",
+		"<li><code>auth.go</code></li>",
+	} {
+		if !strings.Contains(page, want) {
+			t.Errorf("html output missing %q:\n%s", want, page)
+		}
+	}
+	if strings.Contains(page, `id="done"`) {
+		t.Error("html output should omit the empty done section")
+	}
+
+	if md := show("markdown"); !strings.Contains(md, "## Blockers\n- API not ready\n") {
+		t.Errorf("markdown output = %s", md)
+	}
+}
+
+func TestRunHandoffDraftUsesCheckpointGitState(t *testing.T) {
+	storage := checkpoint.NewStorageWithDir(t.TempDir())
+	base := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	for i, cp := range []*checkpoint.Checkpoint{
+		{ID: "cp-1", Name: "auth-handler", Description: "Wrote the auth handler", Git: checkpoint.GitState{Branch: "main", Commit: "1111111111"}},
+		{ID: "cp-2", Name: "auto-pre-send"},
+		{ID: "cp-3", Name: "auth-tests", Git: checkpoint.GitState{Branch: "main", Commit: "2222222222"}},
+	} {
+		cp.SessionName = "proj"
+		cp.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		if err := storage.Save(cp); err != nil {
+			t.Fatalf("Save(%s): %v", cp.ID, err)
+		}
+	}
+	status := "On branch main\nChanges not staged for commit:\n\tmodified:   auth.go\n\tdeleted:    legacy.go\n\nUntracked files:\n\tauth_test.go\n"
+	if err := storage.SaveGitStatus("proj", "cp-3", status); err != nil {
+		t.Fatalf("SaveGitStatus: %v", err)
+	}
+	latest, err := storage.Load("proj", "cp-3")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	latest.Git.StatusFile = checkpoint.GitStatusFile
+	if err := storage.Save(latest); err != nil {
+		t.Fatalf("Save with status file: %v", err)
+	}
+
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetContext(t.Context())
+	cmd.SetOut(&buf)
+	out := filepath.Join(t.TempDir(), "draft.md")
+	if err := runHandoffDraft(cmd, storage, "proj", 5, out); err != nil {
+		t.Fatalf("runHandoffDraft: %v", err)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	draft := string(data)
+	for _, want := range []string{
+		"# Handoff: proj",
+		"## Done This Session\n- Wrote the auth handler\n- auth-tests\n",
+		"**Created:**\n- auth_test.go\n",
+		"**Modified:**\n- auth.go\n",
+		"**Deleted:**\n- legacy.go\n",
+	} {
+		if !strings.Contains(draft, want) {
+			t.Errorf("draft missing %q:\n%s", want, draft)
+		}
+	}
+	if strings.Contains(draft, "auto-pre-send") {
+		t.Error("draft should skip auto-checkpoints")
+	}
+
+	if err := runHandoffDraft(cmd, storage, "empty", 5, ""); err == nil || !strings.Contains(err.Error(), "no checkpoints") {
+		t.Errorf("runHandoffDraft(empty) error = %v, want no checkpoints", err)
+	}
+}
+
+func TestRunHandoffMerge(t *testing.T) {
+	dir := t.TempDir()
+	writer := handoff.NewWriter(dir)
+	first := handoff.New("proj").WithGoalAndNow("Design auth", "Write handler").AddDecision("store", "postgres").MarkCreated("api.md")
+	second := handoff.New("proj").WithGoalAndNow("Ship auth", "Add tests").AddDecision("store", "sqlite").MarkCreated("api.md", "handler.go")
+	var paths []string
+	for i, h := range []*handoff.Handoff{first, second} {
+		path, err := writer.Write(h, fmt.Sprintf("part%d", i))
+		if err != nil {
+			t.Fatalf("write handoff: %v", err)
+		}
+		paths = append(paths, path)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetContext(t.Context())
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+	if err := runHandoffMerge(cmd, paths, ""); err != nil {
+		t.Fatalf("runHandoffMerge: %v", err)
+	}
+	md := stdout.String()
+	for _, want := range []string{
+		"## Goal\nShip auth\n",
+		"- **store:** sqlite (overrides earlier: postgres)\n",
+		"**Created:**\n- api.md\n- handler.go\n",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("merged markdown missing %q:\n%s", want, md)
+		}
+	}
+	if !strings.Contains(stderr.String(), `decision "store" changed from "postgres" to "sqlite"`) {
+		t.Errorf("stderr = %q, want conflict warning", stderr.String())
+	}
+}
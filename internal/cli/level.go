@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -10,10 +11,13 @@ import (
 
 	"github.com/Dicklesworthstone/ntm/internal/cli/tiers"
 	"github.com/Dicklesworthstone/ntm/internal/config"
+	"github.com/Dicklesworthstone/ntm/internal/output"
 	"github.com/Dicklesworthstone/ntm/internal/tui/theme"
 )
 
 func newLevelCmd() *cobra.Command {
+	var format string
+
 	cmd := &cobra.Command{
 		Use:   "level",
 		Short: "View and change CLI proficiency tier",
@@ -24,17 +28,29 @@ NTM uses a tiered command system to avoid overwhelming new users:
   - Journeyman (Tier 2): Full standard commands
   - Master (Tier 3): Advanced features including robot mode
 
+Experience (XP) is earned from recorded actions: spawning sessions and
+running ensembles count most. 'ntm level' shows your progress toward the
+next tier and what it unlocks.
+
 Examples:
-  ntm level              # Show current tier and stats
+  ntm level              # Show current tier, XP, and next unlock
+  ntm level --format=json # Machine-readable progress for dashboards
   ntm level up           # Promote to next tier
   ntm level down         # Demote to previous tier
   ntm level master       # Jump to Master tier
   ntm level apprentice   # Reset to Apprentice tier`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runLevelShow()
+			switch format {
+			case "text", "json":
+			default:
+				return fmt.Errorf("invalid --format %q (want text or json)", format)
+			}
+			return runLevelShow(jsonOutput || format == "json")
 		},
 	}
 
+	cmd.Flags().StringVar(&format, "format", "text", "Output format: text or json")
+
 	cmd.AddCommand(
 		newLevelUpCmd(),
 		newLevelDownCmd(),
@@ -47,16 +63,69 @@ Examples:
 	return cmd
 }
 
-func runLevelShow() error {
+// LevelStatus is the JSON output of `ntm level`.
+type LevelStatus struct {
+	Tier         int               `json:"tier"`
+	TierName     string            `json:"tier_name"`
+	XP           int               `json:"xp"`
+	NextTier     int               `json:"next_tier,omitempty"`
+	NextTierName string            `json:"next_tier_name,omitempty"`
+	NextTierXP   int               `json:"next_tier_xp,omitempty"`
+	Progress     float64           `json:"progress"` // 0-1 toward NextTier; 1 at Master
+	Unlocks      string            `json:"unlocks,omitempty"`
+	Stats        config.UsageStats `json:"stats"`
+	DaysUsing    int               `json:"days_using"`
+}
+
+// buildLevelStatus summarizes cfg's tier and XP progress toward the next tier.
+func buildLevelStatus(cfg *config.ProficiencyConfig) LevelStatus {
+	tier := cfg.GetTier()
+	stats := cfg.GetUsageStats()
+	status := LevelStatus{
+		Tier:      int(tier),
+		TierName:  tier.String(),
+		XP:        stats.XP(),
+		Progress:  1,
+		Stats:     stats,
+		DaysUsing: cfg.DaysSinceFirstUse(),
+	}
+	if tier >= tiers.TierMaster {
+		return status
+	}
+
+	next := tier + 1
+	status.NextTier = int(next)
+	status.NextTierName = next.String()
+	status.NextTierXP = config.TierXP(next)
+	status.Unlocks = getUnlocksDescription(next)
+
+	from := config.TierXP(tier)
+	span := status.NextTierXP - from
+	status.Progress = float64(status.XP-from) / float64(span)
+	if status.Progress < 0 {
+		status.Progress = 0
+	}
+	if status.Progress > 1 {
+		status.Progress = 1
+	}
+	return status
+}
+
+func runLevelShow(asJSON bool) error {
 	cfg, err := config.LoadProficiency()
 	if err != nil {
 		return fmt.Errorf("failed to load proficiency config: %w", err)
 	}
 
+	status := buildLevelStatus(cfg)
+	if asJSON {
+		return output.WriteJSON(os.Stdout, status, true)
+	}
+
 	t := theme.Current()
 	currentTier := cfg.GetTier()
-	stats := cfg.GetUsageStats()
-	days := cfg.DaysSinceFirstUse()
+	stats := status.Stats
+	days := status.DaysUsing
 
 	// Header
 	headerStyle := lipgloss.NewStyle().
@@ -94,7 +163,9 @@ func runLevelShow() error {
 	fmt.Printf("    %s %s\n", labelStyle.Render("Commands run:"), valueStyle.Render(fmt.Sprintf("%d", stats.CommandsRun)))
 	fmt.Printf("    %s %s\n", labelStyle.Render("Sessions created:"), valueStyle.Render(fmt.Sprintf("%d", stats.SessionsCreated)))
 	fmt.Printf("    %s %s\n", labelStyle.Render("Prompts sent:"), valueStyle.Render(fmt.Sprintf("%d", stats.PromptsSent)))
-	fmt.Printf("    %s %s\n\n", labelStyle.Render("Using NTM for:"), valueStyle.Render(fmt.Sprintf("%d days", days)))
+	fmt.Printf("    %s %s\n", labelStyle.Render("Ensembles run:"), valueStyle.Render(fmt.Sprintf("%d", stats.EnsemblesRun)))
+	fmt.Printf("    %s %s\n", labelStyle.Render("Using NTM for:"), valueStyle.Render(fmt.Sprintf("%d days", days)))
+	fmt.Printf("    %s %s\n\n", labelStyle.Render("Experience:"), valueStyle.Render(fmt.Sprintf("%d XP", status.XP)))
 
 	// Next tier info
 	if currentTier < tiers.TierMaster {
@@ -104,7 +175,9 @@ func runLevelShow() error {
 			Bold(true)
 
 		fmt.Printf("  %s %s\n", statsHeaderStyle.Render("Next tier:"), nextStyle.Render(nextTier.String()))
-		fmt.Printf("    %s\n", descStyle.Render(getUnlocksDescription(nextTier)))
+		fmt.Printf("    %s %s\n", renderProgressBar(status.Progress*100, 30),
+			valueStyle.Render(fmt.Sprintf("%d%% (%d/%d XP)", int(status.Progress*100), status.XP, status.NextTierXP)))
+		fmt.Printf("    %s\n", descStyle.Render(status.Unlocks))
 		fmt.Println()
 
 		// Promotion suggestion
@@ -320,6 +393,24 @@ func getTierColor(tier tiers.Tier, t theme.Theme) lipgloss.Color {
 	}
 }
 
+// recordProficiencyAction applies record to the stored usage stats and
+// announces any achievement it unlocks. Errors are ignored: XP tracking must
+// never fail the command being counted.
+func recordProficiencyAction(record func(*config.ProficiencyConfig) error) {
+	cfg, err := config.LoadProficiency()
+	if err != nil {
+		return
+	}
+	if err := record(cfg); err != nil {
+		return
+	}
+	toast := io.Writer(os.Stderr)
+	if IsJSONOutput() {
+		toast = io.Discard
+	}
+	_ = unlockAchievements(toast, cfg.GetUsageStats())
+}
+
 func getUnlocksDescription(tier tiers.Tier) string {
 	switch tier {
 	case tiers.TierJourneyman:
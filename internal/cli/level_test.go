@@ -14,6 +14,7 @@ func setupTestProficiency(t *testing.T) (cleanup func()) {
 	t.Helper()
 	tmpDir := t.TempDir()
 	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+	t.Setenv("XDG_STATE_HOME", filepath.Join(tmpDir, "state"))
 	t.Setenv("NTM_CONFIG", "")
 	return func() {}
 }
@@ -343,6 +344,48 @@ func TestGetUnlocksDescription(t *testing.T) {
 	}
 }
 
+func TestBuildLevelStatusProgress(t *testing.T) {
+	cleanup := setupTestProficiency(t)
+	defer cleanup()
+	t.Setenv("NTM_PROFICIENCY_TIER", "")
+
+	recordProficiencyAction((*config.ProficiencyConfig).IncrementEnsemble)
+	for i := 0; i < 5; i++ {
+		recordProficiencyAction((*config.ProficiencyConfig).IncrementSession)
+	}
+
+	cfg, _ := config.LoadProficiency()
+	status := buildLevelStatus(cfg)
+	wantXP := config.XPPerEnsemble + 5*config.XPPerSession
+	if status.XP != wantXP || status.Stats.EnsemblesRun != 1 || status.Stats.SessionsCreated != 5 {
+		t.Fatalf("status = %+v, want %d XP from 1 ensemble and 5 sessions", status, wantXP)
+	}
+	if status.NextTierName != "Journeyman" || status.NextTierXP != config.TierXP(tiers.TierJourneyman) {
+		t.Errorf("next tier = %s at %d XP", status.NextTierName, status.NextTierXP)
+	}
+	if want := float64(wantXP) / float64(status.NextTierXP); status.Progress != want {
+		t.Errorf("Progress = %v, want %v", status.Progress, want)
+	}
+	if status.Unlocks != getUnlocksDescription(tiers.TierJourneyman) {
+		t.Errorf("Unlocks = %q", status.Unlocks)
+	}
+
+	// Jumping tiers manually leaves XP below the tier's threshold; progress
+	// toward the next one clamps at zero rather than going negative.
+	if err := cfg.SetTier(tiers.TierJourneyman, "manual"); err != nil {
+		t.Fatal(err)
+	}
+	if status := buildLevelStatus(cfg); status.Progress != 0 || status.NextTierName != "Master" {
+		t.Errorf("journeyman status = %+v, want 0 progress toward Master", status)
+	}
+	if err := cfg.SetTier(tiers.TierMaster, "manual"); err != nil {
+		t.Fatal(err)
+	}
+	if status := buildLevelStatus(cfg); status.Progress != 1 || status.NextTier != 0 || status.Unlocks != "" {
+		t.Errorf("master status = %+v, want complete with no next tier", status)
+	}
+}
+
 func TestLevelPromotionHistoryTracking(t *testing.T) {
 	cleanup := setupTestProficiency(t)
 	defer cleanup()
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"sort"
@@ -20,6 +21,7 @@ import (
 	"github.com/Dicklesworthstone/ntm/internal/redaction"
 	"github.com/Dicklesworthstone/ntm/internal/status"
 	"github.com/Dicklesworthstone/ntm/internal/tmux"
+	"github.com/Dicklesworthstone/ntm/internal/util"
 )
 
 func newMailCmd() *cobra.Command {
@@ -39,13 +41,20 @@ This is the CLI equivalent of the Agent Mail web UI at /mail/{project}/overseer/
 
 Examples:
   ntm mail send myproject --to GreenCastle "Please review the API changes"
-  ntm mail send myproject --all "Checkpoint: sync and report status"`,
+  ntm mail send myproject --all "Checkpoint: sync and report status"
+  ntm mail thread myproject 42         # Show a whole conversation`,
 	}
 
 	cmd.AddCommand(newMailSendCmd())
 	cmd.AddCommand(newMailInboxCmdReal())
 	cmd.AddCommand(newMailReadCmd())
 	cmd.AddCommand(newMailAckCmd())
+	cmd.AddCommand(newMailStatsCmd())
+	cmd.AddCommand(newMailWatchCmd())
+	cmd.AddCommand(newMailThreadCmd())
+	cmd.AddCommand(newMailSearchCmd())
+	cmd.AddCommand(newMailRulesCmd())
+	cmd.AddCommand(newMailApplyRulesCmd())
 
 	return cmd
 }
@@ -55,6 +64,7 @@ func newMailSendCmd() *cobra.Command {
 		to                []string
 		subject           string
 		threadID          string
+		replyTo           int
 		all               bool
 		fromFile          string
 		preparedRedaction string
@@ -87,6 +97,7 @@ Examples:
   ntm mail send myproject --to GreenCastle "Please review the API changes"
   ntm mail send myproject --all "Stop current work and checkpoint"
   ntm mail send myproject --to BlueLake --to RedStone --thread FEAT-123 "Status update"
+  ntm mail send myproject --to BlueLake --reply-to 42 "Go ahead"
   ntm mail send myproject --all --file ./instructions.md`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -97,7 +108,19 @@ Examples:
 			// in this closure, so reset them after each execution or
 			// omitted flags can leak into the next Execute() call.
 			currentPrepared := preparedRedaction
-			defer resetMailSendLocalFlags(cmd, &to, &subject, &threadID, &all, &fromFile, &preparedRedaction)
+			defer resetMailSendLocalFlags(cmd, &to, &subject, &threadID, &replyTo, &all, &fromFile, &preparedRedaction)
+
+			// A reply is filed in a thread named after its parent's ID,
+			// which is how `ntm mail thread` finds the parent.
+			if replyTo != 0 {
+				if replyTo < 0 {
+					return fmt.Errorf("invalid --reply-to %d", replyTo)
+				}
+				if threadID != "" {
+					return fmt.Errorf("--reply-to and --thread are mutually exclusive")
+				}
+				threadID = strconv.Itoa(replyTo)
+			}
 
 			// --prepared-redaction is mutually exclusive with positional
 			// body, --file, and the editor flow: the handle IS the body
@@ -164,6 +187,7 @@ Examples:
 	cmd.Flags().StringArrayVar(&to, "to", nil, "recipient agent name (e.g., GreenCastle)")
 	cmd.Flags().StringVarP(&subject, "subject", "s", "", "message subject (auto-derived if not provided)")
 	cmd.Flags().StringVar(&threadID, "thread", "", "thread ID for conversation continuity")
+	cmd.Flags().IntVar(&replyTo, "reply-to", 0, "message ID this message replies to (threads it under that message)")
 	cmd.Flags().BoolVar(&all, "all", false, "send to all registered agents in project")
 	cmd.Flags().StringVarP(&fromFile, "file", "f", "", "read message body from file")
 	cmd.Flags().StringVar(&preparedRedaction, "prepared-redaction", "", "Consume a token-handle from `ntm redact prepare-mail` and use its stashed body as the message (raw token never enters this command's args/env/logs; see ntm#126)")
@@ -171,14 +195,15 @@ Examples:
 	return cmd
 }
 
-func resetMailSendLocalFlags(cmd *cobra.Command, to *[]string, subject, threadID *string, all *bool, fromFile, preparedRedaction *string) {
+func resetMailSendLocalFlags(cmd *cobra.Command, to *[]string, subject, threadID *string, replyTo *int, all *bool, fromFile, preparedRedaction *string) {
 	*to = nil
 	*subject = ""
 	*threadID = ""
+	*replyTo = 0
 	*all = false
 	*fromFile = ""
 	*preparedRedaction = ""
-	for _, name := range []string{"to", "subject", "thread", "all", "file", "prepared-redaction"} {
+	for _, name := range []string{"to", "subject", "thread", "reply-to", "all", "file", "prepared-redaction"} {
 		if flag := cmd.Flags().Lookup(name); flag != nil {
 			flag.Changed = false
 		}
@@ -475,30 +500,92 @@ func runMailInbox(cmd *cobra.Command, client mailInboxClient, session string, se
 		}
 	}
 
+	msgs, err := fetchAggregatedMail(ctx, client, projectKey, targetAgents, urgent, limit, false)
+	if err != nil {
+		return err
+	}
+	if state, err := loadMailRulesState(projectKey); err == nil {
+		for i := range msgs {
+			msgs[i].Tags = state.Tags[strconv.Itoa(msgs[i].ID)]
+		}
+	}
+	if len(msgs) == 0 {
+		if jsonFmt {
+			return json.NewEncoder(cmd.OutOrStdout()).Encode([]aggregatedMessage{})
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), "Inbox empty")
+		return nil
+	}
+
+	if jsonFmt {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(msgs)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Project Inbox: %s\n", sanitizeMailDisplayField(filepath.Base(projectKey)))
+	// Threads collapse to their latest message; `ntm mail thread` expands them.
+	for _, thread := range threadMailMessages(msgs) {
+		m := thread.Messages[len(thread.Messages)-1]
+		prefix := ""
+		if strings.EqualFold(m.Importance, "urgent") || strings.EqualFold(m.Importance, "high") {
+			prefix = "[URGENT] "
+		}
+		subject := sanitizeMailDisplayField(m.Subject)
+		from := sanitizeMailDisplayField(m.From)
+		var recipients []string
+		for _, r := range m.Recipients {
+			recipients = append(recipients, sanitizeMailDisplayField(r))
+		}
+
+		if len(m.Tags) > 0 {
+			subject = "[" + strings.Join(m.Tags, ", ") + "] " + subject
+		}
+		if thread.Replies > 0 {
+			replies := "replies"
+			if thread.Replies == 1 {
+				replies = "reply"
+			}
+			subject += fmt.Sprintf(" (%d %s, thread #%d)", thread.Replies, replies, thread.ID)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "%s%s\n", prefix, subject)
+		if from != "" || len(recipients) > 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "%s → %s\n", from, strings.Join(recipients, ", "))
+		}
+	}
+	return nil
+}
+
+// fetchAggregatedMail fetches each agent's inbox and merges the results into
+// one list ordered by message ID, recording every agent that received each
+// message.
+func fetchAggregatedMail(ctx context.Context, client mailInboxClient, projectKey string, agents []string, urgent bool, limit int, includeBodies bool) ([]aggregatedMessage, error) {
 	// Aggregated messages by ID (to dedupe across multiple agents)
 	agg := make(map[int]*aggregatedMessage)
 
-	for _, name := range targetAgents {
+	for _, name := range agents {
 		if ctxErr := ctx.Err(); ctxErr != nil {
-			return fmt.Errorf("fetching inbox: %w", ctxErr)
+			return nil, fmt.Errorf("fetching inbox: %w", ctxErr)
 		}
 		msgs, err := client.FetchInbox(ctx, agentmail.FetchInboxOptions{
-			ProjectKey: projectKey,
-			AgentName:  name,
-			UrgentOnly: urgent,
-			Limit:      limit,
+			ProjectKey:    projectKey,
+			AgentName:     name,
+			UrgentOnly:    urgent,
+			Limit:         limit,
+			IncludeBodies: includeBodies,
 		})
 		if err != nil {
 			if ctxErr := ctx.Err(); ctxErr != nil {
-				return fmt.Errorf("fetching inbox for %s: %w", name, ctxErr)
+				return nil, fmt.Errorf("fetching inbox for %s: %w", name, ctxErr)
 			}
 			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
-				return fmt.Errorf("fetching inbox for %s: %w", name, err)
+				return nil, fmt.Errorf("fetching inbox for %s: %w", name, err)
 			}
-			return err
+			return nil, err
 		}
 		if ctxErr := ctx.Err(); ctxErr != nil {
-			return fmt.Errorf("fetching inbox for %s: %w", name, ctxErr)
+			return nil, fmt.Errorf("fetching inbox for %s: %w", name, ctxErr)
 		}
 		for _, msg := range msgs {
 			entry, ok := agg[msg.ID]
@@ -513,55 +600,803 @@ func runMailInbox(cmd *cobra.Command, client mailInboxClient, session string, se
 					Kind:        msg.Kind,
 					BodyMD:      msg.BodyMD,
 				}
+				if msg.ThreadID != nil {
+					entry.ThreadID = *msg.ThreadID
+					if parent, err := strconv.Atoi(entry.ThreadID); err == nil && parent != msg.ID {
+						entry.InReplyTo = parent
+					}
+				}
 				agg[msg.ID] = entry
 			}
 			entry.Recipients = append(entry.Recipients, name)
 		}
 	}
 
-	if len(agg) == 0 {
-		if jsonFmt {
-			return json.NewEncoder(cmd.OutOrStdout()).Encode([]aggregatedMessage{})
-		}
-		fmt.Fprintln(cmd.OutOrStdout(), "Inbox empty")
-		return nil
-	}
-
-	var msgs []aggregatedMessage
+	msgs := make([]aggregatedMessage, 0, len(agg))
 	for _, m := range agg {
 		msgs = append(msgs, *m)
 	}
 
 	// Simple deterministic order: newest ID last not available; just sort by ID.
 	sort.Slice(msgs, func(i, j int) bool { return msgs[i].ID < msgs[j].ID })
+	return msgs, nil
+}
+
+// mailConversation is a thread: a root message and every reply filed under it.
+type mailConversation struct {
+	ID       int                 `json:"id"` // the earliest message in the thread
+	ThreadID string              `json:"thread_id,omitempty"`
+	Subject  string              `json:"subject"`
+	Replies  int                 `json:"replies"`
+	Messages []aggregatedMessage `json:"messages"` // oldest first
+}
+
+// threadMailMessages groups msgs (ordered by ID) into threads. Messages that
+// share a thread ID, or whose numeric thread ID references another message,
+// belong together. Threads are ordered by their latest message.
+func threadMailMessages(msgs []aggregatedMessage) []mailConversation {
+	parent := make(map[string]string)
+	var find func(string) string
+	find = func(k string) string {
+		p, ok := parent[k]
+		if !ok || p == k {
+			parent[k] = k
+			return k
+		}
+		root := find(p)
+		parent[k] = root
+		return root
+	}
+	union := func(a, b string) {
+		if ra, rb := find(a), find(b); ra != rb {
+			parent[rb] = ra
+		}
+	}
+	msgKey := func(id int) string { return "msg:" + strconv.Itoa(id) }
+
+	for _, m := range msgs {
+		find(msgKey(m.ID))
+		if m.ThreadID != "" {
+			union(msgKey(m.ID), "thread:"+m.ThreadID)
+		}
+		if m.InReplyTo != 0 {
+			union(msgKey(m.ID), msgKey(m.InReplyTo))
+		}
+	}
+
+	byRoot := make(map[string]*mailConversation)
+	var order []string
+	for _, m := range msgs {
+		root := find(msgKey(m.ID))
+		t, ok := byRoot[root]
+		if !ok {
+			t = &mailConversation{ID: m.ID, ThreadID: m.ThreadID, Subject: m.Subject}
+			byRoot[root] = t
+			order = append(order, root)
+		}
+		t.Messages = append(t.Messages, m)
+	}
+
+	threads := make([]mailConversation, 0, len(order))
+	for _, root := range order {
+		t := byRoot[root]
+		t.Replies = len(t.Messages) - 1
+		threads = append(threads, *t)
+	}
+	sort.SliceStable(threads, func(i, j int) bool {
+		return threads[i].Messages[len(threads[i].Messages)-1].ID < threads[j].Messages[len(threads[j].Messages)-1].ID
+	})
+	return threads
+}
+
+// newMailThreadCmd renders one conversation in full.
+func newMailThreadCmd() *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "thread <session> <message-id>",
+		Short: "Show the full conversation a message belongs to",
+		Long: `Show every message in the thread containing <message-id>, oldest first,
+with bodies. Any message in the thread works; the inbox lists the thread ID
+of collapsed conversations.
+
+Examples:
+  ntm mail thread myproject 42
+  ntm mail thread myproject 42 --json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := parseMessageIDs(args[1:])
+			if err != nil {
+				return err
+			}
+			if limit <= 0 {
+				return fmt.Errorf("--limit must be greater than 0")
+			}
+			return runMailThread(cmd, nil, args[0], ids[0], limit, IsJSONOutput())
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 200, "Max messages to fetch per agent inbox")
+
+	return cmd
+}
+
+func runMailThread(cmd *cobra.Command, client mailInboxClient, session string, messageID, limit int, jsonFmt bool) error {
+	parent, err := requireMailCommandContext(cmd, "mail thread")
+	if err != nil {
+		return err
+	}
+	_, projectKey, err := resolveAgentMailCommandScope(parent, session)
+	if err != nil {
+		return err
+	}
+
+	if client == nil {
+		client = newAgentMailClient(projectKey)
+	}
+	ctx, cancel := context.WithTimeout(parent, 30*time.Second)
+	defer cancel()
+
+	if !client.IsAvailableContext(ctx) {
+		return agentMailUnavailableError(ctx, client, "agent mail server not available")
+	}
+
+	agents, err := client.ListProjectAgents(ctx, projectKey)
+	if err != nil {
+		return fmt.Errorf("listing agents: %w", err)
+	}
+	// Include HumanOverseer: agents' replies to overseer mail land there.
+	names := make([]string, 0, len(agents))
+	for _, a := range agents {
+		names = append(names, a.Name)
+	}
+
+	msgs, err := fetchAggregatedMail(ctx, client, projectKey, names, false, limit, true)
+	if err != nil {
+		return err
+	}
+
+	var thread *mailConversation
+	for _, t := range threadMailMessages(msgs) {
+		for _, m := range t.Messages {
+			if m.ID == messageID {
+				thread = &t
+				break
+			}
+		}
+		if thread != nil {
+			break
+		}
+	}
+	if thread == nil {
+		return fmt.Errorf("message %d not found in any agent inbox", messageID)
+	}
 
 	if jsonFmt {
 		enc := json.NewEncoder(cmd.OutOrStdout())
 		enc.SetIndent("", "  ")
-		return enc.Encode(msgs)
+		return enc.Encode(thread)
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "Project Inbox: %s\n", sanitizeMailDisplayField(filepath.Base(projectKey)))
-	for _, m := range msgs {
-		prefix := ""
-		if strings.EqualFold(m.Importance, "urgent") || strings.EqualFold(m.Importance, "high") {
-			prefix = "[URGENT] "
-		}
-		subject := sanitizeMailDisplayField(m.Subject)
-		from := sanitizeMailDisplayField(m.From)
+	w := cmd.OutOrStdout()
+	fmt.Fprintf(w, "Thread #%d: %s (%d messages)\n", thread.ID, sanitizeMailDisplayField(thread.Subject), len(thread.Messages))
+	for _, m := range thread.Messages {
 		var recipients []string
 		for _, r := range m.Recipients {
 			recipients = append(recipients, sanitizeMailDisplayField(r))
 		}
+		fmt.Fprintf(w, "\n#%d  %s  %s → %s\n", m.ID, m.CreatedTS.Local().Format("2006-01-02 15:04"),
+			sanitizeMailDisplayField(m.From), strings.Join(recipients, ", "))
+		fmt.Fprintf(w, "  %s\n", sanitizeMailDisplayField(m.Subject))
+		for _, line := range strings.Split(strings.TrimSpace(status.StripANSI(m.BodyMD)), "\n") {
+			if line != "" {
+				fmt.Fprintf(w, "  %s\n", line)
+			}
+		}
+	}
+	return nil
+}
 
-		fmt.Fprintf(cmd.OutOrStdout(), "%s%s\n", prefix, subject)
-		if from != "" || len(recipients) > 0 {
-			fmt.Fprintf(cmd.OutOrStdout(), "%s → %s\n", from, strings.Join(recipients, ", "))
+// mailSearchFilter selects messages for `ntm mail search`. Empty fields match
+// everything.
+type mailSearchFilter struct {
+	From    []string  // sender is any of these
+	Subject string    // case-insensitive subject substring
+	Since   time.Time // created at or after
+}
+
+func (f mailSearchFilter) matches(m aggregatedMessage) bool {
+	if len(f.From) > 0 {
+		found := false
+		for _, from := range f.From {
+			if m.From == from {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.Subject != "" && !strings.Contains(strings.ToLower(m.Subject), strings.ToLower(f.Subject)) {
+		return false
+	}
+	return f.Since.IsZero() || !m.CreatedTS.Before(f.Since)
+}
+
+// mailSearchResult is the JSON output of `ntm mail search`.
+type mailSearchResult struct {
+	Project  string              `json:"project"`
+	Count    int                 `json:"count"`
+	Messages []aggregatedMessage `json:"messages"`
+}
+
+// newMailSearchCmd filters the project's mail by sender, subject, and age.
+func newMailSearchCmd() *cobra.Command {
+	var (
+		from    []string
+		subject string
+		since   string
+		format  string
+		limit   int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "search [session]",
+		Short: "Find messages by sender, subject, or age",
+		Long: `Search every agent inbox in the project. Filters combine with AND;
+repeated --from values combine with OR. Message IDs in the results can be
+passed to 'ntm mail read', 'ntm mail ack', or 'ntm mail thread'.`,
+		Example: `  ntm mail search --from BlueLake --subject "bug" --since 2h
+  ntm mail search myproject --from BlueLake,RedStone --format=json
+  ntm mail search --subject deploy --since 1d`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var session string
+			if len(args) > 0 {
+				session = args[0]
+			}
+			switch format {
+			case "text", "json":
+			default:
+				return fmt.Errorf("invalid --format %q (want text or json)", format)
+			}
+			if limit <= 0 {
+				return fmt.Errorf("--limit must be greater than 0")
+			}
+			filter := mailSearchFilter{Subject: strings.TrimSpace(subject)}
+			for _, name := range from {
+				name = strings.TrimSpace(name)
+				if !looksLikeAgentName(name) {
+					return fmt.Errorf("invalid --from %q: agent names look like AdjectiveNoun (e.g. BlueLake)", name)
+				}
+				filter.From = append(filter.From, name)
+			}
+			if since != "" {
+				age, err := util.ParseDuration(since)
+				if err != nil || age <= 0 {
+					return fmt.Errorf("invalid --since %q: use a duration like 30m, 2h, or 1d", since)
+				}
+				filter.Since = time.Now().Add(-age)
+			}
+			return runMailSearch(cmd, nil, session, filter, limit, IsJSONOutput() || format == "json")
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&from, "from", nil, "Only messages from this agent (repeatable or comma-separated)")
+	cmd.Flags().StringVar(&subject, "subject", "", "Only messages whose subject contains this text (case-insensitive)")
+	cmd.Flags().StringVar(&since, "since", "", "Only messages newer than this (e.g. 30m, 2h, 1d)")
+	cmd.Flags().StringVar(&format, "format", "text", "Output format: text or json")
+	cmd.Flags().IntVar(&limit, "limit", 200, "Max messages to fetch per agent inbox")
+
+	return cmd
+}
+
+func runMailSearch(cmd *cobra.Command, client mailInboxClient, session string, filter mailSearchFilter, limit int, jsonFmt bool) error {
+	parent, err := requireMailCommandContext(cmd, "mail search")
+	if err != nil {
+		return err
+	}
+
+	var projectKey string
+	if strings.TrimSpace(session) == "" {
+		_, projectKey, err = resolveAgentMailScopeWithPreference(parent, session, false)
+	} else {
+		_, projectKey, err = resolveAgentMailCommandScope(parent, session)
+	}
+	if err != nil {
+		return err
+	}
+
+	if client == nil {
+		client = newAgentMailClient(projectKey)
+	}
+	ctx, cancel := context.WithTimeout(parent, 30*time.Second)
+	defer cancel()
+
+	if !client.IsAvailableContext(ctx) {
+		return agentMailUnavailableError(ctx, client, "agent mail server not available")
+	}
+
+	agents, err := client.ListProjectAgents(ctx, projectKey)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("listing agents: %w", ctxErr)
+		}
+		return fmt.Errorf("listing agents: %w", err)
+	}
+	names := make([]string, 0, len(agents))
+	for _, a := range agents {
+		names = append(names, a.Name)
+	}
+
+	all, err := fetchAggregatedMail(ctx, client, projectKey, names, false, limit, true)
+	if err != nil {
+		return err
+	}
+	result := mailSearchResult{Project: projectKey, Messages: []aggregatedMessage{}}
+	for _, m := range all {
+		if filter.matches(m) {
+			result.Messages = append(result.Messages, m)
+		}
+	}
+	result.Count = len(result.Messages)
+
+	if jsonFmt {
+		return encodeJSONResult(cmd.OutOrStdout(), result)
+	}
+
+	w := cmd.OutOrStdout()
+	if result.Count == 0 {
+		fmt.Fprintln(w, "No matching messages")
+		return nil
+	}
+	for _, m := range result.Messages {
+		fmt.Fprintf(w, "#%-6d %s  %-16s %s\n", m.ID, m.CreatedTS.Local().Format("2006-01-02 15:04"),
+			sanitizeMailDisplayField(m.From), sanitizeMailDisplayField(m.Subject))
+		if preview := truncateSubject(status.StripANSI(m.BodyMD), 72); preview != "" {
+			fmt.Fprintf(w, "        %s\n", sanitizeMailDisplayField(preview))
+		}
+	}
+	fmt.Fprintf(w, "\n%d matching message(s)\n", result.Count)
+	return nil
+}
+
+// mailSenderStats summarizes the messages received from a single sender.
+type mailSenderStats struct {
+	Sender   string    `json:"sender"`
+	Pane     *int      `json:"pane,omitempty"`
+	Messages int       `json:"messages"`
+	Unread   int       `json:"unread"`
+	Latest   time.Time `json:"latest"`
+}
+
+// mailStats is the aggregate inbox summary emitted by `ntm mail stats`.
+type mailStats struct {
+	Project  string            `json:"project"`
+	Total    int               `json:"total"`
+	Unread   int               `json:"unread"`
+	Read     int               `json:"read"`
+	BySender []mailSenderStats `json:"by_sender"`
+}
+
+// newMailStatsCmd summarizes inbox volume by sender and read state.
+func newMailStatsCmd() *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "stats [session]",
+		Short: "Summarize inbox volume by sender and read state",
+		Long: `Summarize project inbox volume: total messages, unread count, and a
+per-sender breakdown with message counts and the latest message time.
+
+When a session is given, senders that map to a pane in that session are
+annotated with their pane index.`,
+		Example: `  ntm mail stats
+  ntm mail stats myproject
+  ntm mail stats myproject --json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var session string
+			if len(args) > 0 {
+				session = args[0]
+			}
+			return runMailStats(cmd, nil, session, limit, IsJSONOutput())
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 200, "Max messages to fetch per agent inbox")
+
+	return cmd
+}
+
+// runMailStats aggregates inbox messages across project agents into per-sender stats.
+func runMailStats(cmd *cobra.Command, client mailInboxClient, session string, limit int, jsonFmt bool) error {
+	parent, err := requireMailCommandContext(cmd, "mail stats")
+	if err != nil {
+		return err
+	}
+
+	var projectKey string
+	if strings.TrimSpace(session) == "" {
+		session, projectKey, err = resolveAgentMailScopeWithPreference(parent, session, false)
+	} else {
+		session, projectKey, err = resolveAgentMailCommandScope(parent, session)
+	}
+	if err != nil {
+		return err
+	}
+
+	if client == nil {
+		client = newAgentMailClient(projectKey)
+	}
+	ctx, cancel := context.WithTimeout(parent, 30*time.Second)
+	defer cancel()
+
+	if !client.IsAvailableContext(ctx) {
+		return agentMailUnavailableError(ctx, client, "agent mail server not available")
+	}
+
+	agents, err := client.ListProjectAgents(ctx, projectKey)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("listing agents: %w", ctxErr)
+		}
+		return fmt.Errorf("listing agents: %w", err)
+	}
+
+	var msgs []agentmail.InboxMessage
+	for _, a := range agents {
+		if a.Name == "HumanOverseer" {
+			continue
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("fetching inbox: %w", ctxErr)
+		}
+		inbox, err := client.FetchInbox(ctx, agentmail.FetchInboxOptions{
+			ProjectKey: projectKey,
+			AgentName:  a.Name,
+			Limit:      limit,
+		})
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return fmt.Errorf("fetching inbox for %s: %w", a.Name, ctxErr)
+			}
+			return fmt.Errorf("fetching inbox for %s: %w", a.Name, err)
+		}
+		msgs = append(msgs, inbox...)
+	}
+
+	var paneIndex map[string]int
+	if session != "" && tmux.SessionExists(session) {
+		if panes, err := tmux.GetPanesContext(ctx, session); err == nil {
+			registry, _ := agentmail.LoadBestSessionAgentRegistry(session, projectKey)
+			paneIndex = make(map[string]int, len(panes))
+			for _, p := range panes {
+				if name := resolvePaneAgentName(p, registry); name != "" {
+					paneIndex[name] = p.Index
+				}
+			}
+		}
+	}
+
+	stats := buildMailStats(msgs, paneIndex)
+	stats.Project = projectKey
+
+	if jsonFmt {
+		return encodeJSONResult(cmd.OutOrStdout(), stats)
+	}
+
+	w := cmd.OutOrStdout()
+	fmt.Fprintf(w, "Project Inbox: %s\n", sanitizeMailDisplayField(filepath.Base(projectKey)))
+	fmt.Fprintf(w, "Total: %d  Unread: %d  Read: %d\n", stats.Total, stats.Unread, stats.Read)
+	if len(stats.BySender) == 0 {
+		return nil
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "%-24s %-5s %8s %8s  %s\n", "SENDER", "PANE", "MESSAGES", "UNREAD", "LATEST")
+	for _, s := range stats.BySender {
+		pane := "-"
+		if s.Pane != nil {
+			pane = strconv.Itoa(*s.Pane)
+		}
+		latest := "-"
+		if !s.Latest.IsZero() {
+			latest = s.Latest.Local().Format("2006-01-02 15:04")
 		}
+		fmt.Fprintf(w, "%-24s %-5s %8d %8d  %s\n", sanitizeMailDisplayField(s.Sender), pane, s.Messages, s.Unread, latest)
 	}
 	return nil
 }
 
+// buildMailStats dedupes messages by ID and tallies them per sender. A message
+// counts as unread if any recipient's copy has not been read yet.
+func buildMailStats(msgs []agentmail.InboxMessage, paneIndex map[string]int) mailStats {
+	type entry struct {
+		from   string
+		ts     time.Time
+		unread bool
+	}
+	byID := make(map[int]*entry)
+	for _, msg := range msgs {
+		e, ok := byID[msg.ID]
+		if !ok {
+			e = &entry{from: msg.From, ts: msg.CreatedTS.Time}
+			byID[msg.ID] = e
+		}
+		if msg.ReadAt == nil {
+			e.unread = true
+		}
+	}
+
+	stats := mailStats{BySender: []mailSenderStats{}}
+	senders := make(map[string]*mailSenderStats)
+	for _, e := range byID {
+		name := strings.TrimSpace(e.from)
+		if name == "" {
+			name = "(unknown)"
+		}
+		s, ok := senders[name]
+		if !ok {
+			s = &mailSenderStats{Sender: name}
+			if idx, found := paneIndex[name]; found {
+				s.Pane = &idx
+			}
+			senders[name] = s
+		}
+		s.Messages++
+		stats.Total++
+		if e.unread {
+			s.Unread++
+			stats.Unread++
+		}
+		if e.ts.After(s.Latest) {
+			s.Latest = e.ts
+		}
+	}
+	stats.Read = stats.Total - stats.Unread
+
+	for _, s := range senders {
+		stats.BySender = append(stats.BySender, *s)
+	}
+	sort.Slice(stats.BySender, func(i, j int) bool {
+		if stats.BySender[i].Messages != stats.BySender[j].Messages {
+			return stats.BySender[i].Messages > stats.BySender[j].Messages
+		}
+		return stats.BySender[i].Sender < stats.BySender[j].Sender
+	})
+	return stats
+}
+
+// mailWatchMessage is one newly-arrived message emitted by `ntm mail watch`.
+type mailWatchMessage struct {
+	ID         int       `json:"id"`
+	From       string    `json:"from"`
+	Pane       *int      `json:"pane,omitempty"`
+	Subject    string    `json:"subject"`
+	Body       string    `json:"body,omitempty"` // truncated for display
+	Importance string    `json:"importance,omitempty"`
+	CreatedTS  time.Time `json:"created_ts"`
+	Recipients []string  `json:"recipients"`
+}
+
+const (
+	mailWatchSubjectRunes = 80
+	mailWatchBodyRunes    = 160
+)
+
+// newMailWatchCmd streams newly-arrived inbox messages until interrupted.
+func newMailWatchCmd() *cobra.Command {
+	var (
+		from     string
+		interval time.Duration
+		limit    int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "watch [session]",
+		Short: "Stream new inbox messages as they arrive",
+		Long: `Poll the project inbox and print each message as it arrives, until Ctrl+C.
+
+Messages already in the inbox when the watch starts are not shown. Each new
+message is printed once with its sender, recipients, and a truncated subject
+and body. When a session is given, senders that map to a pane in that session
+are annotated with their pane index.
+
+With --json, each new message is written as one JSON object per line.`,
+		Example: `  ntm mail watch
+  ntm mail watch myproject --from GreenCastle
+  ntm mail watch myproject --json --interval 2s`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var session string
+			if len(args) > 0 {
+				session = args[0]
+			}
+			return runMailWatch(cmd, nil, session, from, interval, limit, IsJSONOutput())
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "", "Only show messages from this sender")
+	cmd.Flags().DurationVar(&interval, "interval", 5*time.Second, "How often to poll the inbox")
+	cmd.Flags().IntVar(&limit, "limit", 50, "Max messages to fetch per agent inbox on each poll")
+
+	return cmd
+}
+
+// runMailWatch polls every project agent's inbox and emits messages whose IDs
+// were not present on the first poll. It returns nil when the command context
+// is canceled.
+func runMailWatch(cmd *cobra.Command, client mailInboxClient, session, from string, interval time.Duration, limit int, jsonFmt bool) error {
+	parent, err := requireMailCommandContext(cmd, "mail watch")
+	if err != nil {
+		return err
+	}
+	if interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	var projectKey string
+	if strings.TrimSpace(session) == "" {
+		session, projectKey, err = resolveAgentMailScopeWithPreference(parent, session, false)
+	} else {
+		session, projectKey, err = resolveAgentMailCommandScope(parent, session)
+	}
+	if err != nil {
+		return err
+	}
+
+	if client == nil {
+		client = newAgentMailClient(projectKey)
+	}
+	ctx, cancel := context.WithTimeout(parent, 30*time.Second)
+	defer cancel()
+	if !client.IsAvailableContext(ctx) {
+		return agentMailUnavailableError(ctx, client, "agent mail server not available")
+	}
+
+	var paneIndex map[string]int
+	if session != "" && tmux.SessionExists(session) {
+		if panes, err := tmux.GetPanesContext(parent, session); err == nil {
+			registry, _ := agentmail.LoadBestSessionAgentRegistry(session, projectKey)
+			paneIndex = make(map[string]int, len(panes))
+			for _, p := range panes {
+				if name := resolvePaneAgentName(p, registry); name != "" {
+					paneIndex[name] = p.Index
+				}
+			}
+		}
+	}
+
+	w := cmd.OutOrStdout()
+	enc := json.NewEncoder(w)
+	if !jsonFmt {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Watching inbox for %s every %s (Ctrl+C to stop)\n",
+			sanitizeMailDisplayField(filepath.Base(projectKey)), interval)
+	}
+
+	seen := make(map[int]bool)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for first := true; ; first = false {
+		msgs, err := pollMailWatch(parent, client, projectKey, from, limit, seen)
+		switch {
+		case parent.Err() != nil:
+			return nil
+		case err != nil:
+			// A flaky poll should not end the watch; report it and retry.
+			if jsonFmt {
+				slog.Warn("mail watch poll failed", "project", projectKey, "error", err)
+			} else {
+				fmt.Fprintf(cmd.ErrOrStderr(), "warning: %v\n", err)
+			}
+		case !first:
+			for i := range msgs {
+				if idx, ok := paneIndex[msgs[i].From]; ok {
+					msgs[i].Pane = &idx
+				}
+				if jsonFmt {
+					if err := enc.Encode(msgs[i]); err != nil {
+						return err
+					}
+					continue
+				}
+				printMailWatchMessage(w, msgs[i])
+			}
+		}
+
+		select {
+		case <-parent.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollMailWatch fetches every non-overseer inbox once and returns messages
+// not yet in seen, deduped by ID and ordered by ID. Every returned ID is
+// added to seen.
+func pollMailWatch(parent context.Context, client mailInboxClient, projectKey, from string, limit int, seen map[int]bool) ([]mailWatchMessage, error) {
+	ctx, cancel := context.WithTimeout(parent, 30*time.Second)
+	defer cancel()
+
+	agents, err := client.ListProjectAgents(ctx, projectKey)
+	if err != nil {
+		return nil, fmt.Errorf("listing agents: %w", err)
+	}
+
+	fresh := make(map[int]*mailWatchMessage)
+	for _, a := range agents {
+		if a.Name == "HumanOverseer" {
+			continue
+		}
+		inbox, err := client.FetchInbox(ctx, agentmail.FetchInboxOptions{
+			ProjectKey: projectKey,
+			AgentName:  a.Name,
+			Limit:      limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fetching inbox for %s: %w", a.Name, err)
+		}
+		for _, msg := range inbox {
+			if seen[msg.ID] {
+				continue
+			}
+			if from != "" && !strings.EqualFold(strings.TrimSpace(msg.From), strings.TrimSpace(from)) {
+				continue
+			}
+			entry, ok := fresh[msg.ID]
+			if !ok {
+				entry = &mailWatchMessage{
+					ID:         msg.ID,
+					From:       msg.From,
+					Subject:    msg.Subject,
+					Body:       truncateRunes(sanitizeMailDisplayField(msg.BodyMD), mailWatchBodyRunes, "..."),
+					Importance: msg.Importance,
+					CreatedTS:  msg.CreatedTS.Time,
+				}
+				fresh[msg.ID] = entry
+			}
+			entry.Recipients = append(entry.Recipients, a.Name)
+		}
+	}
+
+	msgs := make([]mailWatchMessage, 0, len(fresh))
+	for id, m := range fresh {
+		seen[id] = true
+		msgs = append(msgs, *m)
+	}
+	sort.Slice(msgs, func(i, j int) bool { return msgs[i].ID < msgs[j].ID })
+	return msgs, nil
+}
+
+func printMailWatchMessage(w io.Writer, m mailWatchMessage) {
+	prefix := ""
+	if strings.EqualFold(m.Importance, "urgent") || strings.EqualFold(m.Importance, "high") {
+		prefix = "[URGENT] "
+	}
+	from := sanitizeMailDisplayField(m.From)
+	if from == "" {
+		from = "(unknown)"
+	}
+	if m.Pane != nil {
+		from = fmt.Sprintf("%s (pane %d)", from, *m.Pane)
+	}
+	var recipients []string
+	for _, r := range m.Recipients {
+		recipients = append(recipients, sanitizeMailDisplayField(r))
+	}
+	ts := m.CreatedTS
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	subject := truncateRunes(sanitizeMailDisplayField(m.Subject), mailWatchSubjectRunes, "...")
+	fmt.Fprintf(w, "%s %s%s → %s: %s\n", ts.Local().Format("15:04:05"), prefix, from, strings.Join(recipients, ", "), subject)
+	if m.Body != "" {
+		fmt.Fprintf(w, "  %s\n", m.Body)
+	}
+}
+
 func requireMailCommandContext(cmd *cobra.Command, operation string) (context.Context, error) {
 	if cmd == nil || cmd.Context() == nil {
 		return nil, fmt.Errorf("%s requires a command context", operation)
@@ -1128,9 +1963,7 @@ func truncateSubject(body string, maxLen int) string {
 	subject := strings.TrimSpace(lines[0])
 
 	// Remove markdown heading prefix
-	subject = strings.TrimPrefix(subject, "# ")
-	subject = strings.TrimPrefix(subject, "## ")
-	subject = strings.TrimPrefix(subject, "### ")
+	subject, _ = stripMarkdownHeading(subject)
 
 	if len(subject) > maxLen {
 		return subject[:maxLen-3] + "..."
@@ -1138,6 +1971,15 @@ func truncateSubject(body string, maxLen int) string {
 	return subject
 }
 
+// stripMarkdownHeading removes a leading "# ", "## ", or "### " heading
+// marker and reports whether line was a heading.
+func stripMarkdownHeading(line string) (string, bool) {
+	stripped := strings.TrimPrefix(line, "# ")
+	stripped = strings.TrimPrefix(stripped, "## ")
+	stripped = strings.TrimPrefix(stripped, "### ")
+	return stripped, stripped != line
+}
+
 func mailJSONWriter(cmd *cobra.Command) io.Writer {
 	return cmd.Root().OutOrStdout()
 }
@@ -1238,4 +2080,11 @@ type aggregatedMessage struct {
 	Kind        string    `json:"kind"`
 	BodyMD      string    `json:"body_md,omitempty"` // truncated for display
 	Recipients  []string  `json:"recipients"`
+	ThreadID    string    `json:"thread_id,omitempty"`
+	// InReplyTo is the message this one threads under. Agent Mail files a
+	// reply in its parent's thread, or in a thread named after the parent's
+	// ID when the parent had none, so a numeric thread ID references a message.
+	InReplyTo int `json:"in_reply_to,omitempty"`
+	// Tags were applied by inbox rules (see `ntm mail rules`).
+	Tags []string `json:"tags,omitempty"`
 }
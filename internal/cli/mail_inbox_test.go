@@ -3,7 +3,9 @@ package cli
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -382,3 +384,209 @@ func TestRunMailInboxSessionAgentsUsesSavedRegistryIdentity(t *testing.T) {
 		t.Fatalf("expected registry-backed message in output, got:\n%s", output)
 	}
 }
+
+func TestRunMailStatsAggregatesBySender(t *testing.T) {
+	readAt := &agentmail.FlexTime{Time: time.Now()}
+	older := time.Now().Add(-time.Hour)
+	newer := time.Now()
+	client := &MockMailClient{
+		Available: true,
+		Agents:    []agentmail.Agent{{Name: "BlueLake"}, {Name: "RedStone"}, {Name: "HumanOverseer"}},
+		Inboxes: map[string][]agentmail.InboxMessage{
+			"BlueLake": {
+				{ID: 1, From: "GreenCastle", CreatedTS: agentmail.FlexTime{Time: older}},
+				{ID: 2, From: "GreenCastle", CreatedTS: agentmail.FlexTime{Time: newer}, ReadAt: readAt},
+			},
+			"RedStone": {
+				// Same message delivered to two recipients counts once.
+				{ID: 1, From: "GreenCastle", CreatedTS: agentmail.FlexTime{Time: older}, ReadAt: readAt},
+				{ID: 3, From: "BlueLake", CreatedTS: agentmail.FlexTime{Time: older}, ReadAt: readAt},
+			},
+		},
+	}
+	cmd := &cobra.Command{}
+	cmd.SetContext(t.Context())
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+
+	if err := runMailStats(cmd, client, "", 50, false); err != nil {
+		t.Fatalf("runMailStats() error = %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{"Total: 3  Unread: 1  Read: 2", "GreenCastle", "BlueLake"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q, got:\n%s", want, out)
+		}
+	}
+
+	stats := buildMailStats(append(client.Inboxes["BlueLake"], client.Inboxes["RedStone"]...), map[string]int{"BlueLake": 2})
+	if len(stats.BySender) != 2 || stats.BySender[0].Sender != "GreenCastle" {
+		t.Fatalf("BySender = %+v, want GreenCastle first", stats.BySender)
+	}
+	if got := stats.BySender[0]; got.Messages != 2 || got.Unread != 1 || !got.Latest.Equal(newer) {
+		t.Errorf("GreenCastle stats = %+v", got)
+	}
+	if got := stats.BySender[1]; got.Pane == nil || *got.Pane != 2 {
+		t.Errorf("BlueLake pane = %v, want 2", got.Pane)
+	}
+}
+
+// pollingMailClient runs a hook before each ListProjectAgents call so a test
+// can mutate inboxes between mail watch polls.
+type pollingMailClient struct {
+	*MockMailClient
+	beforeList func(call int)
+}
+
+func (c *pollingMailClient) ListProjectAgents(ctx context.Context, projectKey string) ([]agentmail.Agent, error) {
+	c.beforeList(c.ListAgentsCalls + 1)
+	return c.MockMailClient.ListProjectAgents(ctx, projectKey)
+}
+
+func TestRunMailWatchEmitsOnlyNewMessages(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	mock := &MockMailClient{
+		Available: true,
+		Agents:    []agentmail.Agent{{Name: "BlueLake"}, {Name: "RedStone"}},
+		Inboxes: map[string][]agentmail.InboxMessage{
+			"BlueLake": {{ID: 1, From: "GreenCastle", Subject: "Old news"}},
+		},
+	}
+	client := &pollingMailClient{MockMailClient: mock, beforeList: func(call int) {
+		switch call {
+		case 2:
+			blocker := agentmail.InboxMessage{ID: 2, From: "GreenCastle", Subject: "Blocked on schema", BodyMD: "Which table owns users?"}
+			mock.Inboxes["BlueLake"] = append(mock.Inboxes["BlueLake"], blocker)
+			mock.Inboxes["RedStone"] = []agentmail.InboxMessage{blocker, {ID: 3, From: "RedStone", Subject: "Filtered out"}}
+		case 4:
+			cancel()
+		}
+	}}
+	cmd := &cobra.Command{}
+	cmd.SetContext(ctx)
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(io.Discard)
+
+	if err := runMailWatch(cmd, client, "", "greencastle", 5*time.Millisecond, 50, true); err != nil {
+		t.Fatalf("runMailWatch() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("got %d JSON lines, want 1:\n%s", len(lines), buf.String())
+	}
+	var got mailWatchMessage
+	if err := json.Unmarshal([]byte(lines[0]), &got); err != nil {
+		t.Fatalf("decode %q: %v", lines[0], err)
+	}
+	if got.ID != 2 || got.Subject != "Blocked on schema" || got.Body != "Which table owns users?" {
+		t.Errorf("message = %+v", got)
+	}
+	if strings.Join(got.Recipients, ",") != "BlueLake,RedStone" {
+		t.Errorf("Recipients = %v, want BlueLake,RedStone", got.Recipients)
+	}
+}
+
+func TestMailThreadingCollapsesInboxAndRendersThread(t *testing.T) {
+	thread := func(id string) *string { return &id }
+	base := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	client := &MockMailClient{
+		Available: true,
+		ProjKey:   "/test/project",
+		Agents:    []agentmail.Agent{{Name: "BlueLake"}, {Name: "HumanOverseer"}},
+		Inboxes: map[string][]agentmail.InboxMessage{
+			"BlueLake": {
+				{ID: 1, Subject: "Review API", From: "HumanOverseer", CreatedTS: agentmail.FlexTime{Time: base}, BodyMD: "Please review"},
+				{ID: 3, Subject: "Re: Review API", From: "HumanOverseer", ThreadID: thread("1"), CreatedTS: agentmail.FlexTime{Time: base.Add(2 * time.Minute)}, BodyMD: "Thanks"},
+				{ID: 4, Subject: "Unrelated", From: "GreenCastle", CreatedTS: agentmail.FlexTime{Time: base.Add(3 * time.Minute)}},
+			},
+			"HumanOverseer": {
+				{ID: 2, Subject: "Re: Review API", From: "BlueLake", ThreadID: thread("1"), CreatedTS: agentmail.FlexTime{Time: base.Add(time.Minute)}, BodyMD: "Looks good"},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetContext(t.Context())
+	cmd.SetOut(&buf)
+	if err := runMailInbox(cmd, client, "", false, "", false, 10, false); err != nil {
+		t.Fatalf("runMailInbox: %v", err)
+	}
+	inbox := buf.String()
+	if !strings.Contains(inbox, "Re: Review API (1 reply, thread #1)") || strings.Contains(inbox, "Review API\n") {
+		t.Errorf("inbox should collapse the thread to its latest message:\n%s", inbox)
+	}
+	if !strings.Contains(inbox, "Unrelated\n") {
+		t.Errorf("inbox missing unthreaded message:\n%s", inbox)
+	}
+
+	buf.Reset()
+	if err := runMailThread(cmd, client, "", 3, 50, true); err != nil {
+		t.Fatalf("runMailThread: %v", err)
+	}
+	var got mailConversation
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("decode thread: %v\n%s", err, buf.String())
+	}
+	var ids []int
+	for _, m := range got.Messages {
+		ids = append(ids, m.ID)
+	}
+	if got.ID != 1 || got.Replies != 2 || len(ids) != 3 || ids[0] != 1 || ids[1] != 2 || ids[2] != 3 {
+		t.Errorf("thread = id %d, replies %d, messages %v; want 1, 2, [1 2 3]", got.ID, got.Replies, ids)
+	}
+	if got.Messages[1].InReplyTo != 1 || got.Messages[1].BodyMD != "Looks good" {
+		t.Errorf("reply = %+v, want in_reply_to 1 with body", got.Messages[1])
+	}
+
+	if err := runMailThread(cmd, client, "", 99, 50, false); err == nil || !strings.Contains(err.Error(), "message 99 not found") {
+		t.Errorf("runMailThread(99) error = %v, want not found", err)
+	}
+}
+
+func TestRunMailSearchFiltersBySenderSubjectAndAge(t *testing.T) {
+	now := time.Now()
+	client := &MockMailClient{
+		Available: true,
+		ProjKey:   "/test/project",
+		Agents:    []agentmail.Agent{{Name: "BlueLake"}, {Name: "GreenCastle"}},
+		Inboxes: map[string][]agentmail.InboxMessage{
+			"GreenCastle": {
+				{ID: 1, Subject: "Bug in parser", From: "BlueLake", CreatedTS: agentmail.FlexTime{Time: now.Add(-time.Hour)}, BodyMD: "# Parser crash\nstack trace"},
+				{ID: 2, Subject: "Old BUG report", From: "BlueLake", CreatedTS: agentmail.FlexTime{Time: now.Add(-5 * time.Hour)}},
+				{ID: 3, Subject: "Bug triage", From: "RedStone", CreatedTS: agentmail.FlexTime{Time: now.Add(-time.Minute)}},
+			},
+			"BlueLake": {
+				{ID: 4, Subject: "Fixed the bug", From: "GreenCastle", CreatedTS: agentmail.FlexTime{Time: now.Add(-30 * time.Minute)}},
+				{ID: 5, Subject: "Lunch", From: "GreenCastle", CreatedTS: agentmail.FlexTime{Time: now}},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetContext(t.Context())
+	cmd.SetOut(&buf)
+	filter := mailSearchFilter{From: []string{"BlueLake", "GreenCastle"}, Subject: "bug", Since: now.Add(-2 * time.Hour)}
+	if err := runMailSearch(cmd, client, "", filter, 50, true); err != nil {
+		t.Fatalf("runMailSearch: %v", err)
+	}
+	var result mailSearchResult
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("decode: %v\n%s", err, buf.String())
+	}
+	if result.Count != 2 || result.Messages[0].ID != 1 || result.Messages[1].ID != 4 {
+		t.Errorf("result = %+v, want messages 1 and 4", result)
+	}
+
+	buf.Reset()
+	if err := runMailSearch(cmd, client, "", mailSearchFilter{From: []string{"BlueLake"}, Subject: "parser"}, 50, false); err != nil {
+		t.Fatalf("runMailSearch text: %v", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "Bug in parser") || !strings.Contains(out, "Parser crash") || !strings.Contains(out, "1 matching message(s)") {
+		t.Errorf("text output = %s", out)
+	}
+}
@@ -0,0 +1,556 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Dicklesworthstone/ntm/internal/agentmail"
+	"github.com/Dicklesworthstone/ntm/internal/util"
+)
+
+const (
+	// mailRulesFile holds a project's inbox rules, under <project>/.ntm.
+	mailRulesFile = "mail-rules.yaml"
+	// mailRulesStateFile records which rules already fired for which
+	// messages, and the tags they applied, so each rule fires once.
+	mailRulesStateFile = "mail-rules-state.json"
+)
+
+// mailRule matches messages by sender and subject and tags them, forwards
+// them to another agent, or both.
+type mailRule struct {
+	Name    string   `yaml:"name" json:"name"`
+	From    []string `yaml:"from,omitempty" json:"from,omitempty"`       // any of these senders
+	Subject string   `yaml:"subject,omitempty" json:"subject,omitempty"` // case-insensitive substring
+	Tag     string   `yaml:"tag,omitempty" json:"tag,omitempty"`
+	Forward string   `yaml:"forward,omitempty" json:"forward,omitempty"` // agent to forward to
+}
+
+func (r mailRule) matches(m aggregatedMessage) bool {
+	return mailSearchFilter{From: r.From, Subject: r.Subject}.matches(m)
+}
+
+// mailRulesState is the on-disk form of mailRulesStateFile, keyed by message ID.
+// Forwarded lists the messages the rules engine sent itself; rules never run
+// on them, so two rules matching the same subject cannot forward each other's
+// copies back and forth.
+type mailRulesState struct {
+	Applied   map[string][]string `json:"applied"`
+	Tags      map[string][]string `json:"tags"`
+	Forwarded []int               `json:"forwarded,omitempty"`
+}
+
+// mailRuleForwardPrefix starts the body of every forward the rules engine
+// sends. It identifies forwards whose message ID the server did not report.
+const mailRuleForwardPrefix = "Forwarded by mail rule "
+
+// isRuleForward reports whether m was sent by applyMailRules.
+func (s *mailRulesState) isRuleForward(m aggregatedMessage) bool {
+	if slices.Contains(s.Forwarded, m.ID) {
+		return true
+	}
+	return m.From == agentmail.HumanOverseerAgentName && strings.Contains(m.BodyMD, mailRuleForwardPrefix)
+}
+
+// mailRuleAction is one rule firing on one message.
+type mailRuleAction struct {
+	MessageID int    `json:"message_id"`
+	Rule      string `json:"rule"`
+	Tag       string `json:"tag,omitempty"`
+	Forward   string `json:"forward,omitempty"`
+	Applied   bool   `json:"applied"` // already fired on an earlier pass
+}
+
+func mailRulesPath(projectKey string) string {
+	return filepath.Join(projectKey, ".ntm", mailRulesFile)
+}
+
+// loadMailRules reads and validates a rules file. Forward targets and
+// senders must look like Agent Mail names.
+func loadMailRules(path string) ([]mailRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no mail rules at %s", path)
+		}
+		return nil, fmt.Errorf("reading mail rules: %w", err)
+	}
+	var file struct {
+		Rules []mailRule `yaml:"rules"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing mail rules %s: %w", path, err)
+	}
+
+	seen := make(map[string]bool, len(file.Rules))
+	for i, r := range file.Rules {
+		switch {
+		case strings.TrimSpace(r.Name) == "":
+			return nil, fmt.Errorf("mail rule %d: name is required", i+1)
+		case seen[r.Name]:
+			return nil, fmt.Errorf("mail rule %q: duplicate name", r.Name)
+		case len(r.From) == 0 && r.Subject == "":
+			return nil, fmt.Errorf("mail rule %q: needs from or subject to match on", r.Name)
+		case r.Tag == "" && r.Forward == "":
+			return nil, fmt.Errorf("mail rule %q: needs a tag or forward action", r.Name)
+		case r.Forward != "" && !looksLikeAgentName(r.Forward):
+			return nil, fmt.Errorf("mail rule %q: forward target %q is not an agent name", r.Name, r.Forward)
+		}
+		for _, from := range r.From {
+			if !looksLikeAgentName(from) {
+				return nil, fmt.Errorf("mail rule %q: sender %q is not an agent name", r.Name, from)
+			}
+		}
+		seen[r.Name] = true
+	}
+	return file.Rules, nil
+}
+
+func loadMailRulesState(projectKey string) (*mailRulesState, error) {
+	state := &mailRulesState{Applied: map[string][]string{}, Tags: map[string][]string{}}
+	data, err := os.ReadFile(filepath.Join(projectKey, ".ntm", mailRulesStateFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return nil, fmt.Errorf("reading mail rules state: %w", err)
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("parsing mail rules state: %w", err)
+	}
+	if state.Applied == nil {
+		state.Applied = map[string][]string{}
+	}
+	if state.Tags == nil {
+		state.Tags = map[string][]string{}
+	}
+	return state, nil
+}
+
+func saveMailRulesState(projectKey string, state *mailRulesState) error {
+	dir := filepath.Join(projectKey, ".ntm")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating .ntm directory: %w", err)
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return util.AtomicWriteFile(filepath.Join(dir, mailRulesStateFile), data, 0644)
+}
+
+// planMailRules returns every rule that matches m, marking the ones that
+// already fired. Forwards sent by the rules engine match nothing, and a
+// forward is dropped when its target already received the message.
+func planMailRules(rules []mailRule, m aggregatedMessage, state *mailRulesState) []mailRuleAction {
+	if state.isRuleForward(m) {
+		return nil
+	}
+	key := strconv.Itoa(m.ID)
+	var actions []mailRuleAction
+	for _, r := range rules {
+		if !r.matches(m) {
+			continue
+		}
+		action := mailRuleAction{
+			MessageID: m.ID,
+			Rule:      r.Name,
+			Tag:       r.Tag,
+			Applied:   slices.Contains(state.Applied[key], r.Name),
+		}
+		if r.Forward != "" && r.Forward != m.From && !slices.Contains(m.Recipients, r.Forward) {
+			action.Forward = r.Forward
+		}
+		if action.Tag == "" && action.Forward == "" {
+			continue
+		}
+		actions = append(actions, action)
+	}
+	return actions
+}
+
+// mailRulesClient is the Agent Mail surface the rules engine needs.
+type mailRulesClient interface {
+	mailInboxClient
+	SendOverseerMessage(ctx context.Context, opts agentmail.OverseerMessageOptions) (*agentmail.OverseerSendResult, error)
+}
+
+// applyMailRules fires every matching rule that has not fired yet on msgs and
+// records it in state. Forwards are sent as the Human Overseer, threaded
+// under the original message.
+func applyMailRules(ctx context.Context, client mailRulesClient, projectKey string, rules []mailRule, msgs []aggregatedMessage, state *mailRulesState) ([]mailRuleAction, error) {
+	var fired []mailRuleAction
+	for _, m := range msgs {
+		key := strconv.Itoa(m.ID)
+		for _, action := range planMailRules(rules, m, state) {
+			if action.Applied {
+				continue
+			}
+			if action.Forward != "" {
+				threadID := m.ThreadID
+				if threadID == "" {
+					threadID = key
+				}
+				result, err := client.SendOverseerMessage(ctx, agentmail.OverseerMessageOptions{
+					ProjectKey: projectKey,
+					Recipients: []string{action.Forward},
+					Subject:    truncateRunes("Fwd: "+m.Subject, 200, "..."),
+					BodyMD:     fmt.Sprintf("%s%q. Message #%d from %s:\n\n%s", mailRuleForwardPrefix, action.Rule, m.ID, m.From, m.BodyMD),
+					ThreadID:   threadID,
+				})
+				if err != nil {
+					return fired, fmt.Errorf("forwarding message %d to %s (rule %q): %w", m.ID, action.Forward, action.Rule, err)
+				}
+				if result != nil && result.MessageID != 0 {
+					state.Forwarded = append(state.Forwarded, result.MessageID)
+				}
+			}
+			if action.Tag != "" && !slices.Contains(state.Tags[key], action.Tag) {
+				state.Tags[key] = append(state.Tags[key], action.Tag)
+			}
+			state.Applied[key] = append(state.Applied[key], action.Rule)
+			fired = append(fired, action)
+		}
+	}
+	return fired, nil
+}
+
+// fetchMailForRules connects to Agent Mail and returns every message in the
+// project's inboxes, with bodies.
+func fetchMailForRules(ctx context.Context, client mailInboxClient, projectKey string, limit int) ([]aggregatedMessage, error) {
+	if !client.IsAvailableContext(ctx) {
+		return nil, agentMailUnavailableError(ctx, client, "agent mail server not available")
+	}
+	agents, err := client.ListProjectAgents(ctx, projectKey)
+	if err != nil {
+		return nil, fmt.Errorf("listing agents: %w", err)
+	}
+	names := make([]string, 0, len(agents))
+	for _, a := range agents {
+		names = append(names, a.Name)
+	}
+	return fetchAggregatedMail(ctx, client, projectKey, names, false, limit, true)
+}
+
+func resolveMailRulesScope(ctx context.Context, session, rulesPath string) (string, []mailRule, error) {
+	var (
+		projectKey string
+		err        error
+	)
+	if strings.TrimSpace(session) == "" {
+		_, projectKey, err = resolveAgentMailScopeWithPreference(ctx, session, false)
+	} else {
+		_, projectKey, err = resolveAgentMailCommandScope(ctx, session)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	if rulesPath == "" {
+		rulesPath = mailRulesPath(projectKey)
+	}
+	rules, err := loadMailRules(rulesPath)
+	if err != nil {
+		return "", nil, err
+	}
+	return projectKey, rules, nil
+}
+
+func newMailRulesCmd() *cobra.Command {
+	var rulesPath string
+
+	cmd := &cobra.Command{
+		Use:   "rules [session]",
+		Short: "Show inbox rules that tag or forward messages",
+		Long: `Show the project's inbox rules from .ntm/mail-rules.yaml.
+
+Each rule matches on sender and/or subject and applies a tag, forwards the
+message to another agent, or both. Rules fire once per message, when
+'ntm mail apply-rules' runs (once, or continuously with --watch).
+
+  rules:
+    - name: p0-to-coordinator
+      subject: "P0"            # case-insensitive substring
+      forward: RedStone        # sent as the Human Overseer, threaded under the original
+    - name: bluelake-bugs
+      from: [BlueLake]
+      subject: bug
+      tag: bug`,
+		Example: `  ntm mail rules
+  ntm mail rules test 42
+  ntm mail apply-rules --watch`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var session string
+			if len(args) > 0 {
+				session = args[0]
+			}
+			ctx, err := requireMailCommandContext(cmd, "mail rules")
+			if err != nil {
+				return err
+			}
+			_, rules, err := resolveMailRulesScope(ctx, session, rulesPath)
+			if err != nil {
+				return err
+			}
+			if IsJSONOutput() {
+				return encodeJSONResult(cmd.OutOrStdout(), rules)
+			}
+			printMailRules(cmd.OutOrStdout(), rules)
+			return nil
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&rulesPath, "rules", "", "Rules file (default: <project>/.ntm/mail-rules.yaml)")
+	cmd.AddCommand(newMailRulesTestCmd(&rulesPath))
+
+	return cmd
+}
+
+func printMailRules(w io.Writer, rules []mailRule) {
+	if len(rules) == 0 {
+		fmt.Fprintln(w, "No mail rules defined")
+		return
+	}
+	for _, r := range rules {
+		var match, actions []string
+		if len(r.From) > 0 {
+			match = append(match, "from "+strings.Join(r.From, "|"))
+		}
+		if r.Subject != "" {
+			match = append(match, fmt.Sprintf("subject ~ %q", r.Subject))
+		}
+		if r.Tag != "" {
+			actions = append(actions, "tag "+r.Tag)
+		}
+		if r.Forward != "" {
+			actions = append(actions, "forward to "+r.Forward)
+		}
+		fmt.Fprintf(w, "%s: %s -> %s\n", r.Name, strings.Join(match, ", "), strings.Join(actions, ", "))
+	}
+}
+
+func newMailRulesTestCmd(rulesPath *string) *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "test <message-id> [session]",
+		Short: "Show which rules would fire on a message",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := parseMessageIDs(args[:1])
+			if err != nil {
+				return err
+			}
+			var session string
+			if len(args) > 1 {
+				session = args[1]
+			}
+			return runMailRulesTest(cmd, nil, session, *rulesPath, ids[0], limit, IsJSONOutput())
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 200, "Max messages to fetch per agent inbox")
+
+	return cmd
+}
+
+func runMailRulesTest(cmd *cobra.Command, client mailInboxClient, session, rulesPath string, messageID, limit int, jsonFmt bool) error {
+	parent, err := requireMailCommandContext(cmd, "mail rules test")
+	if err != nil {
+		return err
+	}
+	projectKey, rules, err := resolveMailRulesScope(parent, session, rulesPath)
+	if err != nil {
+		return err
+	}
+	state, err := loadMailRulesState(projectKey)
+	if err != nil {
+		return err
+	}
+
+	if client == nil {
+		client = newAgentMailClient(projectKey)
+	}
+	ctx, cancel := context.WithTimeout(parent, 30*time.Second)
+	defer cancel()
+	msgs, err := fetchMailForRules(ctx, client, projectKey, limit)
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(msgs, func(m aggregatedMessage) bool { return m.ID == messageID })
+	if idx < 0 {
+		return fmt.Errorf("message %d not found in any agent inbox", messageID)
+	}
+	actions := planMailRules(rules, msgs[idx], state)
+	if actions == nil {
+		actions = []mailRuleAction{}
+	}
+
+	if jsonFmt {
+		return encodeJSONResult(cmd.OutOrStdout(), actions)
+	}
+	w := cmd.OutOrStdout()
+	m := msgs[idx]
+	fmt.Fprintf(w, "#%d from %s: %s\n", m.ID, sanitizeMailDisplayField(m.From), sanitizeMailDisplayField(m.Subject))
+	if len(actions) == 0 {
+		fmt.Fprintln(w, "No rules match")
+		return nil
+	}
+	for _, a := range actions {
+		printMailRuleAction(w, a)
+	}
+	return nil
+}
+
+func printMailRuleAction(w io.Writer, a mailRuleAction) {
+	var parts []string
+	if a.Tag != "" {
+		parts = append(parts, "tag "+a.Tag)
+	}
+	if a.Forward != "" {
+		parts = append(parts, "forward to "+a.Forward)
+	}
+	note := ""
+	if a.Applied {
+		note = " (already applied)"
+	}
+	fmt.Fprintf(w, "  #%d %s: %s%s\n", a.MessageID, a.Rule, strings.Join(parts, ", "), note)
+}
+
+func newMailApplyRulesCmd() *cobra.Command {
+	var (
+		rulesPath string
+		watch     bool
+		interval  time.Duration
+		limit     int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "apply-rules [session]",
+		Short: "Run inbox rules over the project's mail",
+		Long: `Apply the rules from .ntm/mail-rules.yaml (see 'ntm mail rules') to every
+message in the project's inboxes. Each rule fires at most once per message;
+applied rules and tags are recorded in .ntm/mail-rules-state.json.
+
+With --watch, keep polling and apply rules to new mail as it arrives.`,
+		Example: `  ntm mail apply-rules
+  ntm mail apply-rules myproject --watch --interval 10s`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var session string
+			if len(args) > 0 {
+				session = args[0]
+			}
+			if limit <= 0 {
+				return fmt.Errorf("--limit must be greater than 0")
+			}
+			if watch && interval <= 0 {
+				return fmt.Errorf("--interval must be positive")
+			}
+			return runMailApplyRules(cmd, nil, session, rulesPath, watch, interval, limit, IsJSONOutput())
+		},
+	}
+
+	cmd.Flags().StringVar(&rulesPath, "rules", "", "Rules file (default: <project>/.ntm/mail-rules.yaml)")
+	cmd.Flags().BoolVar(&watch, "watch", false, "Keep running and apply rules to new mail")
+	cmd.Flags().DurationVar(&interval, "interval", 5*time.Second, "How often to poll with --watch")
+	cmd.Flags().IntVar(&limit, "limit", 200, "Max messages to fetch per agent inbox")
+
+	return cmd
+}
+
+func runMailApplyRules(cmd *cobra.Command, client mailRulesClient, session, rulesPath string, watch bool, interval time.Duration, limit int, jsonFmt bool) error {
+	parent, err := requireMailCommandContext(cmd, "mail apply-rules")
+	if err != nil {
+		return err
+	}
+	projectKey, rules, err := resolveMailRulesScope(parent, session, rulesPath)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		client = newAgentMailClient(projectKey)
+	}
+
+	pass := func() ([]mailRuleAction, error) {
+		ctx, cancel := context.WithTimeout(parent, 30*time.Second)
+		defer cancel()
+		state, err := loadMailRulesState(projectKey)
+		if err != nil {
+			return nil, err
+		}
+		msgs, err := fetchMailForRules(ctx, client, projectKey, limit)
+		if err != nil {
+			return nil, err
+		}
+		fired, applyErr := applyMailRules(ctx, client, projectKey, rules, msgs, state)
+		if len(fired) > 0 {
+			if err := saveMailRulesState(projectKey, state); err != nil {
+				return fired, errors.Join(applyErr, err)
+			}
+		}
+		return fired, applyErr
+	}
+
+	w := cmd.OutOrStdout()
+	if !watch {
+		fired, err := pass()
+		if jsonFmt {
+			if fired == nil {
+				fired = []mailRuleAction{}
+			}
+			if encErr := encodeJSONResult(w, fired); encErr != nil {
+				return encErr
+			}
+			return err
+		}
+		for _, a := range fired {
+			printMailRuleAction(w, a)
+		}
+		fmt.Fprintf(w, "Applied %d rule action(s)\n", len(fired))
+		return err
+	}
+
+	if !jsonFmt {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Applying %d mail rule(s) every %s (Ctrl+C to stop)\n", len(rules), interval)
+	}
+	enc := json.NewEncoder(w)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		fired, err := pass()
+		if parent.Err() != nil {
+			return nil
+		}
+		for _, a := range fired {
+			if jsonFmt {
+				if encErr := enc.Encode(a); encErr != nil {
+					return encErr
+				}
+				continue
+			}
+			printMailRuleAction(w, a)
+		}
+		if err != nil {
+			// A flaky pass should not end the watch; report it and retry.
+			fmt.Fprintf(cmd.ErrOrStderr(), "warning: %v\n", err)
+		}
+		select {
+		case <-parent.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
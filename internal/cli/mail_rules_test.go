@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/ntm/internal/agentmail"
+)
+
+type recordingRulesClient struct {
+	MockMailClient
+	sent   []agentmail.OverseerMessageOptions
+	nextID int
+}
+
+func (c *recordingRulesClient) SendOverseerMessage(ctx context.Context, opts agentmail.OverseerMessageOptions) (*agentmail.OverseerSendResult, error) {
+	c.sent = append(c.sent, opts)
+	var id int
+	if c.nextID != 0 {
+		id = c.nextID
+		c.nextID++
+	}
+	return &agentmail.OverseerSendResult{Success: true, MessageID: id}, nil
+}
+
+func TestLoadMailRulesValidates(t *testing.T) {
+	dir := t.TempDir()
+	write := func(body string) string {
+		path := filepath.Join(dir, "rules.yaml")
+		if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	rules, err := loadMailRules(write("rules:\n  - name: p0\n    subject: P0\n    forward: RedStone\n"))
+	if err != nil || len(rules) != 1 || rules[0].Forward != "RedStone" {
+		t.Fatalf("loadMailRules = %+v, %v", rules, err)
+	}
+
+	for body, want := range map[string]string{
+		"rules:\n  - subject: P0\n    tag: p0\n":                                                      "name is required",
+		"rules:\n  - name: a\n    tag: p0\n":                                                          "needs from or subject",
+		"rules:\n  - name: a\n    subject: P0\n":                                                      "needs a tag or forward",
+		"rules:\n  - name: a\n    subject: P0\n    forward: red-stone\n":                              "not an agent name",
+		"rules:\n  - name: a\n    subject: P0\n    tag: x\n  - name: a\n    subject: Q\n    tag: y\n": "duplicate name",
+	} {
+		if _, err := loadMailRules(write(body)); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("loadMailRules(%q) error = %v, want %q", body, err, want)
+		}
+	}
+
+	if _, err := loadMailRules(filepath.Join(dir, "missing.yaml")); err == nil || !strings.Contains(err.Error(), "no mail rules") {
+		t.Errorf("missing rules error = %v", err)
+	}
+}
+
+func TestApplyMailRulesTagsForwardsOnce(t *testing.T) {
+	projectKey := t.TempDir()
+	rules := []mailRule{
+		{Name: "p0", Subject: "p0", Forward: "RedStone"},
+		{Name: "blue-bugs", From: []string{"BlueLake"}, Subject: "bug", Tag: "bug"},
+	}
+	msgs := []aggregatedMessage{
+		{ID: 1, From: "BlueLake", Subject: "P0 bug in parser", BodyMD: "crash", Recipients: []string{"GreenCastle"}},
+		{ID: 2, From: "GreenCastle", Subject: "lunch", Recipients: []string{"BlueLake"}},
+		{ID: 3, From: "HumanOverseer", Subject: "Fwd: P0 bug in parser", ThreadID: "1", Recipients: []string{"RedStone"}},
+	}
+	client := &recordingRulesClient{}
+
+	state, err := loadMailRulesState(projectKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fired, err := applyMailRules(t.Context(), client, projectKey, rules, msgs, state)
+	if err != nil {
+		t.Fatalf("applyMailRules: %v", err)
+	}
+	if len(fired) != 2 || fired[0].Forward != "RedStone" || fired[1].Tag != "bug" {
+		t.Errorf("fired = %+v, want forward and tag on message 1", fired)
+	}
+	if len(client.sent) != 1 || client.sent[0].Recipients[0] != "RedStone" || client.sent[0].ThreadID != "1" {
+		t.Errorf("sent = %+v, want one forward threaded under message 1", client.sent)
+	}
+	if got := state.Tags["1"]; len(got) != 1 || got[0] != "bug" {
+		t.Errorf("tags = %v", state.Tags)
+	}
+
+	if err := saveMailRulesState(projectKey, state); err != nil {
+		t.Fatal(err)
+	}
+	reloaded, err := loadMailRulesState(projectKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fired, err = applyMailRules(t.Context(), client, projectKey, rules, msgs, reloaded)
+	if err != nil || len(fired) != 0 || len(client.sent) != 1 {
+		t.Errorf("second pass fired %+v (err %v, sent %d), want nothing new", fired, err, len(client.sent))
+	}
+	if planned := planMailRules(rules, msgs[0], reloaded); len(planned) != 2 || !planned[0].Applied {
+		t.Errorf("planMailRules after apply = %+v, want both marked applied", planned)
+	}
+}
+
+func TestApplyMailRulesDoesNotRunRulesOnForwards(t *testing.T) {
+	projectKey := t.TempDir()
+	rules := []mailRule{
+		{Name: "p0-red", Subject: "p0", Forward: "RedStone"},
+		{Name: "p0-blue", Subject: "p0", Forward: "BlueLake"},
+	}
+	msgs := []aggregatedMessage{
+		{ID: 1, From: "GreenCastle", Subject: "P0 outage", BodyMD: "down", Recipients: []string{"PurpleHill"}},
+	}
+	client := &recordingRulesClient{nextID: 100}
+	state, err := loadMailRulesState(projectKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := applyMailRules(t.Context(), client, projectKey, rules, msgs, state); err != nil {
+		t.Fatalf("applyMailRules: %v", err)
+	}
+	if len(client.sent) != 2 {
+		t.Fatalf("sent %d forwards, want one per rule", len(client.sent))
+	}
+
+	// The forwarded copies show up in the next pass's inboxes, each matching
+	// the other rule's subject.
+	for i, sent := range client.sent {
+		msgs = append(msgs, aggregatedMessage{
+			ID:         100 + i,
+			From:       agentmail.HumanOverseerAgentName,
+			Subject:    sent.Subject,
+			BodyMD:     sent.BodyMD,
+			Recipients: sent.Recipients,
+		})
+	}
+	for pass := 0; pass < 3; pass++ {
+		fired, err := applyMailRules(t.Context(), client, projectKey, rules, msgs, state)
+		if err != nil || len(fired) != 0 {
+			t.Fatalf("pass %d fired %+v (err %v), want nothing", pass, fired, err)
+		}
+	}
+	if len(client.sent) != 2 {
+		t.Errorf("sent %d forwards after repeated passes, want 2", len(client.sent))
+	}
+
+	// A forward whose ID the server did not report is recognised by its body.
+	unrecorded := aggregatedMessage{ID: 200, From: agentmail.HumanOverseerAgentName, Subject: "Fwd: P0 outage", BodyMD: client.sent[0].BodyMD, Recipients: []string{"RedStone"}}
+	if planned := planMailRules(rules, unrecorded, state); len(planned) != 0 {
+		t.Errorf("planMailRules on unrecorded forward = %+v, want none", planned)
+	}
+}
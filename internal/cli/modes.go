@@ -13,6 +13,7 @@ import (
 
 	"github.com/Dicklesworthstone/ntm/internal/ensemble"
 	"github.com/Dicklesworthstone/ntm/internal/output"
+	"github.com/Dicklesworthstone/ntm/internal/util"
 )
 
 type modesListOutput struct {
@@ -29,6 +30,8 @@ type modesListRow struct {
 	Category  string `json:"category" yaml:"category"`
 	Tier      string `json:"tier" yaml:"tier"`
 	ShortDesc string `json:"short_desc" yaml:"short_desc"`
+	// TypicalCost is the mode card's estimated token cost.
+	TypicalCost int `json:"typical_cost,omitempty" yaml:"typical_cost,omitempty"`
 }
 
 type modesExplainOutput struct {
@@ -139,14 +142,18 @@ func runModesList(w io.Writer, format, category, tier string, all bool) error {
 
 	rows := make([]modesListRow, 0, len(modes))
 	for _, m := range modes {
-		rows = append(rows, modesListRow{
+		row := modesListRow{
 			ID:        m.ID,
 			Code:      m.Code,
 			Name:      m.Name,
 			Category:  string(m.Category),
 			Tier:      string(m.Tier),
 			ShortDesc: m.ShortDesc,
-		})
+		}
+		if card, err := catalog.GetModeCard(m.ID); err == nil {
+			row.TypicalCost = card.TypicalCost
+		}
+		rows = append(rows, row)
 	}
 
 	filterDesc := ""
@@ -194,9 +201,13 @@ func renderModesList(w io.Writer, payload modesListOutput, format string) error
 			return nil
 		}
 
-		table := output.NewTable(w, "CODE", "ID", "NAME", "CATEGORY", "TIER")
+		table := output.NewTable(w, "CODE", "ID", "NAME", "CATEGORY", "TIER", "COST")
 		for _, row := range payload.Modes {
-			table.AddRow(row.Code, row.ID, row.Name, row.Category, row.Tier)
+			cost := "-"
+			if row.TypicalCost > 0 {
+				cost = fmt.Sprintf("~%d", row.TypicalCost)
+			}
+			table.AddRow(row.Code, row.ID, row.Name, row.Category, row.Tier, cost)
 		}
 		table.Render()
 		fmt.Fprintf(w, "\n%d modes total\n", payload.Count)
@@ -204,6 +215,66 @@ func renderModesList(w io.Writer, payload modesListOutput, format string) error
 	}
 }
 
+// newEnsembleModesCmd exposes the mode catalog under "ensemble" so users can
+// see what a preset will run. Unlike "modes list" it shows every tier unless
+// --tier is given.
+func newEnsembleModesCmd() *cobra.Command {
+	var (
+		format   string
+		category string
+		tier     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "modes [id-or-code]",
+		Short: "Browse the reasoning mode catalog",
+		Long: `List every reasoning mode with its code, name, category, tier, and
+estimated token cost, or print one mode's full card.
+
+Filter the list with --tier (core, advanced, experimental) and --category.
+Pass a mode ID or code to show its card, including what it is best for and
+what sets it apart from similar modes.`,
+		Example: `  ntm ensemble modes
+  ntm ensemble modes --tier core --category Formal
+  ntm ensemble modes deductive
+  ntm ensemble modes A1 --format json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				if category != "" || tier != "" {
+					return fmt.Errorf("--tier and --category only apply when listing modes")
+				}
+				return runModesExplain(cmd.OutOrStdout(), args[0], format)
+			}
+			return runModesList(cmd.OutOrStdout(), format, category, tier, true)
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text, json, yaml")
+	cmd.Flags().StringVarP(&category, "category", "c", "", "Filter by category (e.g., Formal, Causal)")
+	cmd.Flags().StringVarP(&tier, "tier", "t", "", "Filter by tier (core, advanced, experimental)")
+
+	return cmd
+}
+
+// applyEnsembleModesFiles registers the YAML files passed via the ensemble
+// --modes-file flag before any subcommand loads the mode catalog.
+func applyEnsembleModesFiles(cmd *cobra.Command) error {
+	flag := cmd.Flags().Lookup("modes-file")
+	if flag == nil || !flag.Changed {
+		return nil
+	}
+	paths, err := cmd.Flags().GetStringArray("modes-file")
+	if err != nil {
+		return err
+	}
+	for i, path := range paths {
+		paths[i] = util.ExpandPath(path)
+	}
+	ensemble.SetCustomModeFiles(paths)
+	return nil
+}
+
 func newModesExplainCmd() *cobra.Command {
 	var format string
 
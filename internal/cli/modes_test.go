@@ -226,3 +226,48 @@ func TestNewModesListCmd_Flags(t *testing.T) {
 		}
 	}
 }
+
+func TestEnsembleModesCmd(t *testing.T) {
+	cmd := newEnsembleModesCmd()
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetArgs([]string{"--tier", "core", "--format", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("ensemble modes: %v", err)
+	}
+	var list modesListOutput
+	if err := json.Unmarshal(buf.Bytes(), &list); err != nil {
+		t.Fatalf("JSON decode: %v", err)
+	}
+	if list.Count == 0 {
+		t.Fatal("expected core modes")
+	}
+	for _, row := range list.Modes {
+		if row.Tier != "core" {
+			t.Errorf("mode %s tier = %q, want core", row.ID, row.Tier)
+		}
+		if row.TypicalCost <= 0 {
+			t.Errorf("mode %s typical cost = %d, want > 0", row.ID, row.TypicalCost)
+		}
+	}
+
+	first := list.Modes[0]
+	buf.Reset()
+	cmd = newEnsembleModesCmd()
+	cmd.SetOut(&buf)
+	cmd.SetArgs([]string{first.Code})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("ensemble modes %s: %v", first.Code, err)
+	}
+	if !strings.Contains(buf.String(), first.Name) {
+		t.Errorf("expected card for %s, got: %s", first.Name, buf.String())
+	}
+
+	cmd = newEnsembleModesCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{first.ID, "--tier", "core"})
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error when combining a mode with --tier")
+	}
+}
@@ -300,3 +300,123 @@ system_prompt = "project override"
 		t.Fatalf("determineSourceFromProjectDir() = %q, want %q", got, "project (.ntm/personas.toml)")
 	}
 }
+
+func TestPersonasExportImport(t *testing.T) {
+	tmpDir := t.TempDir()
+	projectDir := filepath.Join(tmpDir, "project")
+	if err := os.MkdirAll(projectDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("NTM_CONFIG", filepath.Join(tmpDir, "cfg", "config.toml"))
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmpDir, "xdg"))
+	userPath := filepath.Join(tmpDir, "cfg", "personas.toml")
+
+	var exported strings.Builder
+	if err := runPersonasExport(&exported, projectDir, "reviewer", ""); err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	file := filepath.Join(tmpDir, "reviewer.yaml")
+	shared := strings.Replace(exported.String(), "name: reviewer", "name: team-reviewer", 1)
+	shared = strings.Replace(shared, "\ntags:", "\ntemperature: 0.2\ntags:", 1)
+	if err := os.WriteFile(file, []byte(shared), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+	if err := runPersonasImport(&out, projectDir, file, "", false); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if !strings.Contains(out.String(), "Imported team-reviewer in "+userPath) {
+		t.Errorf("import output = %q", out.String())
+	}
+
+	var again strings.Builder
+	if err := runPersonasExport(&again, projectDir, "team-reviewer", ""); err != nil {
+		t.Fatalf("re-export: %v", err)
+	}
+	if again.String() != shared {
+		t.Errorf("re-export differs:\n%s\nwant:\n%s", again.String(), shared)
+	}
+
+	if err := runPersonasImport(&out, projectDir, file, "", false); err == nil || !strings.Contains(err.Error(), "--force") {
+		t.Errorf("duplicate import error = %v, want --force hint", err)
+	}
+	out.Reset()
+	if err := runPersonasImport(&out, projectDir, file, "", true); err != nil || !strings.HasPrefix(out.String(), "Replaced") {
+		t.Errorf("forced import = %q, %v", out.String(), err)
+	}
+}
+
+func TestPersonasValidateReadsBrokenPersona(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("NTM_CONFIG", filepath.Join(tmpDir, "cfg", "config.toml"))
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmpDir, "xdg"))
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".ntm"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	content := `
+[[personas]]
+name = "hot"
+agent_type = "claude"
+temperature = 5.0
+tags = ["Loud"]
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, ".ntm", "personas.toml"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+	err := runPersonasValidate(&out, tmpDir, "hot")
+	if err == nil {
+		t.Fatal("runPersonasValidate succeeded, want validation failure")
+	}
+	for _, want := range []string{"Persona: hot (project)", "temperature: 5 is outside 0.0-2.0", `invalid tag "Loud"`, "description is blank"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	if err := runPersonasValidate(&out, tmpDir, "architect"); err != nil || !strings.Contains(out.String(), "Valid (0 warning(s))") {
+		t.Errorf("validate architect = %v\n%s", err, out.String())
+	}
+}
+
+func TestPersonasShowRawSkipsInheritance(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("NTM_CONFIG", filepath.Join(tmpDir, "cfg", "config.toml"))
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmpDir, "xdg"))
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".ntm"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	content := `
+[[personas]]
+name = "warm-reviewer"
+base = "reviewer"
+temperature = 0.9
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, ".ntm", "personas.toml"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(tmpDir)
+
+	resolved, err := captureStdout(t, func() error { return runPersonasShow("warm-reviewer", false) })
+	if err != nil {
+		t.Fatalf("show: %v", err)
+	}
+	raw, err := captureStdout(t, func() error { return runPersonasShow("warm-reviewer", true) })
+	if err != nil {
+		t.Fatalf("show --raw: %v", err)
+	}
+	for _, out := range []string{resolved, raw} {
+		if !strings.Contains(out, "reviewer") || !strings.Contains(out, "Base:") {
+			t.Errorf("output missing base line:\n%s", out)
+		}
+	}
+	if !strings.Contains(resolved, "#review") || !strings.Contains(resolved, "Description:") {
+		t.Errorf("resolved output missing inherited tags or description:\n%s", resolved)
+	}
+	if strings.Contains(raw, "#review") || strings.Contains(raw, "Description:") {
+		t.Errorf("raw output shows inherited fields:\n%s", raw)
+	}
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
@@ -12,7 +13,9 @@ import (
 	"github.com/spf13/cobra"
 
 	agentpkg "github.com/Dicklesworthstone/ntm/internal/agent"
+	"github.com/Dicklesworthstone/ntm/internal/output"
 	"github.com/Dicklesworthstone/ntm/internal/persona"
+	"github.com/Dicklesworthstone/ntm/internal/robot"
 	"github.com/Dicklesworthstone/ntm/internal/tui/icons"
 	"github.com/Dicklesworthstone/ntm/internal/tui/theme"
 )
@@ -35,12 +38,18 @@ Examples:
   ntm personas list              # List all personas
   ntm personas list --json       # JSON output
   ntm personas show architect    # Show persona details
-  ntm personas show architect --json`,
+  ntm personas show architect --json
+  ntm personas validate reviewer # Check a persona before using it
+  ntm personas export reviewer -o reviewer.yaml
+  ntm personas import reviewer.yaml --force`,
 	}
 
 	cmd.AddCommand(
 		newPersonasListCmd(),
 		newPersonasShowCmd(),
+		newPersonasValidateCmd(),
+		newPersonasExportCmd(),
+		newPersonasImportCmd(),
 		newProfileSwitchCmd(),
 	)
 
@@ -243,20 +252,29 @@ func renderProfileSets(sets []*persona.PersonaSet, th theme.Theme, ic icons.Icon
 }
 
 func newPersonasShowCmd() *cobra.Command {
+	var raw bool
+
 	cmd := &cobra.Command{
 		Use:   "show <name>",
 		Short: "Show persona details",
-		Long:  `Show detailed information about a specific persona.`,
-		Args:  cobra.ExactArgs(1),
+		Long: `Show detailed information about a specific persona.
+
+A persona that declares a parent with extends (or its alias, base)
+inherits the parent's settings; tags are merged. By default the effective
+persona is shown with inheritance resolved. Use --raw to show the
+definition exactly as written in its source.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runPersonasShow(args[0])
+			return runPersonasShow(args[0], raw)
 		},
 	}
 
+	cmd.Flags().BoolVar(&raw, "raw", false, "Show the unresolved definition instead of the effective persona")
+
 	return cmd
 }
 
-func runPersonasShow(name string) error {
+func runPersonasShow(name string, raw bool) error {
 	cwd, _ := os.Getwd()
 
 	registry, err := persona.LoadRegistry(cwd)
@@ -265,6 +283,12 @@ func runPersonasShow(name string) error {
 	}
 
 	p, ok := registry.Get(name)
+	if ok && raw {
+		if p, _, err = persona.FindDefinition(cwd, name); err != nil {
+			return err
+		}
+		ok = p != nil
+	}
 	if !ok {
 		err := fmt.Errorf("persona %q not found", name)
 		if jsonOutput {
@@ -314,6 +338,10 @@ func runPersonasShow(name string) error {
 	fmt.Println(borderStyle.Render(vertical) + " " + labelStyle.Render("Agent Type:") + "    " + formatAgentType(p.AgentType, th, ic))
 	fmt.Println(borderStyle.Render(vertical) + " " + labelStyle.Render("Model:") + "         " + valueStyle.Render(valueOrDefault(p.Model, "(default)")))
 
+	if parent := p.Parent(); parent != "" {
+		fmt.Println(borderStyle.Render(vertical) + " " + labelStyle.Render("Base:") + "          " + valueStyle.Render(parent))
+	}
+
 	if p.Description != "" {
 		fmt.Println(borderStyle.Render(vertical) + " " + labelStyle.Render("Description:") + "   " + valueStyle.Render(p.Description))
 	}
@@ -364,24 +392,256 @@ func runPersonasShow(name string) error {
 	return nil
 }
 
+// PersonaValidateResult is the JSON output of `ntm personas validate`.
+type PersonaValidateResult struct {
+	Name     string          `json:"name"`
+	Source   string          `json:"source"`
+	Valid    bool            `json:"valid"`
+	Band     string          `json:"temperature_band,omitempty"`
+	Errors   []persona.Issue `json:"errors"`
+	Warnings []persona.Issue `json:"warnings"`
+}
+
+func newPersonasValidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate <name>",
+		Short: "Check a persona for configuration problems",
+		Long: `Check a persona definition before using it.
+
+Errors (exit status 1):
+  - temperature outside 0.0-2.0
+  - malformed or empty tags
+  - blank description, unless the persona extends another
+  - anything 'ntm personas list' would reject, such as an unknown agent_type
+
+Warnings:
+  - temperature in the "wild" band (above 1.0), as shown by 'personas show'
+  - no tags, or a tag repeated
+
+The persona is read from its source file without the validation that
+normally stops loading, so a broken persona can still be diagnosed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cwd, _ := os.Getwd()
+			return runPersonasValidate(cmd.OutOrStdout(), cwd, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func runPersonasValidate(w io.Writer, projectDir, name string) error {
+	p, source, err := persona.InspectDefinition(projectDir, name)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return fmt.Errorf("persona %q not found", name)
+	}
+
+	errs, warnings := p.Check()
+	result := PersonaValidateResult{
+		Name:     p.Name,
+		Source:   source,
+		Valid:    len(errs) == 0,
+		Errors:   errs,
+		Warnings: warnings,
+	}
+	if result.Errors == nil {
+		result.Errors = []persona.Issue{}
+	}
+	if result.Warnings == nil {
+		result.Warnings = []persona.Issue{}
+	}
+	if p.Temperature != nil {
+		result.Band = persona.TemperatureBand(*p.Temperature)
+	}
+
+	if jsonOutput {
+		if err := output.WriteJSON(w, result, true); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintf(w, "Persona: %s (%s)\n", result.Name, result.Source)
+		for _, issue := range result.Errors {
+			fmt.Fprintf(w, "  error:   %s: %s\n", issue.Field, issue.Message)
+		}
+		for _, issue := range result.Warnings {
+			fmt.Fprintf(w, "  warning: %s: %s\n", issue.Field, issue.Message)
+		}
+		if result.Valid {
+			fmt.Fprintf(w, "Valid (%d warning(s))\n", len(result.Warnings))
+		}
+	}
+
+	if !result.Valid {
+		cause := fmt.Errorf("persona %q has %d validation error(s)", result.Name, len(result.Errors))
+		return robot.ExitResultForCode(1, cause, jsonOutput)
+	}
+	return nil
+}
+
+func newPersonasExportCmd() *cobra.Command {
+	var outputPath string
+
+	cmd := &cobra.Command{
+		Use:   "export <name>",
+		Short: "Export a persona as YAML",
+		Long: `Export a persona as portable YAML for sharing with other machines.
+
+The persona is exported as defined in its source (project, user, or
+built-in), before inheritance is resolved, so 'ntm personas import'
+recreates it exactly.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cwd, _ := os.Getwd()
+			return runPersonasExport(cmd.OutOrStdout(), cwd, args[0], outputPath)
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Write YAML to this file instead of stdout")
+
+	return cmd
+}
+
+func runPersonasExport(w io.Writer, projectDir, name, outputPath string) error {
+	p, _, err := persona.FindDefinition(projectDir, name)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return fmt.Errorf("persona %q not found", name)
+	}
+
+	data, err := persona.ExportYAML(p)
+	if err != nil {
+		return err
+	}
+	if outputPath == "" {
+		_, err := w.Write(data)
+		return err
+	}
+	if err := os.WriteFile(outputPath, data, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", outputPath, err)
+	}
+	fmt.Fprintf(w, "Exported %s to %s\n", p.Name, outputPath)
+	return nil
+}
+
+// PersonaImportResult is the JSON output of `ntm personas import`.
+type PersonaImportResult struct {
+	Success  bool   `json:"success"`
+	Name     string `json:"name"`
+	Target   string `json:"target"`
+	Replaced bool   `json:"replaced"`
+}
+
+func newPersonasImportCmd() *cobra.Command {
+	var (
+		force   bool
+		project bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import a persona from YAML",
+		Long: `Import a persona written by 'ntm personas export' into the user personas
+file, or into .ntm/personas.toml with --project.
+
+The persona is validated before it is saved: temperature must be between
+0 and 2, and tags must be lowercase letters, digits, '_' or '-'. Importing
+a persona whose name already exists in any source requires --force.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cwd, _ := os.Getwd()
+			target := ""
+			if project {
+				target = filepath.Join(cwd, persona.DefaultProjectPath())
+			}
+			return runPersonasImport(cmd.OutOrStdout(), cwd, args[0], target, force)
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Replace an existing persona with the same name")
+	cmd.Flags().BoolVar(&project, "project", false, "Import into the project personas file (.ntm/personas.toml)")
+
+	return cmd
+}
+
+// runPersonasImport saves the persona in file to target, or to the user
+// personas file when target is empty.
+func runPersonasImport(w io.Writer, projectDir, file, target string, force bool) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", file, err)
+	}
+	p, err := persona.ImportYAML(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+
+	registry, err := persona.LoadRegistry(projectDir)
+	if err != nil {
+		return err
+	}
+	if _, exists := registry.Get(p.Name); exists && !force {
+		return fmt.Errorf("persona %q already exists (%s); use --force to replace it", p.Name, determineSourceFromProjectDir(p.Name, projectDir))
+	}
+	if parent := p.Parent(); parent != "" && !strings.EqualFold(parent, p.Name) {
+		if _, ok := registry.Get(parent); !ok {
+			return fmt.Errorf("persona %q extends unknown persona %q", p.Name, parent)
+		}
+	}
+
+	var cfg *persona.PersonasConfig
+	if target == "" {
+		cfg, target, err = persona.LoadUserConfig()
+		if err != nil {
+			return err
+		}
+		if target == "" {
+			target = persona.DefaultUserPath()
+		}
+	} else if cfg, err = persona.LoadFromFile(target); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if target == "" {
+		return fmt.Errorf("cannot determine user personas path")
+	}
+	if cfg == nil {
+		cfg = &persona.PersonasConfig{}
+	}
+
+	replaced := cfg.UpsertPersona(*p)
+	if err := persona.SaveToFile(target, cfg); err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		return output.WriteJSON(w, PersonaImportResult{Success: true, Name: p.Name, Target: target, Replaced: replaced}, true)
+	}
+	verb := "Imported"
+	if replaced {
+		verb = "Replaced"
+	}
+	fmt.Fprintf(w, "%s %s in %s\n", verb, p.Name, target)
+	return nil
+}
+
 // renderTempBar renders a visual temperature indicator
 func renderTempBar(temp float64, th theme.Theme) string {
 	var color lipgloss.Color
-	var label string
 
-	switch {
-	case temp <= 0.3:
+	label := persona.TemperatureBand(temp)
+	switch label {
+	case persona.BandFocused:
 		color = th.Blue
-		label = "focused"
-	case temp <= 0.7:
+	case persona.BandBalanced:
 		color = th.Green
-		label = "balanced"
-	case temp <= 1.0:
+	case persona.BandCreative:
 		color = th.Yellow
-		label = "creative"
 	default:
 		color = th.Red
-		label = "wild"
 	}
 
 	style := lipgloss.NewStyle().Foreground(color)
@@ -475,6 +735,9 @@ Examples:
 	cmd.AddCommand(
 		newPersonasListCmd(),
 		newPersonasShowCmd(),
+		newPersonasValidateCmd(),
+		newPersonasExportCmd(),
+		newPersonasImportCmd(),
 		newProfileSwitchCmd(),
 	)
 
@@ -16,6 +16,7 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -48,9 +49,19 @@ var (
 	cfg     *config.Config
 	sshHost string
 
+	// Global --config-profile selection; overrides NTM_PROFILE
+	configProfile string
+
 	// Global JSON output flag - inherited by all subcommands
 	jsonOutput bool
 
+	// Global compact JSON flag - single-line JSON documents for log ingestion
+	compactJSONOutput bool
+
+	// Global --tee destination; teeOutput is the open file while a command runs
+	teeFile   string
+	teeOutput *os.File
+
 	// Global color control flag - inherited by all subcommands
 	noColor bool
 
@@ -355,6 +366,20 @@ func commandTreeFlagConsumesValue(cmd *cobra.Command, name string, shorthand boo
 	return consumes, found
 }
 
+// encryptionKeyConfig maps the encryption config section onto key
+// resolution parameters.
+func encryptionKeyConfig(c config.EncryptionConfig) encryption.KeyConfig {
+	return encryption.KeyConfig{
+		KeySource:   c.KeySource,
+		KeyEnv:      c.KeyEnv,
+		KeyFile:     c.KeyFile,
+		KeyCommand:  c.KeyCommand,
+		KeyFormat:   c.KeyFormat,
+		ActiveKeyID: c.ActiveKeyID,
+		Keyring:     c.Keyring,
+	}
+}
+
 func machineJSONInvocation(cmd *cobra.Command) (bool, string) {
 	if robotInvocation, robotCommand := robotInvocationFromArgs(os.Args[1:]); robotInvocation {
 		return true, robotCommand
@@ -473,6 +498,13 @@ Shell Integration:
 			os.Setenv("NTM_NO_COLOR", "1")
 		}
 		theme.ApplyLipGlossDefaults(theme.Current())
+		output.SetCompactJSON(compactJSONOutput)
+		if err := openTeeOutput(cmd); err != nil {
+			return err
+		}
+		if err := applyEnsembleModesFiles(cmd); err != nil {
+			return err
+		}
 
 		// Phase 1: Critical startup (always runs, minimal overhead)
 		startup.BeginPhase1()
@@ -510,6 +542,11 @@ Shell Integration:
 						machineCommand,
 					)
 				}
+				// A profile the user asked for by name must not silently
+				// fall back to defaults.
+				if strings.TrimSpace(os.Getenv(config.ProfileEnvVar)) != "" {
+					return fmt.Errorf("config load failed: %w", err)
+				}
 				// Config loading failed for a genuine reason (e.g. an invalid
 				// global config); an invalid project overlay no longer reaches
 				// here — it is skipped with its own warning inside LoadMerged.
@@ -559,15 +596,7 @@ Shell Integration:
 
 			// Wire encryption into history + event log persistence (bd-3ld77)
 			if cfg != nil && cfg.Encryption.Enabled {
-				keyCfg := encryption.KeyConfig{
-					KeySource:   cfg.Encryption.KeySource,
-					KeyEnv:      cfg.Encryption.KeyEnv,
-					KeyFile:     cfg.Encryption.KeyFile,
-					KeyCommand:  cfg.Encryption.KeyCommand,
-					KeyFormat:   cfg.Encryption.KeyFormat,
-					ActiveKeyID: cfg.Encryption.ActiveKeyID,
-					Keyring:     cfg.Encryption.Keyring,
-				}
+				keyCfg := encryptionKeyConfig(cfg.Encryption)
 				encKey, err := encryption.ResolveKey(keyCfg)
 				if err != nil {
 					if machineInvocation, machineCommand := machineJSONInvocation(cmd); machineInvocation {
@@ -2806,6 +2835,7 @@ Shell Integration:
 
 func Execute() error {
 	defer closeRobotPersistence()
+	defer closeTeeOutput()
 	robotProcessExit = nil
 	robotInvocation, robotCommand := robotInvocationFromArgs(os.Args[1:])
 	machineInvocation := robotInvocation || jsonInvocationFromArgs(os.Args[1:])
@@ -3703,9 +3733,12 @@ func init() {
 	jsonArgumentRoot = rootCmd
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default ~/.config/ntm/config.toml)")
+	rootCmd.PersistentFlags().StringVar(&configProfile, "config-profile", "", "apply the [profiles.<name>] config overrides (default $NTM_PROFILE)")
 
 	// Global JSON output flag - applies to all commands
 	rootCmd.PersistentFlags().BoolVar(&jsonOutput, "json", false, "Output in JSON format (machine-readable)")
+	rootCmd.PersistentFlags().BoolVar(&compactJSONOutput, "compact-json", false, "Write JSON output as single-line documents instead of indented")
+	rootCmd.PersistentFlags().StringVar(&teeFile, "tee", "", "Also write the command's output to this file")
 	rootCmd.PersistentFlags().StringVar(&sshHost, "ssh", "", "Remote host for SSH execution (e.g. user@host)")
 
 	// Global no-color flag - disables colored output (respects NO_COLOR env var standard)
@@ -4627,6 +4660,7 @@ func init() {
 		newConfigCmd(),
 		newUpgradeCmd(),
 		newLevelCmd(),
+		newAchievementsCmd(),
 
 		// Tutorial
 		newTutorialCmd(),
@@ -5033,6 +5067,11 @@ func selectedConfigIsExplicit() bool {
 }
 
 func configureStartupConfigPath() {
+	// LoadMerged reads the profile from the environment, so export the flag
+	// there; it also reaches the startup loader and child ntm processes.
+	if p := strings.TrimSpace(configProfile); p != "" {
+		_ = os.Setenv(config.ProfileEnvVar, p)
+	}
 	if selectedConfigIsExplicit() {
 		startup.SetConfigPath(selectedConfigPath())
 		return
@@ -5113,6 +5152,117 @@ func loadSelectedConfigOrDefault() *config.Config {
 	return loaded
 }
 
+// configProjectTarget returns the project directory `config set/unset
+// --project` writes to and a freshly loaded global config to validate the
+// merged result against.
+func configProjectTarget() (string, *config.Config, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", nil, fmt.Errorf("getting working directory: %w", err)
+	}
+	projectDir, _, _ := config.FindProjectConfig(cwd)
+	if projectDir == "" {
+		projectDir = cwd
+	}
+	global, err := config.Load(selectedConfigPath())
+	if err != nil {
+		return "", nil, fmt.Errorf("loading global config: %w", err)
+	}
+	return projectDir, global, nil
+}
+
+// runConfigGetAll writes the whole effective configuration, secrets redacted.
+func runConfigGetAll(w io.Writer, effectiveCfg *config.Config, format string) error {
+	values, err := config.AllValues(effectiveCfg)
+	if err != nil {
+		return err
+	}
+	format = strings.ToLower(strings.TrimSpace(format))
+	if IsJSONOutput() {
+		format = "json"
+	}
+	switch format {
+	case "", "json":
+		return output.WriteJSON(w, values, true)
+	case "yaml", "yml":
+		return renderYAML(w, values)
+	default:
+		return fmt.Errorf("invalid format %q (expected json, yaml)", format)
+	}
+}
+
+func runConfigDiff(w io.Writer, effectiveCfg *config.Config, format string) error {
+	diffs := config.Diff(effectiveCfg)
+	format = strings.ToLower(strings.TrimSpace(format))
+	if IsJSONOutput() {
+		format = "json"
+	}
+	switch format {
+	case "json":
+		return output.WriteJSON(w, map[string]interface{}{
+			"count": len(diffs),
+			"diffs": diffs,
+		}, true)
+	case "", "text":
+	default:
+		return fmt.Errorf("invalid format %q (expected text, json)", format)
+	}
+
+	if len(diffs) == 0 {
+		fmt.Fprintln(w, "No differences from defaults")
+		return nil
+	}
+
+	fmt.Fprintf(w, "Configuration differences (%d):\n\n", len(diffs))
+	for _, d := range diffs {
+		fmt.Fprintf(w, "  %s\n", d.Path)
+		fmt.Fprintf(w, "    default:   %v\n", d.Default)
+		fmt.Fprintf(w, "    effective: %v\n", d.Effective)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func runConfigEnv(w io.Writer, format string) error {
+	statuses := config.EnvOverrideStatuses()
+	format = strings.ToLower(strings.TrimSpace(format))
+	if IsJSONOutput() {
+		format = "json"
+	}
+	set := 0
+	for _, st := range statuses {
+		if st.Set {
+			set++
+		}
+	}
+	switch format {
+	case "json":
+		return output.WriteJSON(w, map[string]interface{}{
+			"count":     len(statuses),
+			"set_count": set,
+			"variables": statuses,
+		}, true)
+	case "", "text":
+	default:
+		return fmt.Errorf("invalid format %q (expected text, json)", format)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "VARIABLE\tCONFIG PATH\tSET\tVALUE")
+	for _, st := range statuses {
+		isSet, value := "no", "-"
+		if st.Set {
+			isSet, value = "yes", st.Value
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", st.Name, st.Path, isSet, value)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "\n%d of %d overrides set\n", set, len(statuses))
+	return nil
+}
+
 func newConfigCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "config",
@@ -5160,10 +5310,77 @@ func newConfigCmd() *cobra.Command {
 	})
 
 	// Add 'set' subcommand for easy configuration
+	var (
+		setProject      bool
+		setAllowSecrets bool
+	)
 	setCmd := &cobra.Command{
-		Use:   "set",
+		Use:   "set <key> <value>",
 		Short: "Set configuration values",
+		Long: `Set a configuration value by its dotted path.
+
+Without --project, the key is written to the global config file (see 'ntm
+config path'). The value is converted to the key's type (integer, boolean,
+number, duration, or string) and the resulting configuration is validated
+before the file is changed; other keys and comments are left untouched.
+Secret keys such as agent_mail.token are refused unless --allow-secrets is
+given.
+
+With --project, the key is written to .ntm/config.toml of the current project
+(the nearest directory with .ntm/config.toml, else the working directory),
+creating the file if needed. Only overridden keys are written, so the global
+config stays authoritative for everything else. The merged configuration is
+validated before the file is changed. Values are read as TOML literals
+(numbers, booleans, arrays) and otherwise stored as strings.
+
+Examples:
+  ntm config set ensemble.synthesis.max_findings 30
+  ntm config set alerts.enabled false
+  ntm config set --project defaults.agents.cc 3
+  ntm config set --project alerts.agent_stuck_minutes 20
+  ntm config set --project assign.operator_gated_labels '["needs-human"]'
+  ntm config set projects-base ~/projects`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !setProject {
+				path := selectedConfigPath()
+				if err := config.SetFileValue(path, args[0], args[1], setAllowSecrets); err != nil {
+					if errors.Is(err, config.ErrSecretConfigKey) {
+						return fmt.Errorf("%w; pass --allow-secrets to write it", err)
+					}
+					return err
+				}
+				if IsJSONOutput() {
+					return output.PrintJSON(map[string]interface{}{
+						"key":   args[0],
+						"value": args[1],
+						"path":  path,
+					})
+				}
+				fmt.Printf("Set %s = %s in %s\n", args[0], args[1], path)
+				return nil
+			}
+			projectDir, global, err := configProjectTarget()
+			if err != nil {
+				return err
+			}
+			path, err := config.SetProjectValue(projectDir, args[0], args[1], global)
+			if err != nil {
+				return err
+			}
+			if IsJSONOutput() {
+				return output.PrintJSON(map[string]interface{}{
+					"key":   args[0],
+					"value": args[1],
+					"path":  path,
+				})
+			}
+			fmt.Printf("Set %s = %s in %s\n", args[0], args[1], path)
+			return nil
+		},
 	}
+	setCmd.Flags().BoolVar(&setProject, "project", false, "write to the project's .ntm/config.toml")
+	setCmd.Flags().BoolVar(&setAllowSecrets, "allow-secrets", false, "allow writing secret keys such as agent_mail.token to the global config")
 
 	setCmd.AddCommand(&cobra.Command{
 		Use:   "projects-base <path>",
@@ -5211,11 +5428,66 @@ Examples:
 
 	cmd.AddCommand(setCmd)
 
-	cmd.AddCommand(&cobra.Command{
+	var unsetProject bool
+	unsetCmd := &cobra.Command{
+		Use:   "unset <key>",
+		Short: "Remove a configuration override",
+		Long: `Remove a dotted key from the project's .ntm/config.toml so the global
+value applies again. Tables left empty are removed as well.
+
+Examples:
+  ntm config unset --project defaults.agents.cc`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !unsetProject {
+				return fmt.Errorf("config unset <key> requires --project; use 'ntm config edit' for the global config")
+			}
+			projectDir, global, err := configProjectTarget()
+			if err != nil {
+				return err
+			}
+			path, removed, err := config.UnsetProjectValue(projectDir, args[0], global)
+			if err != nil {
+				return err
+			}
+			if IsJSONOutput() {
+				return output.PrintJSON(map[string]interface{}{
+					"key":     args[0],
+					"removed": removed,
+					"path":    path,
+				})
+			}
+			if !removed {
+				fmt.Printf("%s is not set in %s\n", args[0], path)
+				return nil
+			}
+			fmt.Printf("Unset %s in %s\n", args[0], path)
+			return nil
+		},
+	}
+	unsetCmd.Flags().BoolVar(&unsetProject, "project", false, "remove the key from the project's .ntm/config.toml")
+	cmd.AddCommand(unsetCmd)
+
+	var showProfile string
+	showCmd := &cobra.Command{
 		Use:   "show",
 		Short: "Show current configuration",
+		Long: `Show the effective configuration: the global config merged with the
+project's .ntm/config.toml, plus the selected profile's overrides.
+
+Examples:
+  ntm config show
+  ntm config show --profile ci   # Preview the [profiles.ci] overrides`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			effectiveCfg := loadSelectedConfigOrDefault()
+			if showProfile != "" {
+				cwd, _ := os.Getwd()
+				loaded, err := config.LoadMergedProfile(cwd, selectedConfigPath(), showProfile)
+				if err != nil {
+					return err
+				}
+				effectiveCfg = loaded
+			}
 
 			if IsJSONOutput() {
 				palette := make([]map[string]interface{}, 0, len(effectiveCfg.Palette))
@@ -5230,6 +5502,7 @@ Examples:
 				}
 
 				return output.PrintJSON(map[string]interface{}{
+					"profile":       effectiveCfg.Profile,
 					"projects_base": effectiveCfg.ProjectsBase,
 					"theme":         effectiveCfg.Theme,
 					"palette_file":  effectiveCfg.PaletteFile,
@@ -5354,58 +5627,88 @@ Examples:
 
 			return config.Print(effectiveCfg, os.Stdout)
 		},
-	})
+	}
+	showCmd.Flags().StringVar(&showProfile, "profile", "", "apply the named [profiles.<name>] overrides before showing")
+	cmd.AddCommand(showCmd)
 
 	// Add diff subcommand
-	cmd.AddCommand(&cobra.Command{
+	var diffFormat string
+	diffCmd := &cobra.Command{
 		Use:   "diff",
 		Short: "Show configuration differences from defaults",
-		Long:  `Shows all configuration values that differ from the built-in defaults.`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			effectiveCfg := loadSelectedConfigOrDefault()
+		Long: `Shows every configuration value of the effective configuration (global
+config, project overrides and environment overrides applied) that differs
+from the built-in defaults, keyed by the same dotted paths as 'ntm config
+get'. Secret values are redacted.
 
-			diffs := config.Diff(effectiveCfg)
-
-			if IsJSONOutput() {
-				return output.PrintJSON(map[string]interface{}{
-					"count": len(diffs),
-					"diffs": diffs,
-				})
-			}
+Examples:
+  ntm config diff
+  ntm config diff --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigDiff(cmd.OutOrStdout(), loadSelectedConfigOrDefault(), diffFormat)
+		},
+	}
+	diffCmd.Flags().StringVarP(&diffFormat, "format", "f", "text", "Output format: text, json")
+	cmd.AddCommand(diffCmd)
 
-			if len(diffs) == 0 {
-				fmt.Println("No differences from defaults")
-				return nil
-			}
+	// Add env subcommand
+	var envFormat string
+	envCmd := &cobra.Command{
+		Use:   "env",
+		Short: "List environment variables that override configuration",
+		Long: `Lists every environment variable ntm applies on top of the config file,
+the dotted config path it overrides, and whether it is set in the current
+environment. Secret values are redacted. Environment overrides take
+precedence over the config file.
 
-			fmt.Printf("Configuration differences (%d):\n\n", len(diffs))
-			for _, d := range diffs {
-				fmt.Printf("  %s\n", d.Path)
-				fmt.Printf("    default: %v\n", d.Default)
-				fmt.Printf("    current: %v\n", d.Current)
-				fmt.Println()
-			}
-			return nil
+Examples:
+  ntm config env
+  ntm config env --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigEnv(cmd.OutOrStdout(), envFormat)
 		},
-	})
+	}
+	envCmd.Flags().StringVarP(&envFormat, "format", "f", "text", "Output format: text, json")
+	cmd.AddCommand(envCmd)
 
 	// Add validate subcommand (comprehensive validation from validate.go)
 	cmd.AddCommand(newConfigValidateCmd())
+	cmd.AddCommand(newConfigLintCmd())
+	cmd.AddCommand(newConfigMigrateCmd())
 
 	// Add get subcommand
-	cmd.AddCommand(&cobra.Command{
+	var (
+		getAll    bool
+		getFormat string
+	)
+	getCmd := &cobra.Command{
 		Use:   "get <key>",
 		Short: "Get a configuration value",
 		Long: `Retrieves a configuration value by its dotted path.
 
+With --all, prints the entire effective configuration (global config, project
+overrides and environment overrides applied) with secrets redacted.
+
 Examples:
   ntm config get projects_base
   ntm config get alerts.enabled
-  ntm config get context_rotation.warning_threshold`,
-		Args: cobra.ExactArgs(1),
+  ntm config get context_rotation.warning_threshold
+  ntm config get --all --format yaml`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if getAll {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			effectiveCfg := loadSelectedConfigOrDefault()
 
+			if getAll {
+				return runConfigGetAll(cmd.OutOrStdout(), effectiveCfg, getFormat)
+			}
+
 			value, err := config.GetValue(effectiveCfg, args[0])
 			if err != nil {
 				return err
@@ -5421,7 +5724,86 @@ Examples:
 			fmt.Printf("%v\n", value)
 			return nil
 		},
-	})
+	}
+	getCmd.Flags().BoolVar(&getAll, "all", false, "Print the entire effective configuration")
+	getCmd.Flags().StringVarP(&getFormat, "format", "f", "json", "Output format for --all: json, yaml")
+	cmd.AddCommand(getCmd)
+
+	// Add explain subcommand
+	explainCmd := &cobra.Command{
+		Use:   "explain <key>",
+		Short: "Describe what a configuration key does",
+		Long: `Describes a configuration key by its dotted path: what it controls, its type,
+the accepted values or range, its built-in default, and the validator that
+enforces the range.
+
+Examples:
+  ntm config explain context_rotation.warning_threshold
+  ntm config explain ensemble.assignment --json`,
+		Args: cobra.ExactArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return config.ExplainedPaths(), cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			exp, err := config.Explain(args[0])
+			if err != nil {
+				return err
+			}
+			if IsJSONOutput() {
+				return output.PrintJSON(exp)
+			}
+
+			w := cmd.OutOrStdout()
+			fmt.Fprintf(w, "%s\n  %s\n\n", exp.Path, exp.Description)
+			fmt.Fprintf(w, "  type:      %s\n", exp.Type)
+			fmt.Fprintf(w, "  default:   %v\n", exp.Default)
+			if exp.Range != "" {
+				fmt.Fprintf(w, "  valid:     %s\n", exp.Range)
+			}
+			if exp.Validator != "" {
+				fmt.Fprintf(w, "  validator: %s\n", exp.Validator)
+			}
+			return nil
+		},
+	}
+	cmd.AddCommand(explainCmd)
+
+	// Add schema subcommand
+	var schemaOutput string
+	schemaCmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Print a JSON Schema for the configuration file",
+		Long: `Prints a JSON Schema (draft-07) describing every configuration key: its type,
+built-in default, description, and, for keys restricted to a fixed set of
+values, the values the config validator accepts. Point an editor's TOML
+language server or a CI check at it to validate config.toml.
+
+Examples:
+  ntm config schema
+  ntm config schema --output ntm-config.schema.json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := json.MarshalIndent(config.Schema(), "", "  ")
+			if err != nil {
+				return err
+			}
+			data = append(data, '\n')
+			if schemaOutput == "" {
+				_, err = cmd.OutOrStdout().Write(data)
+				return err
+			}
+			if err := os.WriteFile(schemaOutput, data, 0644); err != nil {
+				return fmt.Errorf("writing schema: %w", err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Wrote config schema to %s\n", schemaOutput)
+			return nil
+		},
+	}
+	schemaCmd.Flags().StringVarP(&schemaOutput, "output", "o", "", "Output file path (default: stdout)")
+	cmd.AddCommand(schemaCmd)
 
 	// Add edit subcommand
 	cmd.AddCommand(&cobra.Command{
@@ -5552,6 +5934,34 @@ func editorTokensSafe(tokens []string) bool {
 	return true
 }
 
+// openTeeOutput points the root command's output at stdout plus the --tee
+// file, so commands that render through cmd.OutOrStdout() write the same
+// bytes to both.
+func openTeeOutput(cmd *cobra.Command) error {
+	if teeFile == "" || teeOutput != nil {
+		return nil
+	}
+	f, err := os.Create(util.ExpandPath(teeFile))
+	if err != nil {
+		return fmt.Errorf("opening --tee file: %w", err)
+	}
+	teeOutput = f
+	cmd.Root().SetOut(io.MultiWriter(os.Stdout, f))
+	return nil
+}
+
+// closeTeeOutput closes the --tee file opened by openTeeOutput.
+func closeTeeOutput() {
+	if teeOutput == nil {
+		return
+	}
+	if err := teeOutput.Close(); err != nil {
+		slog.Warn("closing --tee file", "error", err)
+	}
+	teeOutput = nil
+	rootCmd.SetOut(nil)
+}
+
 // IsJSONOutput returns true if JSON output is enabled
 func IsJSONOutput() bool {
 	return jsonOutput
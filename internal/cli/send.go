@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
@@ -12,9 +13,12 @@ import (
 	"log/slog"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/mattn/go-isatty"
@@ -22,6 +26,7 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 
+	agentpkg "github.com/Dicklesworthstone/ntm/internal/agent"
 	"github.com/Dicklesworthstone/ntm/internal/assignment"
 	"github.com/Dicklesworthstone/ntm/internal/audit"
 	"github.com/Dicklesworthstone/ntm/internal/bv"
@@ -48,6 +53,7 @@ import (
 	"github.com/Dicklesworthstone/ntm/internal/tmux"
 	"github.com/Dicklesworthstone/ntm/internal/tools"
 	"github.com/Dicklesworthstone/ntm/internal/tui/theme"
+	"github.com/Dicklesworthstone/ntm/internal/util"
 	"github.com/Dicklesworthstone/ntm/internal/webhook"
 )
 
@@ -68,14 +74,36 @@ type SendResult struct {
 	Failed               int                                 `json:"failed"`
 	RoutedTo             *SendRoutingResult                  `json:"routed_to,omitempty"`
 	DispatchPacing       *coordinator.DispatchPacingDecision `json:"dispatch_pacing,omitempty"`
+	EchoConfirmations    []SendEchoConfirmation              `json:"echo_confirmations,omitempty"`
+	DetectedCommands     []string                            `json:"detected_commands,omitempty"`
+	Retries              int                                 `json:"retries,omitempty"`
+	LinesSent            int                                 `json:"lines_sent,omitempty"`
 	Error                string                              `json:"error,omitempty"`
 }
 
+// SendEchoConfirmation reports whether --confirm-echo observed the prompt in
+// a delivered pane before the confirmation timeout expired.
+type SendEchoConfirmation struct {
+	Pane      string `json:"pane"`
+	Confirmed bool   `json:"confirmed"`
+	// Changed reports that the pane's output changed after dispatch even
+	// though the prompt text never appeared, as with agents that show a long
+	// paste as a "[Pasted text ...]" summary. --retry does not resend to
+	// such panes.
+	Changed bool `json:"changed,omitempty"`
+}
+
 const (
 	sendErrorCodeFailed          = "SEND_FAILED"
 	sendErrorCodeNoMatchingPanes = "NO_MATCHING_PANES"
+	sendErrorCodeUnconfirmed     = "SEND_UNCONFIRMED"
+	sendErrorCodeCommandsDenied  = "COMMANDS_NOT_CONFIRMED"
 )
 
+// errSendCommandsNotConfirmed is returned when --confirm-commands detects
+// shell commands in the prompt and the user declines, or cannot be asked.
+var errSendCommandsNotConfirmed = errors.New("detected shell commands were not confirmed")
+
 // sendProjectSessionResult is the per-session receipt in a project broadcast.
 // It intentionally contains only stable, machine-actionable delivery data.
 type sendProjectSessionResult struct {
@@ -125,6 +153,7 @@ type SendDryRunResult struct {
 	Blocked              bool                                `json:"blocked,omitempty"`
 	ErrorCode            string                              `json:"error_code,omitempty"`
 	Total                int                                 `json:"total"`
+	ResolvedPrompt       string                              `json:"resolved_prompt,omitempty"`
 	WouldSend            []SendDryRunEntry                   `json:"would_send"`
 	RoutedTo             *SendRoutingResult                  `json:"routed_to,omitempty"`
 	DispatchPacing       *coordinator.DispatchPacingDecision `json:"dispatch_pacing,omitempty"`
@@ -308,7 +337,7 @@ type sendExecutionResult struct {
 type SendOptions struct {
 	// Context is populated by command entry points. runSendWithTargets supplies
 	// Background only for legacy in-process callers with no context surface.
-	Context        context.Context
+	Context        context.Context `json:"-"`
 	Session        string
 	Prompt         string
 	PromptSource   string
@@ -319,8 +348,12 @@ type SendOptions struct {
 	PaneSelector   string   // Explicit N, W.P, or %N selector from --pane
 	PaneSelectors  []string // Explicit N, W.P, or %N selectors from --panes
 	PanesSpecified bool     // True if --panes was explicitly set
+	PickNewest     bool     // Narrow the filtered panes to the last one in topology order
+	PickOldest     bool     // Narrow the filtered panes to the first one in topology order
 	TemplateName   string
 	Tags           []string
+	Cwd            string // Only panes whose working directory equals this path
+	CwdPrefix      string // Only panes whose working directory is under this path
 	DryRun         bool
 	Randomize      bool  // Randomize send order for individualized prompts
 	Seed           int64 // Deterministic seed (only used when Randomize=true)
@@ -328,7 +361,7 @@ type SendOptions struct {
 	PaceDispatch   bool  // Include advisory dispatch pacing in JSON/dry-run output
 
 	// Runtime/test injection for advisory dispatch pacing.
-	DispatchPacingInput *coordinator.DispatchPacingInput
+	DispatchPacingInput *coordinator.DispatchPacingInput `json:"-"`
 
 	// Smart routing options
 	SmartRoute    bool   // Use smart routing to select best agent
@@ -348,6 +381,43 @@ type SendOptions struct {
 	// Hooks
 	NoHooks bool
 
+	// Queue defers the send to the session's queue when the session does not
+	// exist yet; `ntm send flush` replays it.
+	Queue bool
+
+	// ConfirmCommands lists shell commands detected in the prompt and asks
+	// for confirmation before sending. Without a terminal to ask on (JSON or
+	// non-interactive use) the send fails instead of proceeding.
+	ConfirmCommands bool
+
+	// RenderMarkdown flattens Markdown in the prompt to plain text before
+	// redaction and delivery; see renderMarkdownPlain.
+	RenderMarkdown bool
+
+	// NoTemplate sends the prompt verbatim instead of rendering {{.Session}},
+	// {{.AgentType}}, {{.PaneIndex}}, and {{.AgentName}} per pane.
+	NoTemplate bool
+
+	// SendAt schedules the send for later: it is queued and delivered by
+	// `ntm send queue run` once the time passes. Zero sends immediately.
+	SendAt time.Time `json:"-"`
+
+	// ConfirmEcho polls each delivered pane until the prompt text shows up in
+	// its scrollback, for up to ConfirmEchoTimeout.
+	ConfirmEcho        bool
+	ConfirmEchoTimeout time.Duration
+
+	// RetryCount re-sends the prompt to panes that have not echoed it, up to
+	// this many times, for agents whose input prompt is not up yet. Each
+	// attempt waits RetryBackoff, doubling after every retry, for the echo.
+	RetryCount   int
+	RetryBackoff time.Duration
+
+	// EachLineSeparate sends every non-empty prompt line as its own input,
+	// waiting LineDelay between lines, for agents that mangle pasted blocks.
+	EachLineSeparate bool
+	LineDelay        time.Duration
+
 	// Batch processing options
 	BatchFile       string        // Path to batch file
 	BatchDelay      time.Duration // Delay between prompts
@@ -355,10 +425,15 @@ type SendOptions struct {
 	BatchStopOnErr  bool          // Stop on first error
 	BatchBroadcast  bool          // Send same prompt to all agents simultaneously
 	BatchAgentIndex int           // Send to specific agent index (-1 = round-robin)
+	BatchWeights    []BatchWeight // Weighted round-robin across tag groups (empty = even)
 
 	// Runtime: filled by smart routing
 	routingResult *SendRoutingResult
 
+	// Runtime: pane working directories keyed by pane ID, filled when
+	// Cwd or CwdPrefix is set.
+	paneCwds map[string]string
+
 	// Runtime: composing commands use collect mode to own terminal output.
 	executionPolicy sendExecutionPolicy
 	executionResult *sendExecutionResult
@@ -405,6 +480,24 @@ func (s *SendTargets) Type() string {
 	return "[variant]"
 }
 
+// sendTargetAliasFlags are long-form spellings accepted alongside --cc,
+// --cod, --gmi and --agy. They resolve through agent.NormalizeAgentType.
+var sendTargetAliasFlags = []string{"claude", "codex", "openai", "gemini", "antigravity"}
+
+// registerSendTargetAliasFlags adds hidden alias flags that feed the same
+// targets as their short-form flag, so --claude behaves exactly like --cc.
+func registerSendTargetAliasFlags(cmd *cobra.Command, targets *SendTargets) {
+	for _, alias := range sendTargetAliasFlags {
+		agentType, ok := agentpkg.NormalizeAgentType(alias)
+		if !ok {
+			continue
+		}
+		cmd.Flags().Var(newSendTargetValue(AgentType(agentType), targets), alias, "alias for --"+string(agentType))
+		cmd.Flags().Lookup(alias).NoOptDefVal = "true"
+		_ = cmd.Flags().MarkHidden(alias)
+	}
+}
+
 // sendTargetValue wraps SendTargets with a specific agent type for flag parsing
 type sendTargetValue struct {
 	agentType AgentType
@@ -525,43 +618,14 @@ func intsToStrings(ints []int) []string {
 	return out
 }
 
-// shuffledPermutation returns a Fisher-Yates permutation of [0..n) using a deterministic PRNG.
+// shuffledPermutation returns a deterministic Fisher-Yates permutation of [0..n).
 // If seed is 0, it uses a time-based seed and returns the chosen seed via seedUsed.
 func shuffledPermutation(n int, seed int64) (seedUsed int64, perm []int) {
-	perm = make([]int, n)
-	for i := 0; i < n; i++ {
-		perm[i] = i
-	}
-	if n <= 1 {
-		if seed == 0 {
-			return time.Now().UnixNano(), perm
-		}
-		return seed, perm
-	}
-
 	seedUsed = seed
 	if seedUsed == 0 {
 		seedUsed = time.Now().UnixNano()
 	}
-
-	// xorshift64 (deterministic, stable across Go versions)
-	x := uint64(seedUsed)
-	if x == 0 {
-		x = 0x9e3779b97f4a7c15
-	}
-	next := func() uint64 {
-		x ^= x << 13
-		x ^= x >> 7
-		x ^= x << 17
-		return x
-	}
-
-	for i := n - 1; i > 0; i-- {
-		j := int(next() % uint64(i+1))
-		perm[i], perm[j] = perm[j], perm[i]
-	}
-
-	return seedUsed, perm
+	return seedUsed, util.SeededPermutation(n, seedUsed)
 }
 
 func permutePanes(panes []tmux.Pane, perm []int) []tmux.Pane {
@@ -602,6 +666,15 @@ func permuteBatchPrompts(prompts []BatchPrompt, perm []int) []BatchPrompt {
 func newSendCmd() *cobra.Command {
 	var targets SendTargets
 	var targetAll, skipFirst bool
+	var pickNewest, pickOldest bool
+	var queue bool
+	var sendAfter time.Duration
+	var retryCount int
+	var noTemplate bool
+	var renderMarkdown bool
+	var confirmCommands bool
+	var retryBackoff time.Duration
+	var sendAtStr string
 	var paneSelector string
 	var panesArg string
 	var promptFile, prefix, suffix string
@@ -609,6 +682,7 @@ func newSendCmd() *cobra.Command {
 	var templateName string
 	var templateVars []string
 	var tags []string
+	var cwdFilter, cwdPrefixFilter string
 	var dryRun bool
 	var cassCheck bool
 	var noCassCheck bool
@@ -631,6 +705,7 @@ func newSendCmd() *cobra.Command {
 
 	// Batch mode variables
 	var batchFile string
+	var batchWeights string
 	var batchDelay string
 	var batchConfirm bool
 	var batchStopOnErr bool
@@ -643,6 +718,11 @@ func newSendCmd() *cobra.Command {
 	// Codex goal-send mode (#165)
 	var codexGoal bool
 
+	var confirmEcho bool
+	var confirmEchoTimeout time.Duration
+	var eachLineSeparate bool
+	var lineDelay time.Duration
+
 	cmd := &cobra.Command{
 		Use:   "send <session> [prompt]",
 		Short: "Send a prompt to agent panes",
@@ -651,6 +731,8 @@ func newSendCmd() *cobra.Command {
 		By default, sends to all agent panes. Use flags to target specific types.
 		Use --cc=variant to filter by model or persona (e.g., --cc=opus, --cc=architect).
 		Use --tag to filter by user-defined tags.
+		Use --newest or --oldest to narrow the matching panes to the one with the
+		highest or lowest index in topology order.
 
 		Prompt can be provided as:
 		  - Command line argument (traditional)
@@ -680,6 +762,47 @@ func newSendCmd() *cobra.Command {
 		confirmation classes are NOT bypassed by this flag — they fail closed.
 		When set, JSON output includes "non_interactive_forced": true.
 
+		Use --confirm-commands when broadcasting prompts that may contain
+		commands like 'rm -rf' or 'sudo': ntm lists the shell commands it
+		detects and asks before sending. Without a terminal (JSON output,
+		pipes) it fails closed, and --force-non-interactive does not bypass it.
+		JSON results include the commands as "detected_commands".
+
+		Per-Pane Prompt Variables:
+		Prompts are rendered per pane with Go template syntax, so one command can
+		personalize every pane: {{.Session}}, {{.AgentType}}, {{.PaneIndex}},
+		and {{.AgentName}} (e.g. "You are {{.AgentName}} on pane {{.PaneIndex}}").
+		Unknown fields are an error; use --no-template to send braces literally.
+
+		Queueing:
+		Use --queue in scripts that may send before spawn finishes. If the session
+		does not exist yet, the prompt and all send options are written to a
+		per-session queue instead of failing; 'ntm send flush <session>' sends
+		queued prompts in order once the session is up.
+
+		Scheduling:
+		Use --after 30m or --at 2026-01-02T15:04:05Z to queue a prompt for
+		later instead of sending it now. 'ntm send queue' lists pending prompts,
+		'ntm send queue cancel <id>' drops one, and 'ntm send queue run --watch'
+		delivers them as they come due. With --dry-run the schedule is reported
+		but nothing is queued.
+
+		Delivery Confirmation:
+		Use --confirm-echo for critical prompts. After sending, ntm polls each
+		delivered pane's scrollback until the start of the prompt appears, for
+		up to --confirm-echo-timeout. Each pane is captured just before sending,
+		and only an occurrence beyond those already in that capture counts, so
+		a prompt repeated from earlier scrollback cannot confirm itself. Panes
+		where the echo never shows up are reported as unconfirmed and the send
+		fails.
+
+		Use --retry N when the agent may not be ready yet (e.g. right after
+		spawn). Panes that have not echoed the prompt within --retry-backoff are
+		sent it again, waiting twice as long each time; after N retries the
+		send fails naming the panes that never accepted it. A pane whose output
+		changed since the send is taken to have received the prompt (some agents
+		collapse long pastes into a one-line summary) and is not sent it again.
+
 		Smart Routing:
 		Use --smart to automatically select the best agent based on routing strategies.
 		Use --route to specify the strategy (default: least-loaded).
@@ -690,13 +813,18 @@ func newSendCmd() *cobra.Command {
 		  ntm send myproject --cc "review the changes"          # All Claude agents
 		  ntm send myproject --cc=opus "review the changes"     # Only Claude Opus agents
 		  ntm send myproject --tag=frontend "update ui"         # Agents with 'frontend' tag
+		  ntm send myproject --cwd-prefix ~/src/api "rebase"    # Agents working under a directory
 		  ntm send myproject --cod --gmi "run the tests"        # Codex and Gemini
 		  ntm send myproject --all "git status"                 # All panes
 		  ntm send myproject --pane=2 "specific pane"           # Single-window pane index
 		  ntm send myproject --pane=1.0 "specific pane"         # Exact window.pane
 		  ntm send myproject --panes=%7,2.0 "two panes"         # Exact tmux ID + window.pane
 		  ntm send myproject --skip-first "restart"             # Skip first topology-ordered pane
+		  ntm send myproject --cc --newest "take this one"      # Most recently added Claude pane
 		  ntm send myproject --json "run tests"                 # JSON output
+		  ntm send myproject --pane=2 --confirm-echo "deploy"   # Verify the prompt landed
+		  ntm send myproject --pane=3 --each-line-separate -f steps.txt  # One line at a time
+		  ntm send myproject --render-markdown -f plan.md       # Flatten Markdown first
 		  ntm send myproject --file prompts/review.md           # From file
 		  cat error.log | ntm send myproject --cc               # From stdin
 		  git diff | ntm send myproject --all --prefix "Review these changes:"  # Stdin with prefix
@@ -736,6 +864,88 @@ func newSendCmd() *cobra.Command {
 			if skipFirst && smartRoute {
 				return earlyError(fmt.Errorf("cannot combine --skip-first with --smart"))
 			}
+			if pickNewest && pickOldest {
+				return earlyError(fmt.Errorf("cannot use --newest and --oldest together"))
+			}
+			if pickNewest || pickOldest {
+				if paneSelector != "" || panesSpecified {
+					return earlyError(fmt.Errorf("cannot combine --newest/--oldest with explicit --pane/--panes selectors"))
+				}
+				if projectFilter != "" || distribute || smartRoute || codexGoal || batchFile != "" {
+					return earlyError(fmt.Errorf("--newest/--oldest cannot be combined with --project, --distribute, --smart, --codex-goal, or --batch"))
+				}
+			}
+			if queue && (projectFilter != "" || distribute || codexGoal || batchFile != "") {
+				return earlyError(fmt.Errorf("--queue cannot be combined with --project, --distribute, --codex-goal, or --batch"))
+			}
+			var sendAt time.Time
+			if cmd.Flags().Changed("after") || sendAtStr != "" {
+				if cmd.Flags().Changed("after") && sendAtStr != "" {
+					return earlyError(fmt.Errorf("--after and --at are mutually exclusive"))
+				}
+				if projectFilter != "" || distribute || codexGoal || batchFile != "" {
+					return earlyError(fmt.Errorf("--after/--at cannot be combined with --project, --distribute, --codex-goal, or --batch"))
+				}
+				if sendAtStr != "" {
+					parsed, err := time.Parse(time.RFC3339, sendAtStr)
+					if err != nil {
+						return earlyError(fmt.Errorf("invalid --at time %q (want RFC3339, e.g. 2026-01-02T15:04:05Z): %w", sendAtStr, err))
+					}
+					sendAt = parsed
+				} else {
+					if sendAfter < 0 {
+						return earlyError(fmt.Errorf("--after must not be negative"))
+					}
+					sendAt = time.Now().Add(sendAfter)
+				}
+			}
+			if confirmEcho {
+				if projectFilter != "" || distribute || codexGoal || batchFile != "" {
+					return earlyError(fmt.Errorf("--confirm-echo cannot be combined with --project, --distribute, --codex-goal, or --batch"))
+				}
+				if confirmEchoTimeout <= 0 {
+					return earlyError(fmt.Errorf("--confirm-echo-timeout must be positive"))
+				}
+			}
+			if eachLineSeparate && (projectFilter != "" || distribute || codexGoal || batchFile != "") {
+				return earlyError(fmt.Errorf("--each-line-separate cannot be combined with --project, --distribute, --codex-goal, or --batch"))
+			}
+			if lineDelay < 0 {
+				return earlyError(fmt.Errorf("--line-delay must not be negative"))
+			}
+			if batchWeights != "" && batchFile == "" {
+				return earlyError(fmt.Errorf("--weights requires --batch"))
+			}
+			if confirmCommands && (projectFilter != "" || distribute || codexGoal || batchFile != "") {
+				return earlyError(fmt.Errorf("--confirm-commands cannot be combined with --project, --distribute, --codex-goal, or --batch"))
+			}
+			if confirmCommands && (queue || !sendAt.IsZero()) {
+				return earlyError(fmt.Errorf("--confirm-commands cannot be combined with --queue, --after, or --at (deferred sends cannot ask for confirmation)"))
+			}
+			if retryCount < 0 {
+				return earlyError(fmt.Errorf("--retry must not be negative"))
+			}
+			if retryCount > 0 {
+				if eachLineSeparate || projectFilter != "" || distribute || codexGoal || batchFile != "" {
+					return earlyError(fmt.Errorf("--retry cannot be combined with --each-line-separate, --project, --distribute, --codex-goal, or --batch"))
+				}
+				if retryBackoff <= 0 {
+					return earlyError(fmt.Errorf("--retry-backoff must be positive"))
+				}
+			}
+			cwdFilter = strings.TrimSpace(cwdFilter)
+			cwdPrefixFilter = strings.TrimSpace(cwdPrefixFilter)
+			if cwdFilter != "" || cwdPrefixFilter != "" {
+				if cwdFilter != "" && cwdPrefixFilter != "" {
+					return earlyError(fmt.Errorf("cannot use --cwd and --cwd-prefix together"))
+				}
+				if paneSelector != "" || panesSpecified {
+					return earlyError(fmt.Errorf("cannot combine --cwd/--cwd-prefix with explicit --pane/--panes selectors"))
+				}
+				if projectFilter != "" || distribute || smartRoute || codexGoal {
+					return earlyError(fmt.Errorf("--cwd/--cwd-prefix cannot be combined with --project, --distribute, --smart, or --codex-goal"))
+				}
+			}
 
 			// Handle --project mode: broadcast to all matching sessions (bd-3cu02.14)
 			if projectFilter != "" {
@@ -797,6 +1007,13 @@ func newSendCmd() *cobra.Command {
 				if paneSelector != "" || panesSpecified {
 					return earlyError(fmt.Errorf("cannot combine --batch with --pane or --panes; use --agent for a specific batch target"))
 				}
+				weights, err := parseBatchWeights(batchWeights)
+				if err != nil {
+					return earlyError(err)
+				}
+				if len(weights) > 0 && (batchBroadcast || batchAgentIndex >= 0) {
+					return earlyError(fmt.Errorf("--weights only applies to round-robin batches; drop --broadcast/--agent"))
+				}
 				var delay time.Duration
 				if batchDelay != "" {
 					var err error
@@ -813,6 +1030,8 @@ func newSendCmd() *cobra.Command {
 					TargetAll:           targetAll,
 					SkipFirst:           skipFirst,
 					Tags:                tags,
+					Cwd:                 cwdFilter,
+					CwdPrefix:           cwdPrefixFilter,
 					SmartRoute:          smartRoute,
 					RouteStrategy:       routeStrategy,
 					CassCheck:           cassCheck && !noCassCheck,
@@ -827,6 +1046,7 @@ func newSendCmd() *cobra.Command {
 					BatchStopOnErr:      batchStopOnErr,
 					BatchBroadcast:      batchBroadcast,
 					BatchAgentIndex:     batchAgentIndex,
+					BatchWeights:        weights,
 					Randomize:           randomize,
 					Seed:                seed,
 					PriorityOrder:       priorityOrder,
@@ -845,7 +1065,11 @@ func newSendCmd() *cobra.Command {
 				PaneSelector:        paneSelector,
 				PaneSelectors:       paneSelectors,
 				PanesSpecified:      panesSpecified,
+				PickNewest:          pickNewest,
+				PickOldest:          pickOldest,
 				Tags:                tags,
+				Cwd:                 cwdFilter,
+				CwdPrefix:           cwdPrefixFilter,
 				SmartRoute:          smartRoute,
 				RouteStrategy:       routeStrategy,
 				CassCheck:           cassCheck && !noCassCheck,
@@ -857,6 +1081,17 @@ func newSendCmd() *cobra.Command {
 				Randomize:           randomize,
 				Seed:                seed,
 				PaceDispatch:        paceDispatch,
+				Queue:               queue,
+				SendAt:              sendAt,
+				ConfirmEcho:         confirmEcho,
+				ConfirmEchoTimeout:  confirmEchoTimeout,
+				RetryCount:          retryCount,
+				NoTemplate:          noTemplate,
+				RenderMarkdown:      renderMarkdown,
+				ConfirmCommands:     confirmCommands,
+				RetryBackoff:        retryBackoff,
+				EachLineSeparate:    eachLineSeparate,
+				LineDelay:           lineDelay,
 			}
 
 			// Handle template-based prompts
@@ -904,10 +1139,13 @@ func newSendCmd() *cobra.Command {
 	cmd.Flags().Lookup("gmi").NoOptDefVal = "true"
 	cmd.Flags().Var(newSendTargetValue(AgentTypeAntigravity, &targets), "agy", "send to Antigravity (agy) agents (optional :variant filter)")
 	cmd.Flags().Lookup("agy").NoOptDefVal = "true"
+	registerSendTargetAliasFlags(cmd, &targets)
 	cmd.Flags().BoolVar(&targetAll, "all", false, "send to all panes (including user pane)")
 	cmd.Flags().BoolVarP(&skipFirst, "skip-first", "s", false, "skip the first pane in deterministic topology order")
 	cmd.Flags().StringVarP(&paneSelector, "pane", "p", "", "send to one pane (N, W.P, or %N)")
 	cmd.Flags().StringVarP(&panesArg, "panes", "", "", "send to panes (comma-separated N, W.P, or %N selectors)")
+	cmd.Flags().BoolVar(&pickNewest, "newest", false, "send only to the matching pane with the highest index (last in topology order)")
+	cmd.Flags().BoolVar(&pickOldest, "oldest", false, "send only to the matching pane with the lowest index (first in topology order)")
 	cmd.Flags().StringVarP(&promptFile, "file", "f", "", "read prompt from file (also used as {{file}} in templates)")
 	cmd.Flags().StringVar(&prefix, "prefix", "", "text to prepend to file/stdin content")
 	cmd.Flags().StringVar(&suffix, "suffix", "", "text to append to file/stdin content")
@@ -915,6 +1153,8 @@ func newSendCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&templateName, "template", "t", "", "use a named prompt template (see 'ntm template list')")
 	cmd.Flags().StringArrayVar(&templateVars, "var", nil, "template variable in key=value format (repeatable)")
 	cmd.Flags().StringSliceVar(&tags, "tag", nil, "filter by tag (OR logic)")
+	cmd.Flags().StringVar(&cwdFilter, "cwd", "", "only send to panes whose working directory is exactly this path")
+	cmd.Flags().StringVar(&cwdPrefixFilter, "cwd-prefix", "", "only send to panes whose working directory is this path or below it")
 
 	// Smart routing flags
 	cmd.Flags().BoolVar(&smartRoute, "smart", false, "Use smart routing to select best agent")
@@ -936,7 +1176,19 @@ func newSendCmd() *cobra.Command {
 	cmd.Flags().Float64Var(&cassSimilarity, "cass-similarity", 0.7, "Similarity threshold for duplicate detection")
 	cmd.Flags().IntVar(&cassCheckDays, "cass-check-days", 7, "Look back N days for duplicates")
 	cmd.Flags().BoolVar(&noHooks, "no-hooks", false, "Disable command hooks")
-	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Preview what would be sent without sending")
+	cmd.Flags().BoolVar(&queue, "queue", false, "If the session does not exist yet, queue the prompt for 'ntm send flush'")
+	cmd.Flags().DurationVar(&sendAfter, "after", 0, "Queue the prompt and send it after this delay (e.g. 30m); see 'ntm send queue'")
+	cmd.Flags().StringVar(&sendAtStr, "at", "", "Queue the prompt and send it at this RFC3339 time; see 'ntm send queue'")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Preview the resolved prompt (secrets redacted) and targets without sending")
+	cmd.Flags().BoolVar(&confirmEcho, "confirm-echo", false, "After sending, poll each pane until the prompt text appears and report it confirmed or unconfirmed")
+	cmd.Flags().DurationVar(&confirmEchoTimeout, "confirm-echo-timeout", 10*time.Second, "How long --confirm-echo waits for the prompt to appear in each pane")
+	cmd.Flags().BoolVar(&confirmCommands, "confirm-commands", false, "List shell commands detected in the prompt and ask before sending; fails without a terminal")
+	cmd.Flags().BoolVar(&renderMarkdown, "render-markdown", false, "Flatten Markdown to plain text before sending (headings uppercased, bullets normalized, code fences unwrapped)")
+	cmd.Flags().BoolVar(&noTemplate, "no-template", false, "Send the prompt verbatim; don't render {{.Session}}, {{.AgentType}}, {{.PaneIndex}}, {{.AgentName}} per pane")
+	cmd.Flags().IntVar(&retryCount, "retry", 0, "Re-send up to N times to panes that have not echoed the prompt (agent not ready yet)")
+	cmd.Flags().DurationVar(&retryBackoff, "retry-backoff", defaultSendRetryBackoff, "Initial wait for the prompt echo before each --retry; doubles every attempt")
+	cmd.Flags().BoolVar(&eachLineSeparate, "each-line-separate", false, "Send each non-empty prompt line as its own input followed by Enter")
+	cmd.Flags().DurationVar(&lineDelay, "line-delay", 0, "Pause between lines with --each-line-separate (e.g. 200ms)")
 
 	// Randomization flags
 	cmd.Flags().BoolVar(&randomize, "randomize", false, "Randomize send order for individualized prompts (reduces thundering herd)")
@@ -957,6 +1209,7 @@ func newSendCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&batchStopOnErr, "stop-on-error", false, "Stop batch on first send failure")
 	cmd.Flags().BoolVar(&batchBroadcast, "broadcast", false, "Send same prompt to all agents simultaneously")
 	cmd.Flags().IntVar(&batchAgentIndex, "agent", -1, "Send to specific agent index only (-1 = round-robin)")
+	cmd.Flags().StringVar(&batchWeights, "weights", "", "Weight round-robin by pane tag group, e.g. tag=frontend:1,backend:2 (default: even)")
 
 	// Project filter (bd-3cu02.14)
 	cmd.Flags().StringVar(&projectFilter, "project", "", "broadcast to all sessions for a base project name")
@@ -971,6 +1224,9 @@ func newSendCmd() *cobra.Command {
 	_ = cmd.RegisterFlagCompletionFunc("pane", completeSendPaneSelector)
 	_ = cmd.RegisterFlagCompletionFunc("panes", completeSendPaneSelectors)
 
+	cmd.AddCommand(newSendBroadcastFileCmd())
+	cmd.AddCommand(newSendFlushCmd(), newSendQueueCmd(), newSendHistoryCmd())
+
 	return cmd
 }
 
@@ -1321,6 +1577,49 @@ func applyBasePrompt(basePrompt, userPrompt string) string {
 	return basePrompt + "\n\n" + userPrompt
 }
 
+// preSendHookTimeout bounds send.pre_send_hook so a hung transform cannot
+// wedge a send.
+const preSendHookTimeout = 30 * time.Second
+
+// applyPreSendHook pipes prompt through the configured send.pre_send_hook
+// (run via sh -c with NTM_SESSION set) and returns its stdout, minus trailing
+// newlines, as the new prompt. It runs before redaction so anything the hook
+// adds is still scanned. A non-zero exit or empty output aborts the send.
+func applyPreSendHook(ctx context.Context, session, prompt string) (string, error) {
+	if cfg == nil || strings.TrimSpace(cfg.Send.PreSendHook) == "" {
+		return prompt, nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	hookCtx, cancel := context.WithTimeout(ctx, preSendHookTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(hookCtx, "sh", "-c", cfg.Send.PreSendHook)
+	cmd.WaitDelay = 2 * time.Second
+	cmd.Env = append(os.Environ(), "NTM_SESSION="+session)
+	cmd.Stdin = strings.NewReader(prompt)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if hookCtx.Err() == context.DeadlineExceeded {
+			return "", fmt.Errorf("send.pre_send_hook timed out after %v", preSendHookTimeout)
+		}
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", fmt.Errorf("send.pre_send_hook exited with code %d: %s", exitErr.ExitCode(), strings.TrimSpace(stderr.String()))
+		}
+		return "", fmt.Errorf("send.pre_send_hook failed: %w", err)
+	}
+	transformed := strings.TrimRight(stdout.String(), "\r\n")
+	if strings.TrimSpace(transformed) == "" {
+		return "", errors.New("send.pre_send_hook produced an empty prompt")
+	}
+	return transformed, nil
+}
+
 // buildPrompt combines prefix, content, and suffix into a single prompt string.
 func buildPrompt(content, prefix, suffix string) string {
 	var parts []string
@@ -1334,6 +1633,144 @@ func buildPrompt(content, prefix, suffix string) string {
 	return strings.Join(parts, "\n")
 }
 
+// sendPromptData is the per-pane data available to {{...}} fields in a
+// send prompt.
+type sendPromptData struct {
+	Session   string
+	AgentType string
+	PaneIndex int
+	AgentName string
+}
+
+// sendPromptGroup is a rendered prompt and the panes that receive it.
+type sendPromptGroup struct {
+	Prompt string
+	Panes  []tmux.Pane
+}
+
+// parseSendPromptTemplate parses prompt as a text/template when it contains
+// template actions. It returns nil for plain prompts or with opts.NoTemplate.
+func parseSendPromptTemplate(opts SendOptions, prompt string) (*template.Template, error) {
+	if opts.NoTemplate || !strings.Contains(prompt, "{{") {
+		return nil, nil
+	}
+	tmpl, err := template.New("prompt").Option("missingkey=error").Parse(prompt)
+	if err != nil {
+		return nil, fmt.Errorf("prompt template: %w (use --no-template to send braces literally)", err)
+	}
+	// Execute once against empty data so unknown fields fail before anything
+	// is sent, naming the bad field.
+	if err := tmpl.Execute(io.Discard, sendPromptData{}); err != nil {
+		return nil, fmt.Errorf("prompt template: %w (available fields: .Session, .AgentType, .PaneIndex, .AgentName; use --no-template to send braces literally)", err)
+	}
+	return tmpl, nil
+}
+
+// sendPromptGroups renders prompt for each pane and groups panes that get the
+// same text, in pane order. Plain prompts yield a single group.
+func sendPromptGroups(opts SendOptions, prompt, session string, selected []tmux.Pane) ([]sendPromptGroup, error) {
+	tmpl, err := parseSendPromptTemplate(opts, prompt)
+	if err != nil || tmpl == nil {
+		return []sendPromptGroup{{Prompt: prompt, Panes: selected}}, err
+	}
+	var groups []sendPromptGroup
+	index := make(map[string]int)
+	for _, p := range selected {
+		var buf strings.Builder
+		data := sendPromptData{Session: session, AgentType: p.Type.String(), PaneIndex: p.Index, AgentName: paneAgentLabel(p)}
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("prompt template for pane %d: %w", p.Index, err)
+		}
+		rendered := buf.String()
+		if i, ok := index[rendered]; ok {
+			groups[i].Panes = append(groups[i].Panes, p)
+			continue
+		}
+		index[rendered] = len(groups)
+		groups = append(groups, sendPromptGroup{Prompt: rendered, Panes: []tmux.Pane{p}})
+	}
+	return groups, nil
+}
+
+// mergeSendDispatchResults folds one prompt group's dispatch into the total.
+func mergeSendDispatchResults(total, next dispatchsvc.Result, first bool) dispatchsvc.Result {
+	if first {
+		return next
+	}
+	total.Success = total.Success && next.Success
+	total.Targets = append(total.Targets, next.Targets...)
+	total.Receipts = append(total.Receipts, next.Receipts...)
+	total.Delivered += next.Delivered
+	total.Failed += next.Failed
+	total.Blocked += next.Blocked
+	total.Skipped += next.Skipped
+	total.Warnings = append(total.Warnings, next.Warnings...)
+	return total
+}
+
+// markdownLinkPattern matches inline [text](url) links.
+var markdownLinkPattern = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+
+// markdownBulletPattern matches an unordered list item, keeping its indent.
+var markdownBulletPattern = regexp.MustCompile(`^(\s*)[-*+]\s+`)
+
+// renderMarkdownPlain flattens Markdown for agents that trip over markup:
+// headings become uppercase lines, unordered list items become "- " bullets
+// at their original indent, fenced code is unwrapped verbatim, and inline
+// code, bold markers, and links are reduced to their text.
+func renderMarkdownPlain(md string) string {
+	lines := strings.Split(md, "\n")
+	out := make([]string, 0, len(lines))
+	inFence := false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			out = append(out, line)
+			continue
+		}
+		if heading, ok := stripMarkdownHeading(trimmed); ok {
+			out = append(out, upperOutsideTemplateActions(renderMarkdownInline(heading)))
+			continue
+		}
+		line = markdownBulletPattern.ReplaceAllString(line, "$1- ")
+		out = append(out, renderMarkdownInline(line))
+	}
+	return strings.Join(out, "\n")
+}
+
+func renderMarkdownInline(s string) string {
+	s = markdownLinkPattern.ReplaceAllString(s, "$1 ($2)")
+	s = strings.ReplaceAll(s, "**", "")
+	s = strings.ReplaceAll(s, "__", "")
+	return strings.ReplaceAll(s, "`", "")
+}
+
+// upperOutsideTemplateActions uppercases s but leaves {{...}} actions alone so
+// per-pane prompt variables in headings still render.
+func upperOutsideTemplateActions(s string) string {
+	var b strings.Builder
+	for {
+		start := strings.Index(s, "{{")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(s[start:], "}}")
+		if end < 0 {
+			break
+		}
+		end += start + 2
+		b.WriteString(strings.ToUpper(s[:start]))
+		b.WriteString(s[start:end])
+		s = s[end:]
+	}
+	b.WriteString(strings.ToUpper(s))
+	return b.String()
+}
+
 // runSendWithTemplate handles template-based prompt generation and sending.
 func runSendWithTemplate(templateVars []string, promptFile string, contextFiles []string, opts SendOptions) error {
 	// Load the template
@@ -1400,6 +1837,15 @@ func runSendWithTargets(opts SendOptions) error {
 	if opts.Context == nil {
 		opts.Context = context.Background()
 	}
+	if !opts.SendAt.IsZero() {
+		return scheduleSend(opts)
+	}
+	if opts.Queue && !opts.DryRun {
+		queued, err := queueSendIfSessionMissing(opts)
+		if err != nil || queued {
+			return err
+		}
+	}
 	return runSendInternal(opts)
 }
 
@@ -1407,6 +1853,9 @@ func finishSendResult(opts SendOptions, result SendResult, cause error) error {
 	if result.Targets == nil {
 		result.Targets = []string{}
 	}
+	if opts.ConfirmCommands && result.DetectedCommands == nil {
+		result.DetectedCommands = extractLikelyCommands(opts.Prompt)
+	}
 	if opts.executionPolicy == sendExecutionCollect {
 		if opts.executionResult != nil {
 			opts.executionResult.recorded = true
@@ -1501,6 +1950,12 @@ func runSendInternal(opts SendOptions) (err error) {
 	ctx := opts.Context
 	session := opts.Session
 	prompt := applyBasePrompt(opts.BasePrompt, opts.Prompt)
+	// The pre-send hook runs before the redaction preflight so secrets it
+	// introduces are still caught; its error is reported once outputError exists.
+	prompt, hookErr := applyPreSendHook(ctx, session, prompt)
+	if opts.RenderMarkdown {
+		prompt = renderMarkdownPlain(prompt)
+	}
 	opts.Prompt = prompt // update opts so downstream sees combined prompt
 	promptSource := opts.PromptSource
 	templateName := opts.TemplateName
@@ -1534,7 +1989,7 @@ func runSendInternal(opts SendOptions) (err error) {
 		redactionBlocked  bool
 	)
 
-	if cfg != nil {
+	if cfg != nil && hookErr == nil {
 		redactCfg := cfg.Redaction.ToRedactionLibConfig()
 		if redactCfg.Mode != redaction.ModeOff {
 			result := redaction.ScanAndRedact(prompt, redactCfg)
@@ -1593,6 +2048,8 @@ func runSendInternal(opts SendOptions) (err error) {
 			code := ""
 			if redactionBlocked {
 				code = "SENSITIVE_DATA_BLOCKED"
+			} else if errors.Is(err, errSendCommandsNotConfirmed) {
+				code = sendErrorCodeCommandsDenied
 			} else if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 				code = robot.ErrCodeTimeout
 			}
@@ -1617,6 +2074,9 @@ func runSendInternal(opts SendOptions) (err error) {
 		}
 		return err
 	}
+	if hookErr != nil {
+		return outputError(hookErr)
+	}
 	if paneSelector != "" {
 		if selectorErr := validateShellPaneSelector(paneSelector); selectorErr != nil {
 			return outputError(selectorErr)
@@ -1628,6 +2088,12 @@ func runSendInternal(opts SendOptions) (err error) {
 	if skipFirst && (paneSelector != "" || opts.PanesSpecified) {
 		return outputError(fmt.Errorf("cannot combine --skip-first with explicit --pane/--panes selectors"))
 	}
+	if opts.PickNewest && opts.PickOldest {
+		return outputError(fmt.Errorf("cannot use --newest and --oldest together"))
+	}
+	if (opts.PickNewest || opts.PickOldest) && (paneSelector != "" || opts.PanesSpecified) {
+		return outputError(fmt.Errorf("cannot combine --newest/--oldest with explicit --pane/--panes selectors"))
+	}
 
 	if redactionBlocked {
 		return outputError(redactionBlockedError{summary: *redactionSummary})
@@ -1692,6 +2158,9 @@ func runSendInternal(opts SendOptions) (err error) {
 			entry.SetError(histErr)
 		}
 		_ = history.Append(entry)
+		if histSuccess {
+			recordProficiencyAction((*config.ProficiencyConfig).IncrementPrompt)
+		}
 
 		// Session prompt history is replayable restart state, so only record
 		// prompts that reached at least one pane. Global history above retains
@@ -1880,7 +2349,7 @@ func runSendInternal(opts SendOptions) (err error) {
 	}
 
 	// Auto-checkpoint before broadcast sends
-	isBroadcast := !opts.PanesSpecified && paneSelector == "" && (targetAll || (!targetCC && !targetCod && !targetGmi && !targetAgy && len(tags) == 0))
+	isBroadcast := !opts.PanesSpecified && paneSelector == "" && !opts.hasCwdFilter() && !opts.PickNewest && !opts.PickOldest && (targetAll || (!targetCC && !targetCod && !targetGmi && !targetAgy && len(tags) == 0))
 	// Checkpoint capture emits human-facing advisory logs from lower layers, so
 	// keep it on the interactive path and preserve a clean machine-output channel.
 	if !dryRun && !jsonOutput && !silent && isBroadcast && cfg != nil && cfg.Checkpoints.Enabled && cfg.Checkpoints.BeforeBroadcast {
@@ -1918,8 +2387,11 @@ func runSendInternal(opts SendOptions) (err error) {
 		multiWindow = tmux.PanesSpanMultipleWindows(panes)
 	}
 
-	// Broad sends apply type/tag filters after deterministic topology ordering.
+	// Broad sends apply type/tag/cwd filters after deterministic topology ordering.
 	if selectedPanes == nil {
+		if err := opts.resolvePaneCwds(ctx, session); err != nil {
+			return outputError(err)
+		}
 		noFilter := !targetCC && !targetCod && !targetGmi && !targetAgy && !targetAll && len(tags) == 0
 		hasVariantFilter := len(targets) > 0
 
@@ -1928,6 +2400,9 @@ func runSendInternal(opts SendOptions) (err error) {
 			if skipFirst && i == 0 {
 				continue
 			}
+			if !opts.matchesPaneCwd(p) {
+				continue
+			}
 
 			// Apply filters
 			if !targetAll && !noFilter {
@@ -1962,6 +2437,9 @@ func runSendInternal(opts SendOptions) (err error) {
 
 			selectedPanes = append(selectedPanes, p)
 		}
+		if opts.PickNewest || opts.PickOldest {
+			selectedPanes = pickEdgePane(selectedPanes, opts.PickNewest)
+		}
 	}
 
 	// Track results for JSON output
@@ -2018,21 +2496,70 @@ func runSendInternal(opts SendOptions) (err error) {
 		return histErr
 	}
 
+	if opts.ConfirmCommands && !dryRun {
+		if err := confirmSendCommands(extractLikelyCommands(prompt), len(selectedPanes), silent); err != nil {
+			return outputError(err)
+		}
+	}
+
 	dispatchRedactCfg := activeShellDispatchRedactionConfig()
 	dispatchService, err := newShellDispatchService(session, selectedPanes, dispatchRedactCfg)
 	if err != nil {
 		return outputError(err)
 	}
-	dispatchRequest := shellDispatchRequest(session, panes, selectedPanes, prompt, (!jsonOutput && !silent) || explicitSingle)
-	dispatchRequest.DryRun = dryRun
-	preparedDispatch, err := dispatchService.Prepare(
-		ctx,
-		dispatchRequest,
-	)
+	stopOnFailure := (!jsonOutput && !silent) || explicitSingle
+	promptGroups, err := sendPromptGroups(opts, prompt, session, selectedPanes)
 	if err != nil {
 		return outputError(err)
 	}
-	dispatchResult, dispatchErr := dispatchService.Dispatch(ctx, preparedDispatch)
+	var (
+		dispatchResult dispatchsvc.Result
+		dispatchErr    error
+		linesSent      int
+		groupReceipts  = make([][]dispatchsvc.Receipt, 0, len(promptGroups))
+		echoBaseline   sendEchoBaseline
+	)
+	if (opts.ConfirmEcho || opts.RetryCount > 0) && !dryRun {
+		echoBaseline = captureSendEchoBaseline(ctx, selectedPanes)
+	}
+	for _, group := range promptGroups {
+		var (
+			groupResult dispatchsvc.Result
+			groupErr    error
+			groupLines  int
+		)
+		if lines := splitSendLines(group.Prompt); opts.EachLineSeparate && !dryRun && len(lines) > 0 {
+			// Each line is its own dispatch so the final-message redactor sees
+			// every line on its own.
+			groupResult, groupLines, groupErr = dispatchSendLines(ctx, lines, opts.LineDelay, func(line string) (dispatchsvc.Result, error) {
+				prepared, err := dispatchService.Prepare(ctx, shellDispatchRequest(session, panes, group.Panes, line, stopOnFailure))
+				if err != nil {
+					return dispatchsvc.Result{}, err
+				}
+				return dispatchService.Dispatch(ctx, prepared)
+			})
+		} else {
+			dispatchRequest := shellDispatchRequest(session, panes, group.Panes, group.Prompt, stopOnFailure)
+			dispatchRequest.DryRun = dryRun
+			preparedDispatch, err := dispatchService.Prepare(
+				ctx,
+				dispatchRequest,
+			)
+			if err != nil {
+				return outputError(err)
+			}
+			groupResult, groupErr = dispatchService.Dispatch(ctx, preparedDispatch)
+		}
+		dispatchResult = mergeSendDispatchResults(dispatchResult, groupResult, len(groupReceipts) == 0)
+		groupReceipts = append(groupReceipts, groupResult.Receipts)
+		linesSent = max(linesSent, groupLines)
+		if groupErr != nil {
+			dispatchErr = groupErr
+		}
+		if groupErr != nil || ((!groupResult.Success || groupResult.Failed > 0) && stopOnFailure) {
+			break
+		}
+	}
 	if dryRun {
 		if dispatchErr != nil || !dispatchResult.Success {
 			if dispatchErr == nil {
@@ -2040,7 +2567,11 @@ func runSendInternal(opts SendOptions) (err error) {
 			}
 			return outputError(dispatchErr)
 		}
-		entries := buildSendDryRunEntries(selectedPanes, prompt, promptSource, multiWindow)
+		previewPrompt := sendPreviewPrompt(prompt)
+		var entries []SendDryRunEntry
+		for _, group := range promptGroups {
+			entries = append(entries, buildSendDryRunEntries(group.Panes, sendPreviewPrompt(group.Prompt), promptSource, multiWindow)...)
+		}
 		return finishSendDryRunResult(opts, SendDryRunResult{
 			Success:              true,
 			DryRun:               true,
@@ -2050,6 +2581,7 @@ func runSendInternal(opts SendOptions) (err error) {
 			Warnings:             redactionWarnings,
 			Blocked:              false,
 			Total:                len(entries),
+			ResolvedPrompt:       previewPrompt,
 			WouldSend:            entries,
 			RoutedTo:             opts.routingResult,
 			DispatchPacing:       dispatchPacing,
@@ -2076,6 +2608,38 @@ func runSendInternal(opts SendOptions) (err error) {
 		histErr = dispatchErr
 	}
 
+	var echoConfirmations []SendEchoConfirmation
+	var echoErr error
+	var retries int
+	if (opts.ConfirmEcho || opts.RetryCount > 0) && delivered > 0 {
+		wait := opts.ConfirmEchoTimeout
+		if !opts.ConfirmEcho {
+			wait = sendRetryBackoff(opts)
+		}
+		for i, receipts := range groupReceipts {
+			groupPrompt := promptGroups[i].Prompt
+			confirmations := confirmSendEcho(ctx, receipts, groupPrompt, echoBaseline, wait)
+			if opts.RetryCount > 0 {
+				var groupRetries int
+				confirmations, groupRetries = retryUnechoedSends(ctx, confirmations, receipts, groupPrompt, echoBaseline, opts.RetryCount, sendRetryBackoff(opts),
+					func(retry []tmux.Pane) (dispatchsvc.Result, error) {
+						prepared, err := dispatchService.Prepare(ctx, shellDispatchRequest(session, panes, retry, groupPrompt, false))
+						if err != nil {
+							return dispatchsvc.Result{}, err
+						}
+						return dispatchService.Dispatch(ctx, prepared)
+					})
+				retries = max(retries, groupRetries)
+			}
+			echoConfirmations = append(echoConfirmations, confirmations...)
+		}
+		if opts.RetryCount > 0 {
+			echoErr = sendRetryError(echoConfirmations, retries)
+		} else {
+			echoErr = sendEchoError(echoConfirmations, wait)
+		}
+	}
+
 	// Preserve the explicit single-pane command's receipt and lifecycle: it has
 	// historically returned before broadcast post-hooks and prompt-send events.
 	if explicitSingle {
@@ -2098,6 +2662,7 @@ func runSendInternal(opts SendOptions) (err error) {
 				Failed:               failed,
 				RoutedTo:             opts.routingResult,
 				DispatchPacing:       dispatchPacing,
+				LinesSent:            linesSent,
 				ErrorCode:            errorCode,
 				Error:                firstDeliveryErr.Error(),
 			}
@@ -2121,11 +2686,31 @@ func runSendInternal(opts SendOptions) (err error) {
 			Failed:               failed,
 			RoutedTo:             opts.routingResult,
 			DispatchPacing:       dispatchPacing,
+			EchoConfirmations:    echoConfirmations,
+			Retries:              retries,
+			LinesSent:            linesSent,
+		}
+		if echoErr != nil {
+			histSuccess = false
+			histErr = echoErr
+			result.Success = false
+			result.ErrorCode = sendErrorCodeUnconfirmed
+			result.Error = echoErr.Error()
 		}
 		if jsonOutput || opts.executionPolicy == sendExecutionCollect {
-			return finishSendResult(opts, result, nil)
+			return finishSendResult(opts, result, echoErr)
+		}
+		if linesSent > 0 {
+			fmt.Printf("Sent %d line(s) to pane %s\n", linesSent, targetPanes[0])
+		} else {
+			fmt.Printf("Sent to pane %s\n", targetPanes[0])
+		}
+		if echoErr != nil {
+			return echoErr
+		}
+		if len(echoConfirmations) > 0 {
+			fmt.Printf("Confirmed prompt echo in pane %s\n", targetPanes[0])
 		}
-		fmt.Printf("Sent to pane %s\n", targetPanes[0])
 		return nil
 	}
 	if firstDeliveryErr != nil && !jsonOutput && !silent {
@@ -2181,8 +2766,16 @@ func runSendInternal(opts SendOptions) (err error) {
 		Failed:               failed,
 		RoutedTo:             opts.routingResult,
 		DispatchPacing:       dispatchPacing,
+		EchoConfirmations:    echoConfirmations,
+		Retries:              retries,
+		LinesSent:            linesSent,
 	}
-	if !result.Success {
+	if result.Success && echoErr != nil {
+		result.Success = false
+		result.ErrorCode = sendErrorCodeUnconfirmed
+		result.Error = echoErr.Error()
+		histErr = echoErr
+	} else if !result.Success {
 		result.ErrorCode = sendErrorCodeFailed
 		result.Error = fmt.Sprintf("%d pane(s) failed", failed)
 		if firstDeliveryErr != nil {
@@ -2205,11 +2798,22 @@ func runSendInternal(opts SendOptions) (err error) {
 		histErr = errors.New("no matching panes found")
 		fmt.Println("No matching panes found")
 	} else {
-		fmt.Printf("Sent to %d pane(s)\n", delivered)
+		if linesSent > 0 {
+			fmt.Printf("Sent %d line(s) to %d pane(s)\n", linesSent, delivered)
+		} else {
+			fmt.Printf("Sent to %d pane(s)\n", delivered)
+		}
 		histSuccess = failed == 0 && delivered > 0
 		if failed > 0 && histErr == nil {
 			histErr = fmt.Errorf("%d pane(s) failed", failed)
 		}
+		if echoErr != nil {
+			histSuccess = false
+			return echoErr
+		}
+		if len(echoConfirmations) > 0 {
+			fmt.Printf("Confirmed prompt echo in %d pane(s)\n", len(echoConfirmations))
+		}
 		// Show "What's next?" suggestions only on complete success
 		if failed == 0 {
 			output.SuccessFooter(output.SendSuggestions(session)...)
@@ -2219,6 +2823,201 @@ func runSendInternal(opts SendOptions) (err error) {
 	return nil
 }
 
+// sendEchoPollInterval is how often --confirm-echo re-captures pending panes.
+const sendEchoPollInterval = 250 * time.Millisecond
+
+// sendEchoNeedleRunes caps how much of the prompt --confirm-echo looks for;
+// agents often truncate or reflow long prompts in their input box.
+const sendEchoNeedleRunes = 40
+
+// captureSendEchoPane is swapped out in tests.
+var captureSendEchoPane = func(ctx context.Context, target string) (string, error) {
+	return tmux.CapturePaneOutputContext(ctx, target, 200)
+}
+
+// sendEchoNeedle returns the prefix of the first non-blank prompt line with
+// all whitespace removed, so terminal line wrapping cannot hide a match.
+func sendEchoNeedle(prompt string) string {
+	for _, line := range strings.Split(prompt, "\n") {
+		needle := stripSendEchoSpace(line)
+		if needle == "" {
+			continue
+		}
+		if runes := []rune(needle); len(runes) > sendEchoNeedleRunes {
+			needle = string(runes[:sendEchoNeedleRunes])
+		}
+		return needle
+	}
+	return ""
+}
+
+func stripSendEchoSpace(s string) string {
+	return strings.Join(strings.Fields(s), "")
+}
+
+// sendEchoBaseline holds each pane's capture, whitespace stripped, taken
+// just before dispatch and keyed by pane ID.
+type sendEchoBaseline map[string]string
+
+// captureSendEchoBaseline captures panes before the prompt is sent. A pane
+// that cannot be captured gets no baseline, so any echo confirms it.
+func captureSendEchoBaseline(ctx context.Context, panes []tmux.Pane) sendEchoBaseline {
+	baseline := make(sendEchoBaseline, len(panes))
+	for _, p := range panes {
+		if p.ID == "" {
+			continue
+		}
+		if out, err := captureSendEchoPane(ctx, p.ID); err == nil {
+			baseline[p.ID] = stripSendEchoSpace(out)
+		}
+	}
+	return baseline
+}
+
+// confirmSendEcho polls every delivered pane until the prompt's echo shows up
+// in its capture or timeout elapses. The echo must be new: the capture has to
+// hold more occurrences of it than the pane's baseline did. Capture errors
+// count as "not yet seen".
+func confirmSendEcho(ctx context.Context, receipts []dispatchsvc.Receipt, prompt string, baseline sendEchoBaseline, timeout time.Duration) []SendEchoConfirmation {
+	needle := sendEchoNeedle(prompt)
+	var confirmations []SendEchoConfirmation
+	var captureTargets []string
+	var seenBefore []int
+	for _, receipt := range receipts {
+		if receipt.Status != dispatchsvc.ReceiptDelivered {
+			continue
+		}
+		target := receipt.Target.Pane.ID
+		if target == "" {
+			target = receipt.Target.Address
+		}
+		confirmations = append(confirmations, SendEchoConfirmation{Pane: receipt.Target.Address, Confirmed: needle == ""})
+		captureTargets = append(captureTargets, target)
+		before := 0
+		if needle != "" {
+			before = strings.Count(baseline[target], needle)
+		}
+		seenBefore = append(seenBefore, before)
+	}
+	if needle == "" {
+		return confirmations
+	}
+
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(sendEchoPollInterval)
+	defer ticker.Stop()
+	for {
+		pending := 0
+		for i := range confirmations {
+			if confirmations[i].Confirmed {
+				continue
+			}
+			out, err := captureSendEchoPane(pollCtx, captureTargets[i])
+			if err == nil {
+				stripped := stripSendEchoSpace(out)
+				if strings.Count(stripped, needle) > seenBefore[i] {
+					confirmations[i].Confirmed = true
+					confirmations[i].Changed = false
+					continue
+				}
+				if before, ok := baseline[captureTargets[i]]; ok && stripped != before {
+					confirmations[i].Changed = true
+				}
+			}
+			pending++
+		}
+		if pending == 0 {
+			return confirmations
+		}
+		select {
+		case <-pollCtx.Done():
+			return confirmations
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendEchoError summarizes unconfirmed panes, or returns nil when every pane
+// echoed the prompt.
+func sendEchoError(confirmations []SendEchoConfirmation, timeout time.Duration) error {
+	var unconfirmed []string
+	for _, c := range confirmations {
+		if !c.Confirmed {
+			unconfirmed = append(unconfirmed, c.Pane)
+		}
+	}
+	if len(unconfirmed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("prompt echo not observed within %s in pane(s) %s", timeout, strings.Join(unconfirmed, ", "))
+}
+
+// defaultSendRetryBackoff is the first echo wait for --retry when none is set.
+const defaultSendRetryBackoff = 2 * time.Second
+
+func sendRetryBackoff(opts SendOptions) time.Duration {
+	if opts.RetryBackoff > 0 {
+		return opts.RetryBackoff
+	}
+	return defaultSendRetryBackoff
+}
+
+// retryUnechoedSends re-sends the prompt to panes in confirmations that have
+// not echoed it, up to retries times, waiting backoff (doubled after each
+// attempt) for the echo. Panes whose output changed since the baseline are
+// not resent: the agent most likely took the prompt without echoing it. It
+// returns the updated confirmations and how many retries it made.
+func retryUnechoedSends(ctx context.Context, confirmations []SendEchoConfirmation, receipts []dispatchsvc.Receipt, prompt string, baseline sendEchoBaseline, retries int, backoff time.Duration, resend func([]tmux.Pane) (dispatchsvc.Result, error)) ([]SendEchoConfirmation, int) {
+	panesByAddress := make(map[string]tmux.Pane, len(receipts))
+	for _, receipt := range receipts {
+		panesByAddress[receipt.Target.Address] = receipt.Target.Pane
+	}
+	index := make(map[string]int, len(confirmations))
+	for i, c := range confirmations {
+		index[c.Pane] = i
+	}
+
+	attempt := 0
+	for ; attempt < retries; attempt++ {
+		var pending []tmux.Pane
+		for _, c := range confirmations {
+			if !c.Confirmed && !c.Changed {
+				pending = append(pending, panesByAddress[c.Pane])
+			}
+		}
+		if len(pending) == 0 || ctx.Err() != nil {
+			break
+		}
+		// A failed resend still counts as an attempt; the next one may land.
+		result, _ := resend(pending)
+		for _, c := range confirmSendEcho(ctx, result.Receipts, prompt, baseline, backoff) {
+			if i, ok := index[c.Pane]; ok {
+				confirmations[i].Confirmed = confirmations[i].Confirmed || c.Confirmed
+				confirmations[i].Changed = !confirmations[i].Confirmed && (confirmations[i].Changed || c.Changed)
+			}
+		}
+		backoff *= 2
+	}
+	return confirmations, attempt
+}
+
+// sendRetryError names the panes that never echoed the prompt after retries
+// re-sends, or returns nil when every pane accepted it. Panes whose output
+// changed count as accepted.
+func sendRetryError(confirmations []SendEchoConfirmation, retries int) error {
+	var unconfirmed []string
+	for _, c := range confirmations {
+		if !c.Confirmed && !c.Changed {
+			unconfirmed = append(unconfirmed, c.Pane)
+		}
+	}
+	if len(unconfirmed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("prompt not accepted by pane(s) %s after %d retries; the agent may not be ready", strings.Join(unconfirmed, ", "), retries)
+}
+
 func saveDeliveredPrompt(delivered int, entry sessionPkg.PromptEntry) error {
 	if delivered <= 0 {
 		return nil
@@ -2242,6 +3041,62 @@ func paneAgentLabel(p tmux.Pane) string {
 	return fmt.Sprintf("pane_%d", p.Index)
 }
 
+// splitSendLines returns the non-empty lines of prompt for
+// --each-line-separate, with trailing carriage returns removed.
+func splitSendLines(prompt string) []string {
+	var lines []string
+	for _, line := range strings.Split(prompt, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// dispatchSendLines dispatches lines one at a time, pausing delay between
+// them. It stops at the first line that does not reach every target, so a
+// pane never receives later lines without the earlier ones, and returns the
+// last dispatch result with the number of lines delivered everywhere.
+func dispatchSendLines(ctx context.Context, lines []string, delay time.Duration, dispatch func(line string) (dispatchsvc.Result, error)) (dispatchsvc.Result, int, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var result dispatchsvc.Result
+	sent := 0
+	for i, line := range lines {
+		if i > 0 && delay > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return result, sent, ctx.Err()
+			case <-timer.C:
+			}
+		}
+		res, err := dispatch(line)
+		result = res
+		if err != nil || !res.Success || res.Failed > 0 {
+			return result, sent, err
+		}
+		sent++
+	}
+	return result, sent, nil
+}
+
+// sendPreviewPrompt redacts every detected secret from the fully resolved
+// prompt for dry-run display, whatever the configured redaction mode, so the
+// preview can be shared without leaking credentials.
+func sendPreviewPrompt(prompt string) string {
+	redactCfg := redaction.DefaultConfig()
+	if cfg != nil {
+		redactCfg = cfg.Redaction.ToRedactionLibConfig()
+	}
+	preview, _ := redaction.Redact(prompt, redactCfg)
+	return preview
+}
+
 func buildSendDryRunEntries(panes []tmux.Pane, prompt string, source string, topology ...bool) []SendDryRunEntry {
 	multiWindow := tmux.PanesSpanMultipleWindows(panes)
 	if len(topology) > 0 {
@@ -2321,6 +3176,14 @@ func printSendDryRunResult(result SendDryRunResult) error {
 		fmt.Printf("Routing: pane %d (%s) via %s\n\n", result.RoutedTo.PaneIndex, result.RoutedTo.AgentType, result.RoutedTo.Strategy)
 	}
 
+	if result.ResolvedPrompt != "" {
+		fmt.Println("Resolved prompt (secrets redacted):")
+		fmt.Println("----")
+		fmt.Println(strings.TrimRight(result.ResolvedPrompt, "\n"))
+		fmt.Println("----")
+		fmt.Println()
+	}
+
 	fmt.Printf("Would send %d prompt(s):\n", result.Total)
 	for i, w := range result.WouldSend {
 		source := w.Source
@@ -3336,6 +4199,35 @@ func maybeBlockSendWithDCG(prompt, session string, panes []tmux.Pane) error {
 	return nil
 }
 
+// confirmSendCommandsPrompt asks the user to approve the detected commands;
+// swapped out in tests.
+var confirmSendCommandsPrompt = func(commands []string, panes int) bool {
+	fmt.Fprintf(os.Stderr, "The prompt contains %d likely shell command(s):\n", len(commands))
+	for _, command := range commands {
+		fmt.Fprintf(os.Stderr, "  %s\n", command)
+	}
+	return confirmHuhDestructive(fmt.Sprintf("Send these commands to %d pane(s)?", panes), "Agents may run them.")
+}
+
+// confirmSendCommandsTTY reports whether --confirm-commands can prompt.
+var confirmSendCommandsTTY = isTTY
+
+// confirmSendCommands enforces --confirm-commands. It fails closed when the
+// user cannot be asked, since this guard exists for destructive prompts.
+func confirmSendCommands(commands []string, panes int, silent bool) error {
+	if len(commands) == 0 {
+		return nil
+	}
+	if jsonOutput || silent || !confirmSendCommandsTTY() {
+		return fmt.Errorf("%w: --confirm-commands needs an interactive terminal to confirm %d command(s): %s",
+			errSendCommandsNotConfirmed, len(commands), strings.Join(commands, "; "))
+	}
+	if !confirmSendCommandsPrompt(commands, panes) {
+		return fmt.Errorf("send aborted: %w", errSendCommandsNotConfirmed)
+	}
+	return nil
+}
+
 func hasNonClaudeTargets(panes []tmux.Pane) bool {
 	for _, p := range panes {
 		if isNonClaudeAgent(p) {
@@ -3684,9 +4576,7 @@ func emitCodexGoalSendResult(res CodexGoalSendResult, failErr error, code, hint
 		res.Hint = hint
 	}
 	if emitJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		if err := enc.Encode(res); err != nil {
+		if err := output.WriteJSON(os.Stdout, res, true); err != nil {
 			return fmt.Errorf("encode Codex goal-send response: %w", err)
 		}
 		if failErr != nil {
@@ -4900,6 +5790,80 @@ func promptBatchAction(prompt string) batchAction {
 	}
 }
 
+// hasCwdFilter reports whether --cwd or --cwd-prefix was given.
+func (opts SendOptions) hasCwdFilter() bool {
+	return opts.Cwd != "" || opts.CwdPrefix != ""
+}
+
+// resolvePaneCwds fetches pane working directories from tmux when a cwd
+// filter is active. It fails when tmux reports no paths at all, since the
+// filter would otherwise silently match nothing.
+func (opts *SendOptions) resolvePaneCwds(ctx context.Context, session string) error {
+	if !opts.hasCwdFilter() || opts.paneCwds != nil {
+		return nil
+	}
+	paths, err := tmux.GetPaneCurrentPathsContext(ctx, session)
+	if err != nil {
+		return fmt.Errorf("reading pane working directories for --cwd filter: %w", err)
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("tmux did not report pane working directories for session '%s'; --cwd/--cwd-prefix require #{pane_current_path} support", session)
+	}
+	opts.paneCwds = paths
+	return nil
+}
+
+// matchesPaneCwd applies the --cwd/--cwd-prefix filter. Panes always match
+// when no cwd filter is set.
+func (opts SendOptions) matchesPaneCwd(p tmux.Pane) bool {
+	if !opts.hasCwdFilter() {
+		return true
+	}
+	path, ok := opts.paneCwds[p.ID]
+	if !ok {
+		return false
+	}
+	return paneCwdMatches(path, opts.Cwd, opts.CwdPrefix)
+}
+
+// paneCwdMatches compares a pane path against an exact or prefix filter.
+// Prefix matching respects path boundaries, so /src/api does not match
+// /src/api-v2.
+func paneCwdMatches(path, exact, prefix string) bool {
+	path = filepath.Clean(path)
+	if exact != "" {
+		return path == normalizeSendCwd(exact)
+	}
+	if prefix != "" {
+		prefix = normalizeSendCwd(prefix)
+		if path == prefix {
+			return true
+		}
+		return strings.HasPrefix(path, strings.TrimSuffix(prefix, string(filepath.Separator))+string(filepath.Separator))
+	}
+	return true
+}
+
+func normalizeSendCwd(path string) string {
+	path = config.ExpandHome(path)
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	return filepath.Clean(path)
+}
+
+// pickEdgePane narrows topology-ordered panes to the newest (last, highest
+// index) or oldest (first, lowest index) one. Empty input stays empty.
+func pickEdgePane(panes []tmux.Pane, newest bool) []tmux.Pane {
+	if len(panes) == 0 {
+		return panes
+	}
+	if newest {
+		return panes[len(panes)-1:]
+	}
+	return panes[:1]
+}
+
 // filterPanesForBatch applies target and tag filters to the given panes
 func filterPanesForBatch(panes []tmux.Pane, opts SendOptions) []tmux.Pane {
 	var filtered []tmux.Pane
@@ -4913,6 +5877,9 @@ func filterPanesForBatch(panes []tmux.Pane, opts SendOptions) []tmux.Pane {
 		if opts.SkipFirst && i == 0 {
 			continue
 		}
+		if !opts.matchesPaneCwd(p) {
+			continue
+		}
 		// If --all, include everything
 		if opts.TargetAll {
 			filtered = append(filtered, p)
@@ -4952,6 +5919,119 @@ func filterPanesForBatch(panes []tmux.Pane, opts SendOptions) []tmux.Pane {
 	return filtered
 }
 
+// BatchWeight is one tag group's share of a weighted round-robin batch.
+type BatchWeight struct {
+	Tag    string `json:"tag"`
+	Weight int    `json:"weight"`
+}
+
+// parseBatchWeights parses --weights "tag=frontend:1,backend:2". An empty
+// spec means even distribution.
+func parseBatchWeights(spec string) ([]BatchWeight, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, nil
+	}
+	groups, ok := strings.CutPrefix(spec, "tag=")
+	if !ok {
+		return nil, fmt.Errorf("invalid --weights %q: must start with tag= (e.g. tag=frontend:1,backend:2)", spec)
+	}
+	var weights []BatchWeight
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(groups, ",") {
+		tag, value, ok := strings.Cut(strings.TrimSpace(part), ":")
+		tag = strings.TrimSpace(tag)
+		if !ok || tag == "" {
+			return nil, fmt.Errorf("invalid --weights entry %q: want <tag>:<weight>", part)
+		}
+		weight, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || weight <= 0 {
+			return nil, fmt.Errorf("invalid --weights entry %q: weight must be a positive integer", part)
+		}
+		if seen[tag] {
+			return nil, fmt.Errorf("invalid --weights: tag %q listed twice", tag)
+		}
+		seen[tag] = true
+		weights = append(weights, BatchWeight{Tag: tag, Weight: weight})
+	}
+	return weights, nil
+}
+
+func formatBatchWeights(weights []BatchWeight) string {
+	parts := make([]string, 0, len(weights))
+	for _, w := range weights {
+		parts = append(parts, fmt.Sprintf("%s:%d", w.Tag, w.Weight))
+	}
+	return "tag=" + strings.Join(parts, ",")
+}
+
+// newBatchPaneScheduler returns a function yielding the pane for each
+// successive round-robin batch prompt. Without weights it cycles through
+// panes evenly. With weights, panes are grouped by the first weighted tag
+// they carry (untagged panes form a weight-1 group) and groups are picked by
+// smooth weighted round-robin, so a weight-2 group gets twice the prompts of
+// a weight-1 group, interleaved; panes within a group take turns.
+func newBatchPaneScheduler(panes []tmux.Pane, weights []BatchWeight) func() tmux.Pane {
+	if len(weights) == 0 {
+		next := 0
+		return func() tmux.Pane {
+			p := panes[next%len(panes)]
+			next++
+			return p
+		}
+	}
+
+	type paneGroup struct {
+		panes   []tmux.Pane
+		weight  int
+		current int
+		next    int
+	}
+	var groups []*paneGroup
+	byTag := make(map[string]*paneGroup)
+	var other *paneGroup
+	for _, p := range panes {
+		var group *paneGroup
+		for _, w := range weights {
+			if !HasAnyTag(p.Tags, []string{w.Tag}) {
+				continue
+			}
+			if group = byTag[w.Tag]; group == nil {
+				group = &paneGroup{weight: w.Weight}
+				byTag[w.Tag] = group
+				groups = append(groups, group)
+			}
+			break
+		}
+		if group == nil {
+			if other == nil {
+				other = &paneGroup{weight: 1}
+				groups = append(groups, other)
+			}
+			group = other
+		}
+		group.panes = append(group.panes, p)
+	}
+
+	total := 0
+	for _, g := range groups {
+		total += g.weight
+	}
+	return func() tmux.Pane {
+		var best *paneGroup
+		for _, g := range groups {
+			g.current += g.weight
+			if best == nil || g.current > best.current {
+				best = g
+			}
+		}
+		best.current -= total
+		p := best.panes[best.next%len(best.panes)]
+		best.next++
+		return p
+	}
+}
+
 // runSendBatch handles --batch mode: send multiple prompts from file
 func runSendBatch(opts SendOptions) error {
 	ctx := opts.Context
@@ -4973,6 +6053,13 @@ func runSendBatch(opts SendOptions) error {
 			prompts[i].Text = applyBasePrompt(opts.BasePrompt, prompts[i].Text)
 		}
 	}
+	for i := range prompts {
+		text, err := applyPreSendHook(ctx, opts.Session, prompts[i].Text)
+		if err != nil {
+			return fmt.Errorf("batch prompt %s: %w", prompts[i].Source, err)
+		}
+		prompts[i].Text = text
+	}
 
 	// Sort by priority annotation if --priority-order (bd-2wzs).
 	// Applied before randomization so priority wins.
@@ -5005,11 +6092,14 @@ func runSendBatch(opts SendOptions) error {
 	panes = sortPanesByTopology(panes)
 	multiWindow := tmux.PanesSpanMultipleWindows(panes)
 
-	// Apply agent type and tag filters
+	// Apply agent type, tag, and working-directory filters
+	if err := opts.resolvePaneCwds(ctx, opts.Session); err != nil {
+		return err
+	}
 	agentPanes := filterPanesForBatch(panes, opts)
 
 	if len(agentPanes) == 0 {
-		return errors.New("no matching agent panes found in session (check --cc/--cod/--gmi/--tag filters)")
+		return errors.New("no matching agent panes found in session (check --cc/--cod/--gmi/--tag/--cwd filters)")
 	}
 
 	var batchAgentPane *tmux.Pane
@@ -5021,9 +6111,10 @@ func runSendBatch(opts SendOptions) error {
 		batchAgentPane = &selected[0]
 	}
 
+	nextAgent := newBatchPaneScheduler(agentPanes, opts.BatchWeights)
+
 	if opts.DryRun {
 		entries := make([]SendDryRunEntry, 0, total)
-		currentAgent := 0
 
 		for _, bp := range prompts {
 			var targetPanes []tmux.Pane
@@ -5032,8 +6123,7 @@ func runSendBatch(opts SendOptions) error {
 			} else if opts.BatchAgentIndex >= 0 {
 				targetPanes = []tmux.Pane{*batchAgentPane}
 			} else {
-				targetPanes = []tmux.Pane{agentPanes[currentAgent%len(agentPanes)]}
-				currentAgent++
+				targetPanes = []tmux.Pane{nextAgent()}
 			}
 			preview, err := executeShellDispatch(ctx, opts.Session, panes, targetPanes, bp.Text, true)
 			if err != nil {
@@ -5082,6 +6172,8 @@ func runSendBatch(opts SendOptions) error {
 			fmt.Println("Mode: broadcast (same prompt to all agents)")
 		} else if opts.BatchAgentIndex >= 0 {
 			fmt.Printf("Mode: single agent (pane %s)\n", tmux.PaneTargetKey(*batchAgentPane, multiWindow))
+		} else if len(opts.BatchWeights) > 0 {
+			fmt.Printf("Mode: weighted round-robin (%s)\n", formatBatchWeights(opts.BatchWeights))
 		} else {
 			fmt.Println("Mode: round-robin across agents")
 		}
@@ -5091,7 +6183,6 @@ func runSendBatch(opts SendOptions) error {
 	// Track results
 	results := make([]BatchPromptResult, 0, total)
 	var delivered, failed, skipped int
-	currentAgent := 0
 	interrupted := false
 	var batchCause error
 
@@ -5150,9 +6241,8 @@ func runSendBatch(opts SendOptions) error {
 			// Send to specific pane
 			targetPanes = []tmux.Pane{*batchAgentPane}
 		} else {
-			// Round-robin: cycle through agents
-			targetPanes = []tmux.Pane{agentPanes[currentAgent%len(agentPanes)]}
-			currentAgent++
+			// Round-robin: cycle through agents, weighted by --weights
+			targetPanes = []tmux.Pane{nextAgent()}
 		}
 
 		dispatchResult, sendErr := executeShellDispatch(ctx, opts.Session, panes, targetPanes, promptText, false)
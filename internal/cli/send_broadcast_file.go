@@ -0,0 +1,257 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Dicklesworthstone/ntm/internal/output"
+	"github.com/Dicklesworthstone/ntm/internal/tmux"
+)
+
+// BroadcastFileAssignment records which pane one file was sent to.
+type BroadcastFileAssignment struct {
+	File      string `json:"file"`
+	Pane      string `json:"pane"`
+	PaneID    string `json:"pane_id,omitempty"`
+	Agent     string `json:"agent,omitempty"`
+	Bytes     int    `json:"bytes"`
+	Delivered bool   `json:"delivered"`
+	Error     string `json:"error,omitempty"`
+}
+
+// BroadcastFileResult is the JSON output of `ntm send broadcast-file`.
+type BroadcastFileResult struct {
+	Success     bool                      `json:"success"`
+	Session     string                    `json:"session"`
+	Dir         string                    `json:"dir"`
+	Glob        string                    `json:"glob"`
+	SeedUsed    int64                     `json:"seed_used"`
+	DryRun      bool                      `json:"dry_run,omitempty"`
+	Files       int                       `json:"files"`
+	Panes       int                       `json:"panes"`
+	Delivered   int                       `json:"delivered"`
+	Failed      int                       `json:"failed"`
+	Assignments []BroadcastFileAssignment `json:"assignments"`
+	Error       string                    `json:"error,omitempty"`
+}
+
+type sendBroadcastFileOptions struct {
+	Dir     string
+	Glob    string
+	Prefix  string
+	Seed    int64
+	Targets SendTargets
+	Tags    []string
+	DryRun  bool
+}
+
+func newSendBroadcastFileCmd() *cobra.Command {
+	opts := sendBroadcastFileOptions{Glob: "*"}
+
+	cmd := &cobra.Command{
+		Use:   "broadcast-file [session] <dir>",
+		Short: "Send each file in a directory to the next agent in rotation",
+		Long: `Read every file in a directory (sorted by name, filtered by --glob) and send
+each one as its own prompt, rotating through the session's agent panes.
+
+The pane order is shuffled once with a deterministic permutation before the
+rotation starts, so repeated runs do not always hand the first file to the
+same agent. Pass --seed to reproduce a previous distribution; the seed used
+is always reported. Subdirectories are skipped.
+
+Examples:
+  ntm send broadcast-file myproject ./diffs
+  ntm send broadcast-file myproject ./diffs --glob '*.patch' --prefix "Review this diff:"
+  ntm send broadcast-file myproject ./diffs --cc --seed 42 --dry-run`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session := ""
+			opts.Dir = args[len(args)-1]
+			if len(args) == 2 {
+				session = args[0]
+			}
+			res, err := ResolveSession(session, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			if res.Session == "" {
+				return nil
+			}
+			res.ExplainIfInferred(os.Stderr)
+			return runSendBroadcastFile(cmd.Context(), cmd.OutOrStdout(), res.Session, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Glob, "glob", "*", "only send files whose name matches this pattern")
+	cmd.Flags().StringVar(&opts.Prefix, "prefix", "", "text to prepend to each file's content")
+	cmd.Flags().Int64Var(&opts.Seed, "seed", 0, "deterministic seed for the pane rotation (0 = time-based)")
+	cmd.Flags().Var(newSendTargetValue(AgentTypeClaude, &opts.Targets), "cc", "rotate across Claude agents (optional :variant filter)")
+	cmd.Flags().Lookup("cc").NoOptDefVal = "true"
+	cmd.Flags().Var(newSendTargetValue(AgentTypeCodex, &opts.Targets), "cod", "rotate across Codex agents (optional :variant filter)")
+	cmd.Flags().Lookup("cod").NoOptDefVal = "true"
+	cmd.Flags().Var(newSendTargetValue(AgentTypeGemini, &opts.Targets), "gmi", "rotate across Gemini agents (optional :variant filter)")
+	cmd.Flags().Lookup("gmi").NoOptDefVal = "true"
+	cmd.Flags().StringSliceVar(&opts.Tags, "tag", nil, "filter by tag (OR logic)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "show the file-to-pane mapping without sending")
+	cmd.ValidArgsFunction = completeSessionArgs
+	return cmd
+}
+
+func runSendBroadcastFile(ctx context.Context, w io.Writer, session string, opts sendBroadcastFileOptions) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	result := BroadcastFileResult{
+		Session:     session,
+		Dir:         opts.Dir,
+		Glob:        opts.Glob,
+		DryRun:      opts.DryRun,
+		Assignments: []BroadcastFileAssignment{},
+	}
+	fail := func(err error) error {
+		if !IsJSONOutput() {
+			return err
+		}
+		result.Error = err.Error()
+		return emitJSONFailureEnvelopeToWithCause(w, result, err)
+	}
+
+	files, err := listBroadcastFiles(opts.Dir, opts.Glob)
+	if err != nil {
+		return fail(err)
+	}
+	if len(files) == 0 {
+		return fail(fmt.Errorf("no files in %s match %q", opts.Dir, opts.Glob))
+	}
+
+	panes, err := tmux.GetPanesContext(ctx, session)
+	if err != nil {
+		return fail(fmt.Errorf("getting session panes: %w", err))
+	}
+	panes = sortPanesByTopology(panes)
+	multiWindow := tmux.PanesSpanMultipleWindows(panes)
+	agentPanes := filterPanesForBatch(panes, SendOptions{Targets: opts.Targets, Tags: opts.Tags})
+	if len(agentPanes) == 0 {
+		return fail(errors.New("no matching agent panes found in session (check --cc/--cod/--gmi/--tag filters)"))
+	}
+
+	seedUsed, targets := assignFilesToPanes(len(files), agentPanes, opts.Seed)
+	result.SeedUsed = seedUsed
+	result.Files = len(files)
+	result.Panes = len(agentPanes)
+
+	var firstErr error
+	for i, path := range files {
+		if err := ctx.Err(); err != nil {
+			return fail(fmt.Errorf("broadcast-file canceled: %w", err))
+		}
+		pane := targets[i]
+		entry := BroadcastFileAssignment{
+			File:   filepath.Base(path),
+			Pane:   tmux.PaneTargetKey(pane, multiWindow),
+			PaneID: pane.ID,
+			Agent:  paneAgentLabel(pane),
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fail(fmt.Errorf("reading %s: %w", path, err))
+		}
+		entry.Bytes = len(content)
+
+		if !opts.DryRun {
+			prompt, err := applyPreSendHook(ctx, session, buildPrompt(string(content), opts.Prefix, ""))
+			if err != nil {
+				return fail(fmt.Errorf("%s: %w", entry.File, err))
+			}
+			dispatch, sendErr := executeShellDispatch(ctx, session, panes, []tmux.Pane{pane}, prompt, false)
+			if sendErr == nil && dispatch.Delivered == 0 {
+				sendErr = errors.New("pane dispatch did not complete")
+			}
+			if sendErr != nil {
+				entry.Error = sendErr.Error()
+				result.Failed++
+				if firstErr == nil {
+					firstErr = fmt.Errorf("sending %s: %w", entry.File, sendErr)
+				}
+			} else {
+				entry.Delivered = true
+				result.Delivered++
+			}
+		}
+		result.Assignments = append(result.Assignments, entry)
+	}
+	result.Success = result.Failed == 0
+
+	if IsJSONOutput() {
+		if firstErr != nil {
+			return emitJSONFailureEnvelopeToWithCause(w, result, firstErr)
+		}
+		return output.WriteJSON(w, result, true)
+	}
+
+	if opts.DryRun {
+		fmt.Fprintf(w, "Would send %d file(s) across %d pane(s) (seed=%d)\n\n", result.Files, result.Panes, seedUsed)
+	} else {
+		fmt.Fprintf(w, "Sent %d/%d file(s) across %d pane(s) (seed=%d)\n\n", result.Delivered, result.Files, result.Panes, seedUsed)
+	}
+	table := output.NewTable(w, "FILE", "PANE", "AGENT", "STATUS")
+	for _, a := range result.Assignments {
+		status := "delivered"
+		switch {
+		case opts.DryRun:
+			status = "dry-run"
+		case a.Error != "":
+			status = "failed: " + truncateWithEllipsis(a.Error, 60)
+		}
+		table.AddRow(a.File, a.Pane, a.Agent, status)
+	}
+	table.Render()
+	return firstErr
+}
+
+// listBroadcastFiles returns the regular files directly inside dir whose
+// names match glob, sorted by name.
+func listBroadcastFiles(dir, glob string) ([]string, error) {
+	if strings.TrimSpace(glob) == "" {
+		glob = "*"
+	}
+	if _, err := filepath.Match(glob, ""); err != nil {
+		return nil, fmt.Errorf("invalid --glob %q: %w", glob, err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading directory: %w", err)
+	}
+	var files []string
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		if ok, _ := filepath.Match(glob, entry.Name()); ok {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// assignFilesToPanes shuffles the panes once and hands file i to pane i
+// modulo the pane count, so each pane receives at most one more file than
+// any other.
+func assignFilesToPanes(fileCount int, panes []tmux.Pane, seed int64) (int64, []tmux.Pane) {
+	seedUsed, perm := shuffledPermutation(len(panes), seed)
+	order := permutePanes(panes, perm)
+	targets := make([]tmux.Pane, fileCount)
+	for i := range targets {
+		targets[i] = order[i%len(order)]
+	}
+	return seedUsed, targets
+}
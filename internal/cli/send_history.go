@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Dicklesworthstone/ntm/internal/history"
+	"github.com/Dicklesworthstone/ntm/internal/output"
+)
+
+// SendHistoryResult is the JSON output of `ntm send history`.
+type SendHistoryResult struct {
+	Success    bool               `json:"success"`
+	Session    string             `json:"session,omitempty"`
+	Entries    []HistoryListEntry `json:"entries"`
+	TotalCount int                `json:"total_count"`
+	Showing    int                `json:"showing"`
+	Cleared    int                `json:"cleared,omitempty"`
+}
+
+func newSendHistoryCmd() *cobra.Command {
+	var (
+		limit  int
+		format string
+		clear  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "history [session]",
+		Short: "List prompts delivered by ntm send",
+		Long: `List recent successful 'ntm send' deliveries, newest first: when, which
+session and panes, and the prompt. Entries come from the prompt history log
+(see 'ntm history'), which redacts secrets before writing; prompts are
+redacted again for display.
+
+Examples:
+  ntm send history                  # Recent sends across sessions
+  ntm send history myproject -n 50  # Last 50 sends to one session
+  ntm send history --format=json    # Machine-readable
+  ntm send history myproject --clear`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session := ""
+			if len(args) > 0 {
+				session = args[0]
+			}
+			switch format {
+			case "text", "json":
+			default:
+				return fmt.Errorf("invalid --format %q (want text or json)", format)
+			}
+			resolved, err := resolveHistorySessionFilter(cmd.Context(), session)
+			if err != nil {
+				return err
+			}
+			return runSendHistory(cmd.OutOrStdout(), resolved, limit, jsonOutput || format == "json", clear)
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "n", 20, "Number of sends to show")
+	cmd.Flags().StringVar(&format, "format", "text", "Output format: text or json")
+	cmd.Flags().BoolVar(&clear, "clear", false, "Remove the listed send history (all sessions, or just [session])")
+	cmd.ValidArgsFunction = completeSessionArgs
+	return cmd
+}
+
+// isSendHistoryEntry reports whether e records a successful `ntm send`,
+// optionally limited to session.
+func isSendHistoryEntry(e history.HistoryEntry, session string) bool {
+	return e.Source == history.SourceCLI && e.Success && (session == "" || e.Session == session)
+}
+
+func runSendHistory(w io.Writer, session string, limit int, asJSON, clear bool) error {
+	result := SendHistoryResult{Success: true, Session: session, Entries: []HistoryListEntry{}}
+	if clear {
+		removed, err := history.RemoveWhere(func(e history.HistoryEntry) bool {
+			return isSendHistoryEntry(e, session)
+		})
+		if err != nil {
+			return err
+		}
+		result.Cleared = removed
+		if asJSON {
+			return output.WriteJSON(w, result, true)
+		}
+		fmt.Fprintf(w, "Cleared %d send history entries\n", removed)
+		return nil
+	}
+
+	if limit <= 0 {
+		return fmt.Errorf("--limit must be greater than 0")
+	}
+	all, err := history.ReadAll()
+	if err != nil {
+		return err
+	}
+	var sends []history.HistoryEntry
+	for _, e := range all {
+		if isSendHistoryEntry(e, session) {
+			sends = append(sends, e)
+		}
+	}
+	result.TotalCount = len(sends)
+	if len(sends) > limit {
+		sends = sends[len(sends)-limit:]
+	}
+	// Newest first.
+	for i := len(sends) - 1; i >= 0; i-- {
+		e := sends[i]
+		result.Entries = append(result.Entries, HistoryListEntry{
+			ID:         e.ID,
+			Timestamp:  e.Timestamp,
+			Session:    e.Session,
+			Targets:    e.Targets,
+			Prompt:     sendPreviewPrompt(e.Prompt),
+			Source:     string(e.Source),
+			Template:   e.Template,
+			Success:    e.Success,
+			DurationMs: e.DurationMs,
+		})
+	}
+	result.Showing = len(result.Entries)
+
+	if asJSON {
+		return output.WriteJSON(w, result, true)
+	}
+	if len(result.Entries) == 0 {
+		fmt.Fprintln(w, "No sends recorded")
+		return nil
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIME\tSESSION\tTARGETS\tPROMPT")
+	for _, e := range result.Entries {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n",
+			e.Timestamp.Local().Format("2006-01-02 15:04:05"),
+			e.Session,
+			formatTargets(e.Targets),
+			truncateHistoryStr(strings.TrimSpace(e.Prompt), 60))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if result.TotalCount > result.Showing {
+		fmt.Fprintf(w, "\nShowing %d of %d sends. Use --limit for more.\n", result.Showing, result.TotalCount)
+	}
+	return nil
+}
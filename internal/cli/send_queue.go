@@ -0,0 +1,706 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Dicklesworthstone/ntm/internal/output"
+	"github.com/Dicklesworthstone/ntm/internal/redaction"
+	"github.com/Dicklesworthstone/ntm/internal/tmux"
+	"github.com/Dicklesworthstone/ntm/internal/util"
+)
+
+const sendQueueDirName = "send-queue"
+
+// queuedSend is one deferred `ntm send --queue` or `--after`/`--at` request.
+// Options keeps the full send configuration so targets, hooks, and redaction
+// apply unchanged when the queue is flushed. SendAt is set for scheduled
+// sends, which stay queued until it passes.
+type queuedSend struct {
+	ID       string      `json:"id,omitempty"`
+	QueuedAt time.Time   `json:"queued_at"`
+	SendAt   *time.Time  `json:"send_at,omitempty"`
+	Options  SendOptions `json:"options"`
+}
+
+// due reports whether the entry may be sent at now.
+func (q queuedSend) due(now time.Time) bool {
+	return q.SendAt == nil || !q.SendAt.After(now)
+}
+
+// sendQueueNow is the clock flushes compare scheduled sends against.
+var sendQueueNow = time.Now
+
+// newSendQueueID returns a short random ID for a queued send.
+func newSendQueueID() string {
+	var b [4]byte
+	_, _ = rand.Read(b[:])
+	return "q-" + hex.EncodeToString(b[:])
+}
+
+// sendScheduledResult is the output of `ntm send --after/--at`.
+type sendScheduledResult struct {
+	Success bool   `json:"success"`
+	Session string `json:"session"`
+	DryRun  bool   `json:"dry_run,omitempty"`
+	ID      string `json:"id,omitempty"`
+	SendAt  string `json:"send_at"`
+	Pending int    `json:"pending,omitempty"`
+	Path    string `json:"path,omitempty"`
+}
+
+// sendQueueEntry is one row of `ntm send queue`.
+type sendQueueEntry struct {
+	ID            string `json:"id"`
+	Session       string `json:"session"`
+	QueuedAt      string `json:"queued_at"`
+	SendAt        string `json:"send_at,omitempty"` // Empty: sent once the session exists
+	Due           bool   `json:"due"`
+	PromptPreview string `json:"prompt_preview"`
+}
+
+// sendQueueListResult is the output of `ntm send queue`.
+type sendQueueListResult struct {
+	Success bool             `json:"success"`
+	Pending []sendQueueEntry `json:"pending"`
+}
+
+// sendQueuedResult is the output of a send that was queued instead of sent.
+type sendQueuedResult struct {
+	Success bool   `json:"success"`
+	Session string `json:"session"`
+	Queued  bool   `json:"queued"`
+	Pending int    `json:"pending"`
+	Path    string `json:"path"`
+}
+
+// sendFlushResult is the output of `ntm send flush`.
+type sendFlushResult struct {
+	Success   bool         `json:"success"`
+	Session   string       `json:"session"`
+	Flushed   int          `json:"flushed"`
+	Remaining int          `json:"remaining"`
+	Scheduled int          `json:"scheduled,omitempty"` // Remaining sends not due yet
+	Results   []SendResult `json:"results"`
+	Error     string       `json:"error,omitempty"`
+}
+
+// sendQueuePath returns ~/.ntm/send-queue/<session>.jsonl.
+func sendQueuePath(session string) (string, error) {
+	if err := tmux.ValidateSessionName(session); err != nil {
+		return "", fmt.Errorf("invalid session name: %w", err)
+	}
+	ntmDir, err := util.NTMDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(ntmDir, sendQueueDirName, session+".jsonl"), nil
+}
+
+// enqueueSend appends opts to the session's queue and returns the number of
+// queued requests, including this one.
+func enqueueSend(opts SendOptions, now time.Time) (string, int, error) {
+	opts.Queue = false
+	return appendSendQueue(opts.Session, queuedSend{ID: newSendQueueID(), QueuedAt: now.UTC(), Options: opts})
+}
+
+// appendSendQueue appends entry to the session's queue and returns the queue
+// path and the number of queued requests, including this one.
+func appendSendQueue(session string, entry queuedSend) (string, int, error) {
+	path, err := sendQueuePath(session)
+	if err != nil {
+		return "", 0, err
+	}
+	if err := redactQueuedSend(&entry.Options); err != nil {
+		return "", 0, err
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return "", 0, fmt.Errorf("encode queued send: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return "", 0, fmt.Errorf("create send queue dir: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return "", 0, fmt.Errorf("open send queue: %w", err)
+	}
+	_, writeErr := f.Write(append(line, '\n'))
+	if closeErr := f.Close(); writeErr == nil {
+		writeErr = closeErr
+	}
+	if writeErr != nil {
+		return "", 0, fmt.Errorf("write send queue: %w", writeErr)
+	}
+	entries, err := loadSendQueue(session)
+	if err != nil {
+		return "", 0, err
+	}
+	return path, len(entries), nil
+}
+
+// redactQueuedSend runs the redaction preflight a live send would run before
+// the prompt text reaches disk: block mode refuses to queue and redact mode
+// stores the redacted text. Warn mode queues unchanged; the flushed send warns.
+func redactQueuedSend(opts *SendOptions) error {
+	if cfg == nil {
+		return nil
+	}
+	redactCfg := cfg.Redaction.ToRedactionLibConfig()
+	if redactCfg.Mode == redaction.ModeOff {
+		return nil
+	}
+	for _, text := range []*string{&opts.Prompt, &opts.BasePrompt} {
+		if *text == "" {
+			continue
+		}
+		out, _, err := applyOutputRedaction(*text, redactCfg)
+		if err != nil {
+			return err
+		}
+		*text = out
+	}
+	return nil
+}
+
+// loadSendQueue reads the session's queued sends in the order they were
+// queued. A missing queue file is an empty queue.
+func loadSendQueue(session string) ([]queuedSend, error) {
+	path, err := sendQueuePath(session)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read send queue: %w", err)
+	}
+	var entries []queuedSend
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var entry queuedSend
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("send queue %s line %d: %w", path, lineNo, err)
+		}
+		if entry.ID == "" {
+			// Entries queued before IDs existed get one derived from their
+			// queue time so it stays stable across reads.
+			entry.ID = fmt.Sprintf("q-%x", entry.QueuedAt.UnixNano())
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read send queue: %w", err)
+	}
+	return entries, nil
+}
+
+// saveSendQueue rewrites the session's queue with entries, removing the file
+// when nothing is left.
+func saveSendQueue(session string, entries []queuedSend) error {
+	path, err := sendQueuePath(session)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("remove send queue: %w", err)
+		}
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("encode queued send: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return util.AtomicWriteFile(path, buf.Bytes(), 0o600)
+}
+
+// queueSendIfSessionMissing queues opts when its session does not exist yet.
+// It reports whether the send was queued.
+func queueSendIfSessionMissing(opts SendOptions) (bool, error) {
+	if strings.TrimSpace(opts.Session) == "" {
+		return false, errors.New("--queue requires an explicit session name")
+	}
+	if err := tmux.EnsureInstalled(); err != nil {
+		return false, err
+	}
+	exists, err := tmux.SessionExistsContext(opts.Context, opts.Session)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+
+	path, pending, err := enqueueSend(opts, time.Now())
+	if err != nil {
+		return false, err
+	}
+	if jsonOutput {
+		return true, output.WriteJSON(os.Stdout, sendQueuedResult{
+			Success: true,
+			Session: opts.Session,
+			Queued:  true,
+			Pending: pending,
+			Path:    path,
+		}, false)
+	}
+	fmt.Printf("Session '%s' does not exist yet; queued prompt (%d pending)\n", opts.Session, pending)
+	fmt.Printf("Run 'ntm send flush %s' once it is up\n", opts.Session)
+	return true, nil
+}
+
+// flushSendQueue replays the session's due queued sends in order through
+// send, stopping at the first failure. Sent entries are removed from the
+// queue; scheduled sends that are not due yet, and everything from a failure
+// on, stay queued for the next flush.
+func flushSendQueue(ctx context.Context, session string, send func(SendOptions) (SendResult, error)) (sendFlushResult, error) {
+	result := sendFlushResult{Session: session, Results: []SendResult{}}
+	entries, err := loadSendQueue(session)
+	if err != nil {
+		return result, err
+	}
+
+	now := sendQueueNow()
+	var sendErr error
+	var remaining []queuedSend
+	flushed := 0
+	for i, entry := range entries {
+		if !entry.due(now) {
+			remaining = append(remaining, entry)
+			result.Scheduled++
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			sendErr = fmt.Errorf("send flush canceled: %w", err)
+		} else {
+			opts := entry.Options
+			opts.Context = ctx
+			opts.Session = session
+			opts.Queue = false
+			res, err := send(opts)
+			result.Results = append(result.Results, res)
+			if err == nil {
+				flushed++
+				continue
+			}
+			sendErr = fmt.Errorf("queued send %d (queued %s): %w", flushed+1, entry.QueuedAt.Format(time.RFC3339), err)
+		}
+		for _, rest := range entries[i:] {
+			if !rest.due(now) {
+				result.Scheduled++
+			}
+		}
+		remaining = append(remaining, entries[i:]...)
+		break
+	}
+
+	result.Flushed = flushed
+	result.Remaining = len(remaining)
+	if err := saveSendQueue(session, remaining); err != nil {
+		return result, err
+	}
+	result.Success = sendErr == nil
+	return result, sendErr
+}
+
+func newSendFlushCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flush <session>",
+		Short: "Send prompts queued with --queue now that the session exists",
+		Long: `Replay prompts that 'ntm send --queue' deferred because the session did not
+exist yet. Queued prompts are sent in the order they were queued, with the
+targets, filters, and options they were queued with; hooks and redaction run
+now, at flush time. Flushing stops at the first failed send and leaves it and
+everything after it queued.
+
+Examples:
+  ntm send myproject --queue --cc "start on the API"   # before spawn finishes
+  ntm send flush myproject                            # after spawn`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSendFlush(cmd.Context(), cmd.OutOrStdout(), args[0])
+		},
+	}
+	cmd.ValidArgsFunction = completeSessionArgs
+	return cmd
+}
+
+func runSendFlush(ctx context.Context, w io.Writer, session string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := tmux.EnsureInstalled(); err != nil {
+		return err
+	}
+	exists, err := tmux.SessionExistsContext(ctx, session)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("session '%s' not found; queued prompts stay queued", session)
+	}
+
+	result, flushErr := flushSendQueue(ctx, session, sendQueuedOptions)
+	if flushErr != nil {
+		result.Error = flushErr.Error()
+	}
+
+	if jsonOutput {
+		if flushErr != nil {
+			return emitJSONFailureEnvelopeToWithCause(w, result, flushErr)
+		}
+		return output.WriteJSON(w, result, true)
+	}
+
+	if result.Flushed == 0 && result.Remaining == 0 && flushErr == nil {
+		fmt.Fprintf(w, "No queued prompts for session '%s'\n", session)
+		return nil
+	}
+	for i, res := range result.Results {
+		status := fmt.Sprintf("delivered to %d pane(s)", res.Delivered)
+		if i == len(result.Results)-1 && flushErr != nil {
+			status = "failed"
+		}
+		fmt.Fprintf(w, "  %d. %s  %s\n", i+1, truncateWithEllipsis(res.PromptPreview, 50), status)
+	}
+	fmt.Fprintf(w, "Flushed %d queued prompt(s) to session '%s'", result.Flushed, session)
+	if result.Remaining > 0 {
+		fmt.Fprintf(w, "; %d still queued", result.Remaining)
+		if result.Scheduled > 0 {
+			fmt.Fprintf(w, " (%d scheduled for later)", result.Scheduled)
+		}
+	}
+	fmt.Fprintln(w)
+	return flushErr
+}
+
+// sendQueuedOptions sends one queued request and returns its terminal result.
+func sendQueuedOptions(opts SendOptions) (SendResult, error) {
+	collected := &sendExecutionResult{}
+	opts.executionPolicy = sendExecutionCollect
+	opts.executionResult = collected
+	err := runSendWithTargets(opts)
+	res := collected.result
+	if err == nil && !collected.recorded {
+		err = errors.New("send completed without a terminal result")
+	}
+	if err == nil && !res.Success {
+		err = errors.New(res.Error)
+	}
+	return res, err
+}
+
+// scheduleSend queues opts for delivery at opts.SendAt by 'ntm send queue run'
+// or 'ntm send flush'. With DryRun it reports the schedule without queuing.
+func scheduleSend(opts SendOptions) error {
+	if strings.TrimSpace(opts.Session) == "" {
+		return errors.New("--after and --at require an explicit session name")
+	}
+	sendAt := opts.SendAt.UTC()
+	result := sendScheduledResult{
+		Success: true,
+		Session: opts.Session,
+		DryRun:  opts.DryRun,
+		SendAt:  sendAt.Format(time.RFC3339),
+	}
+	if !opts.DryRun {
+		opts.Queue = false
+		opts.SendAt = time.Time{}
+		entry := queuedSend{ID: newSendQueueID(), QueuedAt: time.Now().UTC(), SendAt: &sendAt, Options: opts}
+		path, pending, err := appendSendQueue(opts.Session, entry)
+		if err != nil {
+			return err
+		}
+		result.ID, result.Path, result.Pending = entry.ID, path, pending
+	}
+
+	if jsonOutput {
+		return output.WriteJSON(os.Stdout, result, false)
+	}
+	if opts.DryRun {
+		fmt.Printf("Dry run: would schedule prompt for session '%s' at %s (not queued)\n", opts.Session, result.SendAt)
+		return nil
+	}
+	fmt.Printf("Scheduled prompt %s for session '%s' at %s (%d pending)\n", result.ID, opts.Session, result.SendAt, result.Pending)
+	fmt.Println("Run 'ntm send queue run' to deliver due prompts")
+	return nil
+}
+
+// listSendQueues returns every session's queued sends, keyed by session.
+func listSendQueues() (map[string][]queuedSend, error) {
+	ntmDir, err := util.NTMDir()
+	if err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(ntmDir, sendQueueDirName, "*.jsonl"))
+	if err != nil {
+		return nil, err
+	}
+	queues := make(map[string][]queuedSend, len(files))
+	for _, file := range files {
+		session := strings.TrimSuffix(filepath.Base(file), ".jsonl")
+		entries, err := loadSendQueue(session)
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) > 0 {
+			queues[session] = entries
+		}
+	}
+	return queues, nil
+}
+
+// sortedSendQueueSessions returns the sessions of queues in name order.
+func sortedSendQueueSessions(queues map[string][]queuedSend) []string {
+	sessions := make([]string, 0, len(queues))
+	for session := range queues {
+		sessions = append(sessions, session)
+	}
+	sort.Strings(sessions)
+	return sessions
+}
+
+// cancelQueuedSend removes the queued send with id from whichever session
+// queue holds it and returns that session.
+func cancelQueuedSend(id string) (string, error) {
+	queues, err := listSendQueues()
+	if err != nil {
+		return "", err
+	}
+	for session, entries := range queues {
+		for i, entry := range entries {
+			if entry.ID != id {
+				continue
+			}
+			kept := append(entries[:i:i], entries[i+1:]...)
+			return session, saveSendQueue(session, kept)
+		}
+	}
+	return "", fmt.Errorf("no queued send with ID %q", id)
+}
+
+func newSendQueueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queue [session]",
+		Short: "List prompts waiting in the send queue",
+		Long: `List prompts deferred by 'ntm send --queue' (waiting for their session) or
+scheduled with 'ntm send --after' / '--at', across all sessions or just one.
+
+Scheduled prompts are delivered by 'ntm send queue run', or by 'ntm send
+flush' once they are due.
+
+Examples:
+  ntm send myproject --after 30m --cc "check in on the migration"
+  ntm send queue                      # everything pending
+  ntm send queue cancel q-1a2b3c4d    # drop one
+  ntm send queue run --watch          # deliver due prompts as they come due`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session := ""
+			if len(args) > 0 {
+				session = args[0]
+			}
+			return runSendQueueList(cmd.OutOrStdout(), session)
+		},
+	}
+	cmd.ValidArgsFunction = completeSessionArgs
+	cmd.AddCommand(newSendQueueCancelCmd(), newSendQueueRunCmd())
+	return cmd
+}
+
+func runSendQueueList(w io.Writer, session string) error {
+	queues, err := listSendQueues()
+	if err != nil {
+		return err
+	}
+	now := sendQueueNow()
+	result := sendQueueListResult{Success: true, Pending: []sendQueueEntry{}}
+	for _, name := range sortedSendQueueSessions(queues) {
+		if session != "" && name != session {
+			continue
+		}
+		for _, entry := range queues[name] {
+			row := sendQueueEntry{
+				ID:            entry.ID,
+				Session:       name,
+				QueuedAt:      entry.QueuedAt.UTC().Format(time.RFC3339),
+				Due:           entry.due(now),
+				PromptPreview: truncatePrompt(forcedRedactedAssignmentText(entry.Options.Prompt), 50),
+			}
+			if entry.SendAt != nil {
+				row.SendAt = entry.SendAt.UTC().Format(time.RFC3339)
+			}
+			result.Pending = append(result.Pending, row)
+		}
+	}
+
+	if jsonOutput {
+		return output.WriteJSON(w, result, true)
+	}
+	if len(result.Pending) == 0 {
+		fmt.Fprintln(w, "No queued prompts")
+		return nil
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tSESSION\tSEND AT\tPROMPT")
+	for _, row := range result.Pending {
+		when := row.SendAt
+		switch {
+		case when == "":
+			when = "when session exists"
+		case row.Due:
+			when += " (due)"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", row.ID, row.Session, when, row.PromptPreview)
+	}
+	return tw.Flush()
+}
+
+func newSendQueueCancelCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel <id>",
+		Short: "Remove a prompt from the send queue",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session, err := cancelQueuedSend(args[0])
+			if err != nil {
+				return err
+			}
+			if jsonOutput {
+				return output.WriteJSON(cmd.OutOrStdout(), map[string]any{"success": true, "id": args[0], "session": session}, true)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Canceled queued prompt %s for session '%s'\n", args[0], session)
+			return nil
+		},
+	}
+}
+
+// sendQueueRunInterval is how often `ntm send queue run --watch` checks for
+// due prompts.
+const sendQueueRunInterval = 5 * time.Second
+
+func newSendQueueRunCmd() *cobra.Command {
+	var watch bool
+	cmd := &cobra.Command{
+		Use:   "run [session]",
+		Short: "Deliver queued prompts that are due",
+		Long: `Send every due prompt in the send queue, in queue order per session.
+Sessions that do not exist yet are skipped and keep their prompts. A failed
+send stops that session's queue, like 'ntm send flush'.
+
+With --watch, keep running and deliver scheduled prompts as they come due
+until interrupted.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session := ""
+			if len(args) > 0 {
+				session = args[0]
+			}
+			if err := tmux.EnsureInstalled(); err != nil {
+				return err
+			}
+			if !watch {
+				return runSendQueueOnce(cmd.Context(), cmd.OutOrStdout(), session)
+			}
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			ticker := time.NewTicker(sendQueueRunInterval)
+			defer ticker.Stop()
+			for {
+				if err := runSendQueueOnce(ctx, cmd.OutOrStdout(), session); err != nil && ctx.Err() == nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "send queue: %v\n", err)
+				}
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&watch, "watch", false, "Keep running and deliver prompts as they come due")
+	cmd.ValidArgsFunction = completeSessionArgs
+	return cmd
+}
+
+// runSendQueueOnce flushes the due prompts of every live session's queue.
+func runSendQueueOnce(ctx context.Context, w io.Writer, session string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	queues, err := listSendQueues()
+	if err != nil {
+		return err
+	}
+	now := sendQueueNow()
+	var errs []error
+	results := []sendFlushResult{}
+	for _, name := range sortedSendQueueSessions(queues) {
+		if session != "" && name != session {
+			continue
+		}
+		anyDue := false
+		for _, entry := range queues[name] {
+			anyDue = anyDue || entry.due(now)
+		}
+		if !anyDue {
+			continue
+		}
+		exists, err := tmux.SessionExistsContext(ctx, name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !exists {
+			continue
+		}
+		result, flushErr := flushSendQueue(ctx, name, sendQueuedOptions)
+		if flushErr != nil {
+			result.Error = flushErr.Error()
+			errs = append(errs, fmt.Errorf("session '%s': %w", name, flushErr))
+		}
+		results = append(results, result)
+		if !jsonOutput && result.Flushed > 0 {
+			fmt.Fprintf(w, "Sent %d queued prompt(s) to session '%s'\n", result.Flushed, name)
+		}
+	}
+
+	runErr := errors.Join(errs...)
+	if jsonOutput {
+		if runErr != nil {
+			return emitJSONFailureEnvelopeToWithCause(w, map[string]any{"success": false, "results": results, "error": runErr.Error()}, runErr)
+		}
+		return output.WriteJSON(w, map[string]any{"success": true, "results": results}, true)
+	}
+	return runErr
+}
@@ -40,7 +40,6 @@ import (
 	"github.com/Dicklesworthstone/ntm/internal/ratelimit"
 	"github.com/Dicklesworthstone/ntm/internal/recipe"
 	"github.com/Dicklesworthstone/ntm/internal/resilience"
-	"github.com/Dicklesworthstone/ntm/internal/robot"
 	"github.com/Dicklesworthstone/ntm/internal/state"
 	statuspkg "github.com/Dicklesworthstone/ntm/internal/status"
 	"github.com/Dicklesworthstone/ntm/internal/tmux"
@@ -281,23 +280,23 @@ func parseEnvDurationMs(key string) (time.Duration, error) {
 	return time.Duration(ms) * time.Millisecond, nil
 }
 
-func resolveSpawnAssignAgentType(agent string, ccOnly, codOnly, gmiOnly, agyOnly bool) string {
+func resolveSpawnAssignAgentType(agent string, ccOnly, codOnly, gmiOnly, agyOnly bool) (string, error) {
 	if strings.TrimSpace(agent) != "" {
-		return robot.ResolveAgentType(agent)
+		return parseAssignAgentTypeFilter(agent)
 	}
 	if ccOnly {
-		return "claude"
+		return string(agentpkg.AgentTypeClaudeCode), nil
 	}
 	if codOnly {
-		return "codex"
+		return string(agentpkg.AgentTypeCodex), nil
 	}
 	if gmiOnly {
-		return "gemini"
+		return string(agentpkg.AgentTypeGemini), nil
 	}
 	if agyOnly {
-		return "antigravity"
+		return string(agentpkg.AgentTypeAntigravity), nil
 	}
-	return ""
+	return "", nil
 }
 
 func parseLocalFallbackProvider(raw string) (AgentType, error) {
@@ -993,7 +992,7 @@ type SpawnOptions struct {
 	AssignVerbose      bool          // Show detailed scoring/decision logs during assignment
 	AssignQuiet        bool          // Suppress non-essential assignment output
 	AssignTimeout      time.Duration // Timeout for external calls during assignment (bv, br, Agent Mail)
-	AssignAgentType    string        // Filter assignment to specific agent type (claude, codex, gemini)
+	AssignAgentType    string        // Filter assignment to specific agent type (cc, cod, gmi)
 	AssignCCOnly       bool          // Only assign to Claude agents (alias for --assign-agent=claude)
 	AssignCodOnly      bool          // Only assign to Codex agents (alias for --assign-agent=codex)
 	AssignGmiOnly      bool          // Only assign to Gemini agents (alias for --assign-agent=gemini)
@@ -1682,7 +1681,10 @@ Examples:
 				}
 			}
 
-			assignAgentFilter := resolveSpawnAssignAgentType(assignAgentType, assignCCOnly, assignCodOnly, assignGmiOnly, assignAgyOnly)
+			assignAgentFilter, err := resolveSpawnAssignAgentType(assignAgentType, assignCCOnly, assignCodOnly, assignGmiOnly, assignAgyOnly)
+			if err != nil {
+				return fmt.Errorf("--assign-agent: %w", err)
+			}
 
 			// Build the concrete agent list. When a persona set/list is
 			// requested (--profile-set/--profiles), expand it into ordered
@@ -3205,6 +3207,7 @@ func spawnSessionLogicContextWithOutput(ctx context.Context, opts SpawnOptions,
 		WorkDir:          dir,
 		Recipe:           opts.RecipeName,
 	})
+	recordProficiencyAction((*config.ProficiencyConfig).IncrementSession)
 	for _, agent := range launchedAgents {
 		events.Emit(events.EventAgentSpawn, opts.Session, events.AgentSpawnData{
 			AgentType: agent.agentType,
@@ -0,0 +1,76 @@
+package tiers
+
+// Usage counters that achievements unlock on. Each names a field of the
+// proficiency usage stats.
+const (
+	CounterSessionsCreated    = "sessions_created"
+	CounterPromptsSent        = "prompts_sent"
+	CounterEnsemblesRun       = "ensembles_run"
+	CounterCheckpointRestores = "checkpoint_restores"
+)
+
+// Achievement is a named milestone unlocked when a usage counter reaches
+// Threshold.
+type Achievement struct {
+	// ID is the stable identifier stored once the achievement unlocks.
+	ID string
+
+	// Name is the short title shown when it unlocks.
+	Name string
+
+	// Description explains how to earn it.
+	Description string
+
+	// Counter is the usage counter checked (one of the Counter* constants).
+	Counter string
+
+	// Threshold is the counter value that unlocks the achievement.
+	Threshold int
+}
+
+// Achievements lists every achievement in display order. Add new entries
+// at the end; IDs must stay stable once released.
+var Achievements = []Achievement{
+	{
+		ID:          "first-session",
+		Name:        "Spawned first session",
+		Description: "Create a session with ntm spawn",
+		Counter:     CounterSessionsCreated,
+		Threshold:   1,
+	},
+	{
+		ID:          "first-ensemble",
+		Name:        "Ran first ensemble",
+		Description: "Run a reasoning ensemble",
+		Counter:     CounterEnsemblesRun,
+		Threshold:   1,
+	},
+	{
+		ID:          "checkpoint-recovery",
+		Name:        "Recovered from checkpoint",
+		Description: "Restore a session with ntm checkpoint restore",
+		Counter:     CounterCheckpointRestores,
+		Threshold:   1,
+	},
+	{
+		ID:          "sessions-20",
+		Name:        "Session regular",
+		Description: "Create 20 sessions",
+		Counter:     CounterSessionsCreated,
+		Threshold:   20,
+	},
+	{
+		ID:          "prompts-100",
+		Name:        "Sent 100 prompts",
+		Description: "Deliver 100 prompts with ntm send",
+		Counter:     CounterPromptsSent,
+		Threshold:   100,
+	},
+	{
+		ID:          "ensembles-10",
+		Name:        "Ensemble conductor",
+		Description: "Run 10 reasoning ensembles",
+		Counter:     CounterEnsemblesRun,
+		Threshold:   10,
+	},
+}
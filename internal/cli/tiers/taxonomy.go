@@ -169,6 +169,16 @@ var Registry = map[string]CommandInfo{
 			"ntm level master",
 		},
 	},
+	"achievements": {
+		Name:        "achievements",
+		Tier:        TierApprentice,
+		Category:    CategoryUtilities,
+		Description: "List achievements and progress toward them",
+		Examples: []string{
+			"ntm achievements",
+			"ntm achievements --format=json",
+		},
+	},
 
 	// ═══════════════════════════════════════════════════════════════
 	// TIER 2 (JOURNEYMAN) - Full standard commands
@@ -308,3 +308,28 @@ func TestGetMasterCommands(t *testing.T) {
 		t.Errorf("master commands (%d) should include all apprentice commands (%d)", len(cmds), len(apprenticeCmds))
 	}
 }
+
+func TestAchievementsTable(t *testing.T) {
+	counters := map[string]bool{
+		CounterSessionsCreated:    true,
+		CounterPromptsSent:        true,
+		CounterEnsemblesRun:       true,
+		CounterCheckpointRestores: true,
+	}
+	seen := make(map[string]bool)
+	for _, a := range Achievements {
+		if a.ID == "" || a.Name == "" || a.Description == "" {
+			t.Errorf("achievement %+v missing required fields", a)
+		}
+		if seen[a.ID] {
+			t.Errorf("duplicate achievement ID %q", a.ID)
+		}
+		seen[a.ID] = true
+		if !counters[a.Counter] {
+			t.Errorf("achievement %q uses unknown counter %q", a.ID, a.Counter)
+		}
+		if a.Threshold < 1 {
+			t.Errorf("achievement %q has threshold %d, want >= 1", a.ID, a.Threshold)
+		}
+	}
+}
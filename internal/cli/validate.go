@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -97,6 +98,155 @@ Examples:
 	return cmd
 }
 
+// ConfigLintReport is the output of `ntm config lint`.
+type ConfigLintReport struct {
+	Path    string           `json:"path"`
+	Fixes   []config.LintFix `json:"fixes"`
+	Applied bool             `json:"applied"`
+	DryRun  bool             `json:"dry_run,omitempty"`
+}
+
+func newConfigLintCmd() *cobra.Command {
+	var fix bool
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Find and auto-correct common config mistakes",
+		Long: `Lint the main config file for mistakes that have one obvious correction.
+
+Detected mistakes:
+  - Out-of-range numbers (clamped to the nearest bound)
+  - Enum values with stray casing or whitespace (normalized to lowercase)
+  - Blank required fields (filled from the built-in defaults)
+
+Values that cannot be corrected unambiguously, such as an unknown
+safety.profile, are reported as an error and nothing is changed.
+With --fix only the affected assignments are rewritten; comments and
+other keys are preserved.
+
+Examples:
+  ntm config lint                 # List proposed fixes
+  ntm config lint --fix           # Apply them
+  ntm config lint --fix --dry-run # Preview what --fix would change`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigLint(cmd.OutOrStdout(), selectedConfigPath(), fix, dryRun)
+		},
+	}
+
+	cmd.Flags().BoolVar(&fix, "fix", false, "rewrite the config with the proposed fixes")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "preview fixes without writing the config")
+
+	return cmd
+}
+
+func runConfigLint(w io.Writer, path string, fix, dryRun bool) error {
+	fixes, err := config.LintConfigFile(path)
+	if err != nil {
+		return fmt.Errorf("linting %s: %w", path, err)
+	}
+
+	report := ConfigLintReport{
+		Path:   path,
+		Fixes:  fixes,
+		DryRun: dryRun,
+	}
+	if report.Fixes == nil {
+		report.Fixes = []config.LintFix{}
+	}
+	if fix && !dryRun && len(fixes) > 0 {
+		if err := config.ApplyLintFixes(path, fixes); err != nil {
+			return fmt.Errorf("applying fixes to %s: %w", path, err)
+		}
+		report.Applied = true
+	}
+
+	if IsJSONOutput() {
+		return output.PrintJSON(report)
+	}
+
+	if len(fixes) == 0 {
+		fmt.Fprintf(w, "✓ %s: no fixes needed\n", path)
+		return nil
+	}
+
+	verb := "Proposed"
+	switch {
+	case report.Applied:
+		verb = "Applied"
+	case fix || dryRun:
+		verb = "Would apply"
+	}
+	fmt.Fprintf(w, "%s %d fix(es) to %s:\n", verb, len(fixes), path)
+	for _, f := range fixes {
+		fmt.Fprintf(w, "  %s: %s → %s (%s)\n", f.Key, f.From, f.To, f.Reason)
+	}
+	if !fix && !dryRun {
+		fmt.Fprintln(w, "\nRun with --fix to apply.")
+	}
+	return nil
+}
+
+func newConfigMigrateCmd() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Upgrade the config file to the current schema version",
+		Long: `Upgrade the main config file from an older schema version, moving or
+renaming settings that newer ntm releases no longer accept. The config's
+version field selects which migrations run; files without one are version 1.
+
+The migrated result must pass validation before anything is written. The
+original is kept next to the config as <config>.v<N>.bak. The file is
+rewritten from its parsed values, so comments are not carried over.
+
+Examples:
+  ntm config migrate            # Upgrade, keeping a backup
+  ntm config migrate --dry-run  # List the changes without writing`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigMigrate(cmd.OutOrStdout(), selectedConfigPath(), dryRun)
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "list migration changes without writing the config")
+
+	return cmd
+}
+
+func runConfigMigrate(w io.Writer, path string, dryRun bool) error {
+	result, err := config.MigrateFile(path, dryRun)
+	if err != nil {
+		return fmt.Errorf("migrating %s: %w", path, err)
+	}
+
+	if IsJSONOutput() {
+		return output.PrintJSON(result)
+	}
+
+	if result.FromVersion == result.ToVersion {
+		fmt.Fprintf(w, "✓ %s is already at version %d\n", path, result.ToVersion)
+		return nil
+	}
+
+	verb := "Migrated"
+	if dryRun {
+		verb = "Would migrate"
+	}
+	fmt.Fprintf(w, "%s %s from version %d to %d:\n", verb, path, result.FromVersion, result.ToVersion)
+	for _, c := range result.Changes {
+		fmt.Fprintf(w, "  %s\n", c)
+	}
+	if len(result.Changes) == 0 {
+		fmt.Fprintln(w, "  (version field added; no settings changed)")
+	}
+	if result.BackupPath != "" {
+		fmt.Fprintf(w, "\nOriginal saved to %s\n", result.BackupPath)
+	}
+	return nil
+}
+
 // discoverConfigs finds all config files to validate.
 func discoverConfigs(all bool) []ConfigLocation {
 	var locations []ConfigLocation
@@ -567,7 +717,7 @@ func validatePersonasFile(path string, result *ValidationResult) {
 		if strings.TrimSpace(p.Name) != "" {
 			field = p.Name
 		}
-		if strings.TrimSpace(p.SystemPrompt) == "" && strings.TrimSpace(p.Extends) == "" {
+		if strings.TrimSpace(p.SystemPrompt) == "" && strings.TrimSpace(p.Parent()) == "" {
 			result.Warnings = append(result.Warnings, ValidationIssue{
 				Field:   field,
 				Message: "persona missing system_prompt field",
@@ -853,3 +853,65 @@ func TestValidateAgentExecutables_BareCommandFlowsThrough(t *testing.T) {
 		)
 	}
 }
+
+func TestRunConfigLintDryRunLeavesFileUntouched(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	original := "help_verbosity = \"FULL\"\n"
+	if err := os.WriteFile(path, []byte(original), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+	if err := runConfigLint(&out, path, true, true); err != nil {
+		t.Fatalf("runConfigLint() error = %v", err)
+	}
+	if !strings.Contains(out.String(), `help_verbosity: "FULL" → "full"`) {
+		t.Fatalf("output missing fix preview:\n%s", out.String())
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != original {
+		t.Fatalf("dry run rewrote config:\n%s", data)
+	}
+
+	out.Reset()
+	if err := runConfigLint(&out, path, true, false); err != nil {
+		t.Fatalf("runConfigLint(fix) error = %v", err)
+	}
+	data, err = os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `help_verbosity = "full"`) {
+		t.Fatalf("config not fixed:\n%s", data)
+	}
+}
+
+func TestRunConfigMigrateText(t *testing.T) {
+	resetFlags()
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte("[health]\nauto_restart = true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+	if err := runConfigMigrate(&out, path, false); err != nil {
+		t.Fatalf("runConfigMigrate() error = %v", err)
+	}
+	for _, want := range []string{"from version 1 to 2", "moved health.auto_restart to resilience.auto_restart", path + ".v1.bak"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	if err := runConfigMigrate(&out, path, false); err != nil {
+		t.Fatalf("runConfigMigrate() second run error = %v", err)
+	}
+	if !strings.Contains(out.String(), "already at version 2") {
+		t.Errorf("second run output:\n%s", out.String())
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/Dicklesworthstone/ntm/internal/agent"
 	"github.com/Dicklesworthstone/ntm/internal/robot"
 	"github.com/Dicklesworthstone/ntm/internal/tmux"
 	"github.com/Dicklesworthstone/ntm/internal/tui/theme"
@@ -178,11 +179,11 @@ func runWait(w io.Writer, opts WaitOptions) error {
 		return fmt.Errorf("invalid condition '%s': must be one of idle, complete, generating, healthy", opts.Condition)
 	}
 	if opts.AgentType != "" {
-		rawAgentType := opts.AgentType
-		opts.AgentType = robot.ResolveAgentType(opts.AgentType)
-		if opts.AgentType == "" || opts.AgentType == "unknown" || opts.AgentType == "user" {
-			return fmt.Errorf("invalid agent type '%s'", strings.TrimSpace(rawAgentType))
+		agentType, ok := agent.NormalizeAgentType(opts.AgentType)
+		if !ok || agentType == agent.AgentTypeUser {
+			return fmt.Errorf("invalid agent type '%s'", strings.TrimSpace(opts.AgentType))
 		}
+		opts.AgentType = string(agentType)
 	}
 
 	// Start waiting
@@ -294,7 +295,7 @@ func filterPanesForWait(panes []tmux.Pane, opts WaitOptions) []tmux.Pane {
 
 		// Filter by agent type
 		if opts.AgentType != "" {
-			if !strings.EqualFold(paneType, opts.AgentType) {
+			if agent.AgentType(paneType).Canonical() != agent.AgentType(opts.AgentType).Canonical() {
 				continue
 			}
 		}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Dicklesworthstone/ntm/internal/cli/tiers"
+	"github.com/Dicklesworthstone/ntm/internal/util"
+)
+
+// AchievementsState records which achievements have unlocked and when. It
+// lives in the ntm state dir rather than beside the config because it is
+// derived progress, not a setting.
+type AchievementsState struct {
+	Unlocked map[string]time.Time `json:"unlocked"`
+}
+
+// AchievementsPath returns $XDG_STATE_HOME/ntm/achievements.json, falling
+// back to ~/.local/state/ntm/achievements.json.
+func AchievementsPath() string {
+	stateDir := os.Getenv("XDG_STATE_HOME")
+	if stateDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil || home == "" {
+			home = os.TempDir()
+		}
+		stateDir = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(stateDir, "ntm", "achievements.json")
+}
+
+// LoadAchievements reads the achievements state. A missing file yields an
+// empty state.
+func LoadAchievements() (*AchievementsState, error) {
+	state := &AchievementsState{Unlocked: make(map[string]time.Time)}
+	data, err := os.ReadFile(AchievementsPath())
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading achievements: %w", err)
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("parsing achievements: %w", err)
+	}
+	if state.Unlocked == nil {
+		state.Unlocked = make(map[string]time.Time)
+	}
+	return state, nil
+}
+
+// Save persists the achievements state.
+func (s *AchievementsState) Save() error {
+	path := AchievementsPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return util.AtomicWriteFile(path, data, 0644)
+}
+
+// Unlock marks every achievement whose counter has reached its threshold
+// in stats as unlocked, and returns those that were not unlocked before.
+// The caller saves the state.
+func (s *AchievementsState) Unlock(stats UsageStats, now time.Time) []tiers.Achievement {
+	var unlocked []tiers.Achievement
+	for _, a := range tiers.Achievements {
+		if _, done := s.Unlocked[a.ID]; done {
+			continue
+		}
+		if stats.Counter(a.Counter) >= a.Threshold {
+			s.Unlocked[a.ID] = now
+			unlocked = append(unlocked, a)
+		}
+	}
+	return unlocked
+}
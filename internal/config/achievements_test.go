@@ -0,0 +1,37 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAchievementsUnlockOnce(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	state, err := LoadAchievements()
+	if err != nil {
+		t.Fatalf("LoadAchievements: %v", err)
+	}
+	now := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	got := state.Unlock(UsageStats{SessionsCreated: 1, EnsemblesRun: 1}, now)
+	if len(got) != 2 || got[0].ID != "first-session" || got[1].ID != "first-ensemble" {
+		t.Fatalf("Unlock = %+v, want first-session and first-ensemble", got)
+	}
+	if err := state.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	reloaded, err := LoadAchievements()
+	if err != nil {
+		t.Fatalf("LoadAchievements: %v", err)
+	}
+	if !reloaded.Unlocked["first-ensemble"].Equal(now) {
+		t.Errorf("Unlocked = %v, want first-ensemble at %v", reloaded.Unlocked, now)
+	}
+	if again := reloaded.Unlock(UsageStats{SessionsCreated: 1, EnsemblesRun: 1, PromptsSent: 99}, now); len(again) != 0 {
+		t.Errorf("second Unlock = %+v, want nothing new", again)
+	}
+	if more := reloaded.Unlock(UsageStats{PromptsSent: 100}, now); len(more) != 1 || more[0].ID != "prompts-100" {
+		t.Errorf("Unlock at 100 prompts = %+v", more)
+	}
+}
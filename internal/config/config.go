@@ -2,8 +2,10 @@ package config
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -47,11 +49,11 @@ var validSynthesisStrategies = map[string]bool{
 	"meta-reasoning": true,
 	"voting":         true,
 	"argumentation":  true,
+	"debate":         true,
 }
 
 // deprecatedSynthesisStrategies maps deprecated names to their replacements.
 var deprecatedSynthesisStrategies = map[string]string{
-	"debate":     "dialectical",
 	"weighted":   "prioritized",
 	"sequential": "manual",
 	"best-of":    "prioritized",
@@ -71,6 +73,7 @@ func validateSynthesisStrategy(name string) error {
 
 // Config represents the main configuration
 type Config struct {
+	Version            int                   `toml:"version"` // Schema version; see CurrentConfigVersion and MigrateFile
 	ProjectsBase       string                `toml:"projects_base"`
 	Theme              string                `toml:"theme"`               // UI Theme (mocha, macchiato, nord, latte, auto)
 	HelpVerbosity      string                `toml:"help_verbosity"`      // Help verbosity: minimal or full (default: full)
@@ -115,8 +118,13 @@ type Config struct {
 	Routing            RoutingConfig         `toml:"routing"`          // Agent routing/scoring weights
 	Coordinator        CoordinatorConfig     `toml:"coordinator"`      // Session coordinator (digests, auto-assign, conflict handling)
 
+	// Profiles holds named override tables ([profiles.<name>]) that
+	// ApplyProfile layers over the merged config. See profiles.go.
+	Profiles map[string]map[string]interface{} `toml:"profiles,omitempty"`
+
 	// Runtime-only fields (populated by project config merging)
 	ProjectDefaults map[string]int `toml:"-"`
+	Profile         string         `toml:"-"` // Name of the applied profile, if any
 }
 
 // CoordinatorConfig holds session coordinator settings.
@@ -947,12 +955,36 @@ func ValidateContextRotationConfig(cfg *ContextRotationConfig) error {
 	return nil
 }
 
+// validateEnsembleWebhookURL accepts an empty value or an absolute http(s) URL.
+func validateEnsembleWebhookURL(raw string) error {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid url %q: %w", raw, err)
+	}
+	scheme := strings.ToLower(parsed.Scheme)
+	if scheme != "http" && scheme != "https" {
+		return fmt.Errorf("invalid url scheme %q (must be http or https)", parsed.Scheme)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("invalid url %q: missing host", raw)
+	}
+	return nil
+}
+
 // ValidateEnsembleConfig validates ensemble defaults in config.toml.
 func ValidateEnsembleConfig(cfg *EnsembleConfig) error {
 	if cfg == nil {
 		return nil
 	}
 
+	if err := validateEnsembleWebhookURL(cfg.WebhookURL); err != nil {
+		return fmt.Errorf("webhook_url: %w", err)
+	}
+
 	if cfg.Assignment != "" {
 		switch strings.ToLower(strings.TrimSpace(cfg.Assignment)) {
 		case "round-robin", "affinity", "category", "explicit":
@@ -1314,7 +1346,7 @@ type DCGConfig struct {
 
 // AssignConfig holds configuration for the ntm assign command
 type AssignConfig struct {
-	Strategy string `toml:"strategy"` // Default strategy: balanced, speed, quality, dependency, round-robin
+	Strategy string `toml:"strategy"` // Default strategy: balanced, speed, quality, dependency, round-robin, load
 	// PromptTemplate is an inline project/user-level default for the bulk-assign
 	// dispatch prompt. When set (and no per-invocation --bulk-assign-template file
 	// is supplied), it overrides the built-in template. Placeholders follow the
@@ -1337,7 +1369,7 @@ type AssignConfig struct {
 }
 
 // ValidAssignStrategies are the recognized assignment strategies
-var ValidAssignStrategies = []string{"balanced", "speed", "quality", "dependency", "round-robin"}
+var ValidAssignStrategies = []string{"balanced", "speed", "quality", "dependency", "round-robin", "load"}
 
 // IsValidStrategy returns true if the strategy is recognized
 func IsValidStrategy(strategy string) bool {
@@ -1367,6 +1399,8 @@ type EnsembleConfig struct {
 	Cache           EnsembleCacheConfig     `toml:"cache"`
 	Budget          EnsembleBudgetConfig    `toml:"budget"`
 	EarlyStop       EnsembleEarlyStopConfig `toml:"early_stop"`
+	// WebhookURL receives a JSON POST whenever a mode assignment changes status.
+	WebhookURL string `toml:"webhook_url"`
 }
 
 // EnsembleSynthesisConfig configures synthesis defaults for ensembles.
@@ -2406,6 +2440,7 @@ func ValidateEncryptionConfig(cfg *EncryptionConfig) error {
 type SendConfig struct {
 	BasePrompt     string `toml:"base_prompt"`      // Text prepended to all prompts
 	BasePromptFile string `toml:"base_prompt_file"` // File whose contents are prepended to all prompts
+	PreSendHook    string `toml:"pre_send_hook"`    // Command that rewrites each prompt (stdin -> stdout) before redaction
 }
 
 // PromptsConfig holds per-agent-type default prompts (bd-2ywo).
@@ -2497,6 +2532,7 @@ func Default() *Config {
 	}
 
 	cfg := &Config{
+		Version:            CurrentConfigVersion,
 		ProjectsBase:       projectsBase,
 		SuggestionsEnabled: true,
 		Agents:             DefaultAgentTemplates(),
@@ -2729,7 +2765,14 @@ func loadWithCWD(path, cwd string) (*Config, error) {
 			return nil, fmt.Errorf("parsing config: %w", err)
 		}
 		if fields := undecodedConfigFields(md); len(fields) > 0 {
-			return nil, fmt.Errorf("parsing config: unknown field(s): %s", strings.Join(fields, ", "))
+			if changes, err := migrateConfigData(data); err == nil && len(changes) > 0 {
+				return nil, fmt.Errorf("parsing config: unknown field(s): %s (config predates schema version %d; run 'ntm config migrate')",
+					describeUnknownConfigFields(fields), CurrentConfigVersion)
+			}
+			return nil, fmt.Errorf("parsing config: unknown field(s): %s", describeUnknownConfigFields(fields))
+		}
+		if cfg.Version > CurrentConfigVersion {
+			return nil, fmt.Errorf("parsing config: version %d is newer than this ntm supports (%d)", cfg.Version, CurrentConfigVersion)
 		}
 
 		// Canonicalize the profile string for stable downstream outputs (config show, robot status).
@@ -2755,94 +2798,7 @@ func loadWithCWD(path, cwd string) (*Config, error) {
 
 	// 3. Apply Environment Variable Overrides (Env > TOML > Default)
 
-	if envBase := os.Getenv("NTM_PROJECTS_BASE"); envBase != "" {
-		cfg.ProjectsBase = envBase
-	}
-
-	// AgentMail Env Overrides
-	if url := os.Getenv("AGENT_MAIL_URL"); url != "" {
-		cfg.AgentMail.URL = url
-	}
-	if token := os.Getenv("AGENT_MAIL_TOKEN"); token != "" {
-		cfg.AgentMail.Token = token
-	}
-	if enabled := os.Getenv("AGENT_MAIL_ENABLED"); enabled != "" {
-		cfg.AgentMail.Enabled = enabled == "1" || enabled == "true"
-	}
-
-	// Scanner Env Overrides
-	applyEnvOverrides(&cfg.Scanner)
-
-	// CASS Env Overrides
-	if enabled := os.Getenv("NTM_CASS_ENABLED"); enabled != "" {
-		cfg.CASS.Enabled = enabled == "1" || enabled == "true"
-	}
-	if timeout := os.Getenv("NTM_CASS_TIMEOUT"); timeout != "" {
-		var t int
-		if _, err := fmt.Sscanf(timeout, "%d", &t); err == nil && t > 0 {
-			cfg.CASS.Timeout = t
-		}
-	}
-	if binary := os.Getenv("NTM_CASS_BINARY"); binary != "" {
-		cfg.CASS.BinaryPath = binary
-	}
-	// CASS Context Env Overrides
-	if contextEnabled := os.Getenv("NTM_CASS_CONTEXT_ENABLED"); contextEnabled != "" {
-		cfg.CASS.Context.Enabled = contextEnabled == "1" || contextEnabled == "true"
-	}
-	if minRel := os.Getenv("NTM_CASS_MIN_RELEVANCE"); minRel != "" {
-		if v, err := strconv.ParseFloat(minRel, 64); err == nil && v >= 0 && v <= 1 {
-			cfg.CASS.Context.MinRelevance = v
-		}
-	}
-	if skipAbove := os.Getenv("NTM_CASS_SKIP_IF_CONTEXT_ABOVE"); skipAbove != "" {
-		if v, err := strconv.ParseFloat(skipAbove, 64); err == nil && v >= 0 && v <= 100 {
-			cfg.CASS.Context.SkipIfContextAbove = v
-		}
-	}
-	if preferSame := os.Getenv("NTM_CASS_PREFER_SAME_PROJECT"); preferSame != "" {
-		cfg.CASS.Context.PreferSameProject = preferSame == "1" || preferSame == "true"
-	}
-
-	// Accounts/Rotation Env Overrides
-	if autoRotate := os.Getenv("NTM_ACCOUNTS_AUTO_ROTATE"); autoRotate != "" {
-		cfg.Accounts.AutoRotate = autoRotate == "1" || autoRotate == "true"
-	}
-	if rotationEnabled := os.Getenv("NTM_ROTATION_ENABLED"); rotationEnabled != "" {
-		cfg.Rotation.Enabled = rotationEnabled == "1" || rotationEnabled == "true"
-	}
-
-	// Gemini Env Overrides
-	if autoSelect := os.Getenv("NTM_GEMINI_AUTO_PRO"); autoSelect != "" {
-		cfg.GeminiSetup.AutoSelectProModel = autoSelect == "1" || autoSelect == "true"
-	}
-
-	// Session Recovery Env Overrides
-	if recoveryEnabled := os.Getenv("NTM_RECOVERY_ENABLED"); recoveryEnabled != "" {
-		cfg.SessionRecovery.Enabled = recoveryEnabled == "1" || recoveryEnabled == "true"
-	}
-	if includeAgentMail := os.Getenv("NTM_RECOVERY_INCLUDE_AGENT_MAIL"); includeAgentMail != "" {
-		cfg.SessionRecovery.IncludeAgentMail = includeAgentMail == "1" || includeAgentMail == "true"
-	}
-	if includeCM := os.Getenv("NTM_RECOVERY_INCLUDE_CM"); includeCM != "" {
-		cfg.SessionRecovery.IncludeCMMemories = includeCM == "1" || includeCM == "true"
-	}
-	if includeBeads := os.Getenv("NTM_RECOVERY_INCLUDE_BEADS"); includeBeads != "" {
-		cfg.SessionRecovery.IncludeBeadsContext = includeBeads == "1" || includeBeads == "true"
-	}
-	if maxTokens := os.Getenv("NTM_RECOVERY_MAX_TOKENS"); maxTokens != "" {
-		if n, err := strconv.Atoi(maxTokens); err == nil && n > 0 {
-			cfg.SessionRecovery.MaxRecoveryTokens = n
-		}
-	}
-	if autoInject := os.Getenv("NTM_RECOVERY_AUTO_INJECT"); autoInject != "" {
-		cfg.SessionRecovery.AutoInjectOnSpawn = autoInject == "1" || autoInject == "true"
-	}
-	if staleHours := os.Getenv("NTM_RECOVERY_STALE_HOURS"); staleHours != "" {
-		if n, err := strconv.Atoi(staleHours); err == nil && n > 0 {
-			cfg.SessionRecovery.StaleThresholdHours = n
-		}
-	}
+	applyConfigEnvOverrides(cfg)
 
 	// 4. Palette Precedence: Markdown > TOML > Default
 	// Default() already loaded Markdown if available.
@@ -2870,6 +2826,9 @@ func loadWithCWD(path, cwd string) (*Config, error) {
 	return cfg, nil
 }
 
+// undecodedConfigFields lists keys md did not decode into a struct field.
+// Keys under [profiles] are skipped: they are free-form until ApplyProfile
+// decodes the selected profile, which reports its own unknown fields.
 func undecodedConfigFields(md toml.MetaData) []string {
 	keys := md.Undecoded()
 	if len(keys) == 0 {
@@ -2877,12 +2836,31 @@ func undecodedConfigFields(md toml.MetaData) []string {
 	}
 	fields := make([]string, 0, len(keys))
 	for _, key := range keys {
+		if len(key) > 0 && key[0] == "profiles" {
+			continue
+		}
 		fields = append(fields, key.String())
 	}
+	if len(fields) == 0 {
+		return nil
+	}
 	sort.Strings(fields)
 	return fields
 }
 
+// describeUnknownConfigFields joins undecoded field names for an error, adding
+// a "did you mean" hint to each one that is a near miss of a known key.
+func describeUnknownConfigFields(fields []string) string {
+	described := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if suggestion := suggestConfigPath(field); suggestion != "" {
+			field += " (did you mean " + suggestion + "?)"
+		}
+		described = append(described, field)
+	}
+	return strings.Join(described, ", ")
+}
+
 // CreateDefault creates a default config file at path.
 // If path is empty, the default config path is used.
 func CreateDefault(path string) (string, error) {
@@ -2990,14 +2968,19 @@ var configPersistenceMu sync.Mutex
 // assignment is replaced in place; a new key is inserted directly after the
 // section header. Values must already be rendered as TOML literals (e.g.
 // `true`, `"30m"`). The rewritten content is parsed before the file is
-// replaced, so a malformed result never reaches disk.
+// replaced, so a malformed result never reaches disk. An empty section
+// addresses root-level keys ahead of the first table header.
 func PersistTOMLKeys(path, section string, keys [][2]string) error {
 	if strings.TrimSpace(path) == "" {
 		return fmt.Errorf("config path is empty")
 	}
-	sectionParts, err := validateTOMLBarePath(section, "config section")
-	if err != nil {
-		return err
+	var sectionParts []string
+	if strings.TrimSpace(section) != "" {
+		parts, err := validateTOMLBarePath(section, "config section")
+		if err != nil {
+			return err
+		}
+		sectionParts = parts
 	}
 	if len(keys) == 0 {
 		return nil
@@ -3074,7 +3057,8 @@ func PersistTOMLKeys(path, section string, keys [][2]string) error {
 }
 
 // upsertTOMLKeys applies per-key replacement/insertion inside [section],
-// keeping all other lines (comments included) untouched.
+// keeping all other lines (comments included) untouched. Empty sectionParts
+// targets the root table.
 func upsertTOMLKeys(contents string, sectionParts []string, keys [][2]string) (string, error) {
 	lines := []string{}
 	if contents != "" {
@@ -3087,6 +3071,7 @@ func upsertTOMLKeys(contents string, sectionParts []string, keys [][2]string) (s
 	}
 
 	header := "[" + strings.Join(sectionParts, ".") + "]"
+	root := len(sectionParts) == 0
 	start := -1
 	end := len(lines)
 	for i, line := range lines {
@@ -3094,7 +3079,7 @@ func upsertTOMLKeys(contents string, sectionParts []string, keys [][2]string) (s
 		if info.startsInMultiline {
 			continue
 		}
-		if start == -1 {
+		if start == -1 && !root {
 			if tomlHeaderMatches(line, info.commentAt, sectionParts) {
 				start = i
 			}
@@ -3106,7 +3091,7 @@ func upsertTOMLKeys(contents string, sectionParts []string, keys [][2]string) (s
 		}
 	}
 
-	if start == -1 {
+	if start == -1 && !root {
 		updatedLines, handled, err := upsertRootDottedTOMLKeys(
 			lines, lineInfo, sectionParts, keys, insertedLineSuffix,
 		)
@@ -3173,6 +3158,14 @@ func upsertTOMLKeys(contents string, sectionParts []string, keys [][2]string) (s
 	}
 	if len(missing) > 0 {
 		insertAt := start + 1
+		if root {
+			// Keep new root keys below any leading comments and above the
+			// first table header.
+			insertAt = end
+			for insertAt > 0 && strings.TrimSpace(lines[insertAt-1]) == "" {
+				insertAt--
+			}
+		}
 		lines = append(lines, make([]string, len(missing))...)
 		copy(lines[insertAt+len(missing):], lines[insertAt:len(lines)-len(missing)])
 		copy(lines[insertAt:], missing)
@@ -3360,7 +3353,7 @@ func validateNTMConfigTOML(contents string) error {
 		return fmt.Errorf("parsing TOML: %w", err)
 	}
 	if fields := undecodedConfigFields(md); len(fields) > 0 {
-		return fmt.Errorf("unknown field(s): %s", strings.Join(fields, ", "))
+		return fmt.Errorf("unknown field(s): %s", describeUnknownConfigFields(fields))
 	}
 	return nil
 }
@@ -3664,6 +3657,15 @@ func Print(cfg *Config, w io.Writer) error {
 		return keys
 	}
 
+	if cfg.Profile != "" {
+		fmt.Fprintf(w, "# Profile: %s ([profiles.%s] overrides applied)\n", cfg.Profile, cfg.Profile)
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintln(w, "# Config schema version ('ntm config migrate' upgrades older files)")
+	fmt.Fprintf(w, "version = %d\n", cfg.Version)
+	fmt.Fprintln(w)
+
 	fmt.Fprintf(w, "# Base directory for projects\n")
 	fmt.Fprintf(w, "projects_base = %q\n", cfg.ProjectsBase)
 	fmt.Fprintln(w)
@@ -4014,6 +4016,13 @@ func Print(cfg *Config, w io.Writer) error {
 	} else {
 		fmt.Fprintln(w, "# base_prompt_file = \"\"")
 	}
+	fmt.Fprintln(w, "# Command run via sh -c with the built prompt on stdin; its stdout replaces")
+	fmt.Fprintln(w, "# the prompt. A non-zero exit aborts the send.")
+	if cfg.Send.PreSendHook != "" {
+		fmt.Fprintf(w, "pre_send_hook = %q\n", cfg.Send.PreSendHook)
+	} else {
+		fmt.Fprintln(w, "# pre_send_hook = \"\"")
+	}
 	fmt.Fprintln(w)
 
 	fmt.Fprintln(w, "[prompts]")
@@ -4573,6 +4582,11 @@ func Print(cfg *Config, w io.Writer) error {
 	fmt.Fprintf(w, "assignment = %q\n", cfg.Ensemble.Assignment)
 	fmt.Fprintf(w, "mode_tier_default = %q  # core|advanced|experimental\n", cfg.Ensemble.ModeTierDefault)
 	fmt.Fprintf(w, "allow_advanced = %t\n", cfg.Ensemble.AllowAdvanced)
+	if cfg.Ensemble.WebhookURL != "" {
+		fmt.Fprintf(w, "webhook_url = %q\n", cfg.Ensemble.WebhookURL)
+	} else {
+		fmt.Fprintln(w, "# webhook_url = \"https://example.com/ntm-ensemble\"  # POSTed on each mode status change")
+	}
 	fmt.Fprintln(w)
 
 	fmt.Fprintln(w, "[ensemble.synthesis]")
@@ -4794,8 +4808,21 @@ func upsertTOMLKey(contents, key, value string) string {
 	return result
 }
 
-// GetValue retrieves a configuration value by its dotted path (e.g., "alerts.enabled")
+// GetValue retrieves a configuration value by its dotted path (e.g., "alerts.enabled").
+// An unknown path within edit distance 2 of a known one gets a "did you mean"
+// hint appended to the error.
 func GetValue(cfg *Config, path string) (interface{}, error) {
+	value, err := lookupValue(cfg, path)
+	if err != nil && cfg != nil && path != "" {
+		if suggestion := suggestConfigPath(path); suggestion != "" {
+			err = fmt.Errorf("%w (did you mean %s?)", err, suggestion)
+		}
+	}
+	return value, err
+}
+
+// lookupValue is GetValue without suggestions; ValuePaths is built from it.
+func lookupValue(cfg *Config, path string) (interface{}, error) {
 	if cfg == nil {
 		return nil, fmt.Errorf("config is nil")
 	}
@@ -4914,7 +4941,7 @@ func GetValue(cfg *Config, path string) (interface{}, error) {
 		case "url":
 			return cfg.AgentMail.URL, nil
 		case "token":
-			return "[redacted]", nil
+			return redactedConfigValue, nil
 		case "auto_register":
 			return cfg.AgentMail.AutoRegister, nil
 		case "program_name":
@@ -5179,7 +5206,7 @@ func GetValue(cfg *Config, path string) (interface{}, error) {
 			case "method":
 				return cfg.Notifications.Webhook.Method, nil
 			case "headers":
-				return cfg.Notifications.Webhook.Headers, nil
+				return redactConfigValue(cfg.Notifications.Webhook.Headers), nil
 			}
 		case "shell":
 			if len(parts) < 3 {
@@ -5451,6 +5478,8 @@ func GetValue(cfg *Config, path string) (interface{}, error) {
 			return cfg.Send.BasePrompt, nil
 		case "base_prompt_file":
 			return cfg.Send.BasePromptFile, nil
+		case "pre_send_hook":
+			return cfg.Send.PreSendHook, nil
 		}
 	case "prompts":
 		if len(parts) < 2 {
@@ -5603,6 +5632,8 @@ func GetValue(cfg *Config, path string) (interface{}, error) {
 			return cfg.Ensemble.ModeTierDefault, nil
 		case "allow_advanced":
 			return cfg.Ensemble.AllowAdvanced, nil
+		case "webhook_url":
+			return cfg.Ensemble.WebhookURL, nil
 		case "synthesis":
 			if len(parts) < 3 {
 				return cfg.Ensemble.Synthesis, nil
@@ -5913,6 +5944,214 @@ func GetValue(cfg *Config, path string) (interface{}, error) {
 	return nil, fmt.Errorf("unknown config path: %s", path)
 }
 
+// ErrSecretConfigKey is returned by SetValue for keys whose values are
+// secrets (see secretConfigKeys).
+var ErrSecretConfigKey = errors.New("config key holds a secret")
+
+// SetValue sets the scalar value at a dotted path known to GetValue (e.g.,
+// "ensemble.synthesis.max_findings"), converting value to the field's type.
+// The change is kept only if Validate accepts the result. Secret keys such as
+// agent_mail.token are refused with ErrSecretConfigKey; use SetSecretValue.
+func SetValue(cfg *Config, path, value string) error {
+	return setValue(cfg, path, value, false)
+}
+
+// SetSecretValue is SetValue without the secret-key guard, for callers the
+// user explicitly allowed to write secrets (`ntm config set --allow-secrets`).
+func SetSecretValue(cfg *Config, path, value string) error {
+	return setValue(cfg, path, value, true)
+}
+
+func setValue(cfg *Config, path, value string, allowSecrets bool) error {
+	if _, err := GetValue(cfg, path); err != nil {
+		return err
+	}
+	parts := strings.Split(path, ".")
+	if secretConfigKeys[parts[len(parts)-1]] && !allowSecrets {
+		return fmt.Errorf("%s: %w", path, ErrSecretConfigKey)
+	}
+
+	field, ok := configField(cfg, path)
+	if !ok {
+		return fmt.Errorf("unknown config path: %s", path)
+	}
+
+	previous := reflect.New(field.Type()).Elem()
+	previous.Set(field)
+	if err := setConfigField(field, value); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if errs := Validate(cfg); len(errs) > 0 {
+		field.Set(previous)
+		msgs := make([]string, 0, len(errs))
+		for _, e := range errs {
+			msgs = append(msgs, e.Error())
+		}
+		return fmt.Errorf("%s: config is invalid: %s", path, strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
+// setConfigField parses raw into a string, bool, integer, float, or
+// time.Duration field.
+func setConfigField(field reflect.Value, raw string) error {
+	raw = strings.TrimSpace(raw)
+	if field.Kind() == reflect.Ptr {
+		// Optional fields (e.g. *bool) get a fresh value so a rejected
+		// change never writes through a pointer shared with the old config.
+		elem := reflect.New(field.Type().Elem())
+		if err := setConfigField(elem.Elem(), raw); err != nil {
+			return err
+		}
+		field.Set(elem)
+		return nil
+	}
+	if field.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("expected a duration (e.g. 30s), got %q", raw)
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("expected true or false, got %q", raw)
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("expected an integer, got %q", raw)
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("expected a non-negative integer, got %q", raw)
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("expected a number, got %q", raw)
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("cannot set %s values; edit the config file instead", field.Type())
+	}
+	return nil
+}
+
+// SetFileValue applies SetValue (SetSecretValue when allowSecrets is set) to
+// the config loaded from configPath and persists just that key with
+// PersistTOMLKeys, leaving the rest of the file untouched.
+func SetFileValue(configPath, key, value string, allowSecrets bool) error {
+	if configPath == "" {
+		configPath = DefaultPath()
+	}
+	cfg, err := Load(configPath)
+	if err != nil {
+		return err
+	}
+	if err := setValue(cfg, key, value, allowSecrets); err != nil {
+		return err
+	}
+	updated, err := GetValue(cfg, key)
+	if err != nil {
+		return err
+	}
+	section, name := "", key
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		section, name = key[:i], key[i+1:]
+	}
+	return PersistTOMLKeys(configPath, section, [][2]string{{name, renderConfigValue(updated)}})
+}
+
+// renderConfigValue renders a scalar returned by GetValue as a TOML literal.
+func renderConfigValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v)
+	case time.Duration:
+		return strconv.Quote(v.String())
+	case float32:
+		return renderLintNumber(float64(v), true)
+	case float64:
+		return renderLintNumber(v, true)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// redactedConfigValue replaces secret values in config dumps.
+const redactedConfigValue = "[redacted]"
+
+// secretConfigKeys lists config keys whose values are secrets. A map-valued
+// key (such as encryption.keyring) keeps its entry names but has every value
+// redacted.
+var secretConfigKeys = map[string]bool{
+	"token":   true,
+	"keyring": true,
+	"headers": true, // notifications.webhook.headers usually carry auth tokens
+}
+
+// AllValues returns the entire configuration as a nested map keyed by TOML
+// names, with secret values redacted. It reflects whatever has already been
+// applied to cfg (project merge, environment overrides), so it is the
+// effective configuration rather than the file contents.
+func AllValues(cfg *Config) (map[string]interface{}, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("config is nil")
+	}
+
+	var buf strings.Builder
+	if err := toml.NewEncoder(&buf).Encode(cfg); err != nil {
+		return nil, fmt.Errorf("encoding config: %w", err)
+	}
+	values := make(map[string]interface{})
+	if _, err := toml.Decode(buf.String(), &values); err != nil {
+		return nil, fmt.Errorf("decoding config: %w", err)
+	}
+	redactConfigValues(values)
+	return values, nil
+}
+
+func redactConfigValues(values map[string]interface{}) {
+	for key, value := range values {
+		if secretConfigKeys[key] {
+			if nested, ok := value.(map[string]interface{}); ok {
+				for name := range nested {
+					nested[name] = redactedConfigValue
+				}
+				continue
+			}
+			if value != "" {
+				values[key] = redactedConfigValue
+			}
+			continue
+		}
+		switch v := value.(type) {
+		case map[string]interface{}:
+			redactConfigValues(v)
+		case []map[string]interface{}:
+			for _, item := range v {
+				redactConfigValues(item)
+			}
+		case []interface{}:
+			for _, item := range v {
+				if nested, ok := item.(map[string]interface{}); ok {
+					redactConfigValues(nested)
+				}
+			}
+		}
+	}
+}
+
 // Reset removes the config file at path and creates a new one with defaults.
 // If path is empty, the default config path is used.
 func Reset(path string) error {
@@ -5930,16 +6169,19 @@ func Reset(path string) error {
 	return err
 }
 
-// ConfigDiff represents a difference between current and default config
+// ConfigDiff represents a difference between the effective and default config
 type ConfigDiff struct {
-	Key     string      `json:"key"`
-	Path    string      `json:"path"`
-	Default interface{} `json:"default"`
-	Current interface{} `json:"current"`
-	Source  string      `json:"source"` // "global", "project", "env", "flag"
+	Key       string      `json:"key"`
+	Path      string      `json:"path"`
+	Default   interface{} `json:"default"`
+	Effective interface{} `json:"effective"`
+	Source    string      `json:"source"` // "global", "project", "env", "flag"
 }
 
-// Diff returns all configuration values that differ from defaults
+// Diff returns every leaf path GetValue resolves whose value in cfg differs
+// from Default(), in ValuePaths order. Values are reported as GetValue
+// returns them; secret keys compare their real values but report them
+// redacted.
 func Diff(cfg *Config) []ConfigDiff {
 	if cfg == nil {
 		return nil
@@ -5947,390 +6189,163 @@ func Diff(cfg *Config) []ConfigDiff {
 
 	defaults := Default()
 	var diffs []ConfigDiff
-
-	// Helper to add diff if values differ
-	// Key is set to path for uniqueness in JSON output
-	addDiff := func(path string, def, cur interface{}) {
-		if reflect.DeepEqual(def, cur) {
-			return
+	for _, path := range ValuePaths() {
+		defField, _ := configField(defaults, path)
+		curField, _ := configField(cfg, path)
+		if !defField.IsValid() || !curField.IsValid() || reflect.DeepEqual(defField.Interface(), curField.Interface()) {
+			continue
+		}
+		def, _ := GetValue(defaults, path)
+		cur, _ := GetValue(cfg, path)
+		if parts := strings.Split(path, "."); secretConfigKeys[parts[len(parts)-1]] {
+			def, cur = redactConfigValue(defField.Interface()), redactConfigValue(curField.Interface())
 		}
 		diffs = append(diffs, ConfigDiff{
-			Key:     path, // Use path as key for uniqueness
-			Path:    path,
-			Default: def,
-			Current: cur,
-			Source:  "config", // Could be enhanced to track actual source
+			Key:       path, // Use path as key for uniqueness
+			Path:      path,
+			Default:   def,
+			Effective: cur,
+			Source:    "config", // Could be enhanced to track actual source
 		})
 	}
+	return diffs
+}
 
-	// Top-level settings
-	addDiff("projects_base", defaults.ProjectsBase, cfg.ProjectsBase)
-	addDiff("theme", defaults.Theme, cfg.Theme)
-	addDiff("help_verbosity", defaults.HelpVerbosity, cfg.HelpVerbosity)
-	addDiff("palette_file", defaults.PaletteFile, cfg.PaletteFile)
-	addDiff("suggestions_enabled", defaults.SuggestionsEnabled, cfg.SuggestionsEnabled)
-	addDiff("palette", defaults.Palette, cfg.Palette)
-	addDiff("palette_state.pinned", defaults.PaletteState.Pinned, cfg.PaletteState.Pinned)
-	addDiff("palette_state.favorites", defaults.PaletteState.Favorites, cfg.PaletteState.Favorites)
-
-	// Agents
-	addDiff("agents.claude", defaults.Agents.Claude, cfg.Agents.Claude)
-	addDiff("agents.codex", defaults.Agents.Codex, cfg.Agents.Codex)
-	addDiff("agents.gemini", defaults.Agents.Gemini, cfg.Agents.Gemini)
-	addDiff("agents.grok", defaults.Agents.Grok, cfg.Agents.Grok)
-	addDiff("agents.cursor", defaults.Agents.Cursor, cfg.Agents.Cursor)
-	addDiff("agents.windsurf", defaults.Agents.Windsurf, cfg.Agents.Windsurf)
-	addDiff("agents.aider", defaults.Agents.Aider, cfg.Agents.Aider)
-	addDiff("agents.plugins", defaults.Agents.Plugins, cfg.Agents.Plugins)
-	addDiff("agents.default_count", defaults.Agents.DefaultCount, cfg.Agents.DefaultCount)
-
-	// Tmux
-	addDiff("tmux.default_panes", defaults.Tmux.DefaultPanes, cfg.Tmux.DefaultPanes)
-	addDiff("tmux.palette_key", defaults.Tmux.PaletteKey, cfg.Tmux.PaletteKey)
-	addDiff("tmux.pane_init_delay_ms", defaults.Tmux.PaneInitDelayMs, cfg.Tmux.PaneInitDelayMs)
-	addDiff("tmux.history_limit", defaults.Tmux.HistoryLimit, cfg.Tmux.HistoryLimit)
-	addDiff("tmux.activity_indicators.enabled", defaults.Tmux.ActivityIndicators.Enabled, cfg.Tmux.ActivityIndicators.Enabled)
-	addDiff("tmux.activity_indicators.active_seconds", defaults.Tmux.ActivityIndicators.ActiveSeconds, cfg.Tmux.ActivityIndicators.ActiveSeconds)
-	addDiff("tmux.activity_indicators.stalled_seconds", defaults.Tmux.ActivityIndicators.StalledSeconds, cfg.Tmux.ActivityIndicators.StalledSeconds)
-
-	// Robot
-	addDiff("robot.verbosity", defaults.Robot.Verbosity, cfg.Robot.Verbosity)
-	addDiff("robot.output.format", defaults.Robot.Output.Format, cfg.Robot.Output.Format)
-	addDiff("robot.output.pretty", defaults.Robot.Output.Pretty, cfg.Robot.Output.Pretty)
-	addDiff("robot.output.timestamps", defaults.Robot.Output.Timestamps, cfg.Robot.Output.Timestamps)
-	addDiff("robot.output.compress", defaults.Robot.Output.Compress, cfg.Robot.Output.Compress)
+// ValuePaths returns every leaf path GetValue resolves, in Config field
+// order: each toml-tagged field whose path GetValue knows and that has no
+// resolvable children of its own.
+var ValuePaths = sync.OnceValue(func() []string {
+	cfg := Default()
+	var leaves []string
+	var walk func(rt reflect.Type, prefix string) int
+	walk = func(rt reflect.Type, prefix string) int {
+		found := 0
+		for i := 0; i < rt.NumField(); i++ {
+			tag, _, _ := strings.Cut(rt.Field(i).Tag.Get("toml"), ",")
+			if tag == "" || tag == "-" {
+				continue
+			}
+			path := tag
+			if prefix != "" {
+				path = prefix + "." + tag
+			}
+			// GetValue ignores trailing segments below a leaf, so a child only
+			// counts when it resolves to something other than its parent.
+			v, err := lookupValue(cfg, path)
+			if err != nil || (prefix != "" && reflect.TypeOf(v) == rt) {
+				continue
+			}
+			found++
+			if ft := rt.Field(i).Type; ft.Kind() == reflect.Struct && walk(ft, path) > 0 {
+				continue
+			}
+			leaves = append(leaves, path)
+		}
+		return found
+	}
+	walk(reflect.TypeOf(Config{}), "")
+	return leaves
+})
 
-	// Agent Mail
-	addDiff("agent_mail.enabled", defaults.AgentMail.Enabled, cfg.AgentMail.Enabled)
-	addDiff("agent_mail.url", defaults.AgentMail.URL, cfg.AgentMail.URL)
-	addDiff("agent_mail.auto_register", defaults.AgentMail.AutoRegister, cfg.AgentMail.AutoRegister)
-	addDiff("agent_mail.program_name", defaults.AgentMail.ProgramName, cfg.AgentMail.ProgramName)
-	addDiff("agent_mail.supervisor_enabled", defaults.AgentMail.SupervisorEnabledOrDefault(), cfg.AgentMail.SupervisorEnabledOrDefault())
-
-	// Integrations (DCG)
-	addDiff("integrations.dcg.enabled", defaults.Integrations.DCG.Enabled, cfg.Integrations.DCG.Enabled)
-	addDiff("integrations.dcg.binary_path", defaults.Integrations.DCG.BinaryPath, cfg.Integrations.DCG.BinaryPath)
-	addDiff("integrations.dcg.custom_blocklist", defaults.Integrations.DCG.CustomBlocklist, cfg.Integrations.DCG.CustomBlocklist)
-	addDiff("integrations.dcg.custom_whitelist", defaults.Integrations.DCG.CustomWhitelist, cfg.Integrations.DCG.CustomWhitelist)
-	addDiff("integrations.dcg.audit_log", defaults.Integrations.DCG.AuditLog, cfg.Integrations.DCG.AuditLog)
-	addDiff("integrations.dcg.allow_override", defaults.Integrations.DCG.AllowOverride, cfg.Integrations.DCG.AllowOverride)
-	addDiff("integrations.rano.enabled", defaults.Integrations.Rano.Enabled, cfg.Integrations.Rano.Enabled)
-	addDiff("integrations.rano.binary_path", defaults.Integrations.Rano.BinaryPath, cfg.Integrations.Rano.BinaryPath)
-	addDiff("integrations.rano.poll_interval_ms", defaults.Integrations.Rano.PollIntervalMs, cfg.Integrations.Rano.PollIntervalMs)
-	addDiff("integrations.rano.providers", defaults.Integrations.Rano.Providers, cfg.Integrations.Rano.Providers)
-	addDiff("integrations.rano.persist_history", defaults.Integrations.Rano.PersistHistory, cfg.Integrations.Rano.PersistHistory)
-	addDiff("integrations.rano.history_days", defaults.Integrations.Rano.HistoryDays, cfg.Integrations.Rano.HistoryDays)
-	addDiff("integrations.caam.enabled", defaults.Integrations.CAAM.Enabled, cfg.Integrations.CAAM.Enabled)
-	addDiff("integrations.caam.binary_path", defaults.Integrations.CAAM.BinaryPath, cfg.Integrations.CAAM.BinaryPath)
-	addDiff("integrations.caam.auto_rotate", defaults.Integrations.CAAM.AutoRotate, cfg.Integrations.CAAM.AutoRotate)
-	addDiff("integrations.caam.providers", defaults.Integrations.CAAM.Providers, cfg.Integrations.CAAM.Providers)
-	addDiff("integrations.caam.rate_limit_patterns", defaults.Integrations.CAAM.RateLimitPatterns, cfg.Integrations.CAAM.RateLimitPatterns)
-	addDiff("integrations.caam.account_cooldown", defaults.Integrations.CAAM.AccountCooldown, cfg.Integrations.CAAM.AccountCooldown)
-	addDiff("integrations.caam.alert_threshold", defaults.Integrations.CAAM.AlertThreshold, cfg.Integrations.CAAM.AlertThreshold)
-	addDiff("integrations.rch.enabled", defaults.Integrations.RCH.Enabled, cfg.Integrations.RCH.Enabled)
-	addDiff("integrations.rch.binary_path", defaults.Integrations.RCH.BinaryPath, cfg.Integrations.RCH.BinaryPath)
-	addDiff("integrations.rch.min_build_time", defaults.Integrations.RCH.MinBuildTime, cfg.Integrations.RCH.MinBuildTime)
-	addDiff("integrations.rch.intercept_patterns", defaults.Integrations.RCH.InterceptPatterns, cfg.Integrations.RCH.InterceptPatterns)
-	addDiff("integrations.rch.fallback_local", defaults.Integrations.RCH.FallbackLocal, cfg.Integrations.RCH.FallbackLocal)
-	addDiff("integrations.rch.show_location", defaults.Integrations.RCH.ShowLocation, cfg.Integrations.RCH.ShowLocation)
-	addDiff("integrations.rch.preferred_worker", defaults.Integrations.RCH.PreferredWorker, cfg.Integrations.RCH.PreferredWorker)
-	addDiff("integrations.rch.dcg_whitelist", defaults.Integrations.RCH.DCGWhitelist, cfg.Integrations.RCH.DCGWhitelist)
-	addDiff("integrations.caut.enabled", defaults.Integrations.Caut.Enabled, cfg.Integrations.Caut.Enabled)
-	addDiff("integrations.caut.binary_path", defaults.Integrations.Caut.BinaryPath, cfg.Integrations.Caut.BinaryPath)
-	addDiff("integrations.caut.poll_interval", defaults.Integrations.Caut.PollInterval, cfg.Integrations.Caut.PollInterval)
-	addDiff("integrations.caut.alert_threshold", defaults.Integrations.Caut.AlertThreshold, cfg.Integrations.Caut.AlertThreshold)
-	addDiff("integrations.caut.providers", defaults.Integrations.Caut.Providers, cfg.Integrations.Caut.Providers)
-	addDiff("integrations.caut.per_agent_tracking", defaults.Integrations.Caut.PerAgentTracking, cfg.Integrations.Caut.PerAgentTracking)
-	addDiff("integrations.caut.currency", defaults.Integrations.Caut.Currency, cfg.Integrations.Caut.Currency)
-	addDiff("integrations.process_triage.enabled", defaults.Integrations.ProcessTriage.Enabled, cfg.Integrations.ProcessTriage.Enabled)
-	addDiff("integrations.process_triage.binary_path", defaults.Integrations.ProcessTriage.BinaryPath, cfg.Integrations.ProcessTriage.BinaryPath)
-	addDiff("integrations.process_triage.check_interval", defaults.Integrations.ProcessTriage.CheckInterval, cfg.Integrations.ProcessTriage.CheckInterval)
-	addDiff("integrations.process_triage.idle_threshold", defaults.Integrations.ProcessTriage.IdleThreshold, cfg.Integrations.ProcessTriage.IdleThreshold)
-	addDiff("integrations.process_triage.stuck_threshold", defaults.Integrations.ProcessTriage.StuckThreshold, cfg.Integrations.ProcessTriage.StuckThreshold)
-	addDiff("integrations.process_triage.on_stuck", defaults.Integrations.ProcessTriage.OnStuck, cfg.Integrations.ProcessTriage.OnStuck)
-	addDiff("integrations.process_triage.use_rano_data", defaults.Integrations.ProcessTriage.UseRanoData, cfg.Integrations.ProcessTriage.UseRanoData)
-
-	// Models
-	addDiff("models.default_claude", defaults.Models.DefaultClaude, cfg.Models.DefaultClaude)
-	addDiff("models.default_codex", defaults.Models.DefaultCodex, cfg.Models.DefaultCodex)
-	addDiff("models.default_gemini", defaults.Models.DefaultGemini, cfg.Models.DefaultGemini)
-	addDiff("models.default_grok", defaults.Models.DefaultGrok, cfg.Models.DefaultGrok)
-	addDiff("models.claude", defaults.Models.Claude, cfg.Models.Claude)
-	addDiff("models.codex", defaults.Models.Codex, cfg.Models.Codex)
-	addDiff("models.gemini", defaults.Models.Gemini, cfg.Models.Gemini)
-	addDiff("models.grok", defaults.Models.Grok, cfg.Models.Grok)
-
-	// Alerts
-	addDiff("alerts.enabled", defaults.Alerts.Enabled, cfg.Alerts.Enabled)
-	addDiff("alerts.agent_stuck_minutes", defaults.Alerts.AgentStuckMinutes, cfg.Alerts.AgentStuckMinutes)
-	addDiff("alerts.disk_low_threshold_gb", defaults.Alerts.DiskLowThresholdGB, cfg.Alerts.DiskLowThresholdGB)
-	addDiff("alerts.mail_backlog_threshold", defaults.Alerts.MailBacklogThreshold, cfg.Alerts.MailBacklogThreshold)
-	addDiff("alerts.bead_stale_hours", defaults.Alerts.BeadStaleHours, cfg.Alerts.BeadStaleHours)
-	addDiff("alerts.context_warning_threshold", defaults.Alerts.ContextWarningThreshold, cfg.Alerts.ContextWarningThreshold)
-	addDiff("alerts.resolved_prune_minutes", defaults.Alerts.ResolvedPruneMinutes, cfg.Alerts.ResolvedPruneMinutes)
-
-	// Checkpoints
-	addDiff("checkpoints.enabled", defaults.Checkpoints.Enabled, cfg.Checkpoints.Enabled)
-	addDiff("checkpoints.before_broadcast", defaults.Checkpoints.BeforeBroadcast, cfg.Checkpoints.BeforeBroadcast)
-	addDiff("checkpoints.before_add_agents", defaults.Checkpoints.BeforeAddAgents, cfg.Checkpoints.BeforeAddAgents)
-	addDiff("checkpoints.max_auto_checkpoints", defaults.Checkpoints.MaxAutoCheckpoints, cfg.Checkpoints.MaxAutoCheckpoints)
-	addDiff("checkpoints.scrollback_lines", defaults.Checkpoints.ScrollbackLines, cfg.Checkpoints.ScrollbackLines)
-	addDiff("checkpoints.include_git", defaults.Checkpoints.IncludeGit, cfg.Checkpoints.IncludeGit)
-	addDiff("checkpoints.auto_checkpoint_on_spawn", defaults.Checkpoints.AutoCheckpointOnSpawn, cfg.Checkpoints.AutoCheckpointOnSpawn)
-	addDiff("checkpoints.interval_minutes", defaults.Checkpoints.IntervalMinutes, cfg.Checkpoints.IntervalMinutes)
-	addDiff("checkpoints.on_rotation", defaults.Checkpoints.OnRotation, cfg.Checkpoints.OnRotation)
-	addDiff("checkpoints.on_error", defaults.Checkpoints.OnError, cfg.Checkpoints.OnError)
-
-	// Notifications
-	addDiff("notifications.enabled", defaults.Notifications.Enabled, cfg.Notifications.Enabled)
-	addDiff("notifications.events", defaults.Notifications.Events, cfg.Notifications.Events)
-	addDiff("notifications.primary", defaults.Notifications.Primary, cfg.Notifications.Primary)
-	addDiff("notifications.fallback", defaults.Notifications.Fallback, cfg.Notifications.Fallback)
-	addDiff("notifications.routing", defaults.Notifications.Routing, cfg.Notifications.Routing)
-	addDiff("notifications.desktop.enabled", defaults.Notifications.Desktop.Enabled, cfg.Notifications.Desktop.Enabled)
-	addDiff("notifications.desktop.title", defaults.Notifications.Desktop.Title, cfg.Notifications.Desktop.Title)
-	addDiff("notifications.webhook.enabled", defaults.Notifications.Webhook.Enabled, cfg.Notifications.Webhook.Enabled)
-	addDiff("notifications.webhook.url", defaults.Notifications.Webhook.URL, cfg.Notifications.Webhook.URL)
-	addDiff("notifications.webhook.template", defaults.Notifications.Webhook.Template, cfg.Notifications.Webhook.Template)
-	addDiff("notifications.webhook.method", defaults.Notifications.Webhook.Method, cfg.Notifications.Webhook.Method)
-	addDiff("notifications.webhook.headers", defaults.Notifications.Webhook.Headers, cfg.Notifications.Webhook.Headers)
-	addDiff("notifications.shell.enabled", defaults.Notifications.Shell.Enabled, cfg.Notifications.Shell.Enabled)
-	addDiff("notifications.shell.command", defaults.Notifications.Shell.Command, cfg.Notifications.Shell.Command)
-	addDiff("notifications.shell.pass_json", defaults.Notifications.Shell.PassJSON, cfg.Notifications.Shell.PassJSON)
-	addDiff("notifications.log.enabled", defaults.Notifications.Log.Enabled, cfg.Notifications.Log.Enabled)
-	addDiff("notifications.log.path", defaults.Notifications.Log.Path, cfg.Notifications.Log.Path)
-	addDiff("notifications.filebox.enabled", defaults.Notifications.FileBox.Enabled, cfg.Notifications.FileBox.Enabled)
-	addDiff("notifications.filebox.path", defaults.Notifications.FileBox.Path, cfg.Notifications.FileBox.Path)
-
-	// Resilience
-	addDiff("resilience.auto_restart", defaults.Resilience.AutoRestart, cfg.Resilience.AutoRestart)
-	addDiff("resilience.max_restarts", defaults.Resilience.MaxRestarts, cfg.Resilience.MaxRestarts)
-	addDiff("resilience.restart_delay_seconds", defaults.Resilience.RestartDelaySeconds, cfg.Resilience.RestartDelaySeconds)
-	addDiff("resilience.health_check_seconds", defaults.Resilience.HealthCheckSeconds, cfg.Resilience.HealthCheckSeconds)
-	addDiff("resilience.crash_threshold", defaults.Resilience.CrashThreshold, cfg.Resilience.CrashThreshold)
-	addDiff("resilience.notify_on_crash", defaults.Resilience.NotifyOnCrash, cfg.Resilience.NotifyOnCrash)
-	addDiff("resilience.notify_on_max_restarts", defaults.Resilience.NotifyOnMaxRestarts, cfg.Resilience.NotifyOnMaxRestarts)
-	addDiff("resilience.rate_limit.detect", defaults.Resilience.RateLimit.Detect, cfg.Resilience.RateLimit.Detect)
-	addDiff("resilience.rate_limit.notify", defaults.Resilience.RateLimit.Notify, cfg.Resilience.RateLimit.Notify)
-	addDiff("resilience.rate_limit.patterns", defaults.Resilience.RateLimit.Patterns, cfg.Resilience.RateLimit.Patterns)
-
-	// Context pack options
-	addDiff("context.ms_skills", defaults.Context.MSSkills, cfg.Context.MSSkills)
-
-	// Recovery defaults
-	addDiff("recovery.enabled", defaults.SessionRecovery.Enabled, cfg.SessionRecovery.Enabled)
-	addDiff("recovery.include_agent_mail", defaults.SessionRecovery.IncludeAgentMail, cfg.SessionRecovery.IncludeAgentMail)
-	addDiff("recovery.include_cm_memories", defaults.SessionRecovery.IncludeCMMemories, cfg.SessionRecovery.IncludeCMMemories)
-	addDiff("recovery.include_beads_context", defaults.SessionRecovery.IncludeBeadsContext, cfg.SessionRecovery.IncludeBeadsContext)
-	addDiff("recovery.max_recovery_tokens", defaults.SessionRecovery.MaxRecoveryTokens, cfg.SessionRecovery.MaxRecoveryTokens)
-	addDiff("recovery.auto_inject_on_spawn", defaults.SessionRecovery.AutoInjectOnSpawn, cfg.SessionRecovery.AutoInjectOnSpawn)
-	addDiff("recovery.stale_threshold_hours", defaults.SessionRecovery.StaleThresholdHours, cfg.SessionRecovery.StaleThresholdHours)
-	addDiff("recovery.max_cm_rules", defaults.SessionRecovery.MaxCMRules, cfg.SessionRecovery.MaxCMRules)
-	addDiff("recovery.max_cm_snippets", defaults.SessionRecovery.MaxCMSnippets, cfg.SessionRecovery.MaxCMSnippets)
-
-	// Cleanup defaults
-	addDiff("cleanup.auto_clean_on_startup", defaults.Cleanup.AutoCleanOnStartup, cfg.Cleanup.AutoCleanOnStartup)
-	addDiff("cleanup.max_age_hours", defaults.Cleanup.MaxAgeHours, cfg.Cleanup.MaxAgeHours)
-	addDiff("cleanup.verbose", defaults.Cleanup.Verbose, cfg.Cleanup.Verbose)
-
-	// Assign defaults
-	addDiff("assign.strategy", defaults.Assign.Strategy, cfg.Assign.Strategy)
-	addDiff("assign.prompt_template", defaults.Assign.PromptTemplate, cfg.Assign.PromptTemplate)
-	addDiff("assign.prompt_template_file", defaults.Assign.PromptTemplateFile, cfg.Assign.PromptTemplateFile)
-	addDiff("assign.operator_gated_labels", defaults.Assign.OperatorGatedLabels, cfg.Assign.OperatorGatedLabels)
-
-	// File reservation
-	addDiff("file_reservation.enabled", defaults.FileReservation.Enabled, cfg.FileReservation.Enabled)
-	addDiff("file_reservation.auto_reserve", defaults.FileReservation.AutoReserve, cfg.FileReservation.AutoReserve)
-	addDiff("file_reservation.auto_release_idle_minutes", defaults.FileReservation.AutoReleaseIdleMin, cfg.FileReservation.AutoReleaseIdleMin)
-	addDiff("file_reservation.notify_on_conflict", defaults.FileReservation.NotifyOnConflict, cfg.FileReservation.NotifyOnConflict)
-	addDiff("file_reservation.extend_on_activity", defaults.FileReservation.ExtendOnActivity, cfg.FileReservation.ExtendOnActivity)
-	addDiff("file_reservation.default_ttl_minutes", defaults.FileReservation.DefaultTTLMin, cfg.FileReservation.DefaultTTLMin)
-	addDiff("file_reservation.poll_interval_seconds", defaults.FileReservation.PollIntervalSec, cfg.FileReservation.PollIntervalSec)
-	addDiff("file_reservation.capture_lines", defaults.FileReservation.CaptureLinesForDetect, cfg.FileReservation.CaptureLinesForDetect)
-	addDiff("file_reservation.debug", defaults.FileReservation.Debug, cfg.FileReservation.Debug)
-
-	// Memory
-	addDiff("memory.enabled", defaults.Memory.Enabled, cfg.Memory.Enabled)
-	addDiff("memory.include_in_recovery", defaults.Memory.IncludeInRecovery, cfg.Memory.IncludeInRecovery)
-	addDiff("memory.max_rules", defaults.Memory.MaxRules, cfg.Memory.MaxRules)
-	addDiff("memory.include_anti_patterns", defaults.Memory.IncludeAntiPatterns, cfg.Memory.IncludeAntiPatterns)
-	addDiff("memory.include_history", defaults.Memory.IncludeHistory, cfg.Memory.IncludeHistory)
-	addDiff("memory.query_timeout_seconds", defaults.Memory.QueryTimeoutSeconds, cfg.Memory.QueryTimeoutSeconds)
-
-	// Privacy
-	addDiff("privacy.enabled", defaults.Privacy.Enabled, cfg.Privacy.Enabled)
-	addDiff("privacy.disable_prompt_history", defaults.Privacy.DisablePromptHistory, cfg.Privacy.DisablePromptHistory)
-	addDiff("privacy.disable_event_logs", defaults.Privacy.DisableEventLogs, cfg.Privacy.DisableEventLogs)
-	addDiff("privacy.disable_checkpoints", defaults.Privacy.DisableCheckpoints, cfg.Privacy.DisableCheckpoints)
-	addDiff("privacy.disable_scrollback_capture", defaults.Privacy.DisableScrollbackCapture, cfg.Privacy.DisableScrollbackCapture)
-	addDiff("privacy.require_explicit_persist", defaults.Privacy.RequireExplicitPersist, cfg.Privacy.RequireExplicitPersist)
-
-	// Safety, preflight, redaction, encryption
-	addDiff("safety.profile", defaults.Safety.Profile, cfg.Safety.Profile)
-	addDiff("preflight.enabled", defaults.Preflight.Enabled, cfg.Preflight.Enabled)
-	addDiff("preflight.strict", defaults.Preflight.Strict, cfg.Preflight.Strict)
-	addDiff("redaction.mode", defaults.Redaction.Mode, cfg.Redaction.Mode)
-	addDiff("redaction.allowlist", defaults.Redaction.Allowlist, cfg.Redaction.Allowlist)
-	addDiff("redaction.disabled_categories", defaults.Redaction.DisabledCategories, cfg.Redaction.DisabledCategories)
-	addDiff("encryption.enabled", defaults.Encryption.Enabled, cfg.Encryption.Enabled)
-	addDiff("encryption.key_source", defaults.Encryption.KeySource, cfg.Encryption.KeySource)
-	addDiff("encryption.key_env", defaults.Encryption.KeyEnv, cfg.Encryption.KeyEnv)
-	addDiff("encryption.key_file", defaults.Encryption.KeyFile, cfg.Encryption.KeyFile)
-	addDiff("encryption.key_command", defaults.Encryption.KeyCommand, cfg.Encryption.KeyCommand)
-	addDiff("encryption.key_format", defaults.Encryption.KeyFormat, cfg.Encryption.KeyFormat)
-	addDiff("encryption.active_key_id", defaults.Encryption.ActiveKeyID, cfg.Encryption.ActiveKeyID)
-
-	// Send/prompt defaults
-	addDiff("send.base_prompt", defaults.Send.BasePrompt, cfg.Send.BasePrompt)
-	addDiff("send.base_prompt_file", defaults.Send.BasePromptFile, cfg.Send.BasePromptFile)
-	addDiff("prompts.cc_default", defaults.Prompts.CCDefault, cfg.Prompts.CCDefault)
-	addDiff("prompts.cc_default_file", defaults.Prompts.CCDefaultFile, cfg.Prompts.CCDefaultFile)
-	addDiff("prompts.cod_default", defaults.Prompts.CodDefault, cfg.Prompts.CodDefault)
-	addDiff("prompts.cod_default_file", defaults.Prompts.CodDefaultFile, cfg.Prompts.CodDefaultFile)
-	addDiff("prompts.gmi_default", defaults.Prompts.GmiDefault, cfg.Prompts.GmiDefault)
-	addDiff("prompts.gmi_default_file", defaults.Prompts.GmiDefaultFile, cfg.Prompts.GmiDefaultFile)
-	addDiff("prompts.agy_default", defaults.Prompts.AgyDefault, cfg.Prompts.AgyDefault)
-	addDiff("prompts.agy_default_file", defaults.Prompts.AgyDefaultFile, cfg.Prompts.AgyDefaultFile)
-
-	// Context Rotation
-	addDiff("context_rotation.enabled", defaults.ContextRotation.Enabled, cfg.ContextRotation.Enabled)
-	addDiff("context_rotation.warning_threshold", defaults.ContextRotation.WarningThreshold, cfg.ContextRotation.WarningThreshold)
-	addDiff("context_rotation.rotate_threshold", defaults.ContextRotation.RotateThreshold, cfg.ContextRotation.RotateThreshold)
-	addDiff("context_rotation.summary_max_tokens", defaults.ContextRotation.SummaryMaxTokens, cfg.ContextRotation.SummaryMaxTokens)
-	addDiff("context_rotation.min_session_age_sec", defaults.ContextRotation.MinSessionAgeSec, cfg.ContextRotation.MinSessionAgeSec)
-	addDiff("context_rotation.try_compact_first", defaults.ContextRotation.TryCompactFirst, cfg.ContextRotation.TryCompactFirst)
-	addDiff("context_rotation.require_confirm", defaults.ContextRotation.RequireConfirm, cfg.ContextRotation.RequireConfirm)
-	addDiff("context_rotation.confirm_timeout_sec", defaults.ContextRotation.ConfirmTimeoutSec, cfg.ContextRotation.ConfirmTimeoutSec)
-	addDiff("context_rotation.default_confirm_action", defaults.ContextRotation.DefaultConfirmAction, cfg.ContextRotation.DefaultConfirmAction)
-
-	// Ensemble defaults
-	addDiff("ensemble.default_ensemble", defaults.Ensemble.DefaultEnsemble, cfg.Ensemble.DefaultEnsemble)
-	addDiff("ensemble.agent_mix", defaults.Ensemble.AgentMix, cfg.Ensemble.AgentMix)
-	addDiff("ensemble.assignment", defaults.Ensemble.Assignment, cfg.Ensemble.Assignment)
-	addDiff("ensemble.mode_tier_default", defaults.Ensemble.ModeTierDefault, cfg.Ensemble.ModeTierDefault)
-	addDiff("ensemble.allow_advanced", defaults.Ensemble.AllowAdvanced, cfg.Ensemble.AllowAdvanced)
-	addDiff("ensemble.synthesis.strategy", defaults.Ensemble.Synthesis.Strategy, cfg.Ensemble.Synthesis.Strategy)
-	addDiff("ensemble.synthesis.min_confidence", defaults.Ensemble.Synthesis.MinConfidence, cfg.Ensemble.Synthesis.MinConfidence)
-	addDiff("ensemble.synthesis.max_findings", defaults.Ensemble.Synthesis.MaxFindings, cfg.Ensemble.Synthesis.MaxFindings)
-	addDiff("ensemble.synthesis.include_raw_outputs", defaults.Ensemble.Synthesis.IncludeRawOutputs, cfg.Ensemble.Synthesis.IncludeRawOutputs)
-	addDiff("ensemble.synthesis.conflict_resolution", defaults.Ensemble.Synthesis.ConflictResolution, cfg.Ensemble.Synthesis.ConflictResolution)
-	addDiff("ensemble.cache.enabled", defaults.Ensemble.Cache.Enabled, cfg.Ensemble.Cache.Enabled)
-	addDiff("ensemble.cache.ttl_minutes", defaults.Ensemble.Cache.TTLMinutes, cfg.Ensemble.Cache.TTLMinutes)
-	addDiff("ensemble.cache.cache_dir", defaults.Ensemble.Cache.CacheDir, cfg.Ensemble.Cache.CacheDir)
-	addDiff("ensemble.cache.max_entries", defaults.Ensemble.Cache.MaxEntries, cfg.Ensemble.Cache.MaxEntries)
-	addDiff("ensemble.cache.share_across_modes", defaults.Ensemble.Cache.ShareAcrossModes, cfg.Ensemble.Cache.ShareAcrossModes)
-	addDiff("ensemble.budget.per_agent", defaults.Ensemble.Budget.PerAgent, cfg.Ensemble.Budget.PerAgent)
-	addDiff("ensemble.budget.total", defaults.Ensemble.Budget.Total, cfg.Ensemble.Budget.Total)
-	addDiff("ensemble.budget.synthesis", defaults.Ensemble.Budget.Synthesis, cfg.Ensemble.Budget.Synthesis)
-	addDiff("ensemble.budget.context_pack", defaults.Ensemble.Budget.ContextPack, cfg.Ensemble.Budget.ContextPack)
-	addDiff("ensemble.early_stop.enabled", defaults.Ensemble.EarlyStop.Enabled, cfg.Ensemble.EarlyStop.Enabled)
-	addDiff("ensemble.early_stop.min_agents", defaults.Ensemble.EarlyStop.MinAgents, cfg.Ensemble.EarlyStop.MinAgents)
-	addDiff("ensemble.early_stop.findings_threshold", defaults.Ensemble.EarlyStop.FindingsThreshold, cfg.Ensemble.EarlyStop.FindingsThreshold)
-	addDiff("ensemble.early_stop.similarity_threshold", defaults.Ensemble.EarlyStop.SimilarityThreshold, cfg.Ensemble.EarlyStop.SimilarityThreshold)
-	addDiff("ensemble.early_stop.window_size", defaults.Ensemble.EarlyStop.WindowSize, cfg.Ensemble.EarlyStop.WindowSize)
-
-	// CASS
-	addDiff("cass.enabled", defaults.CASS.Enabled, cfg.CASS.Enabled)
-	addDiff("cass.show_install_hints", defaults.CASS.ShowInstallHints, cfg.CASS.ShowInstallHints)
-	addDiff("cass.binary_path", defaults.CASS.BinaryPath, cfg.CASS.BinaryPath)
-	addDiff("cass.timeout", defaults.CASS.Timeout, cfg.CASS.Timeout)
-
-	// CASS Context
-	addDiff("cass.context.enabled", defaults.CASS.Context.Enabled, cfg.CASS.Context.Enabled)
-	addDiff("cass.context.max_sessions", defaults.CASS.Context.MaxSessions, cfg.CASS.Context.MaxSessions)
-	addDiff("cass.context.lookback_days", defaults.CASS.Context.LookbackDays, cfg.CASS.Context.LookbackDays)
-	addDiff("cass.context.max_tokens", defaults.CASS.Context.MaxTokens, cfg.CASS.Context.MaxTokens)
-	addDiff("cass.context.min_relevance", defaults.CASS.Context.MinRelevance, cfg.CASS.Context.MinRelevance)
-	addDiff("cass.context.skip_if_context_above", defaults.CASS.Context.SkipIfContextAbove, cfg.CASS.Context.SkipIfContextAbove)
-	addDiff("cass.context.prefer_same_project", defaults.CASS.Context.PreferSameProject, cfg.CASS.Context.PreferSameProject)
-	addDiff("cass.duplicates.enabled", defaults.CASS.Duplicates.Enabled, cfg.CASS.Duplicates.Enabled)
-	addDiff("cass.duplicates.similarity_threshold", defaults.CASS.Duplicates.SimilarityThreshold, cfg.CASS.Duplicates.SimilarityThreshold)
-	addDiff("cass.duplicates.lookback_days", defaults.CASS.Duplicates.LookbackDays, cfg.CASS.Duplicates.LookbackDays)
-	addDiff("cass.duplicates.prompt_on_match", defaults.CASS.Duplicates.PromptOnMatch, cfg.CASS.Duplicates.PromptOnMatch)
-	addDiff("cass.search.default_limit", defaults.CASS.Search.DefaultLimit, cfg.CASS.Search.DefaultLimit)
-	addDiff("cass.search.default_fields", defaults.CASS.Search.DefaultFields, cfg.CASS.Search.DefaultFields)
-	addDiff("cass.search.include_meta", defaults.CASS.Search.IncludeMeta, cfg.CASS.Search.IncludeMeta)
-	addDiff("cass.tui.show_activity_sparkline", defaults.CASS.TUI.ShowActivitySparkline, cfg.CASS.TUI.ShowActivitySparkline)
-	addDiff("cass.tui.show_status_indicator", defaults.CASS.TUI.ShowStatusIndicator, cfg.CASS.TUI.ShowStatusIndicator)
-
-	// Scanner
-	addDiff("scanner.ubs_path", defaults.Scanner.UBSPath, cfg.Scanner.UBSPath)
-	addDiff("scanner.defaults.timeout", defaults.Scanner.Defaults.Timeout, cfg.Scanner.Defaults.Timeout)
-	addDiff("scanner.defaults.parallel", defaults.Scanner.Defaults.Parallel, cfg.Scanner.Defaults.Parallel)
-	addDiff("scanner.defaults.exclude", defaults.Scanner.Defaults.Exclude, cfg.Scanner.Defaults.Exclude)
-	addDiff("scanner.defaults.languages", defaults.Scanner.Defaults.Languages, cfg.Scanner.Defaults.Languages)
-	addDiff("scanner.thresholds.pre_commit", defaults.Scanner.Thresholds.PreCommit, cfg.Scanner.Thresholds.PreCommit)
-	addDiff("scanner.thresholds.ci", defaults.Scanner.Thresholds.CI, cfg.Scanner.Thresholds.CI)
-	addDiff("scanner.thresholds.dashboard", defaults.Scanner.Thresholds.Dashboard, cfg.Scanner.Thresholds.Dashboard)
-	addDiff("scanner.thresholds.interactive", defaults.Scanner.Thresholds.Interactive, cfg.Scanner.Thresholds.Interactive)
-	addDiff("scanner.tools.enabled", defaults.Scanner.Tools.Enabled, cfg.Scanner.Tools.Enabled)
-	addDiff("scanner.tools.disabled", defaults.Scanner.Tools.Disabled, cfg.Scanner.Tools.Disabled)
-	addDiff("scanner.beads.auto_create", defaults.Scanner.Beads.AutoCreate, cfg.Scanner.Beads.AutoCreate)
-	addDiff("scanner.beads.min_severity", defaults.Scanner.Beads.MinSeverity, cfg.Scanner.Beads.MinSeverity)
-	addDiff("scanner.beads.auto_close", defaults.Scanner.Beads.AutoClose, cfg.Scanner.Beads.AutoClose)
-	addDiff("scanner.beads.labels", defaults.Scanner.Beads.Labels, cfg.Scanner.Beads.Labels)
-	addDiff("scanner.notifications.enabled", defaults.Scanner.Notifications.Enabled, cfg.Scanner.Notifications.Enabled)
-	addDiff("scanner.notifications.on_new_critical", defaults.Scanner.Notifications.OnNewCritical, cfg.Scanner.Notifications.OnNewCritical)
-	addDiff("scanner.notifications.summary_after_scan", defaults.Scanner.Notifications.SummaryAfterScan, cfg.Scanner.Notifications.SummaryAfterScan)
-
-	// Accounts and rotation
-	addDiff("accounts.state_file", defaults.Accounts.StateFile, cfg.Accounts.StateFile)
-	addDiff("accounts.auto_rotate", defaults.Accounts.AutoRotate, cfg.Accounts.AutoRotate)
-	addDiff("accounts.reset_buffer_minutes", defaults.Accounts.ResetBufferMinutes, cfg.Accounts.ResetBufferMinutes)
-	addDiff("accounts.claude", defaults.Accounts.Claude, cfg.Accounts.Claude)
-	addDiff("accounts.codex", defaults.Accounts.Codex, cfg.Accounts.Codex)
-	addDiff("accounts.gemini", defaults.Accounts.Gemini, cfg.Accounts.Gemini)
-	addDiff("accounts.antigravity", defaults.Accounts.Antigravity, cfg.Accounts.Antigravity)
-	addDiff("rotation.enabled", defaults.Rotation.Enabled, cfg.Rotation.Enabled)
-	addDiff("rotation.prefer_restart", defaults.Rotation.PreferRestart, cfg.Rotation.PreferRestart)
-	addDiff("rotation.auto_open_browser", defaults.Rotation.AutoOpenBrowser, cfg.Rotation.AutoOpenBrowser)
-	addDiff("rotation.auto_trigger", defaults.Rotation.AutoTrigger, cfg.Rotation.AutoTrigger)
-	addDiff("rotation.auto_initiate", defaults.Rotation.AutoInitiate, cfg.Rotation.AutoInitiate)
-	addDiff("rotation.continuation_prompt", defaults.Rotation.ContinuationPrompt, cfg.Rotation.ContinuationPrompt)
-	addDiff("rotation.accounts", defaults.Rotation.Accounts, cfg.Rotation.Accounts)
-	addDiff("rotation.thresholds.warning_percent", defaults.Rotation.Thresholds.WarningPercent, cfg.Rotation.Thresholds.WarningPercent)
-	addDiff("rotation.thresholds.critical_percent", defaults.Rotation.Thresholds.CriticalPercent, cfg.Rotation.Thresholds.CriticalPercent)
-	addDiff("rotation.thresholds.restart_if_tokens_above", defaults.Rotation.Thresholds.RestartIfTokensAbove, cfg.Rotation.Thresholds.RestartIfTokensAbove)
-	addDiff("rotation.thresholds.restart_if_session_hours", defaults.Rotation.Thresholds.RestartIfSessionHours, cfg.Rotation.Thresholds.RestartIfSessionHours)
-	addDiff("rotation.dashboard.show_quota_bars", defaults.Rotation.Dashboard.ShowQuotaBars, cfg.Rotation.Dashboard.ShowQuotaBars)
-	addDiff("rotation.dashboard.show_account_status", defaults.Rotation.Dashboard.ShowAccountStatus, cfg.Rotation.Dashboard.ShowAccountStatus)
-	addDiff("rotation.dashboard.show_reset_timers", defaults.Rotation.Dashboard.ShowResetTimers, cfg.Rotation.Dashboard.ShowResetTimers)
-
-	// Gemini setup
-	addDiff("gemini_setup.auto_select_pro_model", defaults.GeminiSetup.AutoSelectProModel, cfg.GeminiSetup.AutoSelectProModel)
-	addDiff("gemini_setup.ready_timeout_seconds", defaults.GeminiSetup.ReadyTimeoutSeconds, cfg.GeminiSetup.ReadyTimeoutSeconds)
-	addDiff("gemini_setup.model_select_timeout_seconds", defaults.GeminiSetup.ModelSelectTimeoutSeconds, cfg.GeminiSetup.ModelSelectTimeoutSeconds)
-	addDiff("gemini_setup.verbose", defaults.GeminiSetup.Verbose, cfg.GeminiSetup.Verbose)
-
-	// Swarm
-	addDiff("swarm.enabled", defaults.Swarm.Enabled, cfg.Swarm.Enabled)
-	addDiff("swarm.default_scan_dir", defaults.Swarm.DefaultScanDir, cfg.Swarm.DefaultScanDir)
-	addDiff("swarm.tier1_threshold", defaults.Swarm.Tier1Threshold, cfg.Swarm.Tier1Threshold)
-	addDiff("swarm.tier2_threshold", defaults.Swarm.Tier2Threshold, cfg.Swarm.Tier2Threshold)
-	addDiff("swarm.tier1_allocation", defaults.Swarm.Tier1Allocation, cfg.Swarm.Tier1Allocation)
-	addDiff("swarm.tier2_allocation", defaults.Swarm.Tier2Allocation, cfg.Swarm.Tier2Allocation)
-	addDiff("swarm.tier3_allocation", defaults.Swarm.Tier3Allocation, cfg.Swarm.Tier3Allocation)
-	addDiff("swarm.sessions_per_type", defaults.Swarm.SessionsPerType, cfg.Swarm.SessionsPerType)
-	addDiff("swarm.panes_per_session", defaults.Swarm.PanesPerSession, cfg.Swarm.PanesPerSession)
-	addDiff("swarm.stagger_delay_ms", defaults.Swarm.StaggerDelayMs, cfg.Swarm.StaggerDelayMs)
-	addDiff("swarm.auto_rotate_accounts", defaults.Swarm.AutoRotateAccounts, cfg.Swarm.AutoRotateAccounts)
-	addDiff("swarm.limit_patterns", defaults.Swarm.LimitPatterns, cfg.Swarm.LimitPatterns)
-	addDiff("swarm.marching_orders", defaults.Swarm.MarchingOrders, cfg.Swarm.MarchingOrders)
-
-	// Spawn pacing
-	addDiff("spawn_pacing.enabled", defaults.SpawnPacing.Enabled, cfg.SpawnPacing.Enabled)
-	addDiff("spawn_pacing.max_concurrent_spawns", defaults.SpawnPacing.MaxConcurrentSpawns, cfg.SpawnPacing.MaxConcurrentSpawns)
-	addDiff("spawn_pacing.max_spawns_per_sec", defaults.SpawnPacing.MaxSpawnsPerSecond, cfg.SpawnPacing.MaxSpawnsPerSecond)
-	addDiff("spawn_pacing.burst_size", defaults.SpawnPacing.BurstSize, cfg.SpawnPacing.BurstSize)
-	addDiff("spawn_pacing.default_retries", defaults.SpawnPacing.DefaultRetries, cfg.SpawnPacing.DefaultRetries)
-	addDiff("spawn_pacing.retry_delay_ms", defaults.SpawnPacing.RetryDelayMs, cfg.SpawnPacing.RetryDelayMs)
-	addDiff("spawn_pacing.backpressure_threshold", defaults.SpawnPacing.BackpressureThreshold, cfg.SpawnPacing.BackpressureThreshold)
-	addDiff("spawn_pacing.agent_caps", defaults.SpawnPacing.AgentCaps, cfg.SpawnPacing.AgentCaps)
-	addDiff("spawn_pacing.headroom", defaults.SpawnPacing.Headroom, cfg.SpawnPacing.Headroom)
-	addDiff("spawn_pacing.backoff", defaults.SpawnPacing.Backoff, cfg.SpawnPacing.Backoff)
+// suggestConfigPath returns the known config path or section closest to path
+// when it is within edit distance 2, or "" when none is (or path is known).
+func suggestConfigPath(path string) string {
+	best, bestDist := "", 3
+	for _, known := range knownConfigPaths() {
+		if d := editDistance(path, known); d < bestDist || (d == bestDist && known < best) {
+			best, bestDist = known, d
+		}
+	}
+	if bestDist == 0 {
+		return ""
+	}
+	return best
+}
 
-	return diffs
+// knownConfigPaths is ValuePaths plus every section above a leaf, so a typo
+// in a shorter path such as "ensemble.synthesys" is caught too.
+var knownConfigPaths = sync.OnceValue(func() []string {
+	seen := make(map[string]bool)
+	var paths []string
+	for _, leaf := range ValuePaths() {
+		for i := range leaf {
+			if leaf[i] == '.' && !seen[leaf[:i]] {
+				seen[leaf[:i]] = true
+				paths = append(paths, leaf[:i])
+			}
+		}
+		if !seen[leaf] {
+			seen[leaf] = true
+			paths = append(paths, leaf)
+		}
+	}
+	return paths
+})
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// configField resolves a dotted path to the Config field with those toml
+// tags.
+func configField(cfg *Config, path string) (reflect.Value, bool) {
+	field := reflect.ValueOf(cfg).Elem()
+	for _, part := range strings.Split(path, ".") {
+		if field.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		next := reflect.Value{}
+		for i := 0; i < field.NumField(); i++ {
+			if tag, _, _ := strings.Cut(field.Type().Field(i).Tag.Get("toml"), ","); tag == part {
+				next = field.Field(i)
+				break
+			}
+		}
+		if !next.IsValid() {
+			return reflect.Value{}, false
+		}
+		field = next
+	}
+	return field, true
+}
+
+// redactConfigValue masks a secret the way AllValues does: a non-empty string
+// becomes redactedConfigValue and a map keeps its keys with every value
+// redacted.
+func redactConfigValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return v
+		}
+		return redactedConfigValue
+	case map[string]string:
+		redacted := make(map[string]string, len(v))
+		for name := range v {
+			redacted[name] = redactedConfigValue
+		}
+		return redacted
+	default:
+		return redactedConfigValue
+	}
 }
 
 // Validate checks the configuration for errors and returns all issues found
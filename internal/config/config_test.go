@@ -3,6 +3,7 @@ package config
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -1576,6 +1577,44 @@ func TestResilienceGetValue(t *testing.T) {
 	}
 }
 
+func TestAllValuesRedactsSecrets(t *testing.T) {
+	cfg := Default()
+	cfg.AgentMail.Token = "super-secret"
+	cfg.Encryption.Keyring = map[string]string{"k1": "deadbeef"}
+	cfg.Notifications.Webhook.Headers = map[string]string{"Authorization": "Bearer hook-secret"}
+	cfg.Tmux.HistoryLimit = 4242
+
+	values, err := AllValues(cfg)
+	if err != nil {
+		t.Fatalf("AllValues: %v", err)
+	}
+
+	agentMail, ok := values["agent_mail"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("agent_mail section missing: %#v", values["agent_mail"])
+	}
+	if agentMail["token"] != "[redacted]" {
+		t.Errorf("agent_mail.token = %v, want [redacted]", agentMail["token"])
+	}
+	encryption := values["encryption"].(map[string]interface{})
+	keyring := encryption["keyring"].(map[string]interface{})
+	if keyring["k1"] != "[redacted]" {
+		t.Errorf("encryption.keyring.k1 = %v, want [redacted]", keyring["k1"])
+	}
+	webhook := values["notifications"].(map[string]interface{})["webhook"].(map[string]interface{})
+	headers := webhook["headers"].(map[string]interface{})
+	if headers["Authorization"] != "[redacted]" {
+		t.Errorf("notifications.webhook.headers.Authorization = %v, want [redacted]", headers["Authorization"])
+	}
+	if got, _ := GetValue(cfg, "notifications.webhook.headers"); !reflect.DeepEqual(got, map[string]string{"Authorization": "[redacted]"}) {
+		t.Errorf("GetValue(notifications.webhook.headers) = %v, want values redacted", got)
+	}
+	tmux := values["tmux"].(map[string]interface{})
+	if tmux["history_limit"] != int64(4242) {
+		t.Errorf("tmux.history_limit = %#v, want 4242", tmux["history_limit"])
+	}
+}
+
 func TestAssignOperatorGatedLabelsConfigSurface(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "config.toml")
@@ -2894,7 +2933,7 @@ func TestIsValidStrategy(t *testing.T) {
 
 func TestValidAssignStrategies(t *testing.T) {
 	// Verify all expected strategies are present
-	expected := []string{"balanced", "speed", "quality", "dependency", "round-robin"}
+	expected := []string{"balanced", "speed", "quality", "dependency", "round-robin", "load"}
 	if len(ValidAssignStrategies) != len(expected) {
 		t.Errorf("Expected %d strategies, got %d", len(expected), len(ValidAssignStrategies))
 	}
@@ -4493,7 +4532,6 @@ func TestValidateSynthesisStrategy(t *testing.T) {
 	t.Run("deprecated strategies", func(t *testing.T) {
 		t.Parallel()
 		deprecated := map[string]string{
-			"debate":     "dialectical",
 			"weighted":   "prioritized",
 			"sequential": "manual",
 			"best-of":    "prioritized",
@@ -4604,6 +4642,23 @@ func TestValidateEnsembleConfig(t *testing.T) {
 			wantErr: true,
 			errMsg:  "mode_tier_default",
 		},
+		{
+			name:    "valid webhook url",
+			cfg:     &EnsembleConfig{WebhookURL: "https://hooks.example.com/ntm"},
+			wantErr: false,
+		},
+		{
+			name:    "webhook url missing scheme",
+			cfg:     &EnsembleConfig{WebhookURL: "hooks.example.com/ntm"},
+			wantErr: true,
+			errMsg:  "webhook_url",
+		},
+		{
+			name:    "webhook url unsupported scheme",
+			cfg:     &EnsembleConfig{WebhookURL: "ftp://hooks.example.com"},
+			wantErr: true,
+			errMsg:  "webhook_url",
+		},
 		{
 			name: "invalid synthesis min_confidence negative",
 			cfg: &EnsembleConfig{
@@ -5195,3 +5250,104 @@ func TestConfigReset_NoExistingFile(t *testing.T) {
 		t.Fatalf("config file should exist after reset: %v", err)
 	}
 }
+
+func TestSetValue(t *testing.T) {
+	t.Parallel()
+
+	cfg := Default()
+	if err := SetValue(cfg, "ensemble.synthesis.max_findings", "30"); err != nil {
+		t.Fatalf("SetValue: %v", err)
+	}
+	if cfg.Ensemble.Synthesis.MaxFindings != 30 {
+		t.Fatalf("max_findings = %d, want 30", cfg.Ensemble.Synthesis.MaxFindings)
+	}
+	if err := SetValue(cfg, "alerts.enabled", "false"); err != nil || cfg.Alerts.Enabled {
+		t.Fatalf("SetValue(alerts.enabled) = %v, enabled=%v", err, cfg.Alerts.Enabled)
+	}
+
+	if err := SetValue(cfg, "ensemble.synthesis.nope", "1"); err == nil || !strings.Contains(err.Error(), "unknown config path: ensemble.synthesis.nope") {
+		t.Fatalf("unknown path error = %v", err)
+	}
+	if err := SetValue(cfg, "ensemble.synthesis.max_findings", "lots"); err == nil || !strings.Contains(err.Error(), "expected an integer") {
+		t.Fatalf("type mismatch error = %v", err)
+	}
+	if err := SetValue(cfg, "agent_mail.token", "s3cret"); !errors.Is(err, ErrSecretConfigKey) {
+		t.Fatalf("secret key error = %v, want ErrSecretConfigKey", err)
+	}
+	if err := SetSecretValue(cfg, "agent_mail.token", "s3cret"); err != nil || cfg.AgentMail.Token != "s3cret" {
+		t.Fatalf("SetSecretValue = %v, token=%q", err, cfg.AgentMail.Token)
+	}
+
+	before := cfg.ContextRotation.WarningThreshold
+	if err := SetValue(cfg, "context_rotation.warning_threshold", "7"); err == nil {
+		t.Fatal("expected validation error for out-of-range warning_threshold")
+	}
+	if cfg.ContextRotation.WarningThreshold != before {
+		t.Fatalf("rejected value was kept: %v", cfg.ContextRotation.WarningThreshold)
+	}
+}
+
+func TestSetFileValuePersistsOnlyTheKey(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	initial := "# my settings\n[ensemble.synthesis]\n# keep me\nstrategy = \"manual\"\n"
+	if err := os.WriteFile(path, []byte(initial), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetFileValue(path, "ensemble.synthesis.max_findings", "30", false); err != nil {
+		t.Fatalf("SetFileValue: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"# my settings", "# keep me", "max_findings = 30"} {
+		if !strings.Contains(string(data), want) {
+			t.Fatalf("config missing %q:\n%s", want, data)
+		}
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Ensemble.Synthesis.MaxFindings != 30 {
+		t.Fatalf("max_findings = %d, want 30", loaded.Ensemble.Synthesis.MaxFindings)
+	}
+	if err := SetFileValue(path, "agent_mail.token", "s3cret", false); !errors.Is(err, ErrSecretConfigKey) {
+		t.Fatalf("secret key error = %v", err)
+	}
+}
+
+func TestUnknownConfigPathSuggestions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "ensemble.synthesys.strategy", want: "unknown config path: ensemble.synthesys.strategy (did you mean ensemble.synthesis.strategy?)"},
+		{path: "cass.contxt", want: "unknown config path: cass.contxt (did you mean cass.context?)"},
+		{path: "alerts.enabld", want: "(did you mean alerts.enabled?)"},
+		{path: "no_such_section.at_all", want: "unknown config path: no_such_section.at_all"},
+	}
+	for _, tt := range tests {
+		_, err := GetValue(Default(), tt.path)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("GetValue(%q) error = %v, want %q", tt.path, err, tt.want)
+		}
+	}
+	if _, err := GetValue(Default(), "no_such_section.at_all"); strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("unexpected suggestion: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte("[ensemble.synthesys]\nstrategy = \"manual\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	_, err := Load(path)
+	want := "ensemble.synthesys.strategy (did you mean ensemble.synthesis.strategy?)"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("Load error = %v, want %q", err, want)
+	}
+}
@@ -23,7 +23,7 @@ func TestDiff_DefaultConfig_NoDiffs(t *testing.T) {
 	if len(diffs) != 0 {
 		t.Errorf("expected 0 diffs for default config, got %d:", len(diffs))
 		for _, d := range diffs {
-			t.Logf("  %s: default=%v current=%v", d.Path, d.Default, d.Current)
+			t.Logf("  %s: default=%v effective=%v", d.Path, d.Default, d.Effective)
 		}
 	}
 }
@@ -40,8 +40,8 @@ func TestDiff_TopLevelTheme(t *testing.T) {
 	for _, d := range diffs {
 		if d.Path == "theme" {
 			found = true
-			if d.Current != "dark" {
-				t.Errorf("expected current='dark', got %v", d.Current)
+			if d.Effective != "dark" {
+				t.Errorf("expected effective='dark', got %v", d.Effective)
 			}
 			if d.Source != "config" {
 				t.Errorf("expected source='config', got %q", d.Source)
@@ -68,8 +68,8 @@ func TestDiff_AgentsChanged(t *testing.T) {
 	for _, d := range diffs {
 		if d.Path == "agents.claude" {
 			found = true
-			if d.Current != "/custom/path/to/claude" {
-				t.Errorf("expected custom claude path, got %v", d.Current)
+			if d.Effective != "/custom/path/to/claude" {
+				t.Errorf("expected custom claude path, got %v", d.Effective)
 			}
 		}
 	}
@@ -184,8 +184,8 @@ func TestDiff_ResilienceSettings(t *testing.T) {
 	for _, d := range diffs {
 		if d.Path == "resilience.max_restarts" {
 			found = true
-			if d.Current != 999 {
-				t.Errorf("expected current=999, got %v", d.Current)
+			if d.Effective != 999 {
+				t.Errorf("expected effective=999, got %v", d.Effective)
 			}
 		}
 	}
@@ -383,8 +383,8 @@ func TestDiff_AssignOperatorGatedLabels(t *testing.T) {
 	if len(matches) != 1 {
 		t.Fatalf("assign.operator_gated_labels diff count = %d, want exactly 1", len(matches))
 	}
-	if !reflect.DeepEqual(matches[0].Current, cfg.Assign.OperatorGatedLabels) {
-		t.Fatalf("assign.operator_gated_labels current = %#v, want %#v", matches[0].Current, cfg.Assign.OperatorGatedLabels)
+	if !reflect.DeepEqual(matches[0].Effective, cfg.Assign.OperatorGatedLabels) {
+		t.Fatalf("assign.operator_gated_labels current = %#v, want %#v", matches[0].Effective, cfg.Assign.OperatorGatedLabels)
 	}
 }
 
@@ -444,7 +444,7 @@ func TestDiff_OnlyChangedFieldsReported(t *testing.T) {
 	if len(diffs) != 1 {
 		t.Errorf("expected exactly 1 diff, got %d:", len(diffs))
 		for _, d := range diffs {
-			t.Logf("  %s: default=%v current=%v", d.Path, d.Default, d.Current)
+			t.Logf("  %s: default=%v effective=%v", d.Path, d.Default, d.Effective)
 		}
 	}
 
@@ -452,3 +452,32 @@ func TestDiff_OnlyChangedFieldsReported(t *testing.T) {
 		t.Errorf("expected diff for 'theme', got %q", diffs[0].Path)
 	}
 }
+
+func TestDiff_RedactsSecretsAndCoversEveryLeaf(t *testing.T) {
+	t.Parallel()
+
+	cfg := Default()
+	cfg.AgentMail.Token = "s3cret"
+	cfg.Encryption.Keyring = map[string]string{"k1": "deadbeef"}
+	cfg.Integrations.XF.DefaultMode = "keyword"
+	cfg.SpawnPacing.Backoff.Multiplier = cfg.SpawnPacing.Backoff.Multiplier + 1
+
+	byPath := make(map[string]ConfigDiff)
+	for _, d := range Diff(cfg) {
+		byPath[d.Path] = d
+	}
+	if got := byPath["agent_mail.token"].Effective; got != redactedConfigValue {
+		t.Errorf("agent_mail.token effective = %v, want redacted", got)
+	}
+	if got := byPath["encryption.keyring"].Effective; !reflect.DeepEqual(got, map[string]string{"k1": redactedConfigValue}) {
+		t.Errorf("encryption.keyring effective = %v, want redacted values", got)
+	}
+	for _, path := range []string{"integrations.xf.default_mode", "spawn_pacing.backoff.multiplier"} {
+		if _, ok := byPath[path]; !ok {
+			t.Errorf("expected diff for %q", path)
+		}
+	}
+	if len(byPath) != 4 {
+		t.Errorf("expected 4 diffs, got %d: %v", len(byPath), byPath)
+	}
+}
@@ -0,0 +1,179 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// EnvOverride describes one environment variable that overrides a config
+// field. The tables below drive both Load and `ntm config env`, so the
+// documented list cannot drift from what is applied.
+type EnvOverride struct {
+	Name  string // Environment variable
+	Path  string // Dotted TOML path of the field it overrides
+	apply func(cfg *Config, value string)
+}
+
+// EnvOverrideStatus reports whether an override is set in the current
+// environment. Value is redacted when Path names a secret.
+type EnvOverrideStatus struct {
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Set    bool   `json:"set"`
+	Value  string `json:"value,omitempty"`
+	Secret bool   `json:"secret,omitempty"`
+}
+
+type scannerEnvOverride struct {
+	name  string
+	path  string // Relative to [scanner]
+	apply func(cfg *ScannerConfig, value string)
+}
+
+// envTrue is the boolean parse used by the top-level overrides.
+func envTrue(v string) bool { return v == "1" || v == "true" }
+
+// scannerEnvOverrides are applied by applyEnvOverrides, which scanner config
+// loading also calls on its own.
+var scannerEnvOverrides = []scannerEnvOverride{
+	{"UBS_PATH", "ubs_path", func(c *ScannerConfig, v string) { c.UBSPath = v }},
+	{"NTM_SCANNER_TIMEOUT", "defaults.timeout", func(c *ScannerConfig, v string) { c.Defaults.Timeout = v }},
+	{"NTM_SCANNER_AUTO_BEADS", "beads.auto_create", func(c *ScannerConfig, v string) {
+		c.Beads.AutoCreate = v == "1" || strings.ToLower(v) == "true"
+	}},
+	{"NTM_SCANNER_MIN_SEVERITY", "beads.min_severity", func(c *ScannerConfig, v string) { c.Beads.MinSeverity = v }},
+	{"NTM_SCANNER_BLOCK_CRITICAL", "thresholds.pre_commit.block_critical", func(c *ScannerConfig, v string) {
+		c.Thresholds.PreCommit.BlockCritical = v == "1" || strings.ToLower(v) == "true"
+	}},
+	{"NTM_SCANNER_FAIL_ERRORS", "thresholds.ci.fail_errors", func(c *ScannerConfig, v string) {
+		if n, err := strconv.Atoi(v); err == nil {
+			c.Thresholds.CI.FailErrors = n
+		}
+	}},
+}
+
+// configEnvOverrides are applied in order after the TOML file is decoded
+// (Env > TOML > Default), followed by the scanner overrides.
+var configEnvOverrides = []EnvOverride{
+	{Name: "NTM_PROJECTS_BASE", Path: "projects_base", apply: func(c *Config, v string) { c.ProjectsBase = v }},
+
+	// AgentMail
+	{Name: "AGENT_MAIL_URL", Path: "agent_mail.url", apply: func(c *Config, v string) { c.AgentMail.URL = v }},
+	{Name: "AGENT_MAIL_TOKEN", Path: "agent_mail.token", apply: func(c *Config, v string) { c.AgentMail.Token = v }},
+	{Name: "AGENT_MAIL_ENABLED", Path: "agent_mail.enabled", apply: func(c *Config, v string) { c.AgentMail.Enabled = envTrue(v) }},
+
+	// CASS
+	{Name: "NTM_CASS_ENABLED", Path: "cass.enabled", apply: func(c *Config, v string) { c.CASS.Enabled = envTrue(v) }},
+	{Name: "NTM_CASS_TIMEOUT", Path: "cass.timeout", apply: func(c *Config, v string) {
+		var t int
+		if _, err := fmt.Sscanf(v, "%d", &t); err == nil && t > 0 {
+			c.CASS.Timeout = t
+		}
+	}},
+	{Name: "NTM_CASS_BINARY", Path: "cass.binary_path", apply: func(c *Config, v string) { c.CASS.BinaryPath = v }},
+	{Name: "NTM_CASS_CONTEXT_ENABLED", Path: "cass.context.enabled", apply: func(c *Config, v string) { c.CASS.Context.Enabled = envTrue(v) }},
+	{Name: "NTM_CASS_MIN_RELEVANCE", Path: "cass.context.min_relevance", apply: func(c *Config, v string) {
+		if f, err := strconv.ParseFloat(v, 64); err == nil && f >= 0 && f <= 1 {
+			c.CASS.Context.MinRelevance = f
+		}
+	}},
+	{Name: "NTM_CASS_SKIP_IF_CONTEXT_ABOVE", Path: "cass.context.skip_if_context_above", apply: func(c *Config, v string) {
+		if f, err := strconv.ParseFloat(v, 64); err == nil && f >= 0 && f <= 100 {
+			c.CASS.Context.SkipIfContextAbove = f
+		}
+	}},
+	{Name: "NTM_CASS_PREFER_SAME_PROJECT", Path: "cass.context.prefer_same_project", apply: func(c *Config, v string) { c.CASS.Context.PreferSameProject = envTrue(v) }},
+
+	// Accounts/Rotation
+	{Name: "NTM_ACCOUNTS_AUTO_ROTATE", Path: "accounts.auto_rotate", apply: func(c *Config, v string) { c.Accounts.AutoRotate = envTrue(v) }},
+	{Name: "NTM_ROTATION_ENABLED", Path: "rotation.enabled", apply: func(c *Config, v string) { c.Rotation.Enabled = envTrue(v) }},
+
+	// Gemini
+	{Name: "NTM_GEMINI_AUTO_PRO", Path: "gemini_setup.auto_select_pro_model", apply: func(c *Config, v string) { c.GeminiSetup.AutoSelectProModel = envTrue(v) }},
+
+	// Session recovery
+	{Name: "NTM_RECOVERY_ENABLED", Path: "recovery.enabled", apply: func(c *Config, v string) { c.SessionRecovery.Enabled = envTrue(v) }},
+	{Name: "NTM_RECOVERY_INCLUDE_AGENT_MAIL", Path: "recovery.include_agent_mail", apply: func(c *Config, v string) { c.SessionRecovery.IncludeAgentMail = envTrue(v) }},
+	{Name: "NTM_RECOVERY_INCLUDE_CM", Path: "recovery.include_cm_memories", apply: func(c *Config, v string) { c.SessionRecovery.IncludeCMMemories = envTrue(v) }},
+	{Name: "NTM_RECOVERY_INCLUDE_BEADS", Path: "recovery.include_beads_context", apply: func(c *Config, v string) { c.SessionRecovery.IncludeBeadsContext = envTrue(v) }},
+	{Name: "NTM_RECOVERY_MAX_TOKENS", Path: "recovery.max_recovery_tokens", apply: func(c *Config, v string) {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			c.SessionRecovery.MaxRecoveryTokens = n
+		}
+	}},
+	{Name: "NTM_RECOVERY_AUTO_INJECT", Path: "recovery.auto_inject_on_spawn", apply: func(c *Config, v string) { c.SessionRecovery.AutoInjectOnSpawn = envTrue(v) }},
+	{Name: "NTM_RECOVERY_STALE_HOURS", Path: "recovery.stale_threshold_hours", apply: func(c *Config, v string) {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			c.SessionRecovery.StaleThresholdHours = n
+		}
+	}},
+}
+
+// configSelectorEnvVars choose which config applies instead of overriding a
+// single field, so they are listed by EnvOverrideStatuses but have no apply.
+var configSelectorEnvVars = []EnvOverrideStatus{
+	{Name: ProfileEnvVar, Path: "profiles.<name>"},
+}
+
+// EnvOverrides returns every environment variable that overrides a config
+// field, in the order Load applies them.
+func EnvOverrides() []EnvOverride {
+	out := make([]EnvOverride, 0, len(configEnvOverrides)+len(scannerEnvOverrides))
+	out = append(out, configEnvOverrides...)
+	for _, s := range scannerEnvOverrides {
+		s := s
+		out = append(out, EnvOverride{
+			Name:  s.name,
+			Path:  "scanner." + s.path,
+			apply: func(c *Config, v string) { s.apply(&c.Scanner, v) },
+		})
+	}
+	return out
+}
+
+// applyEnvOverrides applies environment variable overrides to scanner config
+func applyEnvOverrides(cfg *ScannerConfig) {
+	for _, o := range scannerEnvOverrides {
+		if v := os.Getenv(o.name); v != "" {
+			o.apply(cfg, v)
+		}
+	}
+}
+
+// applyConfigEnvOverrides applies every EnvOverrides entry that is set.
+func applyConfigEnvOverrides(cfg *Config) {
+	for _, o := range EnvOverrides() {
+		if v := os.Getenv(o.Name); v != "" {
+			o.apply(cfg, v)
+		}
+	}
+}
+
+// EnvOverrideStatuses returns the current state of every EnvOverrides entry,
+// followed by the config selector variables such as NTM_PROFILE.
+func EnvOverrideStatuses() []EnvOverrideStatus {
+	overrides := EnvOverrides()
+	statuses := make([]EnvOverrideStatus, 0, len(overrides))
+	for _, o := range overrides {
+		key := o.Path[strings.LastIndex(o.Path, ".")+1:]
+		st := EnvOverrideStatus{Name: o.Name, Path: o.Path, Secret: secretConfigKeys[key]}
+		if v := os.Getenv(o.Name); v != "" {
+			st.Set = true
+			st.Value = v
+			if st.Secret {
+				st.Value = redactedConfigValue
+			}
+		}
+		statuses = append(statuses, st)
+	}
+	for _, st := range configSelectorEnvVars {
+		if v := os.Getenv(st.Name); v != "" {
+			st.Set = true
+			st.Value = v
+		}
+		statuses = append(statuses, st)
+	}
+	return statuses
+}
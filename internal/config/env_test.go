@@ -0,0 +1,57 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestEnvOverridesTargetRealPaths(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, o := range EnvOverrides() {
+		if seen[o.Name] {
+			t.Errorf("%s listed twice", o.Name)
+		}
+		seen[o.Name] = true
+
+		if _, err := GetValue(Default(), o.Path); err != nil {
+			t.Errorf("%s: path %q does not resolve: %v", o.Name, o.Path, err)
+		}
+	}
+	for _, name := range []string{"UBS_PATH", "NTM_SCANNER_FAIL_ERRORS", "AGENT_MAIL_TOKEN", "NTM_RECOVERY_STALE_HOURS"} {
+		if !seen[name] {
+			t.Errorf("EnvOverrides is missing %s", name)
+		}
+	}
+}
+
+func TestEnvOverrideStatusesRedactSecrets(t *testing.T) {
+	t.Setenv("AGENT_MAIL_TOKEN", "hunter2")
+	t.Setenv("NTM_CASS_TIMEOUT", "42")
+	t.Setenv("NTM_SCANNER_MIN_SEVERITY", "")
+	t.Setenv(ProfileEnvVar, "ci")
+
+	byName := make(map[string]EnvOverrideStatus)
+	for _, st := range EnvOverrideStatuses() {
+		byName[st.Name] = st
+	}
+	if st := byName["AGENT_MAIL_TOKEN"]; !st.Set || !st.Secret || st.Value != redactedConfigValue {
+		t.Errorf("AGENT_MAIL_TOKEN status = %+v, want set and redacted", st)
+	}
+	if st := byName["NTM_CASS_TIMEOUT"]; !st.Set || st.Value != "42" || st.Path != "cass.timeout" {
+		t.Errorf("NTM_CASS_TIMEOUT status = %+v", st)
+	}
+	if st := byName[ProfileEnvVar]; !st.Set || st.Value != "ci" || st.Path != "profiles.<name>" {
+		t.Errorf("%s status = %+v, want set to ci", ProfileEnvVar, st)
+	}
+	if st := byName["NTM_SCANNER_MIN_SEVERITY"]; st.Set || st.Path != "scanner.beads.min_severity" {
+		t.Errorf("NTM_SCANNER_MIN_SEVERITY status = %+v, want unset", st)
+	}
+
+	cfg, err := Load(filepath.Join(t.TempDir(), "missing.toml"))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.CASS.Timeout != 42 || cfg.AgentMail.Token != "hunter2" {
+		t.Errorf("Load did not apply overrides: timeout=%d", cfg.CASS.Timeout)
+	}
+}
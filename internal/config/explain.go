@@ -0,0 +1,478 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// KeyExplanation describes what a configuration key does, for
+// `ntm config explain`.
+type KeyExplanation struct {
+	Path        string      `json:"path"`
+	Description string      `json:"description"`
+	Type        string      `json:"type"`
+	Range       string      `json:"range,omitempty"`
+	Default     interface{} `json:"default"`
+	Validator   string      `json:"validator,omitempty"`
+}
+
+// keyDoc is the hand-written part of a KeyExplanation; the type and default
+// are derived from Default() through GetValue.
+type keyDoc struct {
+	description string
+	valid       string // accepted values or range, when constrained
+	validator   string // function that enforces valid, if any
+}
+
+// Explain returns the explanation for a leaf config path known to GetValue.
+func Explain(path string) (*KeyExplanation, error) {
+	doc, ok := keyDocs[path]
+	if !ok {
+		return nil, fmt.Errorf("no explanation for config path: %s", path)
+	}
+	def, err := GetValue(Default(), path)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyExplanation{
+		Path:        path,
+		Description: doc.description,
+		Type:        explainTypeName(def),
+		Range:       doc.valid,
+		Default:     def,
+		Validator:   doc.validator,
+	}, nil
+}
+
+// ExplainedPaths returns every path Explain documents, sorted.
+func ExplainedPaths() []string {
+	paths := make([]string, 0, len(keyDocs))
+	for path := range keyDocs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func explainTypeName(v interface{}) string {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return "unknown"
+	}
+	switch {
+	case t.Kind() == reflect.Struct:
+		return "table"
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Struct:
+		return "array of tables"
+	}
+	return t.String()
+}
+
+// keyDocs documents every leaf path GetValue resolves. TestKeyDocsCoverGetValue
+// fails when a path is added to GetValue without an entry here.
+var keyDocs = map[string]keyDoc{
+	"projects_base":       {"Base directory for project working directories", "absolute path (~ is expanded)", "Validate"},
+	"theme":               {"UI color theme", "mocha, macchiato, nord, latte, or auto", ""},
+	"help_verbosity":      {"How much detail help output shows", "minimal or full", "Validate"},
+	"palette_file":        {"Path to a command_palette.md that replaces the built-in palette", "", ""},
+	"suggestions_enabled": {"Show contextual CLI suggestions after commands", "", ""},
+
+	"agents.claude":        {"Launch command for Claude (cc) agents", "", ""},
+	"agents.codex":         {"Launch command for Codex (cod) agents", "", ""},
+	"agents.gemini":        {"Launch command for Gemini (gmi) agents", "", ""},
+	"agents.antigravity":   {"Launch command for Antigravity (agy) agents, the successor to the Gemini CLI", "", ""},
+	"agents.grok":          {"Launch command for xAI Grok Build agents", "", ""},
+	"agents.cursor":        {"Launch command for Cursor agents", "", ""},
+	"agents.windsurf":      {"Launch command for Windsurf agents", "", ""},
+	"agents.aider":         {"Launch command for Aider agents", "", ""},
+	"agents.oc":            {"Launch command for Opencode agents", "", ""},
+	"agents.plugins":       {"Custom agent launch commands keyed by agent type", "", ""},
+	"agents.default_count": {"Number of agents spawned when no counts are given", "", ""},
+
+	"palette":                 {"Command palette entries (key, label, prompt, category, tags)", "", ""},
+	"palette_state.pinned":    {"Palette commands pinned to the top of the palette", "", ""},
+	"palette_state.favorites": {"Palette commands marked as favorites", "", ""},
+
+	"tmux.default_panes":                       {"Default number of panes in a new session", ">= 1", "Validate"},
+	"tmux.palette_key":                         {"tmux key bound to open the command palette", "", ""},
+	"tmux.pane_init_delay_ms":                  {"Delay in milliseconds before sending keys to new panes", ">= 0", "Validate"},
+	"tmux.history_limit":                       {"Scrollback buffer lines kept per pane", ">= 0", "Validate"},
+	"tmux.activity_indicators.enabled":         {"Show per-pane activity indicators", "", ""},
+	"tmux.activity_indicators.active_seconds":  {"Seconds since last output for a pane to count as active", ">= 1; below stalled_seconds", "ValidateActivityIndicatorConfig"},
+	"tmux.activity_indicators.stalled_seconds": {"Seconds since last output for a pane to count as stalled", "greater than active_seconds", "ValidateActivityIndicatorConfig"},
+
+	"robot.verbosity":         {"Detail level of robot (--robot-*) output", "terse, default, or debug", ""},
+	"robot.output.format":     {"Encoding of robot output", "json, toon, or auto", "ValidateRobotOutputConfig"},
+	"robot.output.pretty":     {"Pretty-print robot output", "", ""},
+	"robot.output.timestamps": {"Include timestamps in robot output", "", ""},
+	"robot.output.compress":   {"Compress large robot outputs", "", ""},
+
+	"agent_mail.enabled":            {"Enable Agent Mail coordination", "", ""},
+	"agent_mail.url":                {"Agent Mail server endpoint", "", ""},
+	"agent_mail.token":              {"Bearer token for the Agent Mail server (always shown redacted)", "", ""},
+	"agent_mail.auto_register":      {"Register sessions as Agent Mail agents automatically", "", ""},
+	"agent_mail.program_name":       {"Program identifier used when registering", "", ""},
+	"agent_mail.supervisor_enabled": {"Let ntm start and manage the `am serve-http` daemon instead of using an externally owned one", "", ""},
+
+	"integrations.dcg.enabled":          {"Enable the dcg destructive-command guard integration", "", ""},
+	"integrations.dcg.binary_path":      {"Path to the dcg binary (PATH lookup when empty)", "existing file", "ValidateDCGConfig"},
+	"integrations.dcg.custom_blocklist": {"Legacy extra blocked commands; configure dcg packs directly instead", "", ""},
+	"integrations.dcg.custom_whitelist": {"Legacy extra allowed commands; configure dcg allowlists directly instead", "", ""},
+	"integrations.dcg.audit_log":        {"Legacy audit log path; configure dcg logging directly instead", "file in an existing, writable directory", "ValidateDCGConfig"},
+	"integrations.dcg.allow_override":   {"Allow blocked commands to be overridden", "", ""},
+
+	"integrations.caam.enabled":             {"Enable CAAM account management", "", ""},
+	"integrations.caam.binary_path":         {"Path to the caam binary (PATH lookup when empty)", "", ""},
+	"integrations.caam.auto_rotate":         {"Rotate accounts automatically on rate limit", "", ""},
+	"integrations.caam.providers":           {"Providers to manage (empty = all available)", "", ""},
+	"integrations.caam.rate_limit_patterns": {"Extra patterns that detect a rate limit", "", ""},
+	"integrations.caam.account_cooldown":    {"Seconds before retrying the same account", "", ""},
+	"integrations.caam.alert_threshold":     {"Usage percentage of the limit that raises an alert", "", ""},
+
+	"integrations.rch.enabled":            {"Enable RCH remote build offloading", "", ""},
+	"integrations.rch.binary_path":        {"Path to the rch binary (PATH lookup when empty)", "", ""},
+	"integrations.rch.min_build_time":     {"Builds expected to take fewer seconds than this run locally", "", ""},
+	"integrations.rch.intercept_patterns": {"Regex patterns of build commands to offload", "", ""},
+	"integrations.rch.fallback_local":     {"Build locally when RCH fails", "", ""},
+	"integrations.rch.show_location":      {"Show where each build ran", "", ""},
+	"integrations.rch.preferred_worker":   {"Preferred worker name, or auto", "", ""},
+	"integrations.rch.dcg_whitelist":      {"Legacy no-op; dcg handles RCH hook commands itself", "", ""},
+
+	"integrations.caut.enabled":            {"Enable caut usage tracking", "", ""},
+	"integrations.caut.binary_path":        {"Path to the caut binary (PATH lookup when empty)", "", ""},
+	"integrations.caut.poll_interval":      {"Seconds between usage polls", "", ""},
+	"integrations.caut.alert_threshold":    {"Quota percentage that raises an alert", "", ""},
+	"integrations.caut.providers":          {"Providers to track (empty = all available)", "", ""},
+	"integrations.caut.per_agent_tracking": {"Attribute usage to individual agents", "", ""},
+	"integrations.caut.currency":           {"Currency used to display costs", "", ""},
+
+	"integrations.process_triage.enabled":         {"Enable pt process triage", "", ""},
+	"integrations.process_triage.binary_path":     {"Path to the pt binary (PATH lookup when empty)", "existing file", "ValidateProcessTriageConfig"},
+	"integrations.process_triage.check_interval":  {"Seconds between process checks", ">= 5", "ValidateProcessTriageConfig"},
+	"integrations.process_triage.idle_threshold":  {"Idle seconds before a process counts as abandoned", ">= 30; at most stuck_threshold", "ValidateProcessTriageConfig"},
+	"integrations.process_triage.stuck_threshold": {"Stuck seconds before a process counts as a zombie", ">= idle_threshold", "ValidateProcessTriageConfig"},
+	"integrations.process_triage.on_stuck":        {"Action taken on a stuck process", "alert, kill, or ignore", "ValidateProcessTriageConfig"},
+	"integrations.process_triage.use_rano_data":   {"Use rano network data to improve classification", "", ""},
+
+	"integrations.rano.enabled":          {"Enable rano network monitoring", "", ""},
+	"integrations.rano.binary_path":      {"Path to the rano binary (PATH lookup when empty)", "existing file", "ValidateRanoConfig"},
+	"integrations.rano.poll_interval_ms": {"Milliseconds between network polls", ">= 100", "ValidateRanoConfig"},
+	"integrations.rano.providers":        {"Providers to track (empty = anthropic, openai, google)", "", ""},
+	"integrations.rano.persist_history":  {"Persist historical network data", "", ""},
+	"integrations.rano.history_days":     {"Days of network history to retain", ">= 0", "ValidateRanoConfig"},
+
+	"integrations.proxy.enabled":        {"Enable the rust_proxy integration", "", ""},
+	"integrations.proxy.bin_path":       {"Path or command name of the rust_proxy binary", "non-empty when enabled", "ValidateProxyConfig"},
+	"integrations.proxy.check_interval": {"How often to poll proxy health, as a duration (e.g. 30s)", "non-empty when enabled", "ValidateProxyConfig"},
+
+	"integrations.xf.enabled":      {"Enable the xf archive search integration", "", ""},
+	"integrations.xf.bin_path":     {"Path or command name of the xf binary", "non-empty when enabled", "ValidateXFConfig"},
+	"integrations.xf.archive_path": {"xf archive directory (~ is expanded)", "non-empty when enabled", "ValidateXFConfig"},
+	"integrations.xf.default_mode": {"Default xf search mode", "keyword, semantic, or hybrid", "ValidateXFConfig"},
+
+	"models.default_claude": {"Model used for Claude agents without an explicit model", "", ""},
+	"models.default_codex":  {"Model used for Codex agents without an explicit model", "", ""},
+	"models.default_gemini": {"Model used for Gemini agents without an explicit model", "", ""},
+	"models.default_grok":   {"Model used for Grok Build agents (empty lets the CLI choose)", "", ""},
+	"models.claude":         {"Claude model aliases", "", ""},
+	"models.codex":          {"Codex model aliases", "", ""},
+	"models.gemini":         {"Gemini model aliases", "", ""},
+	"models.grok":           {"Grok Build model aliases", "", ""},
+
+	"alerts.enabled":                   {"Enable alert generation", "", ""},
+	"alerts.agent_stuck_minutes":       {"Minutes without output before an agent is reported stuck", ">= 0", "Validate"},
+	"alerts.disk_low_threshold_gb":     {"Free disk space in GB below which an alert fires", ">= 0", "Validate"},
+	"alerts.mail_backlog_threshold":    {"Unread Agent Mail messages before an alert fires", ">= 0", "Validate"},
+	"alerts.bead_stale_hours":          {"Hours before an in-progress bead is reported stale", ">= 0", "Validate"},
+	"alerts.context_warning_threshold": {"Context usage percentage that triggers a warning", "0-100", "Validate"},
+	"alerts.resolved_prune_minutes":    {"Minutes resolved alerts are kept", ">= 0", "Validate"},
+
+	"checkpoints.enabled":                  {"Enable automatic checkpoints", "", ""},
+	"checkpoints.before_broadcast":         {"Checkpoint before sending to all agents", "", ""},
+	"checkpoints.before_add_agents":        {"Checkpoint when adding at least this many agents (0 = disabled)", ">= 0", "Validate"},
+	"checkpoints.max_auto_checkpoints":     {"Automatic checkpoints kept per session before the oldest is rotated out", ">= 0", "Validate"},
+	"checkpoints.scrollback_lines":         {"Scrollback lines captured per pane", ">= 0", "Validate"},
+	"checkpoints.include_git":              {"Capture git state in automatic checkpoints", "", ""},
+	"checkpoints.auto_checkpoint_on_spawn": {"Checkpoint when a session is spawned", "", ""},
+	"checkpoints.interval_minutes":         {"Minutes between periodic checkpoints (0 = disabled)", ">= 0", "Validate"},
+	"checkpoints.on_rotation":              {"Checkpoint before context rotation", "", ""},
+	"checkpoints.on_error":                 {"Checkpoint when an agent error is detected", "", ""},
+
+	"notifications.enabled":          {"Enable notifications", "", ""},
+	"notifications.events":           {"Event types that trigger notifications", "", ""},
+	"notifications.primary":          {"Primary notification channel", "desktop, webhook, shell, log, or filebox", ""},
+	"notifications.fallback":         {"Channel used when the primary channel fails", "desktop, webhook, shell, log, or filebox", ""},
+	"notifications.routing":          {"Ordered channel list per event type (empty = all enabled channels)", "", ""},
+	"notifications.desktop.enabled":  {"Send desktop notifications", "", ""},
+	"notifications.desktop.title":    {"Title prefix for desktop notifications", "", ""},
+	"notifications.webhook.enabled":  {"Send webhook notifications", "", ""},
+	"notifications.webhook.url":      {"Webhook endpoint", "", ""},
+	"notifications.webhook.template": {"Go template for the webhook payload", "", ""},
+	"notifications.webhook.method":   {"HTTP method for webhook requests", "", ""},
+	"notifications.webhook.headers":  {"Extra HTTP headers for webhook requests", "", ""},
+	"notifications.shell.enabled":    {"Run a shell command per notification", "", ""},
+	"notifications.shell.command":    {"Command to run for each notification", "", ""},
+	"notifications.shell.pass_json":  {"Pass the event as JSON on the command's stdin", "", ""},
+	"notifications.log.enabled":      {"Append notifications to a log file", "", ""},
+	"notifications.log.path":         {"Notification log file path", "", ""},
+	"notifications.filebox.enabled":  {"Write notifications to a file inbox for offline review", "", ""},
+	"notifications.filebox.path":     {"File inbox directory (default .ntm/human_inbox/)", "", ""},
+
+	"resilience.auto_restart":           {"Restart agents automatically when they crash", "", ""},
+	"resilience.max_restarts":           {"Restarts per agent before giving up", ">= 0", "Validate"},
+	"resilience.restart_delay_seconds":  {"Seconds to wait before restarting an agent", ">= 0", "Validate"},
+	"resilience.health_check_seconds":   {"Seconds between agent health checks", ">= 0", "Validate"},
+	"resilience.crash_threshold":        {"Consecutive failures before a restart (text-based detection)", ">= 0", "Validate"},
+	"resilience.notify_on_crash":        {"Notify when an agent crashes", "", ""},
+	"resilience.notify_on_max_restarts": {"Notify when an agent exceeds max_restarts", "", ""},
+	"resilience.rate_limit.detect":      {"Detect rate limits in agent output", "", ""},
+	"resilience.rate_limit.notify":      {"Notify when a rate limit is detected", "", ""},
+	"resilience.rate_limit.patterns":    {"Rate limit patterns added to the built-in ones", "", ""},
+
+	"scanner.ubs_path":           {"Path to the UBS executable (auto-detected when empty)", "", ""},
+	"scanner.defaults.timeout":   {"Scan timeout as a duration (e.g. 60s, 2m)", "duration > 0", "ValidateScannerConfig"},
+	"scanner.defaults.parallel":  {"Scan in parallel", "", ""},
+	"scanner.defaults.exclude":   {"Glob patterns of files and directories to skip", "", ""},
+	"scanner.defaults.languages": {"Languages to scan (empty = auto-detect)", "", ""},
+
+	"scanner.thresholds.pre_commit.block_critical":  {"Block pre-commit scans with any critical finding", "", ""},
+	"scanner.thresholds.pre_commit.fail_critical":   {"Exit non-zero from pre-commit scans with any critical finding", "", ""},
+	"scanner.thresholds.pre_commit.block_errors":    {"Block pre-commit scans with at least this many errors (0 = disabled)", ">= 0", "ValidateScannerConfig"},
+	"scanner.thresholds.pre_commit.fail_errors":     {"Fail pre-commit scans with more than this many errors (0 = any, -1 = disabled)", ">= -1", "ValidateScannerConfig"},
+	"scanner.thresholds.pre_commit.show_warnings":   {"Include warnings in pre-commit scan output", "", ""},
+	"scanner.thresholds.pre_commit.show_info":       {"Include info findings in pre-commit scan output", "", ""},
+	"scanner.thresholds.ci.block_critical":          {"Block CI scans with any critical finding", "", ""},
+	"scanner.thresholds.ci.fail_critical":           {"Exit non-zero from CI scans with any critical finding", "", ""},
+	"scanner.thresholds.ci.block_errors":            {"Block CI scans with at least this many errors (0 = disabled)", ">= 0", "ValidateScannerConfig"},
+	"scanner.thresholds.ci.fail_errors":             {"Fail CI scans with more than this many errors (0 = any, -1 = disabled)", ">= -1", "ValidateScannerConfig"},
+	"scanner.thresholds.ci.show_warnings":           {"Include warnings in CI scan output", "", ""},
+	"scanner.thresholds.ci.show_info":               {"Include info findings in CI scan output", "", ""},
+	"scanner.thresholds.dashboard.block_critical":   {"Block dashboard scans with any critical finding", "", ""},
+	"scanner.thresholds.dashboard.fail_critical":    {"Report dashboard scans with any critical finding as failed", "", ""},
+	"scanner.thresholds.dashboard.block_errors":     {"Block dashboard scans with at least this many errors (0 = disabled)", ">= 0", "ValidateScannerConfig"},
+	"scanner.thresholds.dashboard.fail_errors":      {"Fail dashboard scans with more than this many errors (0 = any, -1 = disabled)", ">= -1", "ValidateScannerConfig"},
+	"scanner.thresholds.dashboard.show_warnings":    {"Include warnings in the dashboard", "", ""},
+	"scanner.thresholds.dashboard.show_info":        {"Include info findings in the dashboard", "", ""},
+	"scanner.thresholds.interactive.block_critical": {"Block interactive scans with any critical finding", "", ""},
+	"scanner.thresholds.interactive.fail_critical":  {"Exit non-zero from interactive scans with any critical finding", "", ""},
+	"scanner.thresholds.interactive.block_errors":   {"Block interactive scans with at least this many errors (0 = disabled)", ">= 0", "ValidateScannerConfig"},
+	"scanner.thresholds.interactive.fail_errors":    {"Fail interactive scans with more than this many errors (0 = any, -1 = disabled)", ">= -1", "ValidateScannerConfig"},
+	"scanner.thresholds.interactive.show_warnings":  {"Include warnings in interactive scan output", "", ""},
+	"scanner.thresholds.interactive.show_info":      {"Include info findings in interactive scan output", "", ""},
+
+	"scanner.tools.enabled":                    {"UBS tools to enable explicitly", "disjoint from tools.disabled", "ValidateScannerConfig"},
+	"scanner.tools.disabled":                   {"UBS tools to disable explicitly", "disjoint from tools.enabled", "ValidateScannerConfig"},
+	"scanner.beads.auto_create":                {"Create beads from scanner findings automatically", "", ""},
+	"scanner.beads.min_severity":               {"Minimum severity that creates a bead", "critical, error, warning, or info", "ValidateScannerConfig"},
+	"scanner.beads.auto_close":                 {"Close beads once their findings are fixed", "", ""},
+	"scanner.beads.labels":                     {"Labels added to auto-created beads", "", ""},
+	"scanner.notifications.enabled":            {"Enable scanner notifications", "", ""},
+	"scanner.notifications.on_new_critical":    {"Notify when new critical findings appear", "", ""},
+	"scanner.notifications.summary_after_scan": {"Show a summary notification after each scan", "", ""},
+
+	"cass.enabled":                         {"Enable all CASS (coding agent session search) features", "", ""},
+	"cass.show_install_hints":              {"Show install hints when cass is missing", "", ""},
+	"cass.binary_path":                     {"Path to the cass binary (PATH lookup when empty)", "", ""},
+	"cass.timeout":                         {"Timeout for CASS operations in seconds", ">= 0", "Validate"},
+	"cass.context.enabled":                 {"Inject relevant past sessions when spawning", "", ""},
+	"cass.context.max_sessions":            {"Past sessions to inject at most", ">= 0", "Validate"},
+	"cass.context.lookback_days":           {"How many days back to search for context", ">= 0", "Validate"},
+	"cass.context.max_tokens":              {"Token budget for injected context", ">= 0", "Validate"},
+	"cass.context.min_relevance":           {"Minimum relevance score for injected results", "0.0-1.0", "Validate"},
+	"cass.context.skip_if_context_above":   {"Skip injection when context usage exceeds this percentage", "0-100", "Validate"},
+	"cass.context.prefer_same_project":     {"Prefer results from the same project", "", ""},
+	"cass.duplicates.enabled":              {"Check for duplicate work before sending", "", ""},
+	"cass.duplicates.similarity_threshold": {"Similarity needed to count as a duplicate (higher = stricter)", "0.0-1.0", "Validate"},
+	"cass.duplicates.lookback_days":        {"How many days back to check for duplicates", ">= 0", "Validate"},
+	"cass.duplicates.prompt_on_match":      {"Ask before sending when a duplicate is found", "", ""},
+	"cass.search.default_limit":            {"Default number of search results", ">= 0", "Validate"},
+	"cass.search.default_fields":           {"Default field selection for search results", "", ""},
+	"cass.search.include_meta":             {"Include metadata in search results", "", ""},
+	"cass.tui.show_activity_sparkline":     {"Show an activity sparkline in the status bar", "", ""},
+	"cass.tui.show_status_indicator":       {"Show the CASS health indicator", "", ""},
+
+	"accounts.state_file":           {"Path to the account state JSON file", "", ""},
+	"accounts.auto_rotate":          {"Rotate accounts automatically when a limit is detected", "", ""},
+	"accounts.reset_buffer_minutes": {"Minutes before a limit reset when an account counts as available again", ">= 0", "ValidateAccountsConfig"},
+	"accounts.claude":               {"Claude accounts", "each needs an email and priority >= 0", "ValidateAccountsConfig"},
+	"accounts.codex":                {"Codex accounts", "each needs an email and priority >= 0", "ValidateAccountsConfig"},
+	"accounts.gemini":               {"Gemini accounts", "each needs an email and priority >= 0", "ValidateAccountsConfig"},
+	"accounts.antigravity":          {"Antigravity (agy) accounts", "each needs an email and priority >= 0", "ValidateAccountsConfig"},
+
+	"rotation.enabled":                             {"Enable account rotation", "", ""},
+	"rotation.prefer_restart":                      {"Restart the agent rather than switch accounts in place", "", ""},
+	"rotation.auto_open_browser":                   {"Open the browser for re-authentication automatically", "", ""},
+	"rotation.auto_trigger":                        {"Notify when a rate limit is detected", "", ""},
+	"rotation.auto_initiate":                       {"Start rotation automatically without asking", "", ""},
+	"rotation.continuation_prompt":                 {"Prompt template sent to the agent after rotating", "", ""},
+	"rotation.accounts":                            {"Accounts available for rotation", "provider claude, codex, gemini, or antigravity; email required; priority >= 0", "ValidateRotationConfig"},
+	"rotation.thresholds.warning_percent":          {"Quota percentage that shows a warning", "0-100; at most critical_percent", "ValidateRotationConfig"},
+	"rotation.thresholds.critical_percent":         {"Quota percentage at which an account counts as limited", "0-100; at least warning_percent", "ValidateRotationConfig"},
+	"rotation.thresholds.restart_if_tokens_above":  {"Restart the agent once its tokens exceed this", ">= 0", "ValidateRotationConfig"},
+	"rotation.thresholds.restart_if_session_hours": {"Restart the agent after this many hours", ">= 0", "ValidateRotationConfig"},
+	"rotation.dashboard.show_quota_bars":           {"Show quota bars in the dashboard", "", ""},
+	"rotation.dashboard.show_account_status":       {"Show account status in the dashboard", "", ""},
+	"rotation.dashboard.show_reset_timers":         {"Show limit reset countdowns in the dashboard", "", ""},
+
+	"gemini_setup.auto_select_pro_model":        {"Select the Pro model after a Gemini agent spawns", "", ""},
+	"gemini_setup.ready_timeout_seconds":        {"Seconds to wait for the Gemini CLI to become ready", ">= 0", "ValidateGeminiSetupConfig"},
+	"gemini_setup.model_select_timeout_seconds": {"Seconds to wait for the model menu", ">= 0", "ValidateGeminiSetupConfig"},
+	"gemini_setup.verbose":                      {"Print debug output during Gemini setup", "", ""},
+
+	"context.ms_skills": {"Include Meta Skill suggestions in context packs", "", ""},
+
+	"context_rotation.enabled":                {"Enable context rotation", "", ""},
+	"context_rotation.warning_threshold":      {"Context usage fraction that triggers a warning", "0.0-1.0; below rotate_threshold", "ValidateContextRotationConfig"},
+	"context_rotation.rotate_threshold":       {"Context usage fraction that rotates the agent", "0.0-1.0; above warning_threshold", "ValidateContextRotationConfig"},
+	"context_rotation.summary_max_tokens":     {"Token limit for the handoff summary", "500-10000", "ValidateContextRotationConfig"},
+	"context_rotation.min_session_age_sec":    {"Agents younger than this many seconds are never rotated", ">= 0", "ValidateContextRotationConfig"},
+	"context_rotation.try_compact_first":      {"Try compacting before rotating", "", ""},
+	"context_rotation.require_confirm":        {"Ask before rotating", "", ""},
+	"context_rotation.confirm_timeout_sec":    {"Seconds to wait for confirmation (0 = never auto-rotate)", ">= 0", "ValidateContextRotationConfig"},
+	"context_rotation.default_confirm_action": {"Action taken when the confirmation times out", "rotate, ignore, or compact", "ValidateContextRotationConfig"},
+
+	"recovery.enabled":               {"Inject recovery context into restarted agents", "", ""},
+	"recovery.include_agent_mail":    {"Include recent Agent Mail messages", "", ""},
+	"recovery.include_cm_memories":   {"Include CM procedural memories", "", ""},
+	"recovery.include_beads_context": {"Include bead task status", "", ""},
+	"recovery.max_recovery_tokens":   {"Token cap for recovery context", ">= 0", "Validate"},
+	"recovery.auto_inject_on_spawn":  {"Send recovery context automatically on spawn", "", ""},
+	"recovery.stale_threshold_hours": {"Ignore context older than this many hours", ">= 0", "Validate"},
+	"recovery.max_cm_rules":          {"CM rules included at most", ">= 0", "Validate"},
+	"recovery.max_cm_snippets":       {"CM history snippets included at most", ">= 0", "Validate"},
+
+	"cleanup.auto_clean_on_startup": {"Remove stale temp files on startup", "", ""},
+	"cleanup.max_age_hours":         {"Hours before a temp file counts as stale", ">= 0", "Validate"},
+	"cleanup.verbose":               {"Log cleanup operations", "", ""},
+
+	"file_reservation.enabled":                   {"Enable automatic file reservations", "", ""},
+	"file_reservation.auto_reserve":              {"Reserve files when an agent is seen editing them", "", ""},
+	"file_reservation.auto_release_idle_minutes": {"Release reservations idle this many minutes (0 = never)", "0 or >= 1", "ValidateFileReservationConfig"},
+	"file_reservation.notify_on_conflict":        {"Notify when a reservation conflict is detected", "", ""},
+	"file_reservation.extend_on_activity":        {"Extend reservations while the agent keeps editing", "", ""},
+	"file_reservation.default_ttl_minutes":       {"Default reservation lifetime in minutes", ">= 1", "ValidateFileReservationConfig"},
+	"file_reservation.poll_interval_seconds":     {"Seconds between scans of pane output for edits", ">= 1", "ValidateFileReservationConfig"},
+	"file_reservation.capture_lines":             {"Pane output lines scanned for file edits", ">= 10", "ValidateFileReservationConfig"},
+	"file_reservation.debug":                     {"Log reservation decisions", "", ""},
+
+	"memory.enabled":               {"Enable CM memory integration", "", ""},
+	"memory.include_in_recovery":   {"Include memory context in session recovery", "", ""},
+	"memory.max_rules":             {"Rules injected at most", ">= 0", "ValidateMemoryConfig"},
+	"memory.include_anti_patterns": {"Include anti-patterns in injected context", "", ""},
+	"memory.include_history":       {"Include historical snippets", "", ""},
+	"memory.query_timeout_seconds": {"Timeout for cm queries in seconds", ">= 1", "ValidateMemoryConfig"},
+
+	"assign.strategy":              {"Default bead assignment strategy", "balanced, speed, quality, dependency, round-robin, or load", "Validate"},
+	"assign.prompt_template":       {"Inline default bulk-assign dispatch prompt (empty = built-in)", "", ""},
+	"assign.prompt_template_file":  {"File holding the default bulk-assign dispatch prompt; wins over prompt_template", "", ""},
+	"assign.operator_gated_labels": {"Extra bead labels that keep beads out of automated assignment", "", ""},
+
+	"ensemble.default_ensemble":                {"Ensemble preset used when none is named", "", ""},
+	"ensemble.agent_mix":                       {"Default agent mix for ensembles (e.g. cc=3,cod=2,gmi=1)", "", ""},
+	"ensemble.assignment":                      {"How modes are assigned to agents", "round-robin, affinity, category, or explicit", "ValidateEnsembleConfig"},
+	"ensemble.mode_tier_default":               {"Highest mode tier allowed by default", "core, advanced, or experimental", "ValidateEnsembleConfig"},
+	"ensemble.allow_advanced":                  {"Allow advanced-tier modes without an explicit flag", "", ""},
+	"ensemble.webhook_url":                     {"URL that receives a JSON POST on each mode status change", "empty or an http(s) URL", "ValidateEnsembleConfig"},
+	"ensemble.synthesis.strategy":              {"Strategy used to combine mode outputs", "a known synthesis strategy", "validateSynthesisStrategy"},
+	"ensemble.synthesis.min_confidence":        {"Minimum confidence for a finding to be included", "0.0-1.0", "ValidateEnsembleConfig"},
+	"ensemble.synthesis.max_findings":          {"Findings included in the synthesis at most (0 = no limit)", ">= 0", "ValidateEnsembleConfig"},
+	"ensemble.synthesis.include_raw_outputs":   {"Include raw mode outputs in the synthesis", "", ""},
+	"ensemble.synthesis.conflict_resolution":   {"How disagreements between modes are handled", "", ""},
+	"ensemble.cache.enabled":                   {"Cache context packs", "", ""},
+	"ensemble.cache.ttl_minutes":               {"Minutes a cached context pack stays valid", ">= 0", "ValidateEnsembleConfig"},
+	"ensemble.cache.cache_dir":                 {"Context pack cache directory (empty = default)", "", ""},
+	"ensemble.cache.max_entries":               {"Cached context packs kept at most", ">= 0", "ValidateEnsembleConfig"},
+	"ensemble.cache.share_across_modes":        {"Share cached context packs between modes of one ensemble", "", ""},
+	"ensemble.budget.per_agent":                {"Token budget per mode", ">= 0; at most budget.total", "ValidateEnsembleConfig"},
+	"ensemble.budget.total":                    {"Total token budget across all modes", ">= 0", "ValidateEnsembleConfig"},
+	"ensemble.budget.synthesis":                {"Tokens reserved for synthesis", ">= 0", "ValidateEnsembleConfig"},
+	"ensemble.budget.context_pack":             {"Tokens reserved for the shared context pack", ">= 0", "ValidateEnsembleConfig"},
+	"ensemble.early_stop.enabled":              {"Stop an ensemble early once new modes add little", "", ""},
+	"ensemble.early_stop.min_agents":           {"Modes that must finish before early stop is considered", ">= 0", "ValidateEnsembleConfig"},
+	"ensemble.early_stop.findings_threshold":   {"New-findings rate below which the ensemble may stop", "0.0-1.0", "ValidateEnsembleConfig"},
+	"ensemble.early_stop.similarity_threshold": {"Output similarity above which the ensemble may stop", "0.0-1.0", "ValidateEnsembleConfig"},
+	"ensemble.early_stop.window_size":          {"Recent outputs compared for early stop", ">= 0", "ValidateEnsembleConfig"},
+
+	"swarm.enabled":              {"Enable swarm orchestration", "", ""},
+	"swarm.default_scan_dir":     {"Base directory scanned for projects", "", ""},
+	"swarm.tier1_threshold":      {"Projects with at least this many beads get the tier 1 allocation", "> tier2_threshold", "ValidateSwarmConfig"},
+	"swarm.tier2_threshold":      {"Projects with at least this many beads get the tier 2 allocation", "> 0", "ValidateSwarmConfig"},
+	"swarm.tier1_allocation":     {"Agents per tier 1 project as {cc, cod, gmi}", "counts >= 0; at least one agent", "ValidateSwarmConfig"},
+	"swarm.tier2_allocation":     {"Agents per tier 2 project as {cc, cod, gmi}", "counts >= 0; at least one agent", "ValidateSwarmConfig"},
+	"swarm.tier3_allocation":     {"Agents per tier 3 project as {cc, cod, gmi}", "counts >= 0; at least one agent", "ValidateSwarmConfig"},
+	"swarm.sessions_per_type":    {"Sessions created per agent type", ">= 1", "ValidateSwarmConfig"},
+	"swarm.panes_per_session":    {"Panes per session (0 = derived from the allocation)", "", ""},
+	"swarm.stagger_delay_ms":     {"Milliseconds between agent launches", ">= 0", "ValidateSwarmConfig"},
+	"swarm.auto_rotate_accounts": {"Rotate accounts when swarm agents hit limits", "", ""},
+	"swarm.limit_patterns":       {"Limit detection patterns per agent type", "", ""},
+	"swarm.marching_orders":      {"Marching orders templates sent to swarm agents (default and review)", "", ""},
+
+	"spawn_pacing.enabled":                            {"Rate-limit and schedule agent spawns", "", ""},
+	"spawn_pacing.max_concurrent_spawns":              {"Spawns in flight at once", ">= 1", "ValidateSpawnPacingConfig"},
+	"spawn_pacing.max_spawns_per_sec":                 {"Global spawn rate across all sessions", "> 0", "ValidateSpawnPacingConfig"},
+	"spawn_pacing.burst_size":                         {"Spawns allowed in a burst before rate limiting", ">= 1", "ValidateSpawnPacingConfig"},
+	"spawn_pacing.default_retries":                    {"Retries for a failed spawn", ">= 0", "ValidateSpawnPacingConfig"},
+	"spawn_pacing.retry_delay_ms":                     {"Milliseconds between spawn retries", ">= 0", "ValidateSpawnPacingConfig"},
+	"spawn_pacing.backpressure_threshold":             {"Queued spawns that trigger backpressure warnings", ">= 1", "ValidateSpawnPacingConfig"},
+	"spawn_pacing.agent_caps.claude_max_concurrent":   {"Concurrent Claude spawns (0 = no per-agent cap)", ">= 0", "validateAgentPacingConfig"},
+	"spawn_pacing.agent_caps.claude_rate_per_sec":     {"Claude spawn rate limit", ">= 0", "validateAgentPacingConfig"},
+	"spawn_pacing.agent_caps.claude_ramp_up_delay_ms": {"Claude warm-up delay before the full rate applies", ">= 0", "validateAgentPacingConfig"},
+	"spawn_pacing.agent_caps.codex_max_concurrent":    {"Concurrent Codex spawns (0 = no per-agent cap)", ">= 0", "validateAgentPacingConfig"},
+	"spawn_pacing.agent_caps.codex_rate_per_sec":      {"Codex spawn rate limit", ">= 0", "validateAgentPacingConfig"},
+	"spawn_pacing.agent_caps.codex_ramp_up_delay_ms":  {"Codex warm-up delay before the full rate applies", ">= 0", "validateAgentPacingConfig"},
+	"spawn_pacing.agent_caps.gemini_max_concurrent":   {"Concurrent Gemini spawns (0 = no per-agent cap)", ">= 0", "validateAgentPacingConfig"},
+	"spawn_pacing.agent_caps.gemini_rate_per_sec":     {"Gemini spawn rate limit", ">= 0", "validateAgentPacingConfig"},
+	"spawn_pacing.agent_caps.gemini_ramp_up_delay_ms": {"Gemini warm-up delay before the full rate applies", ">= 0", "validateAgentPacingConfig"},
+	"spawn_pacing.agent_caps.cooldown_on_failure_ms":  {"Per-agent cooldown after a failed spawn", ">= 0", "validateAgentPacingConfig"},
+	"spawn_pacing.agent_caps.recovery_successes":      {"Successful spawns needed to restore full capacity after a cooldown", ">= 0", "validateAgentPacingConfig"},
+	"spawn_pacing.headroom.enabled":                   {"Block spawns when the machine is short on resources", "", ""},
+	"spawn_pacing.headroom.min_free_mb":               {"Free memory in MB required to spawn", ">= 0", "validateHeadroomPacingConfig"},
+	"spawn_pacing.headroom.min_free_disk_mb":          {"Free disk space in MB required to spawn", ">= 0", "validateHeadroomPacingConfig"},
+	"spawn_pacing.headroom.max_load_average":          {"1-minute load average above which spawns wait", ">= 0", "validateHeadroomPacingConfig"},
+	"spawn_pacing.headroom.max_open_files":            {"Open file descriptors above which spawns wait", ">= 0", "validateHeadroomPacingConfig"},
+	"spawn_pacing.headroom.check_interval_ms":         {"Milliseconds between resource checks", ">= 100", "validateHeadroomPacingConfig"},
+	"spawn_pacing.backoff.initial_delay_ms":           {"First backoff delay after a failed spawn", ">= 0", "validateBackoffPacingConfig"},
+	"spawn_pacing.backoff.max_delay_ms":               {"Longest backoff delay", ">= initial_delay_ms", "validateBackoffPacingConfig"},
+	"spawn_pacing.backoff.multiplier":                 {"Factor applied to the delay after each failure", ">= 1.0", "validateBackoffPacingConfig"},
+	"spawn_pacing.backoff.max_consecutive_failures":   {"Consecutive failures that pause all spawning", ">= 1", "validateBackoffPacingConfig"},
+	"spawn_pacing.backoff.global_pause_duration_ms":   {"How long all spawning pauses after max_consecutive_failures", ">= 0", "validateBackoffPacingConfig"},
+
+	"safety.profile":    {"Safety preset that sets preflight, redaction, and privacy defaults", "standard, safe, or paranoid", "ValidateSafetyConfig"},
+	"preflight.enabled": {"Lint prompts before commands send them", "", ""},
+	"preflight.strict":  {"Treat preflight warnings as errors", "", ""},
+
+	"redaction.mode":                {"What happens when a secret is found in outgoing content", "off, warn, redact, or block", "ValidateRedactionConfig"},
+	"redaction.allowlist":           {"Regex patterns never flagged as secrets", "", ""},
+	"redaction.extra_patterns":      {"Extra secret patterns keyed by category name", "", ""},
+	"redaction.disabled_categories": {"Secret categories skipped during scanning", "", ""},
+
+	"privacy.enabled":                    {"Start new sessions in privacy mode", "", ""},
+	"privacy.disable_prompt_history":     {"Do not store prompt history in privacy mode", "", ""},
+	"privacy.disable_event_logs":         {"Do not write event logs in privacy mode", "", ""},
+	"privacy.disable_checkpoints":        {"Do not create automatic checkpoints in privacy mode", "", ""},
+	"privacy.disable_scrollback_capture": {"Keep scrollback out of support bundles in privacy mode", "", ""},
+	"privacy.require_explicit_persist":   {"Require --allow-persist for anything written to disk in privacy mode", "", ""},
+
+	"encryption.enabled":       {"Encrypt prompt history, event logs, and checkpoint exports at rest", "", ""},
+	"encryption.key_source":    {"Where the encryption key comes from", "env, file, or command (required when enabled)", "ValidateEncryptionConfig"},
+	"encryption.key_env":       {"Environment variable holding the key (key_source = env)", "", ""},
+	"encryption.key_file":      {"File holding the key (key_source = file)", "", ""},
+	"encryption.key_command":   {"Command that prints the key (key_source = command)", "", ""},
+	"encryption.key_format":    {"Encoding of the key material", "hex or base64", "ValidateEncryptionConfig"},
+	"encryption.active_key_id": {"Keyring entry used for new writes", "an ID present in keyring", "ValidateEncryptionConfig"},
+	"encryption.keyring":       {"Encoded keys by ID, for key rotation", "", ""},
+
+	"send.base_prompt":      {"Text prepended to every sent prompt", "", ""},
+	"send.base_prompt_file": {"File whose contents are prepended to every sent prompt", "", ""},
+	"send.pre_send_hook":    {"Command that rewrites each prompt (stdin to stdout) before redaction", "", ""},
+
+	"prompts.cc_default":       {"Default prompt for Claude agents", "", ""},
+	"prompts.cc_default_file":  {"File holding the default prompt for Claude agents", "", ""},
+	"prompts.cod_default":      {"Default prompt for Codex agents", "", ""},
+	"prompts.cod_default_file": {"File holding the default prompt for Codex agents", "", ""},
+	"prompts.gmi_default":      {"Default prompt for Gemini agents", "", ""},
+	"prompts.gmi_default_file": {"File holding the default prompt for Gemini agents", "", ""},
+	"prompts.agy_default":      {"Default prompt for Antigravity agents", "", ""},
+	"prompts.agy_default_file": {"File holding the default prompt for Antigravity agents", "", ""},
+}
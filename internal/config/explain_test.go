@@ -0,0 +1,95 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// getValueLeafPaths returns every leaf path GetValue resolves.
+func getValueLeafPaths(t *testing.T) []string {
+	t.Helper()
+	return ValuePaths()
+}
+
+func TestKeyDocsCoverGetValue(t *testing.T) {
+	t.Parallel()
+
+	leaves := getValueLeafPaths(t)
+	if len(leaves) < 300 {
+		t.Fatalf("found only %d GetValue paths; walker is broken", len(leaves))
+	}
+	known := make(map[string]bool, len(leaves))
+	for _, path := range leaves {
+		known[path] = true
+		if _, ok := keyDocs[path]; !ok {
+			t.Errorf("GetValue path %q has no keyDocs entry", path)
+		}
+	}
+	for path, doc := range keyDocs {
+		if !known[path] {
+			t.Errorf("keyDocs entry %q is not a GetValue leaf path", path)
+		}
+		if doc.description == "" {
+			t.Errorf("keyDocs entry %q has no description", path)
+		}
+		if doc.validator != "" && doc.valid == "" {
+			t.Errorf("keyDocs entry %q names validator %s but no valid range", path, doc.validator)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	exp, err := Explain("context_rotation.warning_threshold")
+	if err != nil {
+		t.Fatalf("Explain: %v", err)
+	}
+	if exp.Type != "float64" || exp.Default != 0.80 || exp.Validator != "ValidateContextRotationConfig" {
+		t.Errorf("unexpected explanation: %+v", exp)
+	}
+	if !strings.Contains(exp.Range, "0.0-1.0") {
+		t.Errorf("Range = %q, want 0.0-1.0", exp.Range)
+	}
+
+	exp, err = Explain("swarm.tier1_allocation")
+	if err != nil {
+		t.Fatalf("Explain: %v", err)
+	}
+	if exp.Type != "table" {
+		t.Errorf("Type = %q, want table", exp.Type)
+	}
+
+	if _, err := Explain("tmux"); err == nil {
+		t.Error("expected error for a section path")
+	}
+	if _, err := Explain("no.such.key"); err == nil {
+		t.Error("expected error for an unknown path")
+	}
+}
+
+func TestSetValueRoundTripsGetValueLeaves(t *testing.T) {
+	t.Parallel()
+
+	for _, path := range getValueLeafPaths(t) {
+		cfg := Default()
+		before, err := GetValue(cfg, path)
+		if err != nil {
+			t.Fatalf("GetValue(%q): %v", path, err)
+		}
+		raw := fmt.Sprint(before)
+		switch reflect.ValueOf(before).Kind() {
+		case reflect.Slice, reflect.Map, reflect.Struct, reflect.Ptr, reflect.Invalid:
+			continue
+		}
+		if err := SetSecretValue(cfg, path, raw); err != nil {
+			t.Errorf("SetSecretValue(%q, %q): %v", path, raw, err)
+			continue
+		}
+		if after, _ := GetValue(cfg, path); !reflect.DeepEqual(after, before) {
+			t.Errorf("%s: round trip changed %v to %v", path, before, after)
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// LintFix is one automatic correction proposed by LintConfig. From and To are
+// rendered TOML literals so they can be shown to the user and written back
+// verbatim.
+type LintFix struct {
+	Key    string `json:"key"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Reason string `json:"reason"`
+}
+
+// lintRange is a numeric key whose out-of-range values are clamped to the
+// nearest bound. float marks keys decoded into float64 fields.
+type lintRange struct {
+	key   string
+	min   float64
+	max   float64
+	float bool
+}
+
+// lintRanges mirrors the range checks in Validate and the section validators
+// it calls. Keep the two in sync.
+var lintRanges = []lintRange{
+	{key: "context_rotation.warning_threshold", min: 0, max: 1, float: true},
+	{key: "context_rotation.rotate_threshold", min: 0, max: 1, float: true},
+	{key: "context_rotation.summary_max_tokens", min: 500, max: 10000},
+	{key: "context_rotation.min_session_age_sec", min: 0, max: math.Inf(1)},
+	{key: "context_rotation.confirm_timeout_sec", min: 0, max: math.Inf(1)},
+	{key: "recovery.max_recovery_tokens", min: 0, max: math.Inf(1)},
+	{key: "recovery.stale_threshold_hours", min: 0, max: math.Inf(1)},
+	{key: "recovery.max_cm_rules", min: 0, max: math.Inf(1)},
+	{key: "recovery.max_cm_snippets", min: 0, max: math.Inf(1)},
+	{key: "cleanup.max_age_hours", min: 0, max: math.Inf(1)},
+	{key: "alerts.agent_stuck_minutes", min: 0, max: math.Inf(1)},
+	{key: "alerts.disk_low_threshold_gb", min: 0, max: math.Inf(1), float: true},
+	{key: "alerts.mail_backlog_threshold", min: 0, max: math.Inf(1)},
+	{key: "alerts.bead_stale_hours", min: 0, max: math.Inf(1)},
+	{key: "alerts.context_warning_threshold", min: 0, max: 100, float: true},
+	{key: "alerts.resolved_prune_minutes", min: 0, max: math.Inf(1)},
+	{key: "checkpoints.max_auto_checkpoints", min: 0, max: math.Inf(1)},
+	{key: "checkpoints.before_add_agents", min: 0, max: math.Inf(1)},
+	{key: "checkpoints.scrollback_lines", min: 0, max: math.Inf(1)},
+	{key: "checkpoints.interval_minutes", min: 0, max: math.Inf(1)},
+	{key: "resilience.max_restarts", min: 0, max: math.Inf(1)},
+	{key: "resilience.restart_delay_seconds", min: 0, max: math.Inf(1)},
+	{key: "resilience.health_check_seconds", min: 0, max: math.Inf(1)},
+	{key: "resilience.crash_threshold", min: 0, max: math.Inf(1)},
+	{key: "cass.timeout", min: 0, max: math.Inf(1)},
+	{key: "cass.context.min_relevance", min: 0, max: 1, float: true},
+	{key: "cass.context.skip_if_context_above", min: 0, max: 100, float: true},
+	{key: "cass.context.max_sessions", min: 0, max: math.Inf(1)},
+	{key: "cass.context.max_tokens", min: 0, max: math.Inf(1)},
+	{key: "cass.context.lookback_days", min: 0, max: math.Inf(1)},
+	{key: "cass.duplicates.similarity_threshold", min: 0, max: 1, float: true},
+	{key: "cass.duplicates.lookback_days", min: 0, max: math.Inf(1)},
+	{key: "cass.search.default_limit", min: 0, max: math.Inf(1)},
+	{key: "tmux.default_panes", min: 1, max: math.Inf(1)},
+	{key: "tmux.pane_init_delay_ms", min: 0, max: math.Inf(1)},
+	{key: "tmux.history_limit", min: 0, max: math.Inf(1)},
+}
+
+// lintEnums lists string keys restricted to a fixed set of lowercase values.
+// A value that matches only after trimming and lowercasing is normalized; any
+// other value is ambiguous and refused.
+var lintEnums = []struct {
+	key     string
+	allowed []string
+}{
+	{key: "help_verbosity", allowed: []string{"minimal", "full"}},
+	{key: "safety.profile", allowed: []string{SafetyProfileStandard, SafetyProfileSafe, SafetyProfileParanoid}},
+	{key: "context_rotation.default_confirm_action", allowed: []string{"rotate", "ignore", "compact"}},
+	{key: "assign.strategy", allowed: ValidAssignStrategies},
+}
+
+// lintRequiredStrings are keys that must not be blank. Omitted keys already
+// fall back to Default() at load time, so only explicit blank assignments are
+// filled in.
+var lintRequiredStrings = []string{
+	"agents.claude",
+	"agents.codex",
+	"agents.gemini",
+}
+
+// LintConfigFile reads the config at path and returns the fixes LintConfig
+// proposes for it.
+func LintConfigFile(path string) ([]LintFix, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return LintConfig(string(data))
+}
+
+// LintConfig inspects raw config TOML for common mistakes that have exactly
+// one sensible correction: out-of-range numbers are clamped, enum casing is
+// normalized, and blank required strings are filled from Default(). Values
+// that cannot be corrected unambiguously are reported as an error and no
+// fixes are returned.
+func LintConfig(contents string) ([]LintFix, error) {
+	var raw map[string]interface{}
+	if _, err := toml.Decode(contents, &raw); err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	var fixes []LintFix
+	var ambiguous []error
+
+	for _, r := range lintRanges {
+		value, ok := lookupLintValue(raw, r.key)
+		if !ok {
+			continue
+		}
+		var n float64
+		switch v := value.(type) {
+		case int64:
+			n = float64(v)
+		case float64:
+			n = v
+		default:
+			continue
+		}
+		clamped := math.Min(math.Max(n, r.min), r.max)
+		if clamped == n {
+			continue
+		}
+		fixes = append(fixes, LintFix{
+			Key:    r.key,
+			From:   renderLintValue(value),
+			To:     renderLintNumber(clamped, r.float),
+			Reason: "clamped to " + r.describe(),
+		})
+	}
+
+	for _, e := range lintEnums {
+		value, ok := lookupLintValue(raw, e.key)
+		if !ok {
+			continue
+		}
+		s, ok := value.(string)
+		if !ok || s == "" {
+			continue
+		}
+		normalized := strings.ToLower(strings.TrimSpace(s))
+		if !slices.Contains(e.allowed, normalized) {
+			ambiguous = append(ambiguous, fmt.Errorf("%s: cannot fix %q automatically: must be one of %s",
+				e.key, s, strings.Join(e.allowed, ", ")))
+			continue
+		}
+		if normalized == s {
+			continue
+		}
+		fixes = append(fixes, LintFix{
+			Key:    e.key,
+			From:   strconv.Quote(s),
+			To:     strconv.Quote(normalized),
+			Reason: "normalized enum value",
+		})
+	}
+
+	defaults := Default()
+	for _, key := range lintRequiredStrings {
+		value, ok := lookupLintValue(raw, key)
+		if !ok {
+			continue
+		}
+		s, ok := value.(string)
+		if !ok || strings.TrimSpace(s) != "" {
+			continue
+		}
+		def, err := GetValue(defaults, key)
+		if err != nil {
+			return nil, err
+		}
+		fixes = append(fixes, LintFix{
+			Key:    key,
+			From:   strconv.Quote(s),
+			To:     strconv.Quote(fmt.Sprint(def)),
+			Reason: "filled from default",
+		})
+	}
+
+	if len(ambiguous) > 0 {
+		return nil, errors.Join(ambiguous...)
+	}
+	return fixes, nil
+}
+
+// ApplyLintFixes writes fixes into the config at path, touching only the
+// affected assignments so comments and unrelated keys survive.
+func ApplyLintFixes(path string, fixes []LintFix) error {
+	bySection := make(map[string][][2]string)
+	for _, fix := range fixes {
+		section, key := "", fix.Key
+		if i := strings.LastIndex(fix.Key, "."); i >= 0 {
+			section, key = fix.Key[:i], fix.Key[i+1:]
+		}
+		bySection[section] = append(bySection[section], [2]string{key, fix.To})
+	}
+
+	sections := make([]string, 0, len(bySection))
+	for section := range bySection {
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+
+	for _, section := range sections {
+		if err := PersistTOMLKeys(path, section, bySection[section]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r lintRange) describe() string {
+	if math.IsInf(r.max, 1) {
+		return ">= " + renderLintNumber(r.min, r.float)
+	}
+	return renderLintNumber(r.min, r.float) + "-" + renderLintNumber(r.max, r.float)
+}
+
+func lookupLintValue(raw map[string]interface{}, key string) (interface{}, bool) {
+	parts := strings.Split(key, ".")
+	current := raw
+	for i, part := range parts {
+		value, ok := current[part]
+		if !ok {
+			return nil, false
+		}
+		if i == len(parts)-1 {
+			return value, true
+		}
+		current, ok = value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+func renderLintValue(value interface{}) string {
+	switch v := value.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return renderLintNumber(v, true)
+	case string:
+		return strconv.Quote(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func renderLintNumber(n float64, float bool) string {
+	if !float {
+		return strconv.FormatInt(int64(n), 10)
+	}
+	s := strconv.FormatFloat(n, 'f', -1, 64)
+	if !strings.ContainsAny(s, ".eE") {
+		s += ".0"
+	}
+	return s
+}
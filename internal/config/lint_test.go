@@ -0,0 +1,144 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLintConfigProposesFixes(t *testing.T) {
+	contents := `help_verbosity = "FULL"
+
+[agents]
+claude = ""
+
+[context_rotation]
+warning_threshold = 2.0
+summary_max_tokens = 20
+
+[tmux]
+default_panes = 0
+`
+	fixes, err := LintConfig(contents)
+	if err != nil {
+		t.Fatalf("LintConfig() error = %v", err)
+	}
+
+	got := make(map[string]LintFix, len(fixes))
+	for _, f := range fixes {
+		got[f.Key] = f
+	}
+	want := map[string]string{
+		"help_verbosity":                      `"full"`,
+		"agents.claude":                       `"` + DefaultAgentTemplates().Claude + `"`,
+		"context_rotation.warning_threshold":  "1.0",
+		"context_rotation.summary_max_tokens": "500",
+		"tmux.default_panes":                  "1",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("fixes = %+v, want %d fixes", fixes, len(want))
+	}
+	for key, to := range want {
+		if got[key].To != to {
+			t.Errorf("%s fix To = %q, want %q", key, got[key].To, to)
+		}
+	}
+	if from := got["context_rotation.warning_threshold"].From; from != "2.0" {
+		t.Errorf("warning_threshold From = %q, want 2.0", from)
+	}
+}
+
+func TestLintConfigRefusesAmbiguousEnum(t *testing.T) {
+	contents := `help_verbosity = "Full"
+
+[safety]
+profile = "strict"
+`
+	fixes, err := LintConfig(contents)
+	if err == nil {
+		t.Fatalf("LintConfig() = %+v, want error for unknown safety profile", fixes)
+	}
+	if !strings.Contains(err.Error(), "safety.profile") {
+		t.Fatalf("error = %v, want it to name safety.profile", err)
+	}
+	if fixes != nil {
+		t.Fatalf("fixes = %+v, want none when a value is ambiguous", fixes)
+	}
+}
+
+func TestLintConfigCleanConfigHasNoFixes(t *testing.T) {
+	var b strings.Builder
+	if err := Print(Default(), &b); err != nil {
+		t.Fatalf("Print() error = %v", err)
+	}
+	fixes, err := LintConfig(b.String())
+	if err != nil {
+		t.Fatalf("LintConfig() error = %v", err)
+	}
+	if len(fixes) != 0 {
+		t.Fatalf("fixes = %+v, want none for the default config", fixes)
+	}
+}
+
+func TestLintRulesReferenceKnownKeys(t *testing.T) {
+	cfg := Default()
+	keys := append([]string(nil), lintRequiredStrings...)
+	for _, r := range lintRanges {
+		keys = append(keys, r.key)
+	}
+	for _, e := range lintEnums {
+		keys = append(keys, e.key)
+	}
+	for _, key := range keys {
+		if _, err := GetValue(cfg, key); err != nil {
+			t.Errorf("lint rule key %q is not a config key: %v", key, err)
+		}
+	}
+}
+
+func TestApplyLintFixesPreservesComments(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	original := `# my config
+help_verbosity = "FULL" # keep this note
+
+[context_rotation]
+# tuned by hand
+warning_threshold = 2.0
+`
+	if err := os.WriteFile(path, []byte(original), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fixes, err := LintConfigFile(path)
+	if err != nil {
+		t.Fatalf("LintConfigFile() error = %v", err)
+	}
+	if err := ApplyLintFixes(path, fixes); err != nil {
+		t.Fatalf("ApplyLintFixes() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(data)
+	for _, want := range []string{
+		"# my config",
+		`help_verbosity = "full" # keep this note`,
+		"# tuned by hand",
+		"warning_threshold = 1.0",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("rewritten config missing %q:\n%s", want, got)
+		}
+	}
+
+	remaining, err := LintConfigFile(path)
+	if err != nil {
+		t.Fatalf("LintConfigFile() after fix error = %v", err)
+	}
+	if len(remaining) != 0 {
+		t.Fatalf("remaining fixes = %+v, want none", remaining)
+	}
+}
@@ -18,15 +18,23 @@ import (
 //
 // A genuinely broken global config still returns an error so the caller can
 // surface the real cause.
+//
+// The profile named by NTM_PROFILE, if any, is applied last (see ApplyProfile).
 func LoadMerged(cwd, globalPath string) (*Config, error) {
-	return loadMerged(cwd, globalPath, false)
+	return loadMerged(cwd, globalPath, os.Getenv(ProfileEnvVar), false)
+}
+
+// LoadMergedProfile is LoadMerged with an explicit profile, which replaces
+// NTM_PROFILE. An empty profile applies none.
+func LoadMergedProfile(cwd, globalPath, profile string) (*Config, error) {
+	return loadMerged(cwd, globalPath, profile, false)
 }
 
 // LoadMergedStrict loads global and project configuration like LoadMerged, but
 // treats an invalid project overlay as a fatal error. Safety-sensitive callers
 // use this variant when silently dropping project policy would fail open.
 func LoadMergedStrict(cwd, globalPath string) (*Config, error) {
-	return loadMerged(cwd, globalPath, true)
+	return loadMerged(cwd, globalPath, os.Getenv(ProfileEnvVar), true)
 }
 
 // LoadAssignmentPolicyStrict loads the global and authoritative project
@@ -54,7 +62,7 @@ func LoadAssignmentPolicyStrict(projectDir, globalPath string, requireGlobal boo
 	return LoadMergedStrict(projectDir, globalPath)
 }
 
-func loadMerged(cwd, globalPath string, strictProject bool) (*Config, error) {
+func loadMerged(cwd, globalPath, profile string, strictProject bool) (*Config, error) {
 	// Load global
 	cfg, err := loadWithCWD(globalPath, cwd)
 	if err != nil {
@@ -82,13 +90,17 @@ func loadMerged(cwd, globalPath string, strictProject bool) (*Config, error) {
 		fmt.Fprintf(log.Writer(),
 			"ntm: warning: ignoring invalid project config %s: %v (continuing with global config)\n",
 			projectConfigPath, err)
-		return cfg, nil
+		projectCfg = nil
 	}
 
 	if projectCfg != nil {
 		cfg = MergeConfig(cfg, projectCfg, projectDir)
 	}
 
+	if err := ApplyProfile(cfg, profile); err != nil {
+		return nil, err
+	}
+
 	return cfg, nil
 }
 
@@ -0,0 +1,217 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/Dicklesworthstone/ntm/internal/util"
+)
+
+// CurrentConfigVersion is the config schema version this build writes.
+// Files without a version field are version 1.
+const CurrentConfigVersion = 2
+
+// configMigration upgrades a decoded config file from version From to
+// From+1, returning a human-readable line per change it made.
+type configMigration struct {
+	From  int
+	apply func(raw map[string]interface{}) []string
+}
+
+// configMigrations is the upgrade chain, ordered by From. Add a step here and
+// bump CurrentConfigVersion whenever a schema change would reject or drop
+// settings from an older file.
+var configMigrations = []configMigration{
+	{From: 1, apply: migrateV1ToV2},
+}
+
+// MigrationResult describes the outcome of MigrateFile.
+type MigrationResult struct {
+	Path        string   `json:"path"`
+	FromVersion int      `json:"from_version"`
+	ToVersion   int      `json:"to_version"`
+	Changes     []string `json:"changes"`
+	BackupPath  string   `json:"backup_path,omitempty"`
+	Migrated    bool     `json:"migrated"`
+	DryRun      bool     `json:"dry_run,omitempty"`
+}
+
+// MigrateFile upgrades the config file at path to CurrentConfigVersion. The
+// migrated file must pass strict decoding and Validate before anything is
+// written; the original is then kept as <path>.v<N>.bak. Comments and key
+// order are not preserved, which is why the backup is made. With dryRun the
+// result reports the changes without touching disk.
+func MigrateFile(path string, dryRun bool) (*MigrationResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config: %w", err)
+	}
+	raw, from, err := decodeVersioned(data)
+	if err != nil {
+		return nil, err
+	}
+	result := &MigrationResult{Path: path, FromVersion: from, ToVersion: from, Changes: []string{}, DryRun: dryRun}
+	if from == CurrentConfigVersion {
+		return result, nil
+	}
+
+	result.Changes = applyMigrations(raw, from)
+	result.ToVersion = CurrentConfigVersion
+
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(raw); err != nil {
+		return nil, fmt.Errorf("encoding migrated config: %w", err)
+	}
+	if err := validateMigrated(buf.String()); err != nil {
+		return nil, fmt.Errorf("migrated config is invalid: %w", err)
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	perm := os.FileMode(0o644)
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	}
+	backup := fmt.Sprintf("%s.v%d.bak", path, from)
+	if err := util.AtomicWriteFile(backup, data, perm); err != nil {
+		return nil, fmt.Errorf("writing backup: %w", err)
+	}
+	if err := util.AtomicWriteFile(path, buf.Bytes(), perm); err != nil {
+		return nil, fmt.Errorf("writing migrated config: %w", err)
+	}
+	result.BackupPath = backup
+	result.Migrated = true
+	return result, nil
+}
+
+// migrateConfigData reports the changes MigrateFile would make to data.
+func migrateConfigData(data []byte) ([]string, error) {
+	raw, from, err := decodeVersioned(data)
+	if err != nil {
+		return nil, err
+	}
+	return applyMigrations(raw, from), nil
+}
+
+func decodeVersioned(data []byte) (map[string]interface{}, int, error) {
+	raw := make(map[string]interface{})
+	if _, err := toml.Decode(string(data), &raw); err != nil {
+		return nil, 0, fmt.Errorf("parsing config: %w", err)
+	}
+	from := 1
+	if v, ok := raw["version"]; ok {
+		n, ok := v.(int64)
+		if !ok || n < 1 {
+			return nil, 0, fmt.Errorf("parsing config: version must be a positive integer, got %v", v)
+		}
+		from = int(n)
+	}
+	if from > CurrentConfigVersion {
+		return nil, 0, fmt.Errorf("config version %d is newer than this ntm supports (%d)", from, CurrentConfigVersion)
+	}
+	return raw, from, nil
+}
+
+func applyMigrations(raw map[string]interface{}, from int) []string {
+	var changes []string
+	for _, m := range configMigrations {
+		if m.From < from {
+			continue
+		}
+		for _, c := range m.apply(raw) {
+			changes = append(changes, fmt.Sprintf("v%d→v%d: %s", m.From, m.From+1, c))
+		}
+	}
+	if from < CurrentConfigVersion {
+		raw["version"] = int64(CurrentConfigVersion)
+	}
+	return changes
+}
+
+// validateMigrated decodes data the way Load does, rejecting unknown fields,
+// and runs Validate on the result.
+func validateMigrated(data string) error {
+	cfg := Default()
+	md, err := toml.Decode(data, cfg)
+	if err != nil {
+		return err
+	}
+	if fields := undecodedConfigFields(md); len(fields) > 0 {
+		return fmt.Errorf("unknown field(s): %s", describeUnknownConfigFields(fields))
+	}
+	if errs := Validate(cfg); len(errs) > 0 {
+		msgs := make([]string, len(errs))
+		for i, e := range errs {
+			msgs[i] = e.Error()
+		}
+		return fmt.Errorf("%s", strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
+// healthToResilienceKeys are the [health] settings that moved to
+// [resilience] when the dead [health] section was removed.
+var healthToResilienceKeys = []string{
+	"auto_restart",
+	"max_restarts",
+	"restart_delay_seconds",
+	"health_check_seconds",
+	"crash_threshold",
+}
+
+// migrateV1ToV2 moves [health] restart settings into [resilience] and
+// replaces deprecated ensemble synthesis strategy names.
+func migrateV1ToV2(raw map[string]interface{}) []string {
+	var changes []string
+
+	if health, ok := raw["health"].(map[string]interface{}); ok {
+		resilience, _ := raw["resilience"].(map[string]interface{})
+		if resilience == nil {
+			resilience = make(map[string]interface{})
+		}
+		for _, key := range healthToResilienceKeys {
+			value, ok := health[key]
+			if !ok {
+				continue
+			}
+			delete(health, key)
+			if _, exists := resilience[key]; exists {
+				changes = append(changes, fmt.Sprintf("dropped health.%s (resilience.%s is already set)", key, key))
+				continue
+			}
+			resilience[key] = value
+			changes = append(changes, fmt.Sprintf("moved health.%s to resilience.%s", key, key))
+		}
+		leftover := make([]string, 0, len(health))
+		for key := range health {
+			leftover = append(leftover, key)
+		}
+		sort.Strings(leftover)
+		for _, key := range leftover {
+			changes = append(changes, fmt.Sprintf("dropped health.%s (no equivalent setting)", key))
+		}
+		delete(raw, "health")
+		if len(resilience) > 0 {
+			raw["resilience"] = resilience
+		}
+	}
+
+	if ensemble, ok := raw["ensemble"].(map[string]interface{}); ok {
+		if synthesis, ok := ensemble["synthesis"].(map[string]interface{}); ok {
+			if name, ok := synthesis["strategy"].(string); ok {
+				if replacement, ok := deprecatedSynthesisStrategies[name]; ok {
+					synthesis["strategy"] = replacement
+					changes = append(changes, fmt.Sprintf("renamed ensemble.synthesis.strategy %q to %q", name, replacement))
+				}
+			}
+		}
+	}
+
+	return changes
+}
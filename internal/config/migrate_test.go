@@ -0,0 +1,92 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMigrateFileV1(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	original := `# my settings
+[health]
+enabled = true
+auto_restart = true
+max_restarts = 5
+
+[resilience]
+max_restarts = 2
+
+[ensemble.synthesis]
+strategy = "weighted"
+`
+	if err := os.WriteFile(path, []byte(original), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Load(path); err == nil || !strings.Contains(err.Error(), "ntm config migrate") {
+		t.Fatalf("Load(v1) error = %v, want migrate hint", err)
+	}
+
+	dry, err := MigrateFile(path, true)
+	if err != nil {
+		t.Fatalf("MigrateFile dry run: %v", err)
+	}
+	if dry.Migrated || dry.FromVersion != 1 || dry.ToVersion != CurrentConfigVersion || len(dry.Changes) != 4 {
+		t.Errorf("dry run = %+v", dry)
+	}
+	if data, _ := os.ReadFile(path); string(data) != original {
+		t.Error("dry run modified the config file")
+	}
+
+	result, err := MigrateFile(path, false)
+	if err != nil {
+		t.Fatalf("MigrateFile: %v", err)
+	}
+	if !result.Migrated || result.BackupPath != path+".v1.bak" {
+		t.Errorf("result = %+v", result)
+	}
+	if backup, _ := os.ReadFile(result.BackupPath); string(backup) != original {
+		t.Error("backup does not hold the original file")
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("migrated file mode = %v, %v; want 0600", info.Mode().Perm(), err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load(migrated): %v", err)
+	}
+	if cfg.Version != CurrentConfigVersion || !cfg.Resilience.AutoRestart || cfg.Resilience.MaxRestarts != 2 {
+		t.Errorf("migrated config: version=%d auto_restart=%v max_restarts=%d",
+			cfg.Version, cfg.Resilience.AutoRestart, cfg.Resilience.MaxRestarts)
+	}
+	if cfg.Ensemble.Synthesis.Strategy != "prioritized" {
+		t.Errorf("strategy = %q, want prioritized", cfg.Ensemble.Synthesis.Strategy)
+	}
+
+	again, err := MigrateFile(path, false)
+	if err != nil || again.Migrated || len(again.Changes) != 0 {
+		t.Errorf("second migrate = %+v, %v; want no-op", again, err)
+	}
+}
+
+func TestMigrateFileRejects(t *testing.T) {
+	dir := t.TempDir()
+	for name, body := range map[string]string{
+		"newer":   "version = 99\n",
+		"invalid": "[health]\nmax_restarts = -1\n",
+	} {
+		path := filepath.Join(dir, name+".toml")
+		if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := MigrateFile(path, false); err == nil {
+			t.Errorf("MigrateFile(%s) succeeded, want error", name)
+		}
+		if _, err := os.Stat(path + ".v1.bak"); err == nil {
+			t.Errorf("MigrateFile(%s) wrote a backup despite failing", name)
+		}
+	}
+}
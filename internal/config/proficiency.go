@@ -24,15 +24,17 @@ type ProficiencyConfig struct {
 
 // UsageStats tracks command usage for tier promotion suggestions.
 type UsageStats struct {
-	CommandsRun      int            `json:"commands_run"`
-	SessionsCreated  int            `json:"sessions_created"`
-	PromptsSent      int            `json:"prompts_sent"`
-	UniqueCommands   map[string]int `json:"unique_commands,omitempty"` // command -> count
-	AdvancedAttempts int            `json:"advanced_attempts"`         // tried tier-locked commands
-	DaysActive       int            `json:"days_active"`               // days with at least one command
-	FirstUse         time.Time      `json:"first_use"`
-	LastUse          time.Time      `json:"last_use"`
-	LastActiveDate   string         `json:"last_active_date,omitempty"` // YYYY-MM-DD for tracking daily activity
+	CommandsRun        int            `json:"commands_run"`
+	SessionsCreated    int            `json:"sessions_created"`
+	PromptsSent        int            `json:"prompts_sent"`
+	EnsemblesRun       int            `json:"ensembles_run"`
+	CheckpointRestores int            `json:"checkpoint_restores"`
+	UniqueCommands     map[string]int `json:"unique_commands,omitempty"` // command -> count
+	AdvancedAttempts   int            `json:"advanced_attempts"`         // tried tier-locked commands
+	DaysActive         int            `json:"days_active"`               // days with at least one command
+	FirstUse           time.Time      `json:"first_use"`
+	LastUse            time.Time      `json:"last_use"`
+	LastActiveDate     string         `json:"last_active_date,omitempty"` // YYYY-MM-DD for tracking daily activity
 }
 
 // SuggestionState tracks when promotion suggestions were shown.
@@ -50,6 +52,53 @@ type PromotionRecord struct {
 	Reason string    `json:"reason"` // "manual", "auto", "reset"
 }
 
+// XP awarded per recorded action. Spawning sessions and running ensembles
+// weigh most because they are the workflows the higher tiers build on.
+const (
+	XPPerCommand  = 1
+	XPPerPrompt   = 2
+	XPPerSession  = 10
+	XPPerEnsemble = 25
+)
+
+// XP returns the experience points earned from the recorded actions.
+func (s UsageStats) XP() int {
+	return s.CommandsRun*XPPerCommand +
+		s.PromptsSent*XPPerPrompt +
+		s.SessionsCreated*XPPerSession +
+		s.EnsemblesRun*XPPerEnsemble
+}
+
+// Counter returns the value of the usage counter named by one of the
+// tiers.Counter* constants, or 0 for an unknown name.
+func (s UsageStats) Counter(name string) int {
+	switch name {
+	case tiers.CounterSessionsCreated:
+		return s.SessionsCreated
+	case tiers.CounterPromptsSent:
+		return s.PromptsSent
+	case tiers.CounterEnsemblesRun:
+		return s.EnsemblesRun
+	case tiers.CounterCheckpointRestores:
+		return s.CheckpointRestores
+	default:
+		return 0
+	}
+}
+
+// TierXP returns the XP at which tier is reached. The thresholds line up
+// with the session-count promotion criteria in ShouldSuggestPromotion.
+func TierXP(tier tiers.Tier) int {
+	switch tier {
+	case tiers.TierJourneyman:
+		return 20 * XPPerSession
+	case tiers.TierMaster:
+		return 100 * XPPerSession
+	default:
+		return 0
+	}
+}
+
 // proficiencyConfigPath returns the path to proficiency.json.
 func proficiencyConfigPath() string {
 	return filepath.Join(filepath.Dir(DefaultPath()), "proficiency.json")
@@ -193,6 +242,28 @@ func (c *ProficiencyConfig) IncrementPrompt() error {
 	return c.RecordUsage(0, 0, 1)
 }
 
+// IncrementEnsemble increments the ensemble counter.
+func (c *ProficiencyConfig) IncrementEnsemble() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.UsageStats.EnsemblesRun++
+	c.UsageStats.LastUse = time.Now()
+
+	return c.saveUnlocked()
+}
+
+// IncrementCheckpointRestore increments the checkpoint restore counter.
+func (c *ProficiencyConfig) IncrementCheckpointRestore() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.UsageStats.CheckpointRestores++
+	c.UsageStats.LastUse = time.Now()
+
+	return c.saveUnlocked()
+}
+
 // saveUnlocked persists config (caller must hold lock).
 func (c *ProficiencyConfig) saveUnlocked() error {
 	path := proficiencyConfigPath()
@@ -432,6 +432,33 @@ func TestIncrementPrompt(t *testing.T) {
 	}
 }
 
+func TestIncrementEnsembleAndXP(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("NTM_CONFIG", "")
+
+	cfg, _ := LoadProficiency()
+	if err := cfg.IncrementEnsemble(); err != nil {
+		t.Fatalf("IncrementEnsemble: %v", err)
+	}
+	if err := cfg.IncrementSession(); err != nil {
+		t.Fatalf("IncrementSession: %v", err)
+	}
+
+	reloaded, _ := LoadProficiency()
+	stats := reloaded.GetUsageStats()
+	if stats.EnsemblesRun != 1 {
+		t.Errorf("EnsemblesRun = %d, want 1", stats.EnsemblesRun)
+	}
+	if got, want := stats.XP(), XPPerEnsemble+XPPerSession; got != want {
+		t.Errorf("XP() = %d, want %d", got, want)
+	}
+
+	if TierXP(tiers.TierApprentice) != 0 || TierXP(tiers.TierJourneyman) >= TierXP(tiers.TierMaster) {
+		t.Errorf("TierXP thresholds not increasing: %d, %d, %d",
+			TierXP(tiers.TierApprentice), TierXP(tiers.TierJourneyman), TierXP(tiers.TierMaster))
+	}
+}
+
 // =============================================================================
 // ProficiencyConfigPath coverage
 // =============================================================================
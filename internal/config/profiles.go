@@ -0,0 +1,71 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// ProfileEnvVar names the environment variable that selects a config profile
+// when --config-profile is not given.
+const ProfileEnvVar = "NTM_PROFILE"
+
+// ErrProfileNotFound is returned when the requested profile is not defined
+// under [profiles] in the merged config.
+var ErrProfileNotFound = errors.New("config profile not found")
+
+// ApplyProfile overlays the [profiles.<name>] table onto cfg in place. Each
+// profile uses the same keys as the top-level config but only needs to set
+// the fields it overrides, e.g.
+//
+//	[profiles.ci.agents]
+//	claude = "claude --dangerously-skip-permissions"
+//
+// An empty name is a no-op. The result is checked with Validate.
+func ApplyProfile(cfg *Config, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil
+	}
+	overrides, ok := cfg.Profiles[name]
+	if !ok {
+		available := make([]string, 0, len(cfg.Profiles))
+		for n := range cfg.Profiles {
+			available = append(available, n)
+		}
+		sort.Strings(available)
+		if len(available) == 0 {
+			return fmt.Errorf("%w: %q (no profiles defined)", ErrProfileNotFound, name)
+		}
+		return fmt.Errorf("%w: %q (available: %s)", ErrProfileNotFound, name, strings.Join(available, ", "))
+	}
+	if _, nested := overrides["profiles"]; nested {
+		return fmt.Errorf("profile %q: profiles cannot define other profiles", name)
+	}
+
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(overrides); err != nil {
+		return fmt.Errorf("profile %q: encoding overrides: %w", name, err)
+	}
+	md, err := toml.Decode(buf.String(), cfg)
+	if err != nil {
+		return fmt.Errorf("profile %q: %w", name, err)
+	}
+	if fields := undecodedConfigFields(md); len(fields) > 0 {
+		return fmt.Errorf("profile %q: unknown field(s): %s", name, describeUnknownConfigFields(fields))
+	}
+	cfg.Profile = name
+
+	if errs := Validate(cfg); len(errs) > 0 {
+		msgs := make([]string, len(errs))
+		for i, e := range errs {
+			msgs[i] = e.Error()
+		}
+		return fmt.Errorf("profile %q: invalid config: %s", name, strings.Join(msgs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestApplyProfile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	body := `
+[agents]
+claude = "claude"
+
+[profiles.ci.agents]
+claude = "claude --ci"
+
+[profiles.ci.alerts]
+enabled = false
+
+[profiles.typo]
+not_a_field = 1
+`
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadMergedProfile(dir, path, "ci")
+	if err != nil {
+		t.Fatalf("LoadMergedProfile(ci): %v", err)
+	}
+	if cfg.Profile != "ci" || cfg.Agents.Claude != "claude --ci" || cfg.Alerts.Enabled {
+		t.Errorf("profile not applied: profile=%q claude=%q alerts=%v", cfg.Profile, cfg.Agents.Claude, cfg.Alerts.Enabled)
+	}
+	if cfg.Agents.Codex == "" {
+		t.Error("fields the profile does not set should keep their merged values")
+	}
+
+	base, err := LoadMergedProfile(dir, path, "")
+	if err != nil || base.Profile != "" || base.Agents.Claude != "claude" {
+		t.Errorf("no profile = %q/%q, %v", base.Profile, base.Agents.Claude, err)
+	}
+
+	_, err = LoadMergedProfile(dir, path, "staging")
+	if !errors.Is(err, ErrProfileNotFound) || !strings.Contains(err.Error(), "available: ci, typo") {
+		t.Errorf("missing profile error = %v", err)
+	}
+
+	_, err = LoadMergedProfile(dir, path, "typo")
+	if err == nil || !strings.Contains(err.Error(), "not_a_field") {
+		t.Errorf("unknown field error = %v", err)
+	}
+
+	t.Setenv(ProfileEnvVar, "ci")
+	cfg, err = LoadMerged(dir, path)
+	if err != nil || cfg.Profile != "ci" {
+		t.Errorf("LoadMerged with %s=ci: profile=%q, %v", ProfileEnvVar, cfg.Profile, err)
+	}
+}
+
+func TestApplyProfileValidates(t *testing.T) {
+	cfg := Default()
+	cfg.Profiles = map[string]map[string]interface{}{
+		"bad": {"context_rotation": map[string]interface{}{"warning_threshold": 5.0}},
+	}
+	err := ApplyProfile(cfg, "bad")
+	if err == nil || !strings.Contains(err.Error(), "invalid config") {
+		t.Errorf("ApplyProfile(bad) = %v, want validation error", err)
+	}
+}
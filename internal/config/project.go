@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"github.com/BurntSushi/toml"
+
+	"github.com/Dicklesworthstone/ntm/internal/util"
 )
 
 // ProjectConfig represents the structure of .ntm/config.toml
@@ -131,9 +133,12 @@ func LoadProjectConfig(path string) (*ProjectConfig, error) {
 	if err != nil {
 		return nil, err
 	}
+	return decodeProjectConfig(string(data))
+}
 
+func decodeProjectConfig(data string) (*ProjectConfig, error) {
 	var cfg ProjectConfig
-	md, err := toml.Decode(string(data), &cfg)
+	md, err := toml.Decode(data, &cfg)
 	if err != nil {
 		return nil, fmt.Errorf("parsing project config: %w", err)
 	}
@@ -411,3 +416,143 @@ cm = true
 
 	return buf.Bytes(), nil
 }
+
+// SetProjectValue sets a dotted key (e.g. "defaults.agents.cc") in
+// projectDir/.ntm/config.toml, creating the file if it does not exist. The raw
+// value is read as a TOML literal (number, bool, array) and otherwise kept as a
+// string. Only keys already in the file plus the new one are written, so the
+// global config stays authoritative for everything else.
+//
+// When global is non-nil the project overlay is merged into it and the result
+// validated before anything is written; global is modified by the merge, so
+// pass a freshly loaded config. Returns the path of the project config file.
+func SetProjectValue(projectDir, key, raw string, global *Config) (string, error) {
+	parts, err := splitProjectConfigKey(key)
+	if err != nil {
+		return "", err
+	}
+	return updateProjectConfigFile(projectDir, global, func(values map[string]interface{}) error {
+		table := values
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := table[part]
+			if !ok {
+				child := make(map[string]interface{})
+				table[part] = child
+				table = child
+				continue
+			}
+			child, ok := next.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s: %q is not a table", key, part)
+			}
+			table = child
+		}
+		table[parts[len(parts)-1]] = parseProjectConfigValue(raw)
+		return nil
+	})
+}
+
+// UnsetProjectValue removes a dotted key from projectDir/.ntm/config.toml so
+// the global value applies again, pruning tables left empty. It reports
+// whether the key was present.
+func UnsetProjectValue(projectDir, key string, global *Config) (string, bool, error) {
+	parts, err := splitProjectConfigKey(key)
+	if err != nil {
+		return "", false, err
+	}
+	removed := false
+	path, err := updateProjectConfigFile(projectDir, global, func(values map[string]interface{}) error {
+		removed = deleteProjectConfigKey(values, parts)
+		return nil
+	})
+	return path, removed, err
+}
+
+func updateProjectConfigFile(projectDir string, global *Config, update func(map[string]interface{}) error) (string, error) {
+	if strings.TrimSpace(projectDir) == "" {
+		return "", fmt.Errorf("project directory is required")
+	}
+	ntmDir := filepath.Join(projectDir, ".ntm")
+	path := filepath.Join(ntmDir, "config.toml")
+
+	values := make(map[string]interface{})
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if _, err := toml.Decode(string(data), &values); err != nil {
+			return "", fmt.Errorf("parsing project config: %w", err)
+		}
+	case !os.IsNotExist(err):
+		return "", fmt.Errorf("reading project config: %w", err)
+	}
+
+	if err := update(values); err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(values); err != nil {
+		return "", fmt.Errorf("encoding project config: %w", err)
+	}
+	projectCfg, err := decodeProjectConfig(buf.String())
+	if err != nil {
+		return "", err
+	}
+	if global != nil {
+		merged := MergeConfig(global, projectCfg, projectDir)
+		if errs := Validate(merged); len(errs) > 0 {
+			msgs := make([]string, 0, len(errs))
+			for _, e := range errs {
+				msgs = append(msgs, e.Error())
+			}
+			return "", fmt.Errorf("merged config is invalid: %s", strings.Join(msgs, "; "))
+		}
+	}
+
+	if err := os.MkdirAll(ntmDir, 0755); err != nil {
+		return "", fmt.Errorf("creating .ntm directory: %w", err)
+	}
+	if err := util.AtomicWriteFile(path, buf.Bytes(), 0644); err != nil {
+		return "", fmt.Errorf("writing project config: %w", err)
+	}
+	return path, nil
+}
+
+func splitProjectConfigKey(key string) ([]string, error) {
+	parts := strings.Split(strings.TrimSpace(key), ".")
+	for _, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			return nil, fmt.Errorf("invalid config key %q", key)
+		}
+	}
+	return parts, nil
+}
+
+// parseProjectConfigValue reads raw as a TOML value, falling back to a plain
+// string so that `set project.name demo` does not need quoting.
+func parseProjectConfigValue(raw string) interface{} {
+	var probe map[string]interface{}
+	if _, err := toml.Decode("v = "+raw, &probe); err == nil {
+		return probe["v"]
+	}
+	return raw
+}
+
+func deleteProjectConfigKey(table map[string]interface{}, parts []string) bool {
+	if len(parts) == 1 {
+		if _, ok := table[parts[0]]; !ok {
+			return false
+		}
+		delete(table, parts[0])
+		return true
+	}
+	child, ok := table[parts[0]].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	removed := deleteProjectConfigKey(child, parts[1:])
+	if removed && len(child) == 0 {
+		delete(table, parts[0])
+	}
+	return removed
+}
@@ -773,3 +773,49 @@ func TestProjectInitResult(t *testing.T) {
 		t.Errorf("CreatedFiles length = %d, want 1", len(result.CreatedFiles))
 	}
 }
+
+func TestSetAndUnsetProjectValue(t *testing.T) {
+	dir := t.TempDir()
+
+	path, err := SetProjectValue(dir, "defaults.agents.cc", "3", Default())
+	if err != nil {
+		t.Fatalf("SetProjectValue: %v", err)
+	}
+	if want := filepath.Join(dir, ".ntm", "config.toml"); path != want {
+		t.Fatalf("path = %q, want %q", path, want)
+	}
+	if _, err := SetProjectValue(dir, "project.name", "demo", Default()); err != nil {
+		t.Fatalf("SetProjectValue string: %v", err)
+	}
+
+	cfg, err := LoadProjectConfig(path)
+	if err != nil {
+		t.Fatalf("LoadProjectConfig: %v", err)
+	}
+	if cfg.Defaults.Agents["cc"] != 3 || cfg.Project.Name != "demo" {
+		t.Fatalf("project config = %+v", cfg)
+	}
+	data, _ := os.ReadFile(path)
+	if strings.Contains(string(data), "alerts") {
+		t.Fatalf("only overridden keys should be written, got:\n%s", data)
+	}
+
+	if _, err := SetProjectValue(dir, "defaults.bogus", "1", Default()); err == nil {
+		t.Fatal("expected unknown key to be rejected")
+	}
+	if after, _ := os.ReadFile(path); string(after) != string(data) {
+		t.Fatal("rejected set must not modify the file")
+	}
+
+	_, removed, err := UnsetProjectValue(dir, "defaults.agents.cc", Default())
+	if err != nil || !removed {
+		t.Fatalf("UnsetProjectValue = %v, %v", removed, err)
+	}
+	data, _ = os.ReadFile(path)
+	if strings.Contains(string(data), "defaults") {
+		t.Fatalf("empty tables should be pruned, got:\n%s", data)
+	}
+	if _, removed, _ := UnsetProjectValue(dir, "defaults.agents.cc", Default()); removed {
+		t.Fatal("unsetting a missing key should report removed=false")
+	}
+}
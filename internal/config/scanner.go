@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"time"
 
@@ -340,41 +339,6 @@ func mergeWithDefaults(user ScannerConfig) ScannerConfig {
 	return user
 }
 
-// applyEnvOverrides applies environment variable overrides to scanner config
-func applyEnvOverrides(cfg *ScannerConfig) {
-	// UBS_PATH overrides ubs_path
-	if path := os.Getenv("UBS_PATH"); path != "" {
-		cfg.UBSPath = path
-	}
-
-	// NTM_SCANNER_TIMEOUT overrides timeout
-	if timeout := os.Getenv("NTM_SCANNER_TIMEOUT"); timeout != "" {
-		cfg.Defaults.Timeout = timeout
-	}
-
-	// NTM_SCANNER_AUTO_BEADS enables auto-bead creation
-	if autoBeads := os.Getenv("NTM_SCANNER_AUTO_BEADS"); autoBeads != "" {
-		cfg.Beads.AutoCreate = autoBeads == "1" || strings.ToLower(autoBeads) == "true"
-	}
-
-	// NTM_SCANNER_MIN_SEVERITY sets minimum severity for beads
-	if minSev := os.Getenv("NTM_SCANNER_MIN_SEVERITY"); minSev != "" {
-		cfg.Beads.MinSeverity = minSev
-	}
-
-	// NTM_SCANNER_BLOCK_CRITICAL enables blocking on critical in pre-commit
-	if block := os.Getenv("NTM_SCANNER_BLOCK_CRITICAL"); block != "" {
-		cfg.Thresholds.PreCommit.BlockCritical = block == "1" || strings.ToLower(block) == "true"
-	}
-
-	// NTM_SCANNER_FAIL_ERRORS sets error threshold for CI
-	if failErrors := os.Getenv("NTM_SCANNER_FAIL_ERRORS"); failErrors != "" {
-		if n, err := strconv.Atoi(failErrors); err == nil {
-			cfg.Thresholds.CI.FailErrors = n
-		}
-	}
-}
-
 // IsToolEnabled checks if a tool should be run
 func (t *ScannerTools) IsToolEnabled(toolName string) bool {
 	// Check disabled list first
@@ -0,0 +1,163 @@
+package config
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// JSONSchema is a JSON Schema (draft-07) node describing config.toml, for
+// `ntm config schema`.
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Default              interface{}            `json:"default,omitempty"`
+}
+
+// schemaEnumCandidates lists the values each enum-like string key may take.
+// Schema keeps only the candidates Validate accepts, so a value dropped from a
+// validator disappears from the schema too; TestSchemaCoversValidatedEnums
+// fails when Validate starts constraining a string key missing here.
+var schemaEnumCandidates = func() map[string][]string {
+	candidates := map[string][]string{
+		"ensemble.assignment":                  {"round-robin", "affinity", "category", "explicit"},
+		"ensemble.mode_tier_default":           {"core", "advanced", "experimental"},
+		"ensemble.synthesis.strategy":          nil,
+		"redaction.mode":                       {"off", "warn", "redact", "block"},
+		"encryption.key_source":                {"env", "file", "command"},
+		"encryption.key_format":                {"hex", "base64"},
+		"robot.output.format":                  {"json", "toon", "auto"},
+		"integrations.xf.default_mode":         {"keyword", "semantic", "hybrid"},
+		"integrations.process_triage.on_stuck": {"alert", "kill", "ignore"},
+		"scanner.beads.min_severity":           {"critical", "error", "warning", "info"},
+	}
+	for strategy := range validSynthesisStrategies {
+		candidates["ensemble.synthesis.strategy"] = append(candidates["ensemble.synthesis.strategy"], strategy)
+	}
+	sort.Strings(candidates["ensemble.synthesis.strategy"])
+	for _, e := range lintEnums {
+		candidates[e.key] = e.allowed
+	}
+	return candidates
+}()
+
+// Schema returns a JSON Schema for the config file, derived from the Config
+// struct's toml tags. Scalar keys carry their built-in default and, when
+// documented, the `ntm config explain` description.
+func Schema() *JSONSchema {
+	schema := configTypeSchema(reflect.TypeOf(Config{}), reflect.ValueOf(*Default()), "")
+	schema.Schema = "http://json-schema.org/draft-07/schema#"
+	schema.Title = "NTM configuration"
+	schema.Description = "Schema for ntm's config.toml"
+	return schema
+}
+
+func configTypeSchema(t reflect.Type, v reflect.Value, path string) *JSONSchema {
+	if t.Kind() == reflect.Ptr {
+		if v.IsValid() && !v.IsNil() {
+			v = v.Elem()
+		} else {
+			v = reflect.Value{}
+		}
+		return configTypeSchema(t.Elem(), v, path)
+	}
+
+	var schema *JSONSchema
+	switch {
+	case t == reflect.TypeOf(time.Duration(0)):
+		schema = &JSONSchema{Type: "string", Description: "Go duration, e.g. 30s or 5m"}
+		if v.IsValid() {
+			schema.Default = time.Duration(v.Int()).String()
+		}
+	case t.Kind() == reflect.Struct:
+		schema = &JSONSchema{Type: "object", Properties: make(map[string]*JSONSchema)}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			tag, _, _ := strings.Cut(field.Tag.Get("toml"), ",")
+			if tag == "" || tag == "-" || !field.IsExported() {
+				continue
+			}
+			child := tag
+			if path != "" {
+				child = path + "." + tag
+			}
+			var fv reflect.Value
+			if v.IsValid() {
+				fv = v.Field(i)
+			}
+			schema.Properties[tag] = configTypeSchema(field.Type, fv, child)
+		}
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		// Elements have no dotted path of their own.
+		schema = &JSONSchema{Type: "array", Items: configTypeSchema(t.Elem(), reflect.Value{}, "")}
+	case t.Kind() == reflect.Map:
+		schema = &JSONSchema{Type: "object", AdditionalProperties: configTypeSchema(t.Elem(), reflect.Value{}, "")}
+	case t.Kind() == reflect.Bool:
+		schema = &JSONSchema{Type: "boolean"}
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Uint64:
+		schema = &JSONSchema{Type: "integer"}
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		schema = &JSONSchema{Type: "number"}
+	case t.Kind() == reflect.String:
+		schema = &JSONSchema{Type: "string", Enum: validatedEnum(path)}
+	default:
+		// interface{} and anything else: accept any value.
+		schema = &JSONSchema{}
+	}
+
+	if path == "" {
+		return schema
+	}
+	if doc, ok := keyDocs[path]; ok && doc.description != "" {
+		schema.Description = doc.description
+	}
+	if schema.Default == nil && v.IsValid() {
+		switch v.Kind() {
+		case reflect.Bool, reflect.String,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			schema.Default = v.Interface()
+		}
+	}
+	return schema
+}
+
+// schemaEnumProbe is a value no enum accepts; Validate rejecting it shows the
+// key is actually constrained.
+const schemaEnumProbe = "ntm-schema-probe"
+
+// validatedEnum returns the candidates for path that Validate accepts,
+// including "" when a blank value falls back to the default. Keys Validate
+// does not constrain get no enum.
+func validatedEnum(path string) []string {
+	candidates, ok := schemaEnumCandidates[path]
+	if !ok || setValue(enumProbeConfig(path), path, schemaEnumProbe, true) == nil {
+		return nil
+	}
+	var enum []string
+	for _, value := range append([]string{""}, candidates...) {
+		if setValue(enumProbeConfig(path), path, value, true) == nil {
+			enum = append(enum, value)
+		}
+	}
+	return enum
+}
+
+// enumProbeConfig returns the defaults with path's section enabled, when it
+// has an enabled switch, since many section validators skip disabled
+// sections entirely.
+func enumProbeConfig(path string) *Config {
+	cfg := Default()
+	if i := strings.LastIndex(path, "."); i >= 0 {
+		_ = setValue(cfg, path[:i]+".enabled", "true", true)
+	}
+	return cfg
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// schemaFreeformKeys are string keys Validate constrains by shape (paths,
+// commands) rather than by a fixed value set.
+var schemaFreeformKeys = map[string]bool{
+	"projects_base":                           true,
+	"ensemble.webhook_url":                    true,
+	"integrations.dcg.binary_path":            true,
+	"integrations.process_triage.binary_path": true,
+	"integrations.rano.binary_path":           true,
+	"integrations.proxy.check_interval":       true,
+	"scanner.defaults.timeout":                true,
+}
+
+func TestSchemaCoversValidatedEnums(t *testing.T) {
+	t.Parallel()
+
+	for _, path := range getValueLeafPaths(t) {
+		def, err := GetValue(Default(), path)
+		if err != nil {
+			t.Fatalf("GetValue(%q): %v", path, err)
+		}
+		if reflect.TypeOf(def) == nil || reflect.TypeOf(def).Kind() != reflect.String {
+			continue
+		}
+		if setValue(enumProbeConfig(path), path, schemaEnumProbe, true) == nil {
+			continue
+		}
+		if _, ok := schemaEnumCandidates[path]; !ok && !schemaFreeformKeys[path] {
+			t.Errorf("%s: Validate constrains this key but schemaEnumCandidates has no entry", path)
+		}
+	}
+	for path := range schemaEnumCandidates {
+		if len(validatedEnum(path)) == 0 {
+			t.Errorf("%s: no candidate passes Validate, or Validate does not constrain the key", path)
+		}
+	}
+}
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := Schema()
+	data, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf("marshal schema: %v", err)
+	}
+	if !json.Valid(data) || schema.Schema == "" || schema.Type != "object" {
+		t.Fatalf("unexpected root schema: %s", data[:min(len(data), 200)])
+	}
+
+	lookup := func(path ...string) *JSONSchema {
+		t.Helper()
+		node := schema
+		for _, part := range path {
+			next, ok := node.Properties[part]
+			if !ok {
+				t.Fatalf("schema has no property %v", path)
+			}
+			node = next
+		}
+		return node
+	}
+
+	if got := lookup("help_verbosity").Enum; !slices.Equal(got, []string{"", "minimal", "full"}) {
+		t.Errorf("help_verbosity enum = %v", got)
+	}
+	if got := lookup("redaction", "mode").Enum; !slices.Equal(got, []string{"", "off", "warn", "redact", "block"}) {
+		t.Errorf("redaction.mode enum = %v", got)
+	}
+	if got := lookup("theme").Enum; got != nil {
+		t.Errorf("theme is not validated but got enum %v", got)
+	}
+	maxFindings := lookup("ensemble", "synthesis", "max_findings")
+	if maxFindings.Type != "integer" || maxFindings.Description == "" {
+		t.Errorf("ensemble.synthesis.max_findings = %+v", maxFindings)
+	}
+	for _, section := range []string{"cass", "integrations", "ensemble"} {
+		if lookup(section).Type != "object" {
+			t.Errorf("%s is not an object", section)
+		}
+	}
+	if lookup("integrations", "dcg").Type != "object" {
+		t.Error("integrations.dcg is not an object")
+	}
+}
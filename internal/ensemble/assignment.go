@@ -12,6 +12,7 @@ import (
 
 	"github.com/Dicklesworthstone/ntm/internal/agent"
 	"github.com/Dicklesworthstone/ntm/internal/tmux"
+	"github.com/Dicklesworthstone/ntm/internal/util"
 )
 
 // CategoryAffinities maps reasoning categories to preferred agent types (ordered).
@@ -37,6 +38,20 @@ var defaultPreferredTypes = []string{
 	string(tmux.AgentGemini),
 }
 
+// ShuffleModeIDs returns a copy of modeIDs reordered by util.SeededPermutation,
+// the same generator as `ntm send --randomize`, so the order is stable across
+// Go versions. A zero seed returns the order unchanged.
+func ShuffleModeIDs(modeIDs []string, seed int64) []string {
+	if seed == 0 || len(modeIDs) <= 1 {
+		return append([]string(nil), modeIDs...)
+	}
+	out := make([]string, len(modeIDs))
+	for i, idx := range util.SeededPermutation(len(modeIDs), seed) {
+		out[i] = modeIDs[idx]
+	}
+	return out
+}
+
 // AssignRoundRobin distributes modes evenly across available panes.
 func AssignRoundRobin(modes []string, panes []tmux.Pane) []ModeAssignment {
 	logger := slog.Default()
@@ -177,13 +192,15 @@ func AssignExplicit(specs []string, panes []tmux.Pane) ([]ModeAssignment, error)
 		if modeID == "" {
 			return nil, fmt.Errorf("invalid assignment %q: empty mode", spec)
 		}
-		agentType := strings.ToLower(strings.TrimSpace(parts[1]))
-		if agentType == "" {
+		rawAgent := strings.TrimSpace(parts[1])
+		if rawAgent == "" {
 			return nil, fmt.Errorf("invalid assignment %q: empty agent type", spec)
 		}
-		if !isAssignableAgentType(agentType) {
-			return nil, fmt.Errorf("invalid assignment %q: unknown agent type %q", spec, agentType)
+		canonical, ok := agent.NormalizeAgentType(rawAgent)
+		if !ok || !isAssignableAgentType(string(canonical)) {
+			return nil, fmt.Errorf("invalid assignment %q: unknown agent type %q", spec, rawAgent)
 		}
+		agentType := string(canonical)
 		if _, exists := modeToAgent[modeID]; exists {
 			return nil, fmt.Errorf("duplicate assignment for mode %q", modeID)
 		}
@@ -3,6 +3,7 @@ package ensemble
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -292,6 +293,34 @@ func TestAssignExplicit_Success(t *testing.T) {
 	}
 }
 
+func TestAssignExplicit_AcceptsAgentAliases(t *testing.T) {
+	panes := []tmux.Pane{
+		{Title: "pane-1", Type: tmux.AgentClaude, Index: 1, NTMIndex: 1},
+		{Title: "pane-2", Type: tmux.AgentCodex, Index: 2, NTMIndex: 2},
+	}
+
+	assignments, err := AssignExplicit([]string{"deductive:claude-code", "abductive:Codex"}, panes)
+	if err != nil {
+		t.Fatalf("AssignExplicit error: %v", err)
+	}
+	for _, assignment := range assignments {
+		switch assignment.ModeID {
+		case "deductive":
+			if assignment.PaneName != "pane-1" {
+				t.Errorf("deductive pane = %q, want pane-1", assignment.PaneName)
+			}
+		case "abductive":
+			if assignment.PaneName != "pane-2" {
+				t.Errorf("abductive pane = %q, want pane-2", assignment.PaneName)
+			}
+		}
+	}
+
+	if _, err := AssignExplicit([]string{"deductive:claudette"}, panes); err == nil || !strings.Contains(err.Error(), "unknown agent type") {
+		t.Fatalf("expected unknown agent type error, got %v", err)
+	}
+}
+
 func TestAssignExplicit_NotEnoughPanes(t *testing.T) {
 	panes := []tmux.Pane{
 		{Title: "pane-1", Type: tmux.AgentClaude, Index: 1, NTMIndex: 1},
@@ -951,3 +980,30 @@ func TestIsAssignableAgentType_Various(t *testing.T) {
 		}
 	}
 }
+
+func TestShuffleModeIDs(t *testing.T) {
+	modes := []string{"deductive", "abductive", "bayesian", "causal", "dialectical"}
+
+	if got := ShuffleModeIDs(modes, 0); !reflect.DeepEqual(got, modes) {
+		t.Fatalf("seed 0 reordered modes: %v", got)
+	}
+
+	first := ShuffleModeIDs(modes, 42)
+	if again := ShuffleModeIDs(modes, 42); !reflect.DeepEqual(first, again) {
+		t.Fatalf("same seed gave %v then %v", first, again)
+	}
+	if reflect.DeepEqual(first, modes) {
+		t.Fatalf("seed 42 left order unchanged: %v", first)
+	}
+	if modes[0] != "deductive" {
+		t.Fatal("ShuffleModeIDs mutated its input")
+	}
+
+	sorted := append([]string(nil), first...)
+	sort.Strings(sorted)
+	want := append([]string(nil), modes...)
+	sort.Strings(want)
+	if !reflect.DeepEqual(sorted, want) {
+		t.Fatalf("shuffle is not a permutation: %v", first)
+	}
+}
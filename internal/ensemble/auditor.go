@@ -29,6 +29,11 @@ type SynthesisResult struct {
 	GeneratedAt      time.Time           `json:"generated_at,omitempty" yaml:"generated_at,omitempty"`
 	Explanation      *ExplanationLayer   `json:"explanation,omitempty" yaml:"explanation,omitempty"`
 	Contributions    *ContributionReport `json:"contributions,omitempty" yaml:"contributions,omitempty"`
+	Debate           *DebateReport       `json:"debate,omitempty" yaml:"debate,omitempty"`
+	// IncludedModes and ExcludedModes record the --synthesis-only-modes
+	// split; both are empty when every collected mode was synthesized.
+	IncludedModes []string `json:"included_modes,omitempty" yaml:"included_modes,omitempty"`
+	ExcludedModes []string `json:"excluded_modes,omitempty" yaml:"excluded_modes,omitempty"`
 }
 
 // AuditReport captures disagreement analysis across modes.
@@ -271,6 +271,49 @@ func EstimateModeOutputsTokens(outputs []ModeOutput) int {
 	return total
 }
 
+// BudgetOverrunFactor is how far past its typical output estimate a finished
+// mode may go before it is flagged as over budget.
+const BudgetOverrunFactor = 1.5
+
+// ModeBudgetComparison compares a finished mode's output tokens with the
+// typical output the cost model predicts for that mode.
+type ModeBudgetComparison struct {
+	ModeID          string  `json:"mode_id" yaml:"mode_id"`
+	ActualTokens    int     `json:"actual_tokens" yaml:"actual_tokens"`
+	EstimatedTokens int     `json:"estimated_tokens" yaml:"estimated_tokens"`
+	Ratio           float64 `json:"ratio" yaml:"ratio"`
+	OverBudget      bool    `json:"over_budget" yaml:"over_budget"`
+}
+
+// CompareModeBudgets estimates each output's tokens and compares them with the
+// mode's typical cost, flagging modes past BudgetOverrunFactor times the
+// estimate. Modes missing from the catalog use the default estimate. The
+// result is keyed by mode ID.
+func CompareModeBudgets(outputs []ModeOutput, catalog *ModeCatalog) map[string]ModeBudgetComparison {
+	comparisons := make(map[string]ModeBudgetComparison, len(outputs))
+	for i := range outputs {
+		out := &outputs[i]
+		var mode *ReasoningMode
+		if catalog != nil {
+			mode = catalog.GetMode(out.ModeID)
+		}
+		if mode == nil {
+			mode = &ReasoningMode{ID: out.ModeID}
+		}
+		cmp := ModeBudgetComparison{
+			ModeID:          out.ModeID,
+			ActualTokens:    EstimateModeOutputTokens(out),
+			EstimatedTokens: estimateTypicalCost(mode),
+		}
+		if cmp.EstimatedTokens > 0 {
+			cmp.Ratio = float64(cmp.ActualTokens) / float64(cmp.EstimatedTokens)
+			cmp.OverBudget = cmp.Ratio > BudgetOverrunFactor
+		}
+		comparisons[out.ModeID] = cmp
+	}
+	return comparisons
+}
+
 func fallbackModeOutputText(output *ModeOutput) string {
 	if output == nil {
 		return ""
@@ -302,3 +302,31 @@ func TestBudgetTracker_Config(t *testing.T) {
 		t.Errorf("Config().MaxTotalTokens = %d, want 25000", returned.MaxTotalTokens)
 	}
 }
+
+func TestCompareModeBudgets(t *testing.T) {
+	catalog, err := NewModeCatalog([]ReasoningMode{
+		{ID: "deductive", Code: "A1", Name: "Deductive", Category: CategoryFormal, Tier: TierCore, ShortDesc: "d"},
+	}, "test")
+	if err != nil {
+		t.Fatalf("NewModeCatalog: %v", err)
+	}
+	short := "brief answer"
+	long := strings.Repeat("a long winded paragraph of reasoning output. ", 1200)
+	outputs := []ModeOutput{
+		{ModeID: "deductive", RawOutput: short},
+		{ModeID: "unknown", RawOutput: long},
+	}
+
+	got := CompareModeBudgets(outputs, catalog)
+	if len(got) != 2 {
+		t.Fatalf("len = %d, want 2", len(got))
+	}
+	deductive := got["deductive"]
+	if deductive.EstimatedTokens != 3000 || deductive.OverBudget || deductive.ActualTokens != EstimateOutputTokens(short) {
+		t.Errorf("deductive = %+v, want 3000 estimate, not over budget", deductive)
+	}
+	unknown := got["unknown"]
+	if unknown.EstimatedTokens != 2000 || !unknown.OverBudget || unknown.Ratio <= BudgetOverrunFactor {
+		t.Errorf("unknown = %+v, want default 2000 estimate and over budget", unknown)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/Dicklesworthstone/ntm/internal/codeblock"
 	"github.com/Dicklesworthstone/ntm/internal/status"
@@ -159,6 +160,26 @@ func (c *OutputCapture) CaptureAll(session *EnsembleSession) ([]CapturedOutput,
 	return outputs, errors.Join(captureErrs...)
 }
 
+// CaptureMode captures the pane of the single assignment for modeID.
+func (c *OutputCapture) CaptureMode(session *EnsembleSession, modeID string) (CapturedOutput, error) {
+	if session == nil {
+		return CapturedOutput{}, errors.New("ensemble session is nil")
+	}
+	for _, assignment := range session.Assignments {
+		if assignment.ModeID != modeID {
+			continue
+		}
+		single := *session
+		single.Assignments = []ModeAssignment{assignment}
+		outputs, err := c.CaptureAll(&single)
+		if len(outputs) == 0 {
+			return CapturedOutput{}, err
+		}
+		return outputs[0], err
+	}
+	return CapturedOutput{}, fmt.Errorf("mode %q is not assigned in session %s", modeID, session.SessionName)
+}
+
 func (c *OutputCapture) capturePane(pane string) (string, error) {
 	if pane == "" {
 		return "", errors.New("pane is empty")
@@ -217,6 +238,45 @@ func (c *OutputCapture) ensureDefaults() {
 	}
 }
 
+// Thresholds below which MeasureOutputLengths flags a capture as short. A
+// healthy mode reply spans many lines; one or two lines usually means the
+// agent refused, crashed, or answered without doing the analysis.
+const (
+	ShortOutputChars = 400
+	ShortOutputLines = 5
+)
+
+// ModeOutputLength is the size of a mode's captured pane output.
+type ModeOutputLength struct {
+	ModeID string `json:"mode_id" yaml:"mode_id"`
+	Chars  int    `json:"chars" yaml:"chars"`
+	Lines  int    `json:"lines" yaml:"lines"`
+	Short  bool   `json:"short" yaml:"short"`
+}
+
+// MeasureOutputLengths counts the characters and non-blank lines of each
+// capture after stripping ANSI escapes, flagging captures under
+// ShortOutputChars or ShortOutputLines. Captures that failed outright are
+// skipped. The result is keyed by mode ID.
+func MeasureOutputLengths(captured []CapturedOutput) map[string]ModeOutputLength {
+	lengths := make(map[string]ModeOutputLength, len(captured))
+	for _, out := range captured {
+		if out.RawOutput == "" && len(out.ParseErrors) > 0 {
+			continue
+		}
+		clean := strings.TrimSpace(status.StripANSI(out.RawOutput))
+		length := ModeOutputLength{ModeID: out.ModeID, Chars: utf8.RuneCountInString(clean)}
+		for _, line := range strings.Split(clean, "\n") {
+			if strings.TrimSpace(line) != "" {
+				length.Lines++
+			}
+		}
+		length.Short = length.Chars < ShortOutputChars || length.Lines < ShortOutputLines
+		lengths[out.ModeID] = length
+	}
+	return lengths
+}
+
 func countLines(text string) int {
 	if text == "" {
 		return 0
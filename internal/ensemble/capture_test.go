@@ -1,6 +1,10 @@
 package ensemble
 
-import "testing"
+import (
+	"errors"
+	"strings"
+	"testing"
+)
 
 func TestOutputCapture_DefaultsAndLineCount(t *testing.T) {
 	capture := &OutputCapture{}
@@ -216,3 +220,25 @@ func captureContains(s, substr string) bool {
 	}
 	return false
 }
+
+func TestMeasureOutputLengths(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("analysis line with enough text to count\n", 12)
+	captured := []CapturedOutput{
+		{ModeID: "deductive", RawOutput: long},
+		{ModeID: "bayesian", RawOutput: "\x1b[32mOK\x1b[0m\n\n"},
+		{ModeID: "lost", ParseErrors: []error{errors.New("pane gone")}},
+	}
+	got := MeasureOutputLengths(captured)
+
+	if len(got) != 2 {
+		t.Fatalf("got %d lengths, want 2 (failed capture skipped): %+v", len(got), got)
+	}
+	if d := got["deductive"]; d.Lines != 12 || d.Short || d.Chars != len(strings.TrimSpace(long)) {
+		t.Errorf("deductive = %+v, want 12 lines, not short", d)
+	}
+	if b := got["bayesian"]; b.Chars != 2 || b.Lines != 1 || !b.Short {
+		t.Errorf("bayesian = %+v, want 2 chars, 1 line, short", b)
+	}
+}
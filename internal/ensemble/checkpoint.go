@@ -23,6 +23,8 @@ const (
 	checkpointMetaFile = "_meta.json"
 	// checkpointSynthesisFile stores streaming synthesis resume state.
 	checkpointSynthesisFile = "synthesis.json"
+	// checkpointContributionsFile caches the run's contribution report.
+	checkpointContributionsFile = "contributions.json"
 )
 
 // NormalizeCheckpointRunID trims and validates a run ID before it is used as a
@@ -127,6 +129,15 @@ type SynthesisCheckpoint struct {
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+// ContributionsCheckpoint caches a computed ContributionReport for a run.
+// OutputsHash is HashModeOutputs of the outputs it was computed from.
+type ContributionsCheckpoint struct {
+	RunID       string              `json:"run_id"`
+	OutputsHash string              `json:"outputs_hash"`
+	Report      *ContributionReport `json:"report"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
+
 // ModeCheckpoint stores a single mode's output for recovery.
 type ModeCheckpoint struct {
 	ModeID      string      `json:"mode_id"`
@@ -358,6 +369,82 @@ func (s *CheckpointStore) LoadSynthesisCheckpoint(runID string) (*SynthesisCheck
 	return &checkpoint, nil
 }
 
+// SaveContributions caches a contribution report next to the run metadata.
+func (s *CheckpointStore) SaveContributions(runID string, checkpoint ContributionsCheckpoint) error {
+	if s == nil {
+		return errors.New("checkpoint store is nil")
+	}
+	normalizedRunID, err := NormalizeCheckpointRunID(runID)
+	if err != nil {
+		return err
+	}
+	runID = normalizedRunID
+
+	runDir, err := s.ensureRunDir(runID)
+	if err != nil {
+		return err
+	}
+
+	if checkpoint.CreatedAt.IsZero() {
+		checkpoint.CreatedAt = time.Now().UTC()
+	}
+	checkpoint.RunID = runID
+
+	filename := filepath.Join(runDir, checkpointContributionsFile)
+	data, err := json.MarshalIndent(checkpoint, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal contributions checkpoint: %w", err)
+	}
+
+	if err := util.AtomicWriteFile(filename, data, 0o644); err != nil {
+		return fmt.Errorf("write contributions checkpoint: %w", err)
+	}
+
+	s.logger.Info("contributions checkpoint saved",
+		"run_id", runID,
+		"outputs_hash", checkpoint.OutputsHash,
+	)
+
+	return nil
+}
+
+// LoadContributions loads a run's cached contribution report. It returns
+// os.ErrNotExist when none has been saved.
+func (s *CheckpointStore) LoadContributions(runID string) (*ContributionsCheckpoint, error) {
+	if s == nil {
+		return nil, errors.New("checkpoint store is nil")
+	}
+	normalizedRunID, err := NormalizeCheckpointRunID(runID)
+	if err != nil {
+		return nil, err
+	}
+	runID = normalizedRunID
+
+	runDir, err := s.safeRunDir(runID)
+	if err != nil {
+		return nil, err
+	}
+
+	filename := filepath.Join(runDir, checkpointContributionsFile)
+	data, err := readRegularCheckpointFile(filename, "contributions checkpoint file")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, os.ErrNotExist
+		}
+		return nil, err
+	}
+
+	var checkpoint ContributionsCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, fmt.Errorf("unmarshal contributions checkpoint: %w", err)
+	}
+	if checkpoint.RunID != runID {
+		return nil, fmt.Errorf("contributions checkpoint run ID mismatch: got %q, want %q", checkpoint.RunID, runID)
+	}
+
+	return &checkpoint, nil
+}
+
 // LoadCheckpoint loads a specific mode's checkpoint.
 func (s *CheckpointStore) LoadCheckpoint(runID, modeID string) (*ModeCheckpoint, error) {
 	if s == nil {
@@ -467,8 +554,8 @@ func (s *CheckpointStore) LoadAllCheckpoints(runID string) ([]ModeCheckpoint, er
 		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
 			continue
 		}
-		// Skip metadata and synthesis checkpoint files
-		if entry.Name() == checkpointMetaFile || entry.Name() == checkpointSynthesisFile {
+		// Skip metadata, synthesis and contributions checkpoint files
+		if entry.Name() == checkpointMetaFile || entry.Name() == checkpointSynthesisFile || entry.Name() == checkpointContributionsFile {
 			continue
 		}
 
@@ -772,6 +859,15 @@ func (m *CheckpointManager) RecordError(modeID string, err error) error {
 	return m.store.UpdateModeStatus(m.runID, modeID, string(AssignmentError))
 }
 
+// RecordPending moves a mode back to the pending list, e.g. when it is
+// re-injected during a resume.
+func (m *CheckpointManager) RecordPending(modeID string) error {
+	if m == nil || m.store == nil {
+		return errors.New("checkpoint manager is nil")
+	}
+	return m.store.UpdateModeStatus(m.runID, modeID, string(AssignmentPending))
+}
+
 // MarkComplete marks the run as complete and optionally removes checkpoints.
 func (m *CheckpointManager) MarkComplete(cleanup bool) error {
 	if m == nil || m.store == nil {
@@ -2,6 +2,7 @@ package ensemble
 
 import (
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -366,6 +367,47 @@ func TestCheckpointStore_LoadSynthesisCheckpoint_RejectsRunIDMismatch(t *testing
 	}
 }
 
+func TestCheckpointStore_ContributionsRoundTrip(t *testing.T) {
+	t.Logf("TEST: %s - starting", t.Name())
+
+	store, err := NewCheckpointStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewCheckpointStore failed: %v", err)
+	}
+
+	runID := "contrib-run"
+	if _, err := store.LoadContributions(runID); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("LoadContributions() before save error = %v, want os.ErrNotExist", err)
+	}
+	if err := store.SaveCheckpoint(runID, ModeCheckpoint{ModeID: "deductive", Status: string(AssignmentDone)}); err != nil {
+		t.Fatalf("SaveCheckpoint failed: %v", err)
+	}
+
+	report := &ContributionReport{TotalFindings: 7, Scores: []ContributionScore{{ModeID: "deductive", Score: 80, Rank: 1}}}
+	if err := store.SaveContributions(runID, ContributionsCheckpoint{OutputsHash: "abc", Report: report}); err != nil {
+		t.Fatalf("SaveContributions failed: %v", err)
+	}
+
+	loaded, err := store.LoadContributions(runID)
+	if err != nil {
+		t.Fatalf("LoadContributions failed: %v", err)
+	}
+	if loaded.RunID != runID || loaded.OutputsHash != "abc" || loaded.CreatedAt.IsZero() {
+		t.Errorf("loaded = %+v, want run %q hash abc with creation time", loaded, runID)
+	}
+	if loaded.Report == nil || loaded.Report.TotalFindings != 7 || len(loaded.Report.Scores) != 1 {
+		t.Errorf("loaded report = %+v, want the saved report", loaded.Report)
+	}
+
+	checkpoints, err := store.LoadAllCheckpoints(runID)
+	if err != nil {
+		t.Fatalf("LoadAllCheckpoints failed: %v", err)
+	}
+	if len(checkpoints) != 1 {
+		t.Errorf("LoadAllCheckpoints returned %d checkpoints, want only the mode checkpoint", len(checkpoints))
+	}
+}
+
 func TestCheckpointStore_SaveMetadata_RejectsSymlinkedRunDir(t *testing.T) {
 	t.Logf("TEST: %s - starting", t.Name())
 
@@ -287,6 +287,52 @@ func (c *OutputCollector) Reset() {
 	c.CollectedAt = time.Time{}
 }
 
+// RestrictTo drops collected outputs whose mode is not in modeIDs (matched
+// case-insensitively) and returns the mode IDs kept and dropped. It fails,
+// leaving the collector unchanged, when no IDs are given or a requested mode
+// has no collected output.
+func (c *OutputCollector) RestrictTo(modeIDs []string) (included, excluded []string, err error) {
+	if c == nil {
+		return nil, nil, errors.New("collector is nil")
+	}
+	wanted := make(map[string]bool, len(modeIDs))
+	for _, id := range modeIDs {
+		if id = strings.ToLower(strings.TrimSpace(id)); id != "" {
+			wanted[id] = true
+		}
+	}
+	if len(wanted) == 0 {
+		return nil, nil, errors.New("no mode IDs given")
+	}
+
+	found := make(map[string]bool, len(wanted))
+	kept := make([]ModeOutput, 0, len(wanted))
+	for _, output := range c.Outputs {
+		key := strings.ToLower(output.ModeID)
+		if wanted[key] {
+			found[key] = true
+			kept = append(kept, output)
+			included = append(included, output.ModeID)
+			continue
+		}
+		excluded = append(excluded, output.ModeID)
+	}
+
+	var missing []string
+	for id := range wanted {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, nil, fmt.Errorf("no collected output for mode(s): %s", strings.Join(missing, ", "))
+	}
+
+	c.Outputs = kept
+	return included, excluded, nil
+}
+
 // CollectFromSession uses OutputCapture to collect outputs from an EnsembleSession.
 func (c *OutputCollector) CollectFromSession(session *EnsembleSession, capture *OutputCapture) error {
 	if c == nil {
@@ -459,6 +505,58 @@ func CollectModeOutputs(session *EnsembleSession, captured []CapturedOutput) (*O
 	return collector, nil
 }
 
+// OutputVerification reports whether a single captured mode output parses
+// into a ModeOutput and satisfies the schema.
+type OutputVerification struct {
+	ModeID       string   `json:"mode_id" yaml:"mode_id"`
+	PaneName     string   `json:"pane_name,omitempty" yaml:"pane_name,omitempty"`
+	Parsed       bool     `json:"parsed" yaml:"parsed"`
+	Valid        bool     `json:"valid" yaml:"valid"`
+	FindingCount int      `json:"finding_count" yaml:"finding_count"`
+	Issues       []string `json:"issues,omitempty" yaml:"issues,omitempty"`
+}
+
+// VerifyCapturedOutputs checks each captured output for parseability and
+// schema issues without adding anything to a collector. Results preserve the
+// order of the captured slice.
+func VerifyCapturedOutputs(captured []CapturedOutput) []OutputVerification {
+	validator := NewSchemaValidator()
+	results := make([]OutputVerification, 0, len(captured))
+	for _, cap := range captured {
+		result := OutputVerification{
+			ModeID:   cap.ModeID,
+			PaneName: cap.PaneName,
+		}
+		if cap.Parsed != nil {
+			output := *cap.Parsed
+			if output.ModeID == "" {
+				output.ModeID = cap.ModeID
+			}
+			result.Parsed = true
+			result.FindingCount = len(output.TopFindings)
+			for _, verr := range validator.Validate(&output) {
+				result.Issues = append(result.Issues, verr.Error())
+			}
+		} else {
+			for _, err := range cap.ParseErrors {
+				if err != nil {
+					result.Issues = append(result.Issues, err.Error())
+				}
+			}
+			if len(result.Issues) == 0 {
+				if strings.TrimSpace(cap.RawOutput) == "" {
+					result.Issues = append(result.Issues, "empty output")
+				} else {
+					result.Issues = append(result.Issues, "no structured output block found")
+				}
+			}
+		}
+		result.Valid = result.Parsed && len(result.Issues) == 0
+		results = append(results, result)
+	}
+	return results
+}
+
 // normalizeOutput applies default values to zero-valued fields.
 // String-to-float conversion is handled by the schema validator during YAML/JSON parsing.
 func normalizeOutput(output *ModeOutput) {
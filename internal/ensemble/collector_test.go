@@ -98,6 +98,41 @@ func TestOutputCollector_Add_RequireAll(t *testing.T) {
 	}
 }
 
+func TestOutputCollector_RestrictTo(t *testing.T) {
+	collector := NewOutputCollector(DefaultOutputCollectorConfig())
+	for _, id := range []string{"deductive", "bayesian", "adversarial"} {
+		if err := collector.Add(ModeOutput{
+			ModeID:      id,
+			Thesis:      "thesis " + id,
+			TopFindings: []Finding{{Finding: "finding " + id, Impact: ImpactMedium, Confidence: 0.8}},
+			Confidence:  0.7,
+		}); err != nil {
+			t.Fatalf("Add(%s): %v", id, err)
+		}
+	}
+
+	if _, _, err := collector.RestrictTo([]string{"deductive", "missing"}); err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Fatalf("RestrictTo unknown mode error = %v, want mention of missing", err)
+	}
+	if collector.Count() != 3 {
+		t.Fatalf("Count after failed RestrictTo = %d, want 3", collector.Count())
+	}
+
+	included, excluded, err := collector.RestrictTo([]string{" Adversarial ", "deductive"})
+	if err != nil {
+		t.Fatalf("RestrictTo: %v", err)
+	}
+	if strings.Join(included, ",") != "deductive,adversarial" {
+		t.Errorf("included = %v, want [deductive adversarial]", included)
+	}
+	if strings.Join(excluded, ",") != "bayesian" {
+		t.Errorf("excluded = %v, want [bayesian]", excluded)
+	}
+	if collector.Count() != 2 {
+		t.Errorf("Count = %d, want 2", collector.Count())
+	}
+}
+
 func TestOutputCollector_AddRaw_ValidJSON(t *testing.T) {
 	cfg := DefaultOutputCollectorConfig()
 	collector := NewOutputCollector(cfg)
@@ -761,3 +796,40 @@ func TestOutputCollector_CollectFromSession_ValidatesInputs(t *testing.T) {
 		t.Fatal("expected error for nil collector")
 	}
 }
+
+func TestVerifyCapturedOutputs(t *testing.T) {
+	valid := &ModeOutput{
+		ModeID:      "deductive",
+		Thesis:      "A thesis",
+		Confidence:  0.7,
+		TopFindings: []Finding{{Finding: "f1", Impact: ImpactHigh, Confidence: 0.8}},
+	}
+	missingThesis := &ModeOutput{
+		ModeID:      "bayesian",
+		Confidence:  0.5,
+		TopFindings: []Finding{{Finding: "f1", Impact: ImpactLow, Confidence: 0.4}},
+	}
+	captured := []CapturedOutput{
+		{ModeID: "deductive", PaneName: "p1", Parsed: valid},
+		{ModeID: "bayesian", PaneName: "p2", Parsed: missingThesis},
+		{ModeID: "inductive", PaneName: "p3", RawOutput: "still thinking..."},
+		{ModeID: "abductive", PaneName: "p4", ParseErrors: []error{errors.New("yaml: bad indent")}},
+	}
+
+	results := VerifyCapturedOutputs(captured)
+	if len(results) != 4 {
+		t.Fatalf("len(results) = %d, want 4", len(results))
+	}
+	if !results[0].Valid || !results[0].Parsed || results[0].FindingCount != 1 {
+		t.Errorf("deductive = %+v, want valid with 1 finding", results[0])
+	}
+	if results[1].Valid || !results[1].Parsed || len(results[1].Issues) == 0 {
+		t.Errorf("bayesian = %+v, want parsed but invalid", results[1])
+	}
+	if results[2].Parsed || results[2].Valid || results[2].Issues[0] != "no structured output block found" {
+		t.Errorf("inductive = %+v, want unparseable", results[2])
+	}
+	if results[3].Issues[0] != "yaml: bad indent" {
+		t.Errorf("abductive issues = %v, want parse error", results[3].Issues)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"math"
 	"sort"
 	"strings"
 	"time"
@@ -215,6 +216,9 @@ type CompareInput struct {
 	// RunID is the identifier for this run.
 	RunID string
 
+	// Question is the question the run analyzed (optional).
+	Question string
+
 	// ModeIDs lists the modes used in this run (sorted).
 	ModeIDs []string
 
@@ -231,6 +235,63 @@ type CompareInput struct {
 	SynthesisOutput string
 }
 
+// MergedModeSource records which run supplied a mode's output in a merge.
+type MergedModeSource struct {
+	ModeID     string     `json:"mode_id" yaml:"mode_id"`
+	Run        string     `json:"run" yaml:"run"`
+	Confidence Confidence `json:"confidence" yaml:"confidence"`
+	// Conflict is true when both runs produced this mode.
+	Conflict bool `json:"conflict,omitempty" yaml:"conflict,omitempty"`
+}
+
+// MergeRunOutputs combines the outputs of two runs, keeping one output per
+// mode. When both runs produced a mode, the higher-confidence output wins and
+// ties go to run A. Results are sorted by mode ID.
+func MergeRunOutputs(runA, runB CompareInput) ([]ModeOutput, []MergedModeSource) {
+	type pick struct {
+		output   ModeOutput
+		run      string
+		conflict bool
+	}
+	picks := make(map[string]*pick)
+	add := func(run string, outputs []ModeOutput) {
+		for _, out := range outputs {
+			existing, ok := picks[out.ModeID]
+			if !ok {
+				picks[out.ModeID] = &pick{output: out, run: run}
+				continue
+			}
+			existing.conflict = true
+			if out.Confidence > existing.output.Confidence {
+				existing.output = out
+				existing.run = run
+			}
+		}
+	}
+	add(runA.RunID, runA.Outputs)
+	add(runB.RunID, runB.Outputs)
+
+	modeIDs := make([]string, 0, len(picks))
+	for modeID := range picks {
+		modeIDs = append(modeIDs, modeID)
+	}
+	sort.Strings(modeIDs)
+
+	merged := make([]ModeOutput, 0, len(modeIDs))
+	sources := make([]MergedModeSource, 0, len(modeIDs))
+	for _, modeID := range modeIDs {
+		p := picks[modeID]
+		merged = append(merged, p.output)
+		sources = append(sources, MergedModeSource{
+			ModeID:     modeID,
+			Run:        p.run,
+			Confidence: p.output.Confidence,
+			Conflict:   p.conflict,
+		})
+	}
+	return merged, sources
+}
+
 // Compare computes the deterministic diff between two ensemble runs.
 func Compare(runA, runB CompareInput) *ComparisonResult {
 	result := &ComparisonResult{
@@ -721,3 +782,143 @@ func (r *ComparisonResult) HasConclusionChanges() bool {
 func (r *ComparisonResult) HasContributionChanges() bool {
 	return len(r.ContributionDiff.ScoreDeltas) > 0 || len(r.ContributionDiff.RankChanges) > 0
 }
+
+// RunDiff reports how the synthesized findings of run B differ from run A.
+// Unlike Compare, which matches raw per-mode findings by stable ID, RunDiff
+// merges each run's outputs first and matches the merged findings across
+// runs by normalized text similarity, so reworded or re-attributed findings
+// still line up.
+type RunDiff struct {
+	RunA string `json:"run_a" yaml:"run_a"`
+	RunB string `json:"run_b" yaml:"run_b"`
+
+	// ModeDiff shows changes in mode composition.
+	ModeDiff ModeDiff `json:"mode_diff" yaml:"mode_diff"`
+
+	// Added lists merged findings of B with no similar finding in A.
+	Added []MergedFinding `json:"added,omitempty" yaml:"added,omitempty"`
+
+	// Removed lists merged findings of A with no similar finding in B.
+	Removed []MergedFinding `json:"removed,omitempty" yaml:"removed,omitempty"`
+
+	// Matched lists findings present in both runs with their confidence delta.
+	Matched []FindingConfidenceDelta `json:"matched,omitempty" yaml:"matched,omitempty"`
+
+	AddedCount     int `json:"added_count" yaml:"added_count"`
+	RemovedCount   int `json:"removed_count" yaml:"removed_count"`
+	ChangedCount   int `json:"changed_count" yaml:"changed_count"`
+	UnchangedCount int `json:"unchanged_count" yaml:"unchanged_count"`
+}
+
+// FindingConfidenceDelta pairs a finding of run A with its match in run B.
+type FindingConfidenceDelta struct {
+	TextA       string     `json:"text_a" yaml:"text_a"`
+	TextB       string     `json:"text_b" yaml:"text_b"`
+	Similarity  float64    `json:"similarity" yaml:"similarity"`
+	ConfidenceA Confidence `json:"confidence_a" yaml:"confidence_a"`
+	ConfidenceB Confidence `json:"confidence_b" yaml:"confidence_b"`
+	Delta       float64    `json:"delta" yaml:"delta"`
+}
+
+// runDiffConfidenceEpsilon is the smallest confidence delta counted as a change.
+const runDiffConfidenceEpsilon = 0.005
+
+// Changed reports whether the confidence moved by more than rounding noise.
+func (d FindingConfidenceDelta) Changed() bool {
+	return math.Abs(d.Delta) > runDiffConfidenceEpsilon
+}
+
+// DiffRuns merges each run's outputs with DefaultMergeConfig and matches the
+// merged findings across runs using the merge deduplication similarity. Each
+// finding matches at most one finding of the other run; the most similar
+// pairs are matched first.
+func DiffRuns(runA, runB CompareInput) RunDiff {
+	cfg := DefaultMergeConfig()
+	findingsA := MergeOutputs(runA.Outputs, cfg).Findings
+	findingsB := MergeOutputs(runB.Outputs, cfg).Findings
+
+	diff := RunDiff{
+		RunA:     runA.RunID,
+		RunB:     runB.RunID,
+		ModeDiff: compareModes(runA.ModeIDs, runB.ModeIDs),
+	}
+
+	type candidate struct {
+		a, b       int
+		similarity float64
+	}
+	tokensB := make([]map[string]struct{}, len(findingsB))
+	for j, f := range findingsB {
+		tokensB[j] = tokenize(normalizeText(f.Finding.Finding))
+	}
+	var candidates []candidate
+	for i, fa := range findingsA {
+		tokensA := tokenize(normalizeText(fa.Finding.Finding))
+		for j := range findingsB {
+			if similarity := jaccardSimilarity(tokensA, tokensB[j]); similarity >= cfg.DeduplicationThreshold {
+				candidates = append(candidates, candidate{a: i, b: j, similarity: similarity})
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].similarity > candidates[j].similarity
+	})
+
+	matchedA := make([]bool, len(findingsA))
+	matchedB := make([]bool, len(findingsB))
+	for _, c := range candidates {
+		if matchedA[c.a] || matchedB[c.b] {
+			continue
+		}
+		matchedA[c.a], matchedB[c.b] = true, true
+		fa, fb := findingsA[c.a].Finding, findingsB[c.b].Finding
+		match := FindingConfidenceDelta{
+			TextA:       fa.Finding,
+			TextB:       fb.Finding,
+			Similarity:  c.similarity,
+			ConfidenceA: fa.Confidence,
+			ConfidenceB: fb.Confidence,
+			Delta:       float64(fb.Confidence) - float64(fa.Confidence),
+		}
+		diff.Matched = append(diff.Matched, match)
+		if match.Changed() {
+			diff.ChangedCount++
+		} else {
+			diff.UnchangedCount++
+		}
+	}
+	for i, f := range findingsA {
+		if !matchedA[i] {
+			diff.Removed = append(diff.Removed, f)
+		}
+	}
+	for j, f := range findingsB {
+		if !matchedB[j] {
+			diff.Added = append(diff.Added, f)
+		}
+	}
+
+	// Largest confidence swings first; ties keep merge ranking order.
+	sort.SliceStable(diff.Matched, func(i, j int) bool {
+		return math.Abs(diff.Matched[i].Delta) > math.Abs(diff.Matched[j].Delta)
+	})
+
+	diff.AddedCount = len(diff.Added)
+	diff.RemovedCount = len(diff.Removed)
+
+	slog.Debug("ensemble run diff computed",
+		"run_a", diff.RunA,
+		"run_b", diff.RunB,
+		"added", diff.AddedCount,
+		"removed", diff.RemovedCount,
+		"changed", diff.ChangedCount,
+	)
+	return diff
+}
+
+// Summary returns the one-line counts shown at the top of `ensemble diff`.
+func (d RunDiff) Summary() string {
+	return fmt.Sprintf("%d added, %d removed, %d changed findings (%d unchanged); %d mode(s) added, %d removed",
+		d.AddedCount, d.RemovedCount, d.ChangedCount, d.UnchangedCount,
+		d.ModeDiff.AddedCount, d.ModeDiff.RemovedCount)
+}
@@ -2,6 +2,7 @@ package ensemble
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 )
@@ -731,3 +732,107 @@ func TestAbsInt(t *testing.T) {
 		}
 	}
 }
+
+func TestMergeRunOutputs(t *testing.T) {
+	t.Log("TEST: TestMergeRunOutputs - starting")
+
+	runA := CompareInput{
+		RunID: "run-a",
+		Outputs: []ModeOutput{
+			{ModeID: "mode-a", Thesis: "A from run A", Confidence: 0.6},
+			{ModeID: "mode-b", Thesis: "B from run A", Confidence: 0.9},
+			{ModeID: "mode-tie", Thesis: "tie from run A", Confidence: 0.5},
+		},
+	}
+	runB := CompareInput{
+		RunID: "run-b",
+		Outputs: []ModeOutput{
+			{ModeID: "mode-a", Thesis: "A from run B", Confidence: 0.8},
+			{ModeID: "mode-b", Thesis: "B from run B", Confidence: 0.4},
+			{ModeID: "mode-c", Thesis: "C from run B", Confidence: 0.7},
+			{ModeID: "mode-tie", Thesis: "tie from run B", Confidence: 0.5},
+		},
+	}
+
+	merged, sources := MergeRunOutputs(runA, runB)
+	if len(merged) != 4 || len(sources) != 4 {
+		t.Fatalf("merged %d outputs / %d sources, want 4", len(merged), len(sources))
+	}
+
+	want := []struct {
+		modeID   string
+		run      string
+		thesis   string
+		conflict bool
+	}{
+		{"mode-a", "run-b", "A from run B", true},
+		{"mode-b", "run-a", "B from run A", true},
+		{"mode-c", "run-b", "C from run B", false},
+		{"mode-tie", "run-a", "tie from run A", true},
+	}
+	for i, w := range want {
+		if merged[i].ModeID != w.modeID || merged[i].Thesis != w.thesis {
+			t.Errorf("merged[%d] = %s/%q, want %s/%q", i, merged[i].ModeID, merged[i].Thesis, w.modeID, w.thesis)
+		}
+		if sources[i].Run != w.run || sources[i].Conflict != w.conflict {
+			t.Errorf("sources[%d] = %+v, want run=%s conflict=%v", i, sources[i], w.run, w.conflict)
+		}
+	}
+
+	t.Log("TEST: TestMergeRunOutputs - assertion: higher confidence wins, ties keep run A")
+}
+
+func TestDiffRunsMatchesFindingsBySimilarity(t *testing.T) {
+	runA := CompareInput{
+		RunID:   "run-a",
+		ModeIDs: []string{"deductive", "systems"},
+		Outputs: []ModeOutput{
+			{ModeID: "deductive", Confidence: 0.8, TopFindings: []Finding{
+				{Finding: "The cache layer has no eviction policy", Impact: ImpactHigh, Confidence: 0.6},
+				{Finding: "Retry loop ignores context cancellation", Impact: ImpactMedium, Confidence: 0.7},
+			}},
+			{ModeID: "systems", Confidence: 0.8, TopFindings: []Finding{
+				{Finding: "Logging is too verbose in hot paths", Impact: ImpactLow, Confidence: 0.5},
+			}},
+		},
+	}
+	runB := CompareInput{
+		RunID:   "run-b",
+		ModeIDs: []string{"deductive", "bayesian"},
+		Outputs: []ModeOutput{
+			{ModeID: "deductive", Confidence: 0.8, TopFindings: []Finding{
+				// Same finding with slightly different wording and higher confidence.
+				{Finding: "The cache layer has no eviction policy at all", Impact: ImpactHigh, Confidence: 0.9},
+				{Finding: "Retry loop ignores context cancellation", Impact: ImpactMedium, Confidence: 0.7},
+			}},
+			{ModeID: "bayesian", Confidence: 0.8, TopFindings: []Finding{
+				{Finding: "Config reload races with request handling", Impact: ImpactHigh, Confidence: 0.75},
+			}},
+		},
+	}
+
+	diff := DiffRuns(runA, runB)
+
+	if strings.Join(diff.ModeDiff.Added, ",") != "bayesian" || strings.Join(diff.ModeDiff.Removed, ",") != "systems" {
+		t.Fatalf("mode diff = %+v", diff.ModeDiff)
+	}
+	if diff.AddedCount != 1 || diff.Added[0].Finding.Finding != "Config reload races with request handling" {
+		t.Fatalf("added = %+v", diff.Added)
+	}
+	if diff.RemovedCount != 1 || diff.Removed[0].Finding.Finding != "Logging is too verbose in hot paths" {
+		t.Fatalf("removed = %+v", diff.Removed)
+	}
+	if diff.ChangedCount != 1 || diff.UnchangedCount != 1 {
+		t.Fatalf("changed = %d, unchanged = %d, want 1 and 1", diff.ChangedCount, diff.UnchangedCount)
+	}
+	top := diff.Matched[0]
+	if !top.Changed() || top.TextA != "The cache layer has no eviction policy" {
+		t.Fatalf("largest delta first, got %+v", top)
+	}
+	if top.Delta < 0.29 || top.Delta > 0.31 {
+		t.Fatalf("delta = %v, want ~0.3", top.Delta)
+	}
+	if got := diff.Summary(); !strings.HasPrefix(got, "1 added, 1 removed, 1 changed findings") {
+		t.Fatalf("summary = %q", got)
+	}
+}
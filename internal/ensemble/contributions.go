@@ -1,6 +1,8 @@
 package ensemble
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -65,6 +67,20 @@ type ContributionReport struct {
 	DiversityScore float64 `json:"diversity_score" yaml:"diversity_score"`
 }
 
+// HashModeOutputs returns a stable hash of outputs, independent of their
+// order, used to tell whether a cached ContributionReport is still current.
+func HashModeOutputs(outputs []ModeOutput) (string, error) {
+	sorted := make([]ModeOutput, len(outputs))
+	copy(sorted, outputs)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].ModeID < sorted[j].ModeID })
+	data, err := json.Marshal(sorted)
+	if err != nil {
+		return "", fmt.Errorf("marshal outputs: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 // ContributionTracker accumulates contribution data during synthesis.
 type ContributionTracker struct {
 	// modeScores tracks per-mode statistics.
@@ -387,3 +403,75 @@ func TrackOriginalFindings(tracker *ContributionTracker, outputs []ModeOutput) {
 		}
 	}
 }
+
+// ContributionReportDiff compares the contribution reports of two runs of the
+// same question, typically one with an extra mode and one without, so the
+// added mode's cost can be weighed against what it contributed.
+type ContributionReportDiff struct {
+	TotalFindingsA     int     `json:"total_findings_a" yaml:"total_findings_a"`
+	TotalFindingsB     int     `json:"total_findings_b" yaml:"total_findings_b"`
+	TotalFindingsDelta int     `json:"total_findings_delta" yaml:"total_findings_delta"`
+	DedupedFindingsA   int     `json:"deduped_findings_a" yaml:"deduped_findings_a"`
+	DedupedFindingsB   int     `json:"deduped_findings_b" yaml:"deduped_findings_b"`
+	DedupedDelta       int     `json:"deduped_findings_delta" yaml:"deduped_findings_delta"`
+	OverlapRateA       float64 `json:"overlap_rate_a" yaml:"overlap_rate_a"`
+	OverlapRateB       float64 `json:"overlap_rate_b" yaml:"overlap_rate_b"`
+	OverlapDelta       float64 `json:"overlap_rate_delta" yaml:"overlap_rate_delta"`
+	DiversityScoreA    float64 `json:"diversity_score_a" yaml:"diversity_score_a"`
+	DiversityScoreB    float64 `json:"diversity_score_b" yaml:"diversity_score_b"`
+	DiversityDelta     float64 `json:"diversity_score_delta" yaml:"diversity_score_delta"`
+
+	// AddedModes are modes only in run B, with what they contributed there.
+	AddedModes []ContributionScore `json:"added_modes,omitempty" yaml:"added_modes,omitempty"`
+	// RemovedModes are modes only in run A, with what they contributed there.
+	RemovedModes []ContributionScore `json:"removed_modes,omitempty" yaml:"removed_modes,omitempty"`
+	// MarginalUniqueFindings is the unique findings of the added modes minus
+	// those of the removed modes.
+	MarginalUniqueFindings int `json:"marginal_unique_findings" yaml:"marginal_unique_findings"`
+}
+
+// DiffContributionReports computes B relative to A.
+func DiffContributionReports(a, b *ContributionReport) ContributionReportDiff {
+	if a == nil {
+		a = &ContributionReport{}
+	}
+	if b == nil {
+		b = &ContributionReport{}
+	}
+	diff := ContributionReportDiff{
+		TotalFindingsA:   a.TotalFindings,
+		TotalFindingsB:   b.TotalFindings,
+		DedupedFindingsA: a.DedupedFindings,
+		DedupedFindingsB: b.DedupedFindings,
+		OverlapRateA:     a.OverlapRate,
+		OverlapRateB:     b.OverlapRate,
+		DiversityScoreA:  a.DiversityScore,
+		DiversityScoreB:  b.DiversityScore,
+	}
+	diff.TotalFindingsDelta = b.TotalFindings - a.TotalFindings
+	diff.DedupedDelta = b.DedupedFindings - a.DedupedFindings
+	diff.OverlapDelta = b.OverlapRate - a.OverlapRate
+	diff.DiversityDelta = b.DiversityScore - a.DiversityScore
+
+	inA := make(map[string]bool, len(a.Scores))
+	for _, s := range a.Scores {
+		inA[s.ModeID] = true
+	}
+	inB := make(map[string]bool, len(b.Scores))
+	for _, s := range b.Scores {
+		inB[s.ModeID] = true
+		if !inA[s.ModeID] {
+			diff.AddedModes = append(diff.AddedModes, s)
+			diff.MarginalUniqueFindings += s.UniqueInsights
+		}
+	}
+	for _, s := range a.Scores {
+		if !inB[s.ModeID] {
+			diff.RemovedModes = append(diff.RemovedModes, s)
+			diff.MarginalUniqueFindings -= s.UniqueInsights
+		}
+	}
+	sort.Slice(diff.AddedModes, func(i, j int) bool { return diff.AddedModes[i].ModeID < diff.AddedModes[j].ModeID })
+	sort.Slice(diff.RemovedModes, func(i, j int) bool { return diff.RemovedModes[i].ModeID < diff.RemovedModes[j].ModeID })
+	return diff
+}
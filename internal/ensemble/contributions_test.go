@@ -424,3 +424,72 @@ func TestDefaultContributionConfig_WeightsSumToOne(t *testing.T) {
 
 	t.Logf("TEST: %s - assertion: weights sum to 1.0", t.Name())
 }
+
+func TestDiffContributionReports(t *testing.T) {
+	t.Logf("TEST: %s - starting", t.Name())
+
+	a := &ContributionReport{
+		TotalFindings:   6,
+		DedupedFindings: 5,
+		OverlapRate:     0.2,
+		DiversityScore:  0.5,
+		Scores: []ContributionScore{
+			{ModeID: "deductive", UniqueInsights: 3},
+			{ModeID: "inductive", UniqueInsights: 1},
+		},
+	}
+	b := &ContributionReport{
+		TotalFindings:   10,
+		DedupedFindings: 8,
+		OverlapRate:     0.25,
+		DiversityScore:  0.6,
+		Scores: []ContributionScore{
+			{ModeID: "deductive", UniqueInsights: 2},
+			{ModeID: "bayesian", UniqueInsights: 4},
+			{ModeID: "abductive", UniqueInsights: 0},
+		},
+	}
+
+	diff := DiffContributionReports(a, b)
+	if diff.TotalFindingsDelta != 4 || diff.DedupedDelta != 3 {
+		t.Fatalf("finding deltas = %d/%d, want 4/3", diff.TotalFindingsDelta, diff.DedupedDelta)
+	}
+	if diff.OverlapDelta < 0.049 || diff.OverlapDelta > 0.051 || diff.DiversityDelta < 0.099 || diff.DiversityDelta > 0.101 {
+		t.Fatalf("rate deltas = %v/%v", diff.OverlapDelta, diff.DiversityDelta)
+	}
+	if len(diff.AddedModes) != 2 || diff.AddedModes[0].ModeID != "abductive" || diff.AddedModes[1].ModeID != "bayesian" {
+		t.Fatalf("added modes = %+v", diff.AddedModes)
+	}
+	if len(diff.RemovedModes) != 1 || diff.RemovedModes[0].ModeID != "inductive" {
+		t.Fatalf("removed modes = %+v", diff.RemovedModes)
+	}
+	if diff.MarginalUniqueFindings != 3 {
+		t.Fatalf("marginal unique findings = %d, want 3 (4 added - 1 removed)", diff.MarginalUniqueFindings)
+	}
+}
+
+func TestHashModeOutputs(t *testing.T) {
+	a := ModeOutput{ModeID: "a", Thesis: "first"}
+	b := ModeOutput{ModeID: "b", Thesis: "second"}
+
+	h1, err := HashModeOutputs([]ModeOutput{a, b})
+	if err != nil {
+		t.Fatalf("HashModeOutputs: %v", err)
+	}
+	h2, err := HashModeOutputs([]ModeOutput{b, a})
+	if err != nil {
+		t.Fatalf("HashModeOutputs: %v", err)
+	}
+	if h1 != h2 {
+		t.Errorf("hash depends on order: %s != %s", h1, h2)
+	}
+
+	b.Thesis = "changed"
+	h3, err := HashModeOutputs([]ModeOutput{a, b})
+	if err != nil {
+		t.Fatalf("HashModeOutputs: %v", err)
+	}
+	if h3 == h1 {
+		t.Error("hash unchanged after an output changed")
+	}
+}
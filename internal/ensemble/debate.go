@@ -0,0 +1,156 @@
+package ensemble
+
+import (
+	"sort"
+	"strings"
+)
+
+// DebateReport is the output of the debate synthesis strategy: instead of
+// merging findings it lays out the issues on which modes take opposing
+// positions.
+type DebateReport struct {
+	Issues []DebateIssue `json:"issues" yaml:"issues"`
+}
+
+// DebateIssue is one contested claim with the modes arguing for and against.
+// Each side is ordered strongest (highest confidence) first.
+type DebateIssue struct {
+	Topic string           `json:"topic" yaml:"topic"`
+	Pro   []DebatePosition `json:"pro" yaml:"pro"`
+	Con   []DebatePosition `json:"con" yaml:"con"`
+}
+
+// DebatePosition is a single mode's finding on one side of an issue.
+type DebatePosition struct {
+	ModeID     string     `json:"mode_id" yaml:"mode_id"`
+	Claim      string     `json:"claim" yaml:"claim"`
+	Evidence   string     `json:"evidence,omitempty" yaml:"evidence,omitempty"`
+	Confidence Confidence `json:"confidence" yaml:"confidence"`
+}
+
+// debateTopicSimilarity is the minimum Jaccard similarity between two
+// findings' topic words (negations removed) for them to address the same issue.
+const debateTopicSimilarity = 0.5
+
+// negationWords flip a finding's stance. "t" catches contractions such as
+// "isn't" once normalizeText has split them.
+var negationWords = map[string]bool{
+	"not": true, "no": true, "never": true, "none": true, "nor": true,
+	"without": true, "cannot": true, "t": true, "lacks": true, "lack": true,
+}
+
+type debateClaim struct {
+	position DebatePosition
+	topic    map[string]struct{}
+	negated  bool
+}
+
+// BuildDebate groups findings from different modes that address the same
+// topic with opposite stances. A finding is "con" when it negates the topic
+// (not, never, isn't, ...) and "pro" otherwise. Issues are ordered by the
+// combined confidence of their strongest pro and con positions; topics on
+// which all modes agree are left out.
+func BuildDebate(outputs []ModeOutput) *DebateReport {
+	var claims []debateClaim
+	for _, output := range outputs {
+		for _, finding := range output.TopFindings {
+			text := strings.TrimSpace(finding.Finding)
+			if text == "" {
+				continue
+			}
+			topic, negated := debateTopic(text)
+			if len(topic) == 0 {
+				continue
+			}
+			claims = append(claims, debateClaim{
+				position: DebatePosition{
+					ModeID:     output.ModeID,
+					Claim:      text,
+					Evidence:   finding.EvidencePointer,
+					Confidence: finding.Confidence,
+				},
+				topic:   topic,
+				negated: negated,
+			})
+		}
+	}
+
+	// Greedily cluster claims by topic, seeding each cluster with the first
+	// unclaimed finding so the result is deterministic for a given input.
+	used := make([]bool, len(claims))
+	report := &DebateReport{Issues: []DebateIssue{}}
+	for i := range claims {
+		if used[i] {
+			continue
+		}
+		used[i] = true
+		cluster := []debateClaim{claims[i]}
+		for j := i + 1; j < len(claims); j++ {
+			if !used[j] && jaccardSimilarity(claims[i].topic, claims[j].topic) >= debateTopicSimilarity {
+				used[j] = true
+				cluster = append(cluster, claims[j])
+			}
+		}
+
+		var issue DebateIssue
+		for _, c := range cluster {
+			if c.negated {
+				issue.Con = append(issue.Con, c.position)
+			} else {
+				issue.Pro = append(issue.Pro, c.position)
+			}
+		}
+		if len(issue.Pro) == 0 || len(issue.Con) == 0 || !debateCrossesModes(issue) {
+			continue
+		}
+		sortDebatePositions(issue.Pro)
+		sortDebatePositions(issue.Con)
+		issue.Topic = issue.Pro[0].Claim
+		report.Issues = append(report.Issues, issue)
+	}
+
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		return debateStrength(report.Issues[i]) > debateStrength(report.Issues[j])
+	})
+	return report
+}
+
+// debateTopic returns the content words of text and whether it is negated.
+func debateTopic(text string) (map[string]struct{}, bool) {
+	negated := false
+	topic := make(map[string]struct{})
+	for word := range tokenize(normalizeText(text)) {
+		if negationWords[word] {
+			negated = !negated
+			continue
+		}
+		if len(word) < 3 || isStopWord(word) {
+			continue
+		}
+		topic[word] = struct{}{}
+	}
+	return topic, negated
+}
+
+// debateCrossesModes reports whether some mode on the pro side differs from
+// some mode on the con side; a mode contradicting only itself is not a debate.
+func debateCrossesModes(issue DebateIssue) bool {
+	for _, pro := range issue.Pro {
+		for _, con := range issue.Con {
+			if pro.ModeID != con.ModeID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sortDebatePositions(positions []DebatePosition) {
+	sort.SliceStable(positions, func(i, j int) bool {
+		return positions[i].Confidence > positions[j].Confidence
+	})
+}
+
+func debateStrength(issue DebateIssue) float64 {
+	return float64(issue.Pro[0].Confidence) + float64(issue.Con[0].Confidence)
+}
@@ -0,0 +1,101 @@
+package ensemble
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func debateOutputs() []ModeOutput {
+	return []ModeOutput{
+		{
+			ModeID: "deductive",
+			Thesis: "Ship the cache",
+			TopFindings: []Finding{
+				{Finding: "The cache layer is safe under concurrent writes", Impact: ImpactHigh, Confidence: 0.8, EvidencePointer: "cache.go:42"},
+				{Finding: "Startup time improves with lazy config loading", Impact: ImpactMedium, Confidence: 0.6},
+			},
+		},
+		{
+			ModeID: "adversarial-review",
+			Thesis: "Hold the cache",
+			TopFindings: []Finding{
+				{Finding: "The cache layer is not safe under concurrent writes", Impact: ImpactHigh, Confidence: 0.9, EvidencePointer: "cache_test.go:88"},
+				{Finding: "Startup time improves with lazy config loading", Impact: ImpactMedium, Confidence: 0.5},
+			},
+		},
+		{
+			ModeID: "systems-thinking",
+			Thesis: "Ship with a flag",
+			TopFindings: []Finding{
+				{Finding: "Cache layer safe under concurrent writes once the mutex lands", Impact: ImpactMedium, Confidence: 0.4},
+			},
+		},
+	}
+}
+
+func TestBuildDebateSurfacesBothSides(t *testing.T) {
+	report := BuildDebate(debateOutputs())
+	if len(report.Issues) != 1 {
+		t.Fatalf("issues = %+v, want one contested issue (agreement on startup time is not a debate)", report.Issues)
+	}
+	issue := report.Issues[0]
+	if len(issue.Pro) != 2 || issue.Pro[0].ModeID != "deductive" || issue.Pro[1].ModeID != "systems-thinking" {
+		t.Errorf("pro = %+v, want deductive then systems-thinking", issue.Pro)
+	}
+	if len(issue.Con) != 1 || issue.Con[0].ModeID != "adversarial-review" || issue.Con[0].Evidence != "cache_test.go:88" {
+		t.Errorf("con = %+v, want adversarial-review citing cache_test.go:88", issue.Con)
+	}
+	if issue.Topic != "The cache layer is safe under concurrent writes" {
+		t.Errorf("topic = %q", issue.Topic)
+	}
+}
+
+func TestBuildDebateIgnoresSelfContradiction(t *testing.T) {
+	outputs := []ModeOutput{{
+		ModeID: "deductive",
+		TopFindings: []Finding{
+			{Finding: "Retries are idempotent", Confidence: 0.7},
+			{Finding: "Retries are not idempotent", Confidence: 0.6},
+		},
+	}}
+	if report := BuildDebate(outputs); len(report.Issues) != 0 {
+		t.Errorf("issues = %+v, want none for a single mode", report.Issues)
+	}
+}
+
+func TestDebateStrategySynthesizeAndFormat(t *testing.T) {
+	synth, err := NewSynthesizer(SynthesisConfig{Strategy: StrategyDebate})
+	if err != nil {
+		t.Fatalf("NewSynthesizer(debate): %v", err)
+	}
+	result, err := synth.Synthesize(&SynthesisInput{Outputs: debateOutputs()})
+	if err != nil {
+		t.Fatalf("Synthesize: %v", err)
+	}
+	if result.Debate == nil || len(result.Debate.Issues) != 1 {
+		t.Fatalf("Debate = %+v, want one issue", result.Debate)
+	}
+
+	var buf bytes.Buffer
+	if err := NewSynthesisFormatter(FormatMarkdown).FormatResult(&buf, result, nil); err != nil {
+		t.Fatalf("FormatResult: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"## Debate",
+		"**Pro:**",
+		"**Con:**",
+		"**deductive** (80% confidence): The cache layer is safe under concurrent writes — evidence: `cache.go:42`",
+		"**adversarial-review** (90% confidence): The cache layer is not safe under concurrent writes — evidence: `cache_test.go:88`",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown missing %q:\n%s", want, out)
+		}
+	}
+
+	consensus, _ := NewSynthesizer(SynthesisConfig{Strategy: StrategyConsensus})
+	if other, _ := consensus.Synthesize(&SynthesisInput{Outputs: debateOutputs()}); other.Debate != nil {
+		t.Error("non-debate strategies should not attach a debate")
+	}
+}
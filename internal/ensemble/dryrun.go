@@ -56,12 +56,16 @@ func (m *EnsembleManager) DryRunEnsemble(ctx context.Context, cfg *EnsembleConfi
 	if err != nil {
 		return nil, fmt.Errorf("resolve config: %w", err)
 	}
+	if cfg.Seed != 0 {
+		modeIDs = ShuffleModeIDs(modeIDs, cfg.Seed)
+	}
 
 	plan := &DryRunPlan{
 		GeneratedAt: time.Now().UTC(),
 		SessionName: cfg.SessionName,
 		Question:    cfg.Question,
 		PresetUsed:  resolvedCfg.presetName,
+		Seed:        cfg.Seed,
 		Modes:       make([]DryRunMode, 0, len(modeIDs)),
 		Assignments: make([]DryRunAssign, 0, len(modeIDs)),
 		Validation:  DryRunValidation{Valid: true},
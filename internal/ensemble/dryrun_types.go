@@ -13,6 +13,7 @@ type DryRunPlan struct {
 	SessionName string           `json:"session_name"`
 	Question    string           `json:"question"`
 	PresetUsed  string           `json:"preset_used,omitempty"`
+	Seed        int64            `json:"seed,omitempty"`
 	Modes       []DryRunMode     `json:"modes"`
 	Assignments []DryRunAssign   `json:"assignments"`
 	Budget      DryRunBudget     `json:"budget"`
@@ -311,6 +311,36 @@ func mergeBudgetDefaults(current, defaults BudgetConfig) BudgetConfig {
 	return current
 }
 
+const (
+	timeboxTokensPerSecond   = 25.0
+	timeboxMinRuntimeSeconds = 15.0
+)
+
+// estimateModeBudgetTokens is the output estimate spawn charges a mode
+// against MaxTotalTokens: its typical cost, capped at the per-mode limit.
+func estimateModeBudgetTokens(mode *ReasoningMode, perMode int) int {
+	tokens := estimateTypicalCost(mode)
+	if perMode > 0 && tokens > perMode {
+		tokens = perMode
+	}
+	return tokens
+}
+
+func estimateModeRuntime(mode *ReasoningMode) time.Duration {
+	if mode == nil {
+		return 0
+	}
+	tokens := estimateTypicalCost(mode)
+	if tokens <= 0 {
+		tokens = 2000
+	}
+	seconds := float64(tokens) / timeboxTokensPerSecond
+	if seconds < timeboxMinRuntimeSeconds {
+		seconds = timeboxMinRuntimeSeconds
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
 func modeValueScore(mode *ReasoningMode) float64 {
 	if mode == nil {
 		return 0.0
@@ -115,3 +115,33 @@ func TestEstimator_AlternativesSuggested(t *testing.T) {
 		t.Fatalf("expected alternative %s, got %s", cheaper.ID, alts[0].ID)
 	}
 }
+
+func TestEstimateModeBudgetTokens(t *testing.T) {
+	mode := &ReasoningMode{ID: "formal", Category: CategoryFormal, Tier: TierExperimental}
+	if got := estimateModeBudgetTokens(mode, 0); got != 4500 {
+		t.Errorf("uncapped estimate = %d, want 4500", got)
+	}
+	if got := estimateModeBudgetTokens(mode, 4000); got != 4000 {
+		t.Errorf("capped estimate = %d, want 4000", got)
+	}
+	if got := estimateModeBudgetTokens(nil, 4000); got != 0 {
+		t.Errorf("nil mode estimate = %d, want 0", got)
+	}
+}
+
+func TestParseOverBudgetPolicy(t *testing.T) {
+	for input, want := range map[string]OverBudgetPolicy{
+		"":        OverBudgetSkip,
+		"skip":    OverBudgetSkip,
+		" Allow ": OverBudgetAllow,
+		"ERROR":   OverBudgetError,
+	} {
+		got, err := ParseOverBudgetPolicy(input)
+		if err != nil || got != want {
+			t.Errorf("ParseOverBudgetPolicy(%q) = %q, %v; want %q", input, got, err, want)
+		}
+	}
+	if _, err := ParseOverBudgetPolicy("warn"); err == nil {
+		t.Error("expected error for unknown policy")
+	}
+}
@@ -60,6 +60,33 @@ func (f *SynthesisFormatter) FormatResult(w io.Writer, result *SynthesisResult,
 	}
 }
 
+// writeDebateMarkdown renders the pro/con sections of a debate synthesis.
+func writeDebateMarkdown(b *strings.Builder, debate *DebateReport) {
+	b.WriteString("## Debate\n\n")
+	if len(debate.Issues) == 0 {
+		b.WriteString("*No opposing positions found; the modes agree on their key findings.*\n\n")
+		return
+	}
+	b.WriteString(fmt.Sprintf("*%d contested issue(s)*\n\n", len(debate.Issues)))
+
+	writeSide := func(label string, positions []DebatePosition) {
+		b.WriteString(fmt.Sprintf("**%s:**\n", label))
+		for _, pos := range positions {
+			b.WriteString(fmt.Sprintf("- **%s** (%.0f%% confidence): %s", pos.ModeID, float64(pos.Confidence)*100, pos.Claim))
+			if pos.Evidence != "" {
+				b.WriteString(fmt.Sprintf(" — evidence: `%s`", pos.Evidence))
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+	for i, issue := range debate.Issues {
+		b.WriteString(fmt.Sprintf("### %d. %s\n\n", i+1, truncate(issue.Topic, 80)))
+		writeSide("Pro", issue.Pro)
+		writeSide("Con", issue.Con)
+	}
+}
+
 // formatJSON outputs the result as JSON.
 func (f *SynthesisFormatter) formatJSON(w io.Writer, result *SynthesisResult, audit *AuditReport) error {
 	output := struct {
@@ -116,6 +143,11 @@ func (f *SynthesisFormatter) formatMarkdown(w io.Writer, result *SynthesisResult
 	}
 	b.WriteString(fmt.Sprintf("**Overall Confidence:** %.0f%%\n\n", float64(result.Confidence)*100))
 
+	// Debate (debate strategy)
+	if result.Debate != nil {
+		writeDebateMarkdown(&b, result.Debate)
+	}
+
 	// Key Findings
 	if len(result.Findings) > 0 {
 		b.WriteString("## Key Findings\n\n")
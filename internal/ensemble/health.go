@@ -0,0 +1,170 @@
+package ensemble
+
+import (
+	"fmt"
+	"time"
+)
+
+// StuckRuntimeFactor is how many times its estimated runtime a mode may run
+// before it is reported as possibly stuck.
+const StuckRuntimeFactor = 3.0
+
+// ModeHealthState classifies a running mode against its runtime estimate.
+type ModeHealthState string
+
+const (
+	// ModeHealthy means the mode is within StuckRuntimeFactor of its estimate.
+	ModeHealthy ModeHealthState = "healthy"
+	// ModePossiblyStuck means the mode has run far longer than estimated.
+	ModePossiblyStuck ModeHealthState = "possibly_stuck"
+)
+
+// ModeHealth reports elapsed versus estimated runtime for one running mode.
+type ModeHealth struct {
+	ModeID    string        `json:"mode_id" yaml:"mode_id"`
+	PaneName  string        `json:"pane_name" yaml:"pane_name"`
+	Status    string        `json:"status" yaml:"status"`
+	Elapsed   time.Duration `json:"-" yaml:"-"`
+	Estimated time.Duration `json:"-" yaml:"-"`
+	// ElapsedSeconds and EstimatedSeconds carry Elapsed and Estimated in
+	// whole seconds for JSON and YAML.
+	ElapsedSeconds   int64           `json:"elapsed_seconds" yaml:"elapsed_seconds"`
+	EstimatedSeconds int64           `json:"estimated_seconds" yaml:"estimated_seconds"`
+	Ratio            float64         `json:"ratio" yaml:"ratio"`
+	Health           ModeHealthState `json:"health" yaml:"health"`
+	Suggestion       string          `json:"suggestion,omitempty" yaml:"suggestion,omitempty"`
+}
+
+// AssessModeHealth compares how long each injecting or active mode has been
+// running (since AssignedAt) with the timebox runtime estimate for that mode,
+// and flags modes past StuckRuntimeFactor times the estimate. Modes that have
+// finished or were never assigned a start time are not reported.
+func AssessModeHealth(state *EnsembleSession, catalog *ModeCatalog, now time.Time) []ModeHealth {
+	if state == nil {
+		return nil
+	}
+	var report []ModeHealth
+	for _, a := range state.Assignments {
+		if a.Status != AssignmentActive && a.Status != AssignmentInjecting {
+			continue
+		}
+		if a.AssignedAt.IsZero() {
+			continue
+		}
+		var mode *ReasoningMode
+		if catalog != nil {
+			mode = catalog.GetMode(a.ModeID)
+		}
+		if mode == nil {
+			mode = &ReasoningMode{ID: a.ModeID}
+		}
+		estimate := estimateModeRuntime(mode)
+		elapsed := max(now.Sub(a.AssignedAt), 0)
+
+		entry := ModeHealth{
+			ModeID:           a.ModeID,
+			PaneName:         a.PaneName,
+			Status:           a.Status.String(),
+			Elapsed:          elapsed.Round(time.Second),
+			Estimated:        estimate,
+			ElapsedSeconds:   int64(elapsed.Round(time.Second) / time.Second),
+			EstimatedSeconds: int64(estimate.Round(time.Second) / time.Second),
+			Health:           ModeHealthy,
+		}
+		if estimate > 0 {
+			entry.Ratio = elapsed.Seconds() / estimate.Seconds()
+		}
+		if entry.Ratio > StuckRuntimeFactor {
+			entry.Health = ModePossiblyStuck
+			entry.Suggestion = fmt.Sprintf("check pane %s or rerun: ntm ensemble rerun-mode %s %s", a.PaneName, state.SessionName, a.ModeID)
+		}
+		report = append(report, entry)
+	}
+	return report
+}
+
+// CompletionEstimate projects when every mode will have finished and
+// synthesis can run.
+type CompletionEstimate struct {
+	Remaining time.Duration `json:"-" yaml:"-"`
+	// RemainingSeconds is Remaining in whole seconds, for JSON and YAML.
+	RemainingSeconds int64     `json:"remaining_seconds" yaml:"remaining_seconds"`
+	ReadyAt          time.Time `json:"ready_at" yaml:"ready_at"`
+	// Unfinished counts modes that are pending, injecting, or active.
+	Unfinished int `json:"unfinished" yaml:"unfinished"`
+	// Calibration scales the runtime model by how long completed modes
+	// actually took relative to their estimates (1 when none have finished).
+	Calibration      float64   `json:"calibration" yaml:"calibration"`
+	CompletedSamples int       `json:"completed_samples" yaml:"completed_samples"`
+	Deadline         time.Time `json:"deadline,omitempty" yaml:"deadline,omitempty"`
+	CappedByDeadline bool      `json:"capped_by_deadline,omitempty" yaml:"capped_by_deadline,omitempty"`
+}
+
+// EstimateRemaining projects the time until all unfinished modes complete.
+// Each unfinished mode is charged its runtime estimate, scaled by the ratio of
+// actual to estimated runtime over modes that already finished, less the time
+// it has already run. Modes on different panes run concurrently and modes
+// sharing a pane run one after another, so the projection is the longest pane.
+// A positive totalTimeout caps the projection at the timebox deadline.
+func EstimateRemaining(state *EnsembleSession, catalog *ModeCatalog, totalTimeout time.Duration, now time.Time) CompletionEstimate {
+	estimate := CompletionEstimate{ReadyAt: now, Calibration: 1}
+	if state == nil {
+		return estimate
+	}
+
+	runtimeFor := func(modeID string) time.Duration {
+		var mode *ReasoningMode
+		if catalog != nil {
+			mode = catalog.GetMode(modeID)
+		}
+		if mode == nil {
+			mode = &ReasoningMode{ID: modeID}
+		}
+		return estimateModeRuntime(mode)
+	}
+
+	var actual, predicted time.Duration
+	for _, a := range state.Assignments {
+		if a.Status != AssignmentDone || a.AssignedAt.IsZero() || a.CompletedAt == nil {
+			continue
+		}
+		took := a.CompletedAt.Sub(a.AssignedAt)
+		if took <= 0 {
+			continue
+		}
+		actual += took
+		predicted += runtimeFor(a.ModeID)
+		estimate.CompletedSamples++
+	}
+	if actual > 0 && predicted > 0 {
+		estimate.Calibration = actual.Seconds() / predicted.Seconds()
+	}
+
+	lanes := make(map[string]time.Duration)
+	for _, a := range state.Assignments {
+		if a.Status.IsTerminal() {
+			continue
+		}
+		estimate.Unfinished++
+		left := time.Duration(float64(runtimeFor(a.ModeID)) * estimate.Calibration)
+		if !a.AssignedAt.IsZero() && (a.Status == AssignmentActive || a.Status == AssignmentInjecting) {
+			left -= now.Sub(a.AssignedAt)
+		}
+		lanes[a.PaneName] += max(left, 0)
+	}
+	for _, left := range lanes {
+		estimate.Remaining = max(estimate.Remaining, left)
+	}
+
+	if totalTimeout > 0 && !state.CreatedAt.IsZero() {
+		estimate.Deadline = state.CreatedAt.Add(totalTimeout)
+		if untilDeadline := max(estimate.Deadline.Sub(now), 0); estimate.Unfinished > 0 && estimate.Remaining > untilDeadline {
+			estimate.Remaining = untilDeadline
+			estimate.CappedByDeadline = true
+		}
+	}
+	estimate.Remaining = estimate.Remaining.Round(time.Second)
+	estimate.RemainingSeconds = int64(estimate.Remaining / time.Second)
+	estimate.ReadyAt = now.Add(estimate.Remaining)
+	return estimate
+}
@@ -0,0 +1,92 @@
+package ensemble
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAssessModeHealthFlagsModesPastThreeTimesEstimate(t *testing.T) {
+	now := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	catalog, err := NewModeCatalog([]ReasoningMode{
+		{ID: "deductive", Code: "A1", Name: "Deductive", Category: CategoryFormal, Tier: TierCore, ShortDesc: "d"},
+	}, "test")
+	if err != nil {
+		t.Fatalf("NewModeCatalog: %v", err)
+	}
+	// Formal core modes are estimated at 3000 tokens / 25 tok/s = 120s.
+	state := &EnsembleSession{
+		SessionName: "health",
+		Assignments: []ModeAssignment{
+			{ModeID: "deductive", PaneName: "health__cc_1", Status: AssignmentActive, AssignedAt: now.Add(-7 * time.Minute)},
+			{ModeID: "unknown", PaneName: "health__cc_2", Status: AssignmentActive, AssignedAt: now.Add(-time.Minute)},
+			{ModeID: "finished", PaneName: "health__cc_3", Status: AssignmentDone, AssignedAt: now.Add(-time.Hour)},
+		},
+	}
+
+	report := AssessModeHealth(state, catalog, now)
+	if len(report) != 2 {
+		t.Fatalf("len(report) = %d, want 2 (finished modes excluded)", len(report))
+	}
+	stuck := report[0]
+	if stuck.Estimated != 120*time.Second || stuck.Health != ModePossiblyStuck {
+		t.Fatalf("deductive health = %+v, want possibly stuck with 120s estimate", stuck)
+	}
+	if !strings.Contains(stuck.Suggestion, "rerun-mode health deductive") {
+		t.Fatalf("suggestion = %q", stuck.Suggestion)
+	}
+	data, err := json.Marshal(stuck)
+	if err != nil {
+		t.Fatalf("marshal health: %v", err)
+	}
+	if !strings.Contains(string(data), `"elapsed_seconds":420`) || !strings.Contains(string(data), `"estimated_seconds":120`) {
+		t.Fatalf("health JSON = %s, want elapsed_seconds 420 and estimated_seconds 120", data)
+	}
+	if report[1].Health != ModeHealthy || report[1].Estimated != 80*time.Second {
+		t.Fatalf("unknown mode health = %+v, want healthy with default 80s estimate", report[1])
+	}
+}
+
+func TestEstimateRemainingCalibratesAndRespectsDeadline(t *testing.T) {
+	now := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	catalog, err := NewModeCatalog([]ReasoningMode{
+		{ID: "deductive", Code: "A1", Name: "Deductive", Category: CategoryFormal, Tier: TierCore, ShortDesc: "d"},
+	}, "test")
+	if err != nil {
+		t.Fatalf("NewModeCatalog: %v", err)
+	}
+	// deductive is estimated at 120s but took 240s, so unknown modes (80s)
+	// are projected at 160s each.
+	completed := now.Add(-time.Minute)
+	state := &EnsembleSession{
+		SessionName: "eta",
+		CreatedAt:   now.Add(-10 * time.Minute),
+		Assignments: []ModeAssignment{
+			{ModeID: "deductive", PaneName: "eta__cc_1", Status: AssignmentDone, AssignedAt: completed.Add(-240 * time.Second), CompletedAt: &completed},
+			{ModeID: "running", PaneName: "eta__cc_2", Status: AssignmentActive, AssignedAt: now.Add(-time.Minute)},
+			{ModeID: "queued-a", PaneName: "eta__cc_3", Status: AssignmentPending},
+			{ModeID: "queued-b", PaneName: "eta__cc_3", Status: AssignmentPending},
+		},
+	}
+
+	est := EstimateRemaining(state, catalog, 0, now)
+	if est.Calibration != 2 || est.CompletedSamples != 1 || est.Unfinished != 3 {
+		t.Fatalf("estimate = %+v, want calibration 2 from 1 sample, 3 unfinished", est)
+	}
+	if est.Remaining != 320*time.Second || !est.ReadyAt.Equal(now.Add(320*time.Second)) {
+		t.Fatalf("remaining = %s, want 5m20s (two queued modes on one pane)", est.Remaining)
+	}
+	data, err := json.Marshal(est)
+	if err != nil {
+		t.Fatalf("marshal estimate: %v", err)
+	}
+	if !strings.Contains(string(data), `"remaining_seconds":320`) || strings.Contains(string(data), `"remaining":`) {
+		t.Fatalf("estimate JSON = %s, want remaining_seconds 320 and no nanosecond field", data)
+	}
+
+	capped := EstimateRemaining(state, catalog, 12*time.Minute, now)
+	if !capped.CappedByDeadline || capped.Remaining != 2*time.Minute {
+		t.Fatalf("capped estimate = %+v, want 2m capped by deadline", capped)
+	}
+}
@@ -19,6 +19,9 @@ type InjectionResult struct {
 	Duration    time.Duration `json:"duration"`
 	Error       string        `json:"error,omitempty"`
 	SentAt      time.Time     `json:"sent_at"`
+	// Prompt is the full text sent to the pane, kept out of JSON to avoid
+	// bloating batch results.
+	Prompt string `json:"-"`
 }
 
 // BatchInjectionResult tracks the results of a batch injection operation.
@@ -155,6 +158,7 @@ func (e *EnsembleInjector) InjectWithMode(
 		parts = append(parts, question)
 	}
 	prompt := strings.Join(parts, "\n\n")
+	result.Prompt = prompt
 
 	if err := e.BasicInjector.InjectPrompt(sessionPane, agentType, prompt); err != nil {
 		result.Success = false
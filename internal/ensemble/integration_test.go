@@ -465,12 +465,12 @@ func TestIntegration_OrchestratorToSynthesis(t *testing.T) {
 	}
 
 	// Step 5: Verify deprecated strategies migrate correctly
-	migrated, wasMigrated := MigrateStrategy("debate")
+	migrated, wasMigrated := MigrateStrategy("best-of")
 	if !wasMigrated {
-		t.Error("expected 'debate' to be deprecated")
+		t.Error("expected 'best-of' to be deprecated")
 	}
-	if migrated != "dialectical" {
-		t.Errorf("debate migration = %q, want dialectical", migrated)
+	if migrated != "prioritized" {
+		t.Errorf("best-of migration = %q, want prioritized", migrated)
 	}
 
 	// Step 6: Test strategy validation
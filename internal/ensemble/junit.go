@@ -0,0 +1,161 @@
+package ensemble
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// skippedErrorPrefix marks assignments the manager never injected because the
+// timebox or budget ran out (see markAssignmentsSkipped).
+const skippedErrorPrefix = "skipped"
+
+// isSkippedAssignment reports whether an errored assignment was skipped by the
+// timebox or budget rather than failing on its own.
+func isSkippedAssignment(a ModeAssignment) bool {
+	return a.Status == AssignmentError && strings.HasPrefix(a.Error, skippedErrorPrefix)
+}
+
+// ErroredModes splits errored assignments into genuine failures, returned as
+// mode IDs in assignment order, and the count of deliberate timebox or budget
+// skips.
+func ErroredModes(state *EnsembleSession) (failed []string, skipped int) {
+	if state == nil {
+		return nil, 0
+	}
+	for _, a := range state.Assignments {
+		switch {
+		case isSkippedAssignment(a):
+			skipped++
+		case a.Status == AssignmentError:
+			failed = append(failed, a.ModeID)
+		}
+	}
+	return failed, skipped
+}
+
+// JUnitTestSuites is the root element of a JUnit XML report.
+type JUnitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []JUnitTestSuite `xml:"testsuite"`
+}
+
+// JUnitTestSuite is one ensemble run.
+type JUnitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Time       string          `xml:"time,attr"`
+	Timestamp  string          `xml:"timestamp,attr,omitempty"`
+	Properties []JUnitProperty `xml:"properties>property,omitempty"`
+	TestCases  []JUnitTestCase `xml:"testcase"`
+}
+
+// JUnitProperty is a name/value pair attached to a suite.
+type JUnitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// JUnitTestCase is one mode assignment.
+type JUnitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *JUnitMessage `xml:"failure,omitempty"`
+	Skipped   *JUnitMessage `xml:"skipped,omitempty"`
+}
+
+// JUnitMessage carries the message of a failure or skip.
+type JUnitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// NewJUnitReport maps an ensemble run to a JUnit test suite: each mode is a
+// test case that passes when done, fails with its error when errored, and is
+// skipped when the timebox or budget skipped it or it has not finished yet.
+// Durations come from assignment timestamps; now bounds unfinished modes.
+func NewJUnitReport(state *EnsembleSession, now time.Time) (*JUnitTestSuites, error) {
+	if state == nil {
+		return nil, errors.New("ensemble session is nil")
+	}
+	suite := JUnitTestSuite{
+		Name:      "ensemble." + state.SessionName,
+		Tests:     len(state.Assignments),
+		TestCases: make([]JUnitTestCase, 0, len(state.Assignments)),
+	}
+	if !state.CreatedAt.IsZero() {
+		suite.Timestamp = state.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	suite.Properties = append(suite.Properties, JUnitProperty{Name: "status", Value: state.Status.String()})
+	if state.PresetUsed != "" {
+		suite.Properties = append(suite.Properties, JUnitProperty{Name: "preset", Value: state.PresetUsed})
+	}
+	if strings.TrimSpace(state.Question) != "" {
+		suite.Properties = append(suite.Properties, JUnitProperty{Name: "question", Value: state.Question})
+	}
+
+	end := state.CreatedAt
+	for _, a := range state.Assignments {
+		finished := now
+		if a.CompletedAt != nil {
+			finished = *a.CompletedAt
+		}
+		if finished.After(end) {
+			end = finished
+		}
+		tc := JUnitTestCase{
+			Name:      a.ModeID,
+			ClassName: suite.Name + "." + a.AgentType,
+			Time:      junitSeconds(a.AssignedAt, finished),
+		}
+		switch {
+		case a.Status == AssignmentDone:
+		case isSkippedAssignment(a):
+			tc.Skipped = &JUnitMessage{Message: a.Error}
+			suite.Skipped++
+		case a.Status == AssignmentError:
+			msg := a.Error
+			if msg == "" {
+				msg = "mode failed"
+			}
+			tc.Failure = &JUnitMessage{Message: msg, Type: "error", Text: fmt.Sprintf("pane %s: %s", a.PaneName, msg)}
+			suite.Failures++
+		default:
+			tc.Skipped = &JUnitMessage{Message: "not finished: " + a.Status.String()}
+			suite.Skipped++
+		}
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+	suite.Time = junitSeconds(state.CreatedAt, end)
+
+	return &JUnitTestSuites{Suites: []JUnitTestSuite{suite}}, nil
+}
+
+// WriteJUnit writes the report as indented XML with a declaration header.
+func (r *JUnitTestSuites) WriteJUnit(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		return fmt.Errorf("encode junit: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitSeconds(start, end time.Time) string {
+	if start.IsZero() || end.Before(start) {
+		return "0.000"
+	}
+	return fmt.Sprintf("%.3f", end.Sub(start).Seconds())
+}
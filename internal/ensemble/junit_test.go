@@ -0,0 +1,87 @@
+package ensemble
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewJUnitReportMapsAssignmentOutcomes(t *testing.T) {
+	start := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	done := start.Add(90 * time.Second)
+	failed := start.Add(30 * time.Second)
+	skipped := start.Add(2 * time.Minute)
+	state := &EnsembleSession{
+		SessionName: "ci-run",
+		Question:    "Is it safe?",
+		Status:      EnsembleComplete,
+		CreatedAt:   start,
+		Assignments: []ModeAssignment{
+			{ModeID: "deductive", AgentType: "cc", Status: AssignmentDone, AssignedAt: start, CompletedAt: &done},
+			{ModeID: "abductive", AgentType: "cod", PaneName: "ci-run__cod_1", Status: AssignmentError, Error: "agent crashed", AssignedAt: start, CompletedAt: &failed},
+			{ModeID: "bayesian", AgentType: "gmi", Status: AssignmentError, Error: "skipped: total timeout reached before injection", AssignedAt: start, CompletedAt: &skipped},
+		},
+	}
+
+	report, err := NewJUnitReport(state, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("NewJUnitReport: %v", err)
+	}
+	suite := report.Suites[0]
+	if suite.Tests != 3 || suite.Failures != 1 || suite.Skipped != 1 {
+		t.Fatalf("suite counts = tests %d failures %d skipped %d", suite.Tests, suite.Failures, suite.Skipped)
+	}
+	if suite.Time != "120.000" || suite.Timestamp != "2026-05-01T12:00:00Z" {
+		t.Fatalf("suite timing = %q at %q", suite.Time, suite.Timestamp)
+	}
+	if tc := suite.TestCases[0]; tc.Time != "90.000" || tc.Failure != nil || tc.Skipped != nil {
+		t.Fatalf("done testcase = %+v", tc)
+	}
+	if tc := suite.TestCases[1]; tc.Failure == nil || tc.Failure.Message != "agent crashed" {
+		t.Fatalf("errored testcase = %+v", tc)
+	}
+	if tc := suite.TestCases[2]; tc.Skipped == nil || tc.Failure != nil {
+		t.Fatalf("skipped testcase = %+v", tc)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteJUnit(&buf); err != nil {
+		t.Fatalf("WriteJUnit: %v", err)
+	}
+	if !strings.HasPrefix(buf.String(), "<?xml") {
+		t.Fatalf("missing XML header: %q", buf.String())
+	}
+	var parsed JUnitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatalf("output is not valid XML: %v", err)
+	}
+	if len(parsed.Suites) != 1 || len(parsed.Suites[0].TestCases) != 3 {
+		t.Fatalf("round-tripped report = %+v", parsed)
+	}
+}
+
+func TestErroredModesExcludesDeliberateSkips(t *testing.T) {
+	state := &EnsembleSession{
+		Assignments: []ModeAssignment{
+			{ModeID: "deductive", Status: AssignmentDone},
+			{ModeID: "abductive", Status: AssignmentError, Error: "pane crashed"},
+			{ModeID: "bayesian", Status: AssignmentError, Error: "skipped: total timeout reached before injection"},
+			{ModeID: "causal", Status: AssignmentError},
+			{ModeID: "analogical", Status: AssignmentActive},
+		},
+	}
+
+	failed, skipped := ErroredModes(state)
+	if len(failed) != 2 || failed[0] != "abductive" || failed[1] != "causal" {
+		t.Fatalf("failed = %v, want [abductive causal]", failed)
+	}
+	if skipped != 1 {
+		t.Fatalf("skipped = %d, want 1", skipped)
+	}
+
+	if failed, skipped := ErroredModes(nil); failed != nil || skipped != 0 {
+		t.Fatalf("ErroredModes(nil) = %v, %d", failed, skipped)
+	}
+}
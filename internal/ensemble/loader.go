@@ -1,22 +1,34 @@
 package ensemble
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync"
 
 	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
 )
 
 // ModeLoader loads reasoning modes from multiple sources with precedence:
 // embedded < user (~/.config/ntm/modes.toml) < project (.ntm/modes.toml).
+// Custom YAML modes are added on top; unlike the TOML layers they may not
+// replace an existing mode.
 type ModeLoader struct {
 	// UserConfigDir is the user config directory (default: ~/.config/ntm).
 	UserConfigDir string
 	// ProjectDir is the project root (for .ntm/modes.toml).
 	ProjectDir string
+	// CustomModesDir holds custom mode definitions as *.yaml files
+	// (default: ~/.ntm/modes). A missing directory is not an error.
+	CustomModesDir string
+	// CustomModeFiles are additional YAML mode files (from --modes-file).
+	// Unlike CustomModesDir, every listed file must exist.
+	CustomModeFiles []string
 }
 
 // modesFile is the TOML structure for user/project mode files.
@@ -24,11 +36,38 @@ type modesFile struct {
 	Modes []ReasoningMode `toml:"modes"`
 }
 
+// customModesFile is the YAML structure for custom mode files holding more
+// than one mode. A file may also contain a single mode at the top level.
+type customModesFile struct {
+	Modes []ReasoningMode `yaml:"modes"`
+}
+
+var (
+	customModeFilesMu sync.RWMutex
+	customModeFiles   []string
+)
+
+// SetCustomModeFiles registers extra YAML mode files for loaders created by
+// NewModeLoader and clears the cached global catalog and ensemble registry so
+// the files take effect.
+func SetCustomModeFiles(paths []string) {
+	customModeFilesMu.Lock()
+	customModeFiles = append([]string(nil), paths...)
+	customModeFilesMu.Unlock()
+	ResetGlobalCatalog()
+	ResetGlobalEnsembleRegistry()
+}
+
 // NewModeLoader creates a loader with default paths.
 func NewModeLoader() *ModeLoader {
+	customModeFilesMu.RLock()
+	files := append([]string(nil), customModeFiles...)
+	customModeFilesMu.RUnlock()
 	return &ModeLoader{
-		UserConfigDir: defaultModeConfigDir(),
-		ProjectDir:    currentDir(),
+		UserConfigDir:   defaultModeConfigDir(),
+		ProjectDir:      currentDir(),
+		CustomModesDir:  defaultCustomModesDir(),
+		CustomModeFiles: files,
 	}
 }
 
@@ -44,6 +83,16 @@ func defaultModeConfigDir() string {
 	return filepath.Join(home, ".config", "ntm")
 }
 
+// defaultCustomModesDir returns ~/.ntm/modes, or "" when the home directory
+// is unknown.
+func defaultCustomModesDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return ""
+	}
+	return filepath.Join(home, ".ntm", "modes")
+}
+
 // currentDir returns the current working directory or empty string.
 func currentDir() string {
 	dir, _ := os.Getwd()
@@ -79,9 +128,127 @@ func (l *ModeLoader) Load() (*ModeCatalog, error) {
 		modes = append(modes, m)
 	}
 
+	custom, err := l.loadCustomModes(modes)
+	if err != nil {
+		return nil, err
+	}
+	if len(custom) > 0 {
+		slog.Default().Info("loaded custom reasoning modes", "count", len(custom))
+		modes = append(modes, custom...)
+	}
+
 	return NewModeCatalog(modes, CatalogVersion)
 }
 
+// loadCustomModes reads YAML modes from CustomModesDir and CustomModeFiles.
+// Each mode must set id, code, name, category, and tier, and may not reuse
+// the ID or code of an existing mode or of another custom mode.
+func (l *ModeLoader) loadCustomModes(existing []ReasoningMode) ([]ReasoningMode, error) {
+	var paths []string
+	if l.CustomModesDir != "" {
+		matches, err := filepath.Glob(filepath.Join(l.CustomModesDir, "*.yaml"))
+		if err != nil {
+			return nil, fmt.Errorf("custom modes (%s): %w", l.CustomModesDir, err)
+		}
+		paths = append(paths, matches...)
+	}
+	paths = append(paths, l.CustomModeFiles...)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	ids := make(map[string]string, len(existing))
+	codes := make(map[string]string, len(existing))
+	for _, m := range existing {
+		ids[m.ID] = "built-in mode"
+		if m.Code != "" {
+			codes[m.Code] = "built-in mode " + m.ID
+		}
+	}
+
+	var custom []ReasoningMode
+	for _, path := range paths {
+		modes, err := readCustomModesFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("custom modes (%s): %w", path, err)
+		}
+		for i := range modes {
+			m := modes[i]
+			if err := validateCustomMode(&m); err != nil {
+				return nil, fmt.Errorf("custom modes (%s): mode %q: %w", path, m.ID, err)
+			}
+			if owner, ok := ids[m.ID]; ok {
+				return nil, fmt.Errorf("custom modes (%s): mode ID %q already used by %s", path, m.ID, owner)
+			}
+			if owner, ok := codes[m.Code]; ok {
+				return nil, fmt.Errorf("custom modes (%s): mode code %q already used by %s", path, m.Code, owner)
+			}
+			ids[m.ID] = path
+			codes[m.Code] = path
+			m.Source = "custom"
+			custom = append(custom, m)
+		}
+	}
+	return custom, nil
+}
+
+// readCustomModesFile decodes either a single mode or a "modes" list.
+// Unknown fields are rejected so typos do not silently drop settings.
+func readCustomModesFile(path string) ([]ReasoningMode, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	var probe map[string]interface{}
+	if err := yaml.Unmarshal(data, &probe); err != nil {
+		return nil, fmt.Errorf("parse YAML: %w", err)
+	}
+	if len(probe) == 0 {
+		return nil, errors.New("file defines no modes")
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if _, ok := probe["modes"]; ok {
+		var file customModesFile
+		if err := dec.Decode(&file); err != nil {
+			return nil, fmt.Errorf("parse YAML: %w", err)
+		}
+		return file.Modes, nil
+	}
+	var mode ReasoningMode
+	if err := dec.Decode(&mode); err != nil {
+		return nil, fmt.Errorf("parse YAML: %w", err)
+	}
+	return []ReasoningMode{mode}, nil
+}
+
+// validateCustomMode requires the fields the embedded catalog always sets
+// (Validate allows code and tier to be empty) before running Validate.
+func validateCustomMode(m *ReasoningMode) error {
+	var missing []string
+	if m.ID == "" {
+		missing = append(missing, "id")
+	}
+	if m.Code == "" {
+		missing = append(missing, "code")
+	}
+	if m.Name == "" {
+		missing = append(missing, "name")
+	}
+	if m.Category == "" {
+		missing = append(missing, "category")
+	}
+	if m.Tier == "" {
+		missing = append(missing, "tier")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required field(s): %s", strings.Join(missing, ", "))
+	}
+	return m.Validate()
+}
+
 // mergeFromFile reads a TOML modes file and merges entries into the map.
 // Missing files are silently skipped. Invalid content returns an error.
 func (l *ModeLoader) mergeFromFile(merged map[string]ReasoningMode, path, source string) error {
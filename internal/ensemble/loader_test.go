@@ -580,3 +580,102 @@ func logCatalogStats(t *testing.T, catalog *ModeCatalog) {
 	}
 	t.Logf("mode samples: %v", samples)
 }
+
+func TestModeLoader_CustomYAMLModes(t *testing.T) {
+	dir := t.TempDir()
+	single := `
+id: threat-model
+code: K8
+name: Threat Modeling
+category: Domain
+tier: advanced
+short_desc: Enumerate attackers, assets, and entry points
+best_for: [security reviews]
+`
+	if err := os.WriteFile(filepath.Join(dir, "threat.yaml"), []byte(single), 0644); err != nil {
+		t.Fatal(err)
+	}
+	extra := filepath.Join(t.TempDir(), "extra.yaml")
+	list := `
+modes:
+  - id: incident-timeline
+    code: K9
+    name: Incident Timeline
+    category: Domain
+    tier: experimental
+    short_desc: Reconstruct an incident as an ordered timeline
+`
+	if err := os.WriteFile(extra, []byte(list), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	loader := &ModeLoader{
+		UserConfigDir:   "/nonexistent",
+		CustomModesDir:  dir,
+		CustomModeFiles: []string{extra},
+	}
+	catalog, err := loader.Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if catalog.Count() != 82 {
+		t.Errorf("catalog count = %d, want 82", catalog.Count())
+	}
+	m := catalog.GetModeByCode("K8")
+	if m == nil || m.ID != "threat-model" {
+		t.Fatalf("K8 = %+v, want threat-model", m)
+	}
+	if m.Source != "custom" || len(m.BestFor) != 1 {
+		t.Errorf("threat-model = %+v, want custom source with best_for", m)
+	}
+	if catalog.GetMode("incident-timeline") == nil {
+		t.Error("incident-timeline from modes file not loaded")
+	}
+}
+
+func TestModeLoader_CustomYAMLModesRejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "missing fields",
+			content: "id: half-done\nname: Half Done\ncategory: Domain\nshort_desc: Missing code and tier\n",
+			wantErr: "missing required field(s): code, tier",
+		},
+		{
+			name:    "duplicate id",
+			content: "id: deductive\ncode: K8\nname: Mine\ncategory: Domain\ntier: core\nshort_desc: Clash\n",
+			wantErr: `mode ID "deductive" already used`,
+		},
+		{
+			name:    "duplicate code",
+			content: "id: my-logic\ncode: A1\nname: Mine\ncategory: Formal\ntier: core\nshort_desc: Clash\n",
+			wantErr: `mode code "A1" already used`,
+		},
+		{
+			name:    "unknown field",
+			content: "id: typo\ncode: K8\nname: Typo\ncategory: Domain\ntier: core\nshortdesc: Oops\n",
+			wantErr: "field shortdesc not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "mode.yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			loader := &ModeLoader{UserConfigDir: "/nonexistent", CustomModeFiles: []string{path}}
+			_, err := loader.Load()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Load() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	loader := &ModeLoader{UserConfigDir: "/nonexistent", CustomModeFiles: []string{"/nonexistent/modes.yaml"}}
+	if _, err := loader.Load(); err == nil {
+		t.Error("expected error for missing --modes-file")
+	}
+}
@@ -48,6 +48,23 @@ type EnsembleConfig struct {
 	// CacheOverride forces Cache config to apply even when disabling.
 	CacheOverride bool
 	EarlyStop     EarlyStopConfig
+
+	// StrictBudget enforces Budget.MaxTotalTokens during injection by measuring
+	// the output of already-injected modes and skipping the rest once the cap
+	// would be exceeded.
+	StrictBudget bool
+
+	// OverBudget decides what happens when the modes' estimated tokens exceed
+	// Budget.MaxTotalTokens. Empty behaves as OverBudgetSkip.
+	OverBudget OverBudgetPolicy
+
+	// Seed, when non-zero, shuffles the resolved mode order before assignment
+	// so the mode-to-pane layout is varied but reproducible.
+	Seed int64
+
+	// WebhookURL, when set, receives a JSON POST each time a mode assignment
+	// changes status during the run. Delivery failures are only logged.
+	WebhookURL string
 }
 
 // EnsembleManager orchestrates ensemble session lifecycle steps.
@@ -99,6 +116,9 @@ func (m *EnsembleManager) SpawnEnsemble(ctx context.Context, cfg *EnsembleConfig
 	if cfg.Ensemble != "" && len(cfg.Modes) > 0 {
 		return nil, errors.New("ensemble name and explicit modes are mutually exclusive")
 	}
+	if !cfg.OverBudget.IsValid() {
+		return nil, fmt.Errorf("invalid over-budget policy %q: must be allow, skip, or error", cfg.OverBudget)
+	}
 
 	catalog, err := m.catalog()
 	if err != nil {
@@ -113,6 +133,20 @@ func (m *EnsembleManager) SpawnEnsemble(ctx context.Context, cfg *EnsembleConfig
 	if err != nil {
 		return nil, err
 	}
+	if cfg.Seed != 0 {
+		modeIDs = ShuffleModeIDs(modeIDs, cfg.Seed)
+		logger.Info("ensemble mode order shuffled", "session", cfg.SessionName, "seed", cfg.Seed, "modes", modeIDs)
+	}
+	if cfg.OverBudget == OverBudgetError && resolvedCfg.budget.MaxTotalTokens > 0 {
+		estimate := 0
+		for _, modeID := range modeIDs {
+			estimate += estimateModeBudgetTokens(catalog.GetMode(modeID), resolvedCfg.budget.MaxTokensPerMode)
+		}
+		if estimate > resolvedCfg.budget.MaxTotalTokens {
+			return nil, fmt.Errorf("estimated %d tokens for %d modes exceeds budget of %d (use --over-budget=skip or allow)",
+				estimate, len(modeIDs), resolvedCfg.budget.MaxTotalTokens)
+		}
+	}
 
 	state := &EnsembleSession{
 		SessionName:       cfg.SessionName,
@@ -122,6 +156,7 @@ func (m *EnsembleManager) SpawnEnsemble(ctx context.Context, cfg *EnsembleConfig
 		Status:            EnsembleSpawning,
 		SynthesisStrategy: resolvedCfg.synthesis.Strategy,
 		CreatedAt:         time.Now().UTC(),
+		Seed:              cfg.Seed,
 	}
 
 	if saveErr := SaveSession(cfg.SessionName, state); saveErr != nil {
@@ -237,23 +272,67 @@ func (m *EnsembleManager) SpawnEnsemble(ctx context.Context, cfg *EnsembleConfig
 		)
 	}
 
+	budgetLimit := resolvedCfg.budget.MaxTotalTokens
+	budgetExhausted := false
+	budgetEstimate := 0
+	if cfg.StrictBudget {
+		state.BudgetLimit = budgetLimit
+		logger.Info("ensemble strict budget enabled",
+			"session", cfg.SessionName,
+			"max_total_tokens", budgetLimit,
+			"max_tokens_per_mode", resolvedCfg.budget.MaxTokensPerMode,
+		)
+	}
+
+	webhook := newStatusWebhook(cfg.WebhookURL, logger)
+
 	for orderIndex, assignmentIndex := range order {
 		if timeboxExpired(deadline, time.Now()) {
-			skippedModes = append(skippedModes, markAssignmentsSkipped(
+			skipped := skipAssignments(ctx, webhook, cfg.SessionName,
 				state.Assignments,
 				order[orderIndex:],
 				"skipped: total timeout reached before injection",
-			)...)
+			)
+			skippedModes = append(skippedModes, skipped...)
 			break
 		}
+		if cfg.StrictBudget {
+			state.BudgetSpent = m.measureSpentTokens(state)
+			if strictBudgetExhausted(state.BudgetSpent, resolvedCfg.budget.MaxTokensPerMode, budgetLimit) {
+				budgetExhausted = true
+				skipped := skipAssignments(ctx, webhook, cfg.SessionName,
+					state.Assignments,
+					order[orderIndex:],
+					StrictBudgetSkipReason,
+				)
+				skippedModes = append(skippedModes, skipped...)
+				break
+			}
+		}
 
 		assignment := &state.Assignments[assignmentIndex]
 		mode := catalog.GetMode(assignment.ModeID)
+		modeEstimate := estimateModeBudgetTokens(mode, resolvedCfg.budget.MaxTokensPerMode)
+		if mode != nil && overBudgetCutoff(cfg.OverBudget, budgetLimit, budgetEstimate, modeEstimate) {
+			budgetExhausted = true
+			state.BudgetCutoff = budgetLimit
+			skipped := skipAssignments(ctx, webhook, cfg.SessionName, state.Assignments, order[orderIndex:], OverBudgetSkipReason)
+			skippedModes = append(skippedModes, skipped...)
+			logger.Info("ensemble estimated budget reached",
+				"session", cfg.SessionName,
+				"estimate", budgetEstimate,
+				"next_mode", assignment.ModeID,
+				"next_estimate", modeEstimate,
+				"limit", budgetLimit,
+			)
+			break
+		}
 		if mode == nil {
 			err := fmt.Errorf("mode not found: %s", assignment.ModeID)
 			assignment.Status = AssignmentError
 			assignment.Error = err.Error()
 			injectErrors = append(injectErrors, err)
+			webhook.notify(ctx, cfg.SessionName, assignment)
 			continue
 		}
 
@@ -263,6 +342,7 @@ func (m *EnsembleManager) SpawnEnsemble(ctx context.Context, cfg *EnsembleConfig
 		}
 
 		assignment.Status = AssignmentInjecting
+		webhook.notify(ctx, cfg.SessionName, assignment)
 		contextPack := sharedContext
 		if contextPack == nil && !cacheCfg.ShareAcrossModes {
 			if pack, err := contextGenerator.Generate(cfg.Question, assignment.ModeID, cacheCfg); err == nil {
@@ -280,6 +360,9 @@ func (m *EnsembleManager) SpawnEnsemble(ctx context.Context, cfg *EnsembleConfig
 			resolvedCfg.budget.MaxTokensPerMode,
 			"",
 		)
+		if injResult != nil && injResult.Prompt != "" {
+			assignment.Prompt = injResult.Prompt
+		}
 		if err != nil || injResult == nil || !injResult.Success {
 			assignment.Status = AssignmentError
 			if err != nil {
@@ -292,18 +375,24 @@ func (m *EnsembleManager) SpawnEnsemble(ctx context.Context, cfg *EnsembleConfig
 				assignment.Error = "inject failed"
 				injectErrors = append(injectErrors, fmt.Errorf("inject failed for %s", assignment.PaneName))
 			}
+			webhook.notify(ctx, cfg.SessionName, assignment)
 			continue
 		}
 
 		assignment.Status = AssignmentActive
+		webhook.notify(ctx, cfg.SessionName, assignment)
 		successes++
+		budgetEstimate += modeEstimate
 	}
+	state.BudgetEstimate = budgetEstimate
 
 	state.Status = spawnCompletionStatus(false, successes, len(injectErrors), len(skippedModes))
 	switch state.Status {
 	case EnsembleError:
 		if successes == 0 && len(injectErrors) > 0 {
 			state.Error = "all injections failed"
+		} else if successes == 0 && len(skippedModes) > 0 && budgetExhausted {
+			state.Error = "all injections skipped due to budget exhaustion"
 		} else if successes == 0 && len(skippedModes) > 0 {
 			state.Error = "all injections skipped due to timeout"
 		}
@@ -311,7 +400,26 @@ func (m *EnsembleManager) SpawnEnsemble(ctx context.Context, cfg *EnsembleConfig
 		state.Error = ""
 	}
 
-	if len(skippedModes) > 0 {
+	if cfg.StrictBudget {
+		state.BudgetSpent = m.measureSpentTokens(state)
+		logger.Info("ensemble strict budget summary",
+			"session", cfg.SessionName,
+			"spent", state.BudgetSpent,
+			"limit", budgetLimit,
+			"exhausted", budgetExhausted,
+		)
+	}
+
+	if budgetExhausted {
+		logger.Info("ensemble budget exhausted; skipping remaining modes",
+			"session", cfg.SessionName,
+			"skipped", skippedModes,
+			"completed", successes,
+			"total", len(state.Assignments),
+			"spent", state.BudgetSpent,
+			"limit", budgetLimit,
+		)
+	} else if len(skippedModes) > 0 {
 		logger.Info("ensemble timebox reached; skipping remaining modes",
 			"session", cfg.SessionName,
 			"skipped", skippedModes,
@@ -645,26 +753,6 @@ func modePriorityWeight(mode *ReasoningMode) int {
 	}
 }
 
-const (
-	timeboxTokensPerSecond   = 25.0
-	timeboxMinRuntimeSeconds = 15.0
-)
-
-func estimateModeRuntime(mode *ReasoningMode) time.Duration {
-	if mode == nil {
-		return 0
-	}
-	tokens := estimateTypicalCost(mode)
-	if tokens <= 0 {
-		tokens = 2000
-	}
-	seconds := float64(tokens) / timeboxTokensPerSecond
-	if seconds < timeboxMinRuntimeSeconds {
-		seconds = timeboxMinRuntimeSeconds
-	}
-	return time.Duration(seconds * float64(time.Second))
-}
-
 func modeValuePerSecond(mode *ReasoningMode) float64 {
 	if mode == nil {
 		return 0
@@ -690,6 +778,63 @@ func timeboxExpired(deadline, now time.Time) bool {
 	return !now.Before(deadline)
 }
 
+// measureSpentTokens captures the panes of already-injected modes and sums
+// the estimated tokens of their output so far.
+func (m *EnsembleManager) measureSpentTokens(state *EnsembleSession) int {
+	if state == nil {
+		return 0
+	}
+	injected := make([]ModeAssignment, 0, len(state.Assignments))
+	for _, assignment := range state.Assignments {
+		if assignment.Status == AssignmentActive || assignment.Status == AssignmentDone {
+			injected = append(injected, assignment)
+		}
+	}
+	if len(injected) == 0 {
+		return 0
+	}
+
+	capture := NewOutputCapture(m.tmuxClient())
+	captured, err := capture.CaptureAll(&EnsembleSession{
+		SessionName: state.SessionName,
+		Assignments: injected,
+	})
+	if err != nil {
+		m.logger().Warn("strict budget capture incomplete", "session", state.SessionName, "error", err)
+	}
+
+	total := 0
+	for _, output := range captured {
+		total += output.TokenEstimate
+	}
+	return total
+}
+
+// overBudgetCutoff reports whether policy stops injection before a mode whose
+// estimate next would push the running estimate past limit. The empty policy
+// is the default and behaves as OverBudgetSkip.
+func overBudgetCutoff(policy OverBudgetPolicy, limit, estimate, next int) bool {
+	if limit <= 0 || (policy != "" && policy != OverBudgetSkip) {
+		return false
+	}
+	return estimate+next > limit
+}
+
+// skipAssignments marks the pending assignments at indices as skipped and
+// reports each one to the status webhook, which may be nil.
+func skipAssignments(ctx context.Context, webhook *statusWebhook, session string, assignments []ModeAssignment, indices []int, reason string) []string {
+	skipped := markAssignmentsSkipped(assignments, indices, reason)
+	for _, modeID := range skipped {
+		webhook.send(ctx, AssignmentStatusEvent{
+			Session:   session,
+			ModeID:    modeID,
+			Status:    AssignmentError,
+			Timestamp: time.Now().UTC(),
+		})
+	}
+	return skipped
+}
+
 func markAssignmentsSkipped(assignments []ModeAssignment, indices []int, reason string) []string {
 	if reason == "" {
 		reason = "skipped"
@@ -822,23 +967,6 @@ func normalizeAssignment(value string) string {
 	return value
 }
 
-func buildPaneTargetMap(sessionName string, panes []tmux.Pane) map[string]string {
-	targets := make(map[string]string, len(panes))
-	for _, pane := range panes {
-		target := pane.ID
-		if target == "" {
-			target = swarm.GetPaneTarget(sessionName, pane.Index)
-		}
-		if pane.Title != "" {
-			targets[pane.Title] = target
-		}
-		if pane.ID != "" {
-			targets[pane.ID] = target
-		}
-	}
-	return targets
-}
-
 func isModeCode(value string) bool {
 	return modeCodeRegex.MatchString(strings.ToUpper(strings.TrimSpace(value)))
 }
@@ -4,6 +4,7 @@
 package ensemble
 
 import (
+	"context"
 	"testing"
 
 	"github.com/Dicklesworthstone/ntm/internal/tmux"
@@ -919,3 +920,65 @@ func TestSpawnCompletionStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestSkipAssignmentsWithoutWebhook(t *testing.T) {
+	t.Parallel()
+
+	assignments := []ModeAssignment{
+		{ModeID: "deductive", Status: AssignmentActive},
+		{ModeID: "bayesian", Status: AssignmentPending},
+		{ModeID: "adversarial", Status: AssignmentPending},
+	}
+	// The default config has no webhook URL; skipping must not dereference it.
+	hook := newStatusWebhook("", nil)
+	skipped := skipAssignments(context.Background(), hook, "ens-1", assignments, []int{1, 2}, "skipped: total timeout reached before injection")
+
+	if len(skipped) != 2 || skipped[0] != "bayesian" || skipped[1] != "adversarial" {
+		t.Fatalf("skipped = %v, want [bayesian adversarial]", skipped)
+	}
+	for _, a := range assignments[1:] {
+		if a.Status != AssignmentError || a.CompletedAt == nil {
+			t.Errorf("assignment %s = %+v, want skipped", a.ModeID, a)
+		}
+	}
+	if assignments[0].Status != AssignmentActive {
+		t.Errorf("active assignment changed to %s", assignments[0].Status)
+	}
+}
+
+func TestOverBudgetDefaultPolicySkipsWithoutWebhook(t *testing.T) {
+	t.Parallel()
+
+	assignments := []ModeAssignment{
+		{ModeID: "deductive", Status: AssignmentPending},
+		{ModeID: "bayesian", Status: AssignmentPending},
+		{ModeID: "adversarial", Status: AssignmentPending},
+	}
+	order := []int{0, 1, 2}
+	hook := newStatusWebhook("", nil)
+
+	var skipped []string
+	estimate := 0
+	for i, idx := range order {
+		// The zero-value policy is what spawn uses without --over-budget.
+		if overBudgetCutoff("", 9000, estimate, 4000) {
+			skipped = skipAssignments(context.Background(), hook, "ens-1", assignments, order[i:], OverBudgetSkipReason)
+			break
+		}
+		assignments[idx].Status = AssignmentActive
+		estimate += 4000
+	}
+
+	if len(skipped) != 1 || skipped[0] != "adversarial" {
+		t.Fatalf("skipped = %v, want [adversarial]", skipped)
+	}
+	if got := assignments[2]; got.Status != AssignmentError || got.Error != OverBudgetSkipReason {
+		t.Errorf("adversarial = %+v, want over-budget skip", got)
+	}
+	if overBudgetCutoff(OverBudgetAllow, 9000, 8000, 4000) {
+		t.Error("allow policy must not cut off")
+	}
+	if overBudgetCutoff("", 0, 8000, 4000) {
+		t.Error("zero limit must not cut off")
+	}
+}
@@ -0,0 +1,149 @@
+package ensemble
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// StrictBudgetSkipReason is recorded on modes never injected because
+// --strict-budget spend reached the cap.
+const StrictBudgetSkipReason = "skipped: budget exhausted"
+
+// ProgressUpdate reports the assignment transitions made by one
+// ProgressMonitor.Refresh.
+type ProgressUpdate struct {
+	// Completed lists modes whose output parsed and are now done.
+	Completed []string
+	// Skipped lists pending modes skipped because measured spend crossed
+	// the strict budget cap.
+	Skipped []string
+	// Spent is the measured token spend of injected modes (strict budget only).
+	Spent int
+
+	spentChanged bool
+}
+
+// Changed reports whether the refresh modified the session state.
+func (u ProgressUpdate) Changed() bool {
+	return len(u.Completed) > 0 || len(u.Skipped) > 0 || u.spentChanged
+}
+
+// ProgressMonitor advances active assignments of a running ensemble by
+// inspecting their panes. SpawnEnsemble returns once prompts are injected, so
+// callers that observe a run (ensemble status) use it to record completions
+// and to enforce --strict-budget against what the agents have actually
+// written since. It never touches the panes: modes already running are left
+// to finish.
+type ProgressMonitor struct {
+	// Capture reads and parses one mode's pane output.
+	Capture func(state *EnsembleSession, modeID string) (CapturedOutput, error)
+	// WebhookURL, when set, receives a status event for every transition
+	// (see EnsembleConfig.WebhookURL). Events are posted together once the
+	// refresh is done, under one shared timeout.
+	WebhookURL string
+	Logger     *slog.Logger
+}
+
+// Refresh captures every active mode and marks those whose output parses as
+// done. When the session has a strict budget (BudgetLimit), done modes are
+// captured too and their token estimates summed into BudgetSpent; once that
+// crosses the limit, pending modes are skipped with StrictBudgetSkipReason,
+// as the timebox does. state is updated in place; the caller persists it
+// when the returned update reports a change.
+func (p *ProgressMonitor) Refresh(ctx context.Context, state *EnsembleSession) (ProgressUpdate, error) {
+	var update ProgressUpdate
+	if state == nil {
+		return update, errors.New("ensemble session is nil")
+	}
+	if p.Capture == nil {
+		return update, errors.New("progress capture function is nil")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	logger := p.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	webhook := newStatusWebhook(p.WebhookURL, logger)
+	var events []AssignmentStatusEvent
+	notify := func(assignment *ModeAssignment) {
+		if webhook != nil {
+			events = append(events, webhook.event(state.SessionName, assignment))
+		}
+	}
+	defer func() { webhook.sendAll(ctx, events) }()
+	strict := state.BudgetLimit > 0
+
+	spent := 0
+	for i := range state.Assignments {
+		assignment := &state.Assignments[i]
+		if assignment.Status != AssignmentActive && (!strict || assignment.Status != AssignmentDone) {
+			continue
+		}
+		captured, err := p.Capture(state, assignment.ModeID)
+		if err != nil {
+			logger.Debug("ensemble progress capture failed", "session", state.SessionName, "mode_id", assignment.ModeID, "error", err)
+			continue
+		}
+		spent += captured.TokenEstimate
+		if assignment.Status != AssignmentActive || captured.Parsed == nil {
+			continue
+		}
+		completedAt := time.Now().UTC()
+		assignment.Status = AssignmentDone
+		assignment.CompletedAt = &completedAt
+		update.Completed = append(update.Completed, assignment.ModeID)
+		notify(assignment)
+	}
+	if !strict {
+		return update, nil
+	}
+
+	// A pane that could not be captured this time still spent its tokens,
+	// so the running total never goes down.
+	if spent > state.BudgetSpent {
+		state.BudgetSpent = spent
+		update.spentChanged = true
+	}
+	update.Spent = state.BudgetSpent
+	if !strictBudgetExhausted(state.BudgetSpent, 0, state.BudgetLimit) {
+		return update, nil
+	}
+
+	now := time.Now().UTC()
+	for i := range state.Assignments {
+		assignment := &state.Assignments[i]
+		if assignment.Status != AssignmentPending {
+			continue
+		}
+		assignment.Status = AssignmentError
+		assignment.Error = StrictBudgetSkipReason
+		assignment.CompletedAt = &now
+		update.Skipped = append(update.Skipped, assignment.ModeID)
+		notify(assignment)
+	}
+	if len(update.Skipped) > 0 {
+		logger.Info("ensemble strict budget exhausted; skipping remaining modes",
+			"session", state.SessionName,
+			"spent", state.BudgetSpent,
+			"limit", state.BudgetLimit,
+			"skipped", update.Skipped,
+		)
+	}
+	return update, nil
+}
+
+// strictBudgetExhausted reports whether injecting another mode (which may
+// spend up to perMode tokens) would push the running total past limit.
+func strictBudgetExhausted(spent, perMode, limit int) bool {
+	if limit <= 0 {
+		return false
+	}
+	if perMode < 0 {
+		perMode = 0
+	}
+	return spent+perMode > limit
+}
@@ -0,0 +1,204 @@
+package ensemble
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestProgressMonitorRefreshMarksDoneAndNotifies(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu     sync.Mutex
+		events []AssignmentStatusEvent
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event AssignmentStatusEvent
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		mu.Lock()
+		events = append(events, event)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	state := &EnsembleSession{
+		SessionName: "ens-1",
+		Assignments: []ModeAssignment{
+			{ModeID: "deductive", Status: AssignmentActive},
+			{ModeID: "bayesian", Status: AssignmentActive},
+			{ModeID: "adversarial", Status: AssignmentError},
+		},
+	}
+	monitor := &ProgressMonitor{
+		Capture: func(_ *EnsembleSession, modeID string) (CapturedOutput, error) {
+			switch modeID {
+			case "deductive":
+				return CapturedOutput{ModeID: modeID, Parsed: &ModeOutput{ModeID: modeID}}, nil
+			case "bayesian":
+				return CapturedOutput{ModeID: modeID}, nil
+			}
+			return CapturedOutput{}, errors.New("unexpected capture of " + modeID)
+		},
+		WebhookURL: srv.URL,
+	}
+
+	update, err := monitor.Refresh(context.Background(), state)
+	if err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if !update.Changed() || len(update.Completed) != 1 || update.Completed[0] != "deductive" {
+		t.Fatalf("update = %+v, want deductive completed", update)
+	}
+	if got := state.Assignments[0]; got.Status != AssignmentDone || got.CompletedAt == nil {
+		t.Errorf("deductive = %+v, want done", got)
+	}
+	if got := state.Assignments[1].Status; got != AssignmentActive {
+		t.Errorf("bayesian status = %s, want active", got)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(events) != 1 || events[0].ModeID != "deductive" || events[0].Status != AssignmentDone {
+		t.Fatalf("events = %+v, want one done event for deductive", events)
+	}
+}
+
+func TestProgressMonitorRefreshPostsEventsConcurrently(t *testing.T) {
+	t.Parallel()
+
+	const delay = 300 * time.Millisecond
+	var received atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		received.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	state := &EnsembleSession{SessionName: "ens-1"}
+	for _, id := range []string{"deductive", "bayesian", "adversarial", "abductive", "causal"} {
+		state.Assignments = append(state.Assignments, ModeAssignment{ModeID: id, Status: AssignmentActive})
+	}
+	monitor := &ProgressMonitor{
+		Capture: func(_ *EnsembleSession, modeID string) (CapturedOutput, error) {
+			return CapturedOutput{ModeID: modeID, Parsed: &ModeOutput{ModeID: modeID}}, nil
+		},
+		WebhookURL: srv.URL,
+	}
+
+	start := time.Now()
+	update, err := monitor.Refresh(context.Background(), state)
+	if err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	elapsed := time.Since(start)
+	if len(update.Completed) != 5 || received.Load() != 5 {
+		t.Fatalf("completed %d, webhook received %d; want 5 each", len(update.Completed), received.Load())
+	}
+	if elapsed >= 3*delay {
+		t.Errorf("Refresh took %v with a %v endpoint; events were posted one after another", elapsed, delay)
+	}
+}
+
+func TestProgressMonitorRefreshWithoutWebhook(t *testing.T) {
+	t.Parallel()
+
+	state := &EnsembleSession{
+		SessionName: "ens-1",
+		Assignments: []ModeAssignment{{ModeID: "deductive", Status: AssignmentActive}},
+	}
+	monitor := &ProgressMonitor{
+		Capture: func(_ *EnsembleSession, modeID string) (CapturedOutput, error) {
+			return CapturedOutput{ModeID: modeID, Parsed: &ModeOutput{ModeID: modeID}}, nil
+		},
+	}
+	if _, err := monitor.Refresh(context.Background(), state); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if state.Assignments[0].Status != AssignmentDone {
+		t.Errorf("status = %s, want done", state.Assignments[0].Status)
+	}
+	if _, err := (&ProgressMonitor{}).Refresh(context.Background(), state); err == nil {
+		t.Error("expected error without capture function")
+	}
+}
+
+func TestProgressMonitorRefreshSkipsPendingModesWhenSpendGrows(t *testing.T) {
+	t.Parallel()
+
+	// Spawn measured almost nothing right after injection; the agents keep
+	// writing afterwards and each refresh sees more output.
+	state := &EnsembleSession{
+		SessionName: "ens-1",
+		BudgetLimit: 5000,
+		BudgetSpent: 40,
+		Assignments: []ModeAssignment{
+			{ModeID: "deductive", Status: AssignmentActive},
+			{ModeID: "bayesian", Status: AssignmentActive},
+			{ModeID: "adversarial", Status: AssignmentPending},
+		},
+	}
+	perMode := map[string]int{"deductive": 1000, "bayesian": 1000}
+	monitor := &ProgressMonitor{
+		Capture: func(_ *EnsembleSession, modeID string) (CapturedOutput, error) {
+			captured := CapturedOutput{ModeID: modeID, TokenEstimate: perMode[modeID]}
+			if modeID == "deductive" && perMode[modeID] > 1000 {
+				captured.Parsed = &ModeOutput{ModeID: modeID}
+			}
+			return captured, nil
+		},
+	}
+
+	update, err := monitor.Refresh(context.Background(), state)
+	if err != nil {
+		t.Fatalf("first Refresh: %v", err)
+	}
+	if update.Spent != 2000 || state.BudgetSpent != 2000 || len(update.Skipped) != 0 || !update.Changed() {
+		t.Fatalf("first update = %+v (spent %d), want 2000 spent and nothing skipped", update, state.BudgetSpent)
+	}
+
+	perMode["deductive"] = 2500
+	perMode["bayesian"] = 3000
+	update, err = monitor.Refresh(context.Background(), state)
+	if err != nil {
+		t.Fatalf("second Refresh: %v", err)
+	}
+	if update.Spent != 5500 || state.BudgetSpent != 5500 {
+		t.Fatalf("spent = %d/%d, want 5500", update.Spent, state.BudgetSpent)
+	}
+	if len(update.Completed) != 1 || update.Completed[0] != "deductive" {
+		t.Errorf("completed = %v, want [deductive]", update.Completed)
+	}
+	if len(update.Skipped) != 1 || update.Skipped[0] != "adversarial" {
+		t.Fatalf("skipped = %v, want [adversarial]", update.Skipped)
+	}
+	if got := state.Assignments[1]; got.Status != AssignmentActive || got.Error != "" {
+		t.Errorf("bayesian = %+v, want left running", got)
+	}
+	if got := state.Assignments[2]; got.Status != AssignmentError || got.Error != StrictBudgetSkipReason {
+		t.Errorf("adversarial = %+v, want skipped by budget", got)
+	}
+	if failed, skipped := ErroredModes(state); len(failed) != 0 || skipped != 1 {
+		t.Errorf("ErroredModes = %v, %d; want budget cutoffs counted as skips", failed, skipped)
+	}
+
+	// Spend never decreases when a pane can no longer be captured.
+	perMode["deductive"] = 0
+	update, err = monitor.Refresh(context.Background(), state)
+	if err != nil {
+		t.Fatalf("third Refresh: %v", err)
+	}
+	if state.BudgetSpent != 5500 || update.Changed() {
+		t.Errorf("after shrinking capture spent = %d, changed = %v; want 5500, false", state.BudgetSpent, update.Changed())
+	}
+}
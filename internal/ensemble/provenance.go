@@ -442,6 +442,110 @@ func FormatProvenance(chain *ProvenanceChain) string {
 	return b.String()
 }
 
+// provenanceDOTColors maps impact levels to Graphviz fill colors.
+var provenanceDOTColors = map[ImpactLevel]string{
+	ImpactCritical: "#e06666",
+	ImpactHigh:     "#f4a3a3",
+	ImpactMedium:   "#ffd966",
+	ImpactLow:      "#b6d7a8",
+}
+
+// FormatProvenanceDOT renders chains as a Graphviz digraph. Each step is a
+// node filled by the finding's impact, consecutive steps are joined by edges
+// labeled with the source mode and confidence, and a merged finding's
+// absorbed step points at the merge step of the finding it joined.
+func FormatProvenanceDOT(chains []*ProvenanceChain) string {
+	var b strings.Builder
+	b.WriteString("digraph provenance {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	b.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n")
+
+	byID := make(map[string]*ProvenanceChain, len(chains))
+	for _, chain := range chains {
+		if chain != nil {
+			byID[chain.FindingID] = chain
+		}
+	}
+
+	for _, chain := range chains {
+		if chain == nil || len(chain.Steps) == 0 {
+			continue
+		}
+		color := provenanceDOTColors[chain.Impact]
+		if color == "" {
+			color = "#d9d9d9"
+		}
+		fmt.Fprintf(&b, "\n  subgraph %s {\n", dotQuote("cluster_"+chain.FindingID))
+		fmt.Fprintf(&b, "    label=%s;\n", dotQuote(chain.FindingID+" ("+string(chain.Impact)+")"))
+		for i, step := range chain.Steps {
+			label := step.Stage + ": " + step.Action
+			if i == 0 {
+				label += "\n" + truncateText(chain.OriginalText, 40)
+			}
+			fmt.Fprintf(&b, "    %s [label=%s, fillcolor=%s];\n",
+				dotQuote(provenanceDOTNode(chain.FindingID, i)), dotQuote(label), dotQuote(color))
+		}
+		edgeLabel := dotQuote(fmt.Sprintf("%s (%s)", chain.SourceMode, chain.Confidence))
+		for i := 1; i < len(chain.Steps); i++ {
+			fmt.Fprintf(&b, "    %s -> %s [label=%s];\n",
+				dotQuote(provenanceDOTNode(chain.FindingID, i-1)),
+				dotQuote(provenanceDOTNode(chain.FindingID, i)),
+				edgeLabel)
+		}
+		b.WriteString("  }\n")
+	}
+
+	for _, chain := range chains {
+		if chain == nil || chain.MergedInto == "" {
+			continue
+		}
+		primary, ok := byID[chain.MergedInto]
+		if !ok {
+			continue
+		}
+		from := provenanceStepIndex(chain, "absorbed", chain.MergedInto)
+		to := provenanceStepIndex(primary, "merged", chain.FindingID)
+		if from < 0 || to < 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "  %s -> %s [label=%s, style=dashed];\n",
+			dotQuote(provenanceDOTNode(chain.FindingID, from)),
+			dotQuote(provenanceDOTNode(primary.FindingID, to)),
+			dotQuote(fmt.Sprintf("%s (%s)", chain.SourceMode, chain.Confidence)))
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func provenanceDOTNode(findingID string, step int) string {
+	return fmt.Sprintf("%s_%d", findingID, step)
+}
+
+// provenanceStepIndex returns the index of the step with the given action that
+// references relatedID, or -1.
+func provenanceStepIndex(chain *ProvenanceChain, action, relatedID string) int {
+	for i, step := range chain.Steps {
+		if step.Action != action {
+			continue
+		}
+		for _, id := range step.RelatedIDs {
+			if id == relatedID {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// dotQuote returns s as a double-quoted DOT ID. Newlines become DOT's \n
+// line break so multi-line labels render as such.
+func dotQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r", "", "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
 // truncateText shortens text to maxLen characters with ellipsis.
 func truncateText(text string, maxLen int) string {
 	if len(text) <= maxLen {
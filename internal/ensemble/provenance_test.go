@@ -557,6 +557,34 @@ func TestFormatProvenance_WithCitations(t *testing.T) {
 	}
 }
 
+func TestFormatProvenanceDOT(t *testing.T) {
+	t.Parallel()
+	tracker := NewProvenanceTracker("q", []string{"m1", "m2"})
+	primary := tracker.RecordDiscovery("m1", Finding{Finding: `Cache "keys" leak`, Impact: ImpactHigh, Confidence: 0.9})
+	dup := tracker.RecordDiscovery("m2", Finding{Finding: "Cache keys leak", Impact: ImpactLow, Confidence: 0.6})
+	if err := tracker.RecordMerge(primary, []string{dup}, 0.85); err != nil {
+		t.Fatalf("RecordMerge: %v", err)
+	}
+	if err := tracker.RecordSynthesisCitation(primary, "synthesis:findings"); err != nil {
+		t.Fatalf("RecordSynthesisCitation: %v", err)
+	}
+
+	result := FormatProvenanceDOT(tracker.ListChains())
+	for _, want := range []string{
+		"digraph provenance {",
+		`"cluster_` + primary + `"`,
+		`"` + primary + `_0" [label="discovery: discovered\nCache \"keys\" leak", fillcolor="#f4a3a3"];`,
+		`"` + primary + `_0" -> "` + primary + `_1" [label="m1 (90%)"];`,
+		`"` + primary + `_1" -> "` + primary + `_2" [label="m1 (90%)"];`,
+		`fillcolor="#b6d7a8"`,
+		`"` + dup + `_1" -> "` + primary + `_1" [label="m2 (60%)", style=dashed];`,
+	} {
+		if !strContains(result, want) {
+			t.Errorf("DOT output missing %q:\n%s", want, result)
+		}
+	}
+}
+
 func TestProvenanceIndex_Full(t *testing.T) {
 	t.Parallel()
 
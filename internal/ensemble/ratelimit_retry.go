@@ -0,0 +1,303 @@
+package ensemble
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/ntm/internal/ratelimit"
+)
+
+const (
+	// rateLimitScanLines bounds detection to the tail of the pane so an old
+	// error above a later successful retry is not counted again.
+	rateLimitScanLines = 50
+	// rateLimitAnchorLines is how many non-blank lines, ending at the
+	// rate-limit error, are recorded as ModeAssignment.RateLimitAnchor.
+	rateLimitAnchorLines = 5
+	// defaultRateLimitBaseDelay is the backoff before the first re-injection.
+	defaultRateLimitBaseDelay = 30 * time.Second
+	// defaultRateLimitMaxDelay caps the exponential backoff.
+	defaultRateLimitMaxDelay = 10 * time.Minute
+)
+
+// RateLimitRetryPolicy controls re-injection of modes whose agents hit a
+// rate-limit or quota error.
+type RateLimitRetryPolicy struct {
+	// MaxRetries is how many re-injections a mode gets (BudgetConfig.MaxRetries).
+	MaxRetries int
+	// BaseDelay is the backoff before the first retry; it doubles per retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff.
+	MaxDelay time.Duration
+}
+
+// DefaultRateLimitRetryPolicy derives a retry policy from an ensemble budget.
+func DefaultRateLimitRetryPolicy(budget BudgetConfig) RateLimitRetryPolicy {
+	return RateLimitRetryPolicy{
+		MaxRetries: budget.MaxRetries,
+		BaseDelay:  defaultRateLimitBaseDelay,
+		MaxDelay:   defaultRateLimitMaxDelay,
+	}
+}
+
+// Backoff returns the wait before retry number retries+1. A wait hint parsed
+// from the agent output ("try again in 90s") is honoured when it is longer.
+func (p RateLimitRetryPolicy) Backoff(retries, waitHintSeconds int) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = defaultRateLimitBaseDelay
+	}
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultRateLimitMaxDelay
+	}
+	delay := base
+	for i := 0; i < retries && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxDelay)
+	if hint := time.Duration(waitHintSeconds) * time.Second; hint > delay {
+		delay = hint
+	}
+	return delay
+}
+
+// RateLimitedMode is an assignment whose captured output shows a rate limit.
+type RateLimitedMode struct {
+	AssignmentIndex int
+	Detection       ratelimit.RateLimitDetection
+	// Anchor marks the end of the output that was scanned; it becomes the
+	// assignment's RateLimitAnchor once the mode is re-injected.
+	Anchor string
+}
+
+// DetectRateLimitedModes finds assignments that are still running, produced
+// no parsed output, and whose recent pane output carries a rate-limit or
+// quota signature. Output up to a mode's RateLimitAnchor was already handled
+// by an earlier retry and is not scanned again.
+func DetectRateLimitedModes(state *EnsembleSession, captured []CapturedOutput) []RateLimitedMode {
+	if state == nil {
+		return nil
+	}
+	byMode := make(map[string]CapturedOutput, len(captured))
+	for _, c := range captured {
+		byMode[c.ModeID] = c
+	}
+
+	var limited []RateLimitedMode
+	for i, assignment := range state.Assignments {
+		if assignment.Status.IsTerminal() {
+			continue
+		}
+		c, ok := byMode[assignment.ModeID]
+		if !ok || c.Parsed != nil {
+			continue
+		}
+		scanned := tailLines(outputAfterAnchor(c.RawOutput, assignment.RateLimitAnchor), rateLimitScanLines)
+		detection := ratelimit.DetectRateLimitForAgent(scanned, assignment.AgentType)
+		if detection.RateLimited {
+			limited = append(limited, RateLimitedMode{
+				AssignmentIndex: i,
+				Detection:       detection,
+				Anchor:          rateLimitAnchor(scanned, assignment.AgentType),
+			})
+		}
+	}
+	return limited
+}
+
+// RateLimitOutcome is the result of handling one rate-limited mode.
+type RateLimitOutcome string
+
+const (
+	// RateLimitRetried means the prompt was re-injected after the backoff.
+	RateLimitRetried RateLimitOutcome = "retried"
+	// RateLimitExhausted means MaxRetries was reached and the mode was failed.
+	RateLimitExhausted RateLimitOutcome = "exhausted"
+	// RateLimitNoPrompt means no injected prompt was recorded to re-send.
+	RateLimitNoPrompt RateLimitOutcome = "no_prompt"
+	// RateLimitReinjectFailed means sending the prompt again failed.
+	RateLimitReinjectFailed RateLimitOutcome = "reinject_failed"
+)
+
+// RateLimitRetry reports what happened to one rate-limited mode.
+type RateLimitRetry struct {
+	ModeID     string        `json:"mode_id" yaml:"mode_id"`
+	PaneName   string        `json:"pane_name" yaml:"pane_name"`
+	AgentType  string        `json:"agent_type" yaml:"agent_type"`
+	Attempt    int           `json:"attempt" yaml:"attempt"`
+	MaxRetries int           `json:"max_retries" yaml:"max_retries"`
+	Backoff    time.Duration `json:"-" yaml:"-"`
+	// BackoffSeconds is Backoff in seconds for JSON and YAML; set both
+	// with SetBackoff.
+	BackoffSeconds float64          `json:"backoff_seconds,omitempty" yaml:"backoff_seconds,omitempty"`
+	Outcome        RateLimitOutcome `json:"outcome" yaml:"outcome"`
+	Error          string           `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// SetBackoff records the wait before the retry.
+func (r *RateLimitRetry) SetBackoff(d time.Duration) {
+	r.Backoff = d
+	r.BackoffSeconds = d.Seconds()
+}
+
+// RetryRateLimitedModes re-injects each rate-limited mode's recorded prompt
+// after an exponential backoff while its retry budget lasts, and marks it
+// failed once the budget is spent. Backoffs run from a common start, so the
+// total wait is the longest single backoff rather than their sum. state is
+// updated in place; the caller persists it.
+func RetryRateLimitedModes(
+	ctx context.Context,
+	state *EnsembleSession,
+	limited []RateLimitedMode,
+	policy RateLimitRetryPolicy,
+	reinject func(assignment *ModeAssignment) error,
+	sleep func(ctx context.Context, d time.Duration) error,
+) ([]RateLimitRetry, error) {
+	if state == nil {
+		return nil, errors.New("ensemble session is nil")
+	}
+	if reinject == nil {
+		return nil, errors.New("reinject function is nil")
+	}
+	if sleep == nil {
+		sleep = sleepContext
+	}
+
+	results := make([]RateLimitRetry, 0, len(limited))
+	type pending struct {
+		result  int
+		limited int
+		index   int
+		delay   time.Duration
+	}
+	var queue []pending
+
+	for li, mode := range limited {
+		if mode.AssignmentIndex < 0 || mode.AssignmentIndex >= len(state.Assignments) {
+			continue
+		}
+		assignment := &state.Assignments[mode.AssignmentIndex]
+		result := RateLimitRetry{
+			ModeID:     assignment.ModeID,
+			PaneName:   assignment.PaneName,
+			AgentType:  assignment.AgentType,
+			Attempt:    assignment.RateLimitRetries,
+			MaxRetries: policy.MaxRetries,
+		}
+		switch {
+		case assignment.RateLimitRetries >= policy.MaxRetries:
+			result.Outcome = RateLimitExhausted
+			assignment.Status = AssignmentError
+			assignment.Error = fmt.Sprintf("rate limited: %d retries exhausted", assignment.RateLimitRetries)
+		case strings.TrimSpace(assignment.Prompt) == "":
+			result.Outcome = RateLimitNoPrompt
+			result.Error = "no recorded prompt to re-inject"
+		default:
+			result.Attempt = assignment.RateLimitRetries + 1
+			result.SetBackoff(policy.Backoff(assignment.RateLimitRetries, mode.Detection.WaitSeconds))
+			queue = append(queue, pending{result: len(results), limited: li, index: mode.AssignmentIndex, delay: result.Backoff})
+		}
+		results = append(results, result)
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool { return queue[i].delay < queue[j].delay })
+	var waited time.Duration
+	for _, item := range queue {
+		if err := sleep(ctx, item.delay-waited); err != nil {
+			return results, err
+		}
+		waited = item.delay
+
+		assignment := &state.Assignments[item.index]
+		result := &results[item.result]
+		if err := reinject(assignment); err != nil {
+			result.Outcome = RateLimitReinjectFailed
+			result.Error = err.Error()
+			slog.Default().Warn("ensemble rate-limit re-injection failed",
+				"session", state.SessionName,
+				"mode_id", assignment.ModeID,
+				"pane", assignment.PaneName,
+				"error", err,
+			)
+			continue
+		}
+		assignment.RateLimitRetries++
+		assignment.RateLimitAnchor = limited[item.limited].Anchor
+		assignment.Status = AssignmentActive
+		assignment.Error = ""
+		result.Outcome = RateLimitRetried
+		slog.Default().Info("ensemble mode re-injected after rate limit",
+			"session", state.SessionName,
+			"mode_id", assignment.ModeID,
+			"pane", assignment.PaneName,
+			"attempt", result.Attempt,
+			"max_retries", policy.MaxRetries,
+			"backoff", item.delay,
+		)
+	}
+	return results, nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// outputAfterAnchor returns the part of text after the last occurrence of
+// anchor. When the anchor is empty or has scrolled out of the capture, the
+// output that preceded it has too, so all of text is new.
+func outputAfterAnchor(text, anchor string) string {
+	if anchor == "" {
+		return text
+	}
+	if idx := strings.LastIndex(text, anchor); idx >= 0 {
+		return text[idx+len(anchor):]
+	}
+	return text
+}
+
+// rateLimitAnchor returns the non-blank lines ending at the last line of
+// scanned that carries a rate-limit signature. Ending at the error rather
+// than at the bottom of the pane keeps agent chrome that is redrawn after
+// every prompt (input box, status bar) out of the anchor.
+func rateLimitAnchor(scanned, agentType string) string {
+	lines := strings.Split(strings.TrimRight(scanned, "\n"), "\n")
+	end := len(lines)
+	for i := len(lines) - 1; i >= 0; i-- {
+		if ratelimit.DetectRateLimitForAgent(lines[i], agentType).RateLimited {
+			end = i + 1
+			break
+		}
+	}
+	start := end
+	for count := 0; start > 0 && count < rateLimitAnchorLines; {
+		start--
+		if strings.TrimSpace(lines[start]) != "" {
+			count++
+		}
+	}
+	return strings.Join(lines[start:end], "\n")
+}
+
+func tailLines(text string, n int) string {
+	lines := strings.Split(text, "\n")
+	if len(lines) <= n {
+		return text
+	}
+	return strings.Join(lines[len(lines)-n:], "\n")
+}
@@ -0,0 +1,162 @@
+package ensemble
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRateLimitRetryPolicyBackoff(t *testing.T) {
+	policy := RateLimitRetryPolicy{MaxRetries: 3, BaseDelay: 10 * time.Second, MaxDelay: time.Minute}
+
+	tests := []struct {
+		retries int
+		hint    int
+		want    time.Duration
+	}{
+		{0, 0, 10 * time.Second},
+		{1, 0, 20 * time.Second},
+		{2, 0, 40 * time.Second},
+		{3, 0, time.Minute},
+		{0, 45, 45 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := policy.Backoff(tt.retries, tt.hint); got != tt.want {
+			t.Errorf("Backoff(%d, %d) = %v, want %v", tt.retries, tt.hint, got, tt.want)
+		}
+	}
+}
+
+func TestDetectRateLimitedModes(t *testing.T) {
+	state := &EnsembleSession{
+		SessionName: "rl",
+		Assignments: []ModeAssignment{
+			{ModeID: "limited", PaneName: "rl__cc_1", AgentType: "cc", Status: AssignmentActive},
+			{ModeID: "parsed", PaneName: "rl__cc_2", AgentType: "cc", Status: AssignmentActive},
+			{ModeID: "done", PaneName: "rl__cc_3", AgentType: "cc", Status: AssignmentDone},
+			{ModeID: "working", PaneName: "rl__cc_4", AgentType: "cc", Status: AssignmentActive},
+		},
+	}
+	captured := []CapturedOutput{
+		{ModeID: "limited", RawOutput: "Error: 429 Too Many Requests"},
+		{ModeID: "parsed", RawOutput: "rate limit warning", Parsed: &ModeOutput{ModeID: "parsed"}},
+		{ModeID: "done", RawOutput: "quota exceeded"},
+		{ModeID: "working", RawOutput: "Thinking about the question..."},
+	}
+
+	limited := DetectRateLimitedModes(state, captured)
+	if len(limited) != 1 || limited[0].AssignmentIndex != 0 {
+		t.Fatalf("DetectRateLimitedModes = %+v, want only the first assignment", limited)
+	}
+}
+
+func TestDetectRateLimitedModesIgnoresErrorsBeforeLastRetry(t *testing.T) {
+	state := &EnsembleSession{
+		SessionName: "rl",
+		Assignments: []ModeAssignment{
+			{ModeID: "limited", PaneName: "rl__cc_1", AgentType: "cc", Status: AssignmentActive, Prompt: "analyze"},
+		},
+	}
+	chrome := "\n> \n? for shortcuts"
+	before := "Reading the codebase...\nError: 429 Too Many Requests" + chrome
+	limited := DetectRateLimitedModes(state, []CapturedOutput{{ModeID: "limited", RawOutput: before}})
+	if len(limited) != 1 {
+		t.Fatalf("DetectRateLimitedModes = %+v, want the limited mode", limited)
+	}
+	noSleep := func(context.Context, time.Duration) error { return nil }
+	reinject := func(*ModeAssignment) error { return nil }
+	policy := RateLimitRetryPolicy{MaxRetries: 3}
+	if _, err := RetryRateLimitedModes(context.Background(), state, limited, policy, reinject, noSleep); err != nil {
+		t.Fatalf("RetryRateLimitedModes: %v", err)
+	}
+	if state.Assignments[0].RateLimitAnchor == "" || strings.Contains(state.Assignments[0].RateLimitAnchor, "shortcuts") {
+		t.Fatalf("RateLimitAnchor = %q, want lines ending at the error", state.Assignments[0].RateLimitAnchor)
+	}
+
+	// The old error is still in the pane tail, followed by the re-sent prompt.
+	after := before + "\n> analyze\nThinking..." + chrome
+	if again := DetectRateLimitedModes(state, []CapturedOutput{{ModeID: "limited", RawOutput: after}}); len(again) != 0 {
+		t.Fatalf("old error detected again after retry: %+v", again)
+	}
+
+	// A new error after the retry is still caught.
+	after += "\nError: 429 Too Many Requests" + chrome
+	if again := DetectRateLimitedModes(state, []CapturedOutput{{ModeID: "limited", RawOutput: after}}); len(again) != 1 {
+		t.Fatalf("new error after retry not detected: %+v", again)
+	}
+}
+
+func TestRetryRateLimitedModes(t *testing.T) {
+	state := &EnsembleSession{
+		SessionName: "rl",
+		Assignments: []ModeAssignment{
+			{ModeID: "fresh", PaneName: "p1", AgentType: "cc", Status: AssignmentActive, Prompt: "p1 prompt"},
+			{ModeID: "spent", PaneName: "p2", AgentType: "cc", Status: AssignmentActive, Prompt: "p2 prompt", RateLimitRetries: 2},
+			{ModeID: "unrecorded", PaneName: "p3", AgentType: "cc", Status: AssignmentActive},
+			{ModeID: "second", PaneName: "p4", AgentType: "cc", Status: AssignmentActive, Prompt: "p4 prompt", RateLimitRetries: 1},
+		},
+	}
+	limited := []RateLimitedMode{{AssignmentIndex: 0}, {AssignmentIndex: 1}, {AssignmentIndex: 2}, {AssignmentIndex: 3}}
+	policy := RateLimitRetryPolicy{MaxRetries: 2, BaseDelay: time.Second, MaxDelay: time.Minute}
+
+	var slept time.Duration
+	sleep := func(_ context.Context, d time.Duration) error {
+		slept += d
+		return nil
+	}
+	var sent []string
+	reinject := func(a *ModeAssignment) error {
+		sent = append(sent, a.Prompt)
+		return nil
+	}
+
+	results, err := RetryRateLimitedModes(context.Background(), state, limited, policy, reinject, sleep)
+	if err != nil {
+		t.Fatalf("RetryRateLimitedModes: %v", err)
+	}
+	want := []RateLimitOutcome{RateLimitRetried, RateLimitExhausted, RateLimitNoPrompt, RateLimitRetried}
+	for i, r := range results {
+		if r.Outcome != want[i] {
+			t.Errorf("results[%d].Outcome = %q, want %q", i, r.Outcome, want[i])
+		}
+	}
+	if slept != 2*time.Second {
+		t.Errorf("total sleep = %v, want the longest backoff (2s)", slept)
+	}
+	if len(sent) != 2 || sent[0] != "p1 prompt" || sent[1] != "p4 prompt" {
+		t.Errorf("re-injected prompts = %v, want shortest backoff first", sent)
+	}
+	if state.Assignments[0].RateLimitRetries != 1 || state.Assignments[3].RateLimitRetries != 2 {
+		t.Errorf("retry counters not incremented: %+v", state.Assignments)
+	}
+	if state.Assignments[1].Status != AssignmentError {
+		t.Errorf("exhausted mode status = %q, want error", state.Assignments[1].Status)
+	}
+	data, err := json.Marshal(results[3])
+	if err != nil {
+		t.Fatalf("marshal result: %v", err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal result: %v", err)
+	}
+	if decoded["backoff_seconds"] != 2.0 {
+		t.Errorf("backoff_seconds = %v, want 2 (json %s)", decoded["backoff_seconds"], data)
+	}
+	if _, ok := decoded["backoff"]; ok {
+		t.Errorf("result JSON still carries nanosecond backoff: %s", data)
+	}
+
+	failing := func(*ModeAssignment) error { return errors.New("pane gone") }
+	state.Assignments[0].RateLimitRetries = 0
+	results, err = RetryRateLimitedModes(context.Background(), state, limited[:1], policy, failing, sleep)
+	if err != nil {
+		t.Fatalf("RetryRateLimitedModes: %v", err)
+	}
+	if results[0].Outcome != RateLimitReinjectFailed || state.Assignments[0].RateLimitRetries != 0 {
+		t.Errorf("failed re-injection should not consume a retry: %+v", results[0])
+	}
+}
@@ -0,0 +1,332 @@
+package ensemble
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/ntm/internal/swarm"
+	"github.com/Dicklesworthstone/ntm/internal/tmux"
+)
+
+// defaultResumePollInterval is how often resumed panes are captured while
+// waiting for their output.
+const defaultResumePollInterval = 5 * time.Second
+
+// ResumeOutcome is the result of resuming one mode from a checkpoint.
+type ResumeOutcome string
+
+const (
+	// ResumeCompleted means the prompt was re-sent and output was checkpointed.
+	ResumeCompleted ResumeOutcome = "completed"
+	// ResumeInjected means the prompt was re-sent without waiting for output.
+	ResumeInjected ResumeOutcome = "injected"
+	// ResumeTimedOut means no output arrived within the per-mode timeout.
+	ResumeTimedOut ResumeOutcome = "timed_out"
+	// ResumeNotAssigned means the session state has no assignment for the mode.
+	ResumeNotAssigned ResumeOutcome = "not_assigned"
+	// ResumeNoPrompt means no injected prompt was recorded to re-send.
+	ResumeNoPrompt ResumeOutcome = "no_prompt"
+	// ResumeReinjectFailed means sending the prompt again failed.
+	ResumeReinjectFailed ResumeOutcome = "reinject_failed"
+)
+
+// ResumedMode reports what happened to one mode during a resume.
+type ResumedMode struct {
+	ModeID    string        `json:"mode_id" yaml:"mode_id"`
+	PaneName  string        `json:"pane_name,omitempty" yaml:"pane_name,omitempty"`
+	AgentType string        `json:"agent_type,omitempty" yaml:"agent_type,omitempty"`
+	Outcome   ResumeOutcome `json:"outcome" yaml:"outcome"`
+	Error     string        `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// Restarted reports whether the mode's prompt was actually re-sent.
+func (r ResumedMode) Restarted() bool {
+	switch r.Outcome {
+	case ResumeCompleted, ResumeInjected, ResumeTimedOut:
+		return true
+	default:
+		return false
+	}
+}
+
+// ModeResumer re-injects checkpointed modes and records their results.
+type ModeResumer struct {
+	// Checkpoints receives a status update for every mode as it restarts,
+	// completes, or fails.
+	Checkpoints *CheckpointManager
+	// ContextHash is stored with checkpointed outputs.
+	ContextHash string
+	// Timeout bounds the wait for each mode's output (BudgetConfig.TimeoutPerMode).
+	// Zero re-sends prompts without waiting.
+	Timeout time.Duration
+	// PollInterval is the delay between captures while waiting.
+	PollInterval time.Duration
+	// Reinject sends the assignment's recorded prompt to its pane.
+	Reinject func(assignment *ModeAssignment) error
+	// Capture reads and parses one mode's pane output.
+	Capture func(state *EnsembleSession, modeID string) (CapturedOutput, error)
+	// Sleep waits between captures; nil waits on a real timer.
+	Sleep func(ctx context.Context, d time.Duration) error
+	// WebhookURL, when set, receives a status event for every mode as it
+	// restarts, completes, or fails (see EnsembleConfig.WebhookURL).
+	WebhookURL string
+	Logger     *slog.Logger
+}
+
+// Resume re-sends the recorded prompt of each mode in modeIDs, then waits up
+// to Timeout for their output, checkpointing each mode as it completes. Modes
+// still silent at the deadline are checkpointed as errors so a later resume
+// picks them up again. state is updated in place; the caller persists it.
+func (r *ModeResumer) Resume(ctx context.Context, state *EnsembleSession, modeIDs []string) ([]ResumedMode, error) {
+	if state == nil {
+		return nil, errors.New("ensemble session is nil")
+	}
+	if r.Reinject == nil {
+		return nil, errors.New("resume reinject function is nil")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	logger := r.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	sleep := r.Sleep
+	if sleep == nil {
+		sleep = sleepContext
+	}
+	webhook := newStatusWebhook(r.WebhookURL, logger)
+	poll := r.PollInterval
+	if poll <= 0 {
+		poll = defaultResumePollInterval
+	}
+
+	byMode := make(map[string]int, len(state.Assignments))
+	for i, assignment := range state.Assignments {
+		byMode[assignment.ModeID] = i
+	}
+
+	results := make([]ResumedMode, 0, len(modeIDs))
+	var waiting []int // indices into results
+	for _, modeID := range modeIDs {
+		idx, ok := byMode[modeID]
+		if !ok {
+			result := ResumedMode{ModeID: modeID, Outcome: ResumeNotAssigned, Error: "mode has no assignment in the session state"}
+			r.recordError(logger, modeID, errors.New(result.Error))
+			results = append(results, result)
+			continue
+		}
+		assignment := &state.Assignments[idx]
+		result := ResumedMode{
+			ModeID:    assignment.ModeID,
+			PaneName:  assignment.PaneName,
+			AgentType: assignment.AgentType,
+		}
+		if assignment.Prompt == "" {
+			result.Outcome = ResumeNoPrompt
+			result.Error = "no recorded prompt to re-send"
+			r.recordError(logger, modeID, errors.New(result.Error))
+			results = append(results, result)
+			continue
+		}
+		if err := r.Reinject(assignment); err != nil {
+			assignment.Status = AssignmentError
+			assignment.Error = fmt.Sprintf("resume re-injection failed: %v", err)
+			result.Outcome = ResumeReinjectFailed
+			result.Error = err.Error()
+			r.recordError(logger, modeID, err)
+			webhook.notify(ctx, state.SessionName, assignment)
+			results = append(results, result)
+			continue
+		}
+
+		assignment.Status = AssignmentActive
+		assignment.Error = ""
+		assignment.CompletedAt = nil
+		result.Outcome = ResumeInjected
+		webhook.notify(ctx, state.SessionName, assignment)
+		if r.Checkpoints != nil {
+			if err := r.Checkpoints.RecordPending(modeID); err != nil {
+				logger.Warn("ensemble resume checkpoint update failed", "mode_id", modeID, "error", err)
+			}
+		}
+		logger.Info("ensemble mode resumed",
+			"session", state.SessionName,
+			"mode_id", modeID,
+			"pane", assignment.PaneName,
+		)
+		results = append(results, result)
+		waiting = append(waiting, len(results)-1)
+	}
+
+	if r.Timeout <= 0 || r.Capture == nil || len(waiting) == 0 {
+		return results, nil
+	}
+
+	deadline := time.Now().Add(r.Timeout)
+	for {
+		pending := waiting[:0]
+		for _, ri := range waiting {
+			modeID := results[ri].ModeID
+			captured, err := r.Capture(state, modeID)
+			if err != nil || captured.Parsed == nil {
+				pending = append(pending, ri)
+				continue
+			}
+			assignment := &state.Assignments[byMode[modeID]]
+			completedAt := time.Now().UTC()
+			assignment.Status = AssignmentDone
+			assignment.CompletedAt = &completedAt
+			results[ri].Outcome = ResumeCompleted
+			webhook.notify(ctx, state.SessionName, assignment)
+			if r.Checkpoints != nil {
+				output := *captured.Parsed
+				if output.ModeID == "" {
+					output.ModeID = modeID
+				}
+				if err := r.Checkpoints.RecordOutput(modeID, &output, captured.TokenEstimate, r.ContextHash); err != nil {
+					logger.Warn("ensemble resume checkpoint save failed", "mode_id", modeID, "error", err)
+				}
+			}
+		}
+		waiting = pending
+		if len(waiting) == 0 {
+			return results, nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			break
+		}
+		if err := sleep(ctx, min(poll, remaining)); err != nil {
+			return results, err
+		}
+	}
+
+	for _, ri := range waiting {
+		modeID := results[ri].ModeID
+		err := fmt.Errorf("no output within %s", r.Timeout)
+		assignment := &state.Assignments[byMode[modeID]]
+		assignment.Status = AssignmentError
+		assignment.Error = err.Error()
+		results[ri].Outcome = ResumeTimedOut
+		results[ri].Error = err.Error()
+		r.recordError(logger, modeID, err)
+		webhook.notify(ctx, state.SessionName, assignment)
+	}
+	return results, nil
+}
+
+func (r *ModeResumer) recordError(logger *slog.Logger, modeID string, cause error) {
+	if r.Checkpoints == nil {
+		return
+	}
+	if err := r.Checkpoints.RecordError(modeID, cause); err != nil {
+		logger.Warn("ensemble resume checkpoint update failed", "mode_id", modeID, "error", err)
+	}
+}
+
+// RestoreModePanes returns pane targets for the session, keyed by pane title.
+// When the tmux session no longer exists it is recreated with one agent pane
+// per mode in modeIDs, and those assignments are re-pointed at the new panes.
+func RestoreModePanes(ctx context.Context, client *tmux.Client, state *EnsembleSession, modeIDs []string, projectDir string) (map[string]string, error) {
+	if state == nil {
+		return nil, errors.New("ensemble session is nil")
+	}
+	if client == nil {
+		client = tmux.DefaultClient
+	}
+	if client.SessionExists(state.SessionName) {
+		panes, err := client.GetPanes(state.SessionName)
+		if err != nil {
+			return nil, fmt.Errorf("get panes: %w", err)
+		}
+		return buildPaneTargetMap(state.SessionName, panes), nil
+	}
+
+	var indices []int
+	for i, assignment := range state.Assignments {
+		for _, modeID := range modeIDs {
+			if assignment.ModeID == modeID {
+				indices = append(indices, i)
+				break
+			}
+		}
+	}
+	if len(indices) == 0 {
+		return map[string]string{}, nil
+	}
+
+	specs := make([]swarm.PaneSpec, 0, len(indices))
+	for i, idx := range indices {
+		agentType := state.Assignments[idx].AgentType
+		if agentType == "" {
+			agentType = string(tmux.AgentClaude)
+		}
+		specs = append(specs, swarm.PaneSpec{
+			Index:     i + 1,
+			AgentType: agentType,
+			Project:   projectDir,
+		})
+	}
+	sessionSpec := swarm.SessionSpec{
+		Name:      state.SessionName,
+		AgentType: "ensemble",
+		PaneCount: len(specs),
+		Panes:     specs,
+	}
+
+	plan := &swarm.SwarmPlan{Sessions: []swarm.SessionSpec{sessionSpec}}
+	created, err := swarm.NewSessionOrchestratorWithClient(client).CreateSessions(plan)
+	if err != nil {
+		return nil, fmt.Errorf("recreate ensemble session: %w", err)
+	}
+	if created == nil || len(created.Sessions) == 0 {
+		return nil, errors.New("failed to recreate ensemble session")
+	}
+	if created.Sessions[0].Error != nil {
+		return nil, fmt.Errorf("recreate ensemble session: %w", created.Sessions[0].Error)
+	}
+
+	if _, err := swarm.NewPaneLauncherWithClient(client).LaunchSession(ctx, sessionSpec, 300*time.Millisecond); err != nil {
+		slog.Default().Warn("ensemble resume agent launch errors", "session", state.SessionName, "error", err)
+	}
+
+	panes, err := client.GetPanes(state.SessionName)
+	if err != nil {
+		return nil, fmt.Errorf("get panes: %w", err)
+	}
+	if len(panes) < len(indices) {
+		return nil, fmt.Errorf("recreated session has %d panes for %d modes", len(panes), len(indices))
+	}
+	sort.Slice(panes, func(i, j int) bool { return panes[i].Index < panes[j].Index })
+	for i, idx := range indices {
+		name := panes[i].Title
+		if name == "" {
+			name = panes[i].ID
+		}
+		state.Assignments[idx].PaneName = name
+	}
+	return buildPaneTargetMap(state.SessionName, panes), nil
+}
+
+func buildPaneTargetMap(sessionName string, panes []tmux.Pane) map[string]string {
+	targets := make(map[string]string, len(panes))
+	for _, pane := range panes {
+		target := pane.ID
+		if target == "" {
+			target = swarm.GetPaneTarget(sessionName, pane.Index)
+		}
+		if pane.Title != "" {
+			targets[pane.Title] = target
+		}
+		if pane.ID != "" {
+			targets[pane.ID] = target
+		}
+	}
+	return targets
+}
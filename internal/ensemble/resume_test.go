@@ -0,0 +1,120 @@
+package ensemble
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestModeResumerResume(t *testing.T) {
+	store, err := NewCheckpointStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewCheckpointStore: %v", err)
+	}
+	const runID = "resume-run"
+	if err := store.SaveMetadata(CheckpointMetadata{
+		RunID:        runID,
+		SessionName:  "resume-session",
+		CompletedIDs: []string{"done"},
+		PendingIDs:   []string{"fast", "slow", "silent"},
+		ErrorIDs:     []string{"broken"},
+		TotalModes:   5,
+	}); err != nil {
+		t.Fatalf("SaveMetadata: %v", err)
+	}
+
+	state := &EnsembleSession{
+		SessionName: "resume-session",
+		Assignments: []ModeAssignment{
+			{ModeID: "done", PaneName: "p0", Status: AssignmentDone},
+			{ModeID: "fast", PaneName: "p1", Status: AssignmentActive, Prompt: "go fast"},
+			{ModeID: "slow", PaneName: "p2", Status: AssignmentError, Prompt: "go slow"},
+			{ModeID: "silent", PaneName: "p3", Status: AssignmentActive},
+			{ModeID: "broken", PaneName: "p4", Status: AssignmentError, Prompt: "fail"},
+		},
+	}
+
+	var injected []string
+	resumer := &ModeResumer{
+		Checkpoints:  NewCheckpointManager(store, runID),
+		Timeout:      20 * time.Millisecond,
+		PollInterval: time.Millisecond,
+		Reinject: func(a *ModeAssignment) error {
+			if a.ModeID == "broken" {
+				return errors.New("pane gone")
+			}
+			injected = append(injected, a.ModeID)
+			return nil
+		},
+		Capture: func(_ *EnsembleSession, modeID string) (CapturedOutput, error) {
+			if modeID == "fast" {
+				return CapturedOutput{ModeID: modeID, Parsed: &ModeOutput{Thesis: "done"}, TokenEstimate: 42}, nil
+			}
+			return CapturedOutput{ModeID: modeID}, nil
+		},
+		Sleep: func(_ context.Context, d time.Duration) error {
+			time.Sleep(d)
+			return nil
+		},
+	}
+
+	results, err := resumer.Resume(context.Background(), state, []string{"fast", "slow", "silent", "broken", "missing"})
+	if err != nil {
+		t.Fatalf("Resume: %v", err)
+	}
+
+	outcomes := make(map[string]ResumeOutcome, len(results))
+	restarted := 0
+	for _, r := range results {
+		outcomes[r.ModeID] = r.Outcome
+		if r.Restarted() {
+			restarted++
+		}
+	}
+	want := map[string]ResumeOutcome{
+		"fast":    ResumeCompleted,
+		"slow":    ResumeTimedOut,
+		"silent":  ResumeNoPrompt,
+		"broken":  ResumeReinjectFailed,
+		"missing": ResumeNotAssigned,
+	}
+	for modeID, outcome := range want {
+		if outcomes[modeID] != outcome {
+			t.Errorf("outcome[%s] = %q, want %q", modeID, outcomes[modeID], outcome)
+		}
+	}
+	if restarted != 2 {
+		t.Errorf("restarted = %d, want 2", restarted)
+	}
+	if !slices.Equal(injected, []string{"fast", "slow"}) {
+		t.Errorf("injected = %v, want [fast slow]", injected)
+	}
+
+	if state.Assignments[1].Status != AssignmentDone || state.Assignments[1].CompletedAt == nil {
+		t.Errorf("fast assignment = %+v, want done with completion time", state.Assignments[1])
+	}
+	if state.Assignments[2].Status != AssignmentError {
+		t.Errorf("slow assignment status = %q, want error", state.Assignments[2].Status)
+	}
+
+	meta, err := store.LoadMetadata(runID)
+	if err != nil {
+		t.Fatalf("LoadMetadata: %v", err)
+	}
+	slices.Sort(meta.CompletedIDs)
+	if !slices.Equal(meta.CompletedIDs, []string{"done", "fast"}) {
+		t.Errorf("CompletedIDs = %v, want [done fast]", meta.CompletedIDs)
+	}
+	if len(meta.PendingIDs) != 0 {
+		t.Errorf("PendingIDs = %v, want none", meta.PendingIDs)
+	}
+	cp, err := store.LoadCheckpoint(runID, "fast")
+	if err != nil {
+		t.Fatalf("LoadCheckpoint: %v", err)
+	}
+	if cp.Output == nil || cp.Output.ModeID != "fast" || cp.TokensUsed != 42 {
+		t.Errorf("fast checkpoint = %+v, want output for fast with 42 tokens", cp)
+	}
+}
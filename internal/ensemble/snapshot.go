@@ -0,0 +1,214 @@
+package ensemble
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Dicklesworthstone/ntm/internal/util"
+)
+
+const (
+	// snapshotDirName is the directory name for ensemble snapshots.
+	snapshotDirName = "ensemble-snapshots"
+	// snapshotVersion is the on-disk snapshot format version.
+	snapshotVersion = 1
+	// snapshotTimeLayout is used in snapshot filenames; it sorts chronologically.
+	snapshotTimeLayout = "20060102T150405Z"
+)
+
+// Snapshot is a point-in-time save of a running ensemble: its session state
+// plus whatever mode outputs had been captured when it was taken. Unlike a
+// checkpoint it is a single self-contained file and taking one leaves the
+// session running.
+type Snapshot struct {
+	Version   int               `json:"version"`
+	CreatedAt time.Time         `json:"created_at"`
+	Session   *EnsembleSession  `json:"session"`
+	Outputs   []SnapshotCapture `json:"outputs"`
+}
+
+// SnapshotCapture is the serializable form of a CapturedOutput.
+type SnapshotCapture struct {
+	ModeID        string      `json:"mode_id"`
+	PaneName      string      `json:"pane_name"`
+	RawOutput     string      `json:"raw_output"`
+	Parsed        *ModeOutput `json:"parsed,omitempty"`
+	ParseErrors   []string    `json:"parse_errors,omitempty"`
+	CapturedAt    time.Time   `json:"captured_at"`
+	LineCount     int         `json:"line_count"`
+	TokenEstimate int         `json:"token_estimate"`
+}
+
+// NewSnapshot builds a snapshot from session state and captured outputs.
+func NewSnapshot(state *EnsembleSession, captured []CapturedOutput, now time.Time) (*Snapshot, error) {
+	if state == nil {
+		return nil, errors.New("ensemble session is nil")
+	}
+	snap := &Snapshot{
+		Version:   snapshotVersion,
+		CreatedAt: now.UTC(),
+		Session:   state,
+		Outputs:   make([]SnapshotCapture, 0, len(captured)),
+	}
+	for _, c := range captured {
+		entry := SnapshotCapture{
+			ModeID:        c.ModeID,
+			PaneName:      c.PaneName,
+			RawOutput:     c.RawOutput,
+			Parsed:        c.Parsed,
+			CapturedAt:    c.CapturedAt,
+			LineCount:     c.LineCount,
+			TokenEstimate: c.TokenEstimate,
+		}
+		for _, err := range c.ParseErrors {
+			if err != nil {
+				entry.ParseErrors = append(entry.ParseErrors, err.Error())
+			}
+		}
+		snap.Outputs = append(snap.Outputs, entry)
+	}
+	return snap, nil
+}
+
+// ModeOutputs returns the parsed outputs contained in the snapshot.
+func (s *Snapshot) ModeOutputs() []ModeOutput {
+	if s == nil {
+		return nil
+	}
+	outputs := make([]ModeOutput, 0, len(s.Outputs))
+	for _, c := range s.Outputs {
+		if c.Parsed != nil {
+			outputs = append(outputs, *c.Parsed)
+		}
+	}
+	return outputs
+}
+
+// DefaultSnapshotDir returns the snapshot directory under baseDir, or under
+// .ntm in the working directory when baseDir is empty.
+func DefaultSnapshotDir(baseDir string) (string, error) {
+	if baseDir == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return "", fmt.Errorf("get working directory: %w", err)
+		}
+		baseDir = filepath.Join(cwd, ".ntm")
+	}
+	return filepath.Join(baseDir, snapshotDirName), nil
+}
+
+// WriteSnapshot writes the snapshot to dir as <session>-<timestamp>.json and
+// returns the path written.
+func WriteSnapshot(dir string, snap *Snapshot) (string, error) {
+	if snap == nil || snap.Session == nil {
+		return "", errors.New("snapshot has no session")
+	}
+	name, err := NormalizeCheckpointRunID(snap.Session.SessionName)
+	if err != nil {
+		return "", fmt.Errorf("snapshot session name: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("create snapshot directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal snapshot: %w", err)
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.json", name, snap.CreatedAt.UTC().Format(snapshotTimeLayout)))
+	if err := util.AtomicWriteFile(path, data, 0o644); err != nil {
+		return "", fmt.Errorf("write snapshot: %w", err)
+	}
+	return path, nil
+}
+
+// LoadSnapshot reads a snapshot written by WriteSnapshot.
+func LoadSnapshot(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot: %w", err)
+	}
+	var snap Snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("parse snapshot: %w", err)
+	}
+	if snap.Version != snapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d", snap.Version)
+	}
+	if snap.Session == nil {
+		return nil, errors.New("snapshot has no session")
+	}
+	return &snap, nil
+}
+
+// RestoreSnapshot rebuilds the session state saved in snap so a crashed run
+// can be synthesized from it. Each parsed output is written to outputDir as
+// <mode>.yaml and its assignment is marked done with OutputPath pointing at
+// the file, which is where synthesis reads saved outputs once the tmux
+// session is gone; modes still running without output are marked errored.
+// It returns the restored session, ready for SaveSession, and the IDs of the
+// modes whose outputs were restored.
+func RestoreSnapshot(snap *Snapshot, outputDir string) (*EnsembleSession, []string, error) {
+	if snap == nil || snap.Session == nil {
+		return nil, nil, errors.New("snapshot has no session")
+	}
+	state := *snap.Session
+	state.Assignments = append([]ModeAssignment(nil), snap.Session.Assignments...)
+	byMode := make(map[string]int, len(state.Assignments))
+	for i, assignment := range state.Assignments {
+		byMode[assignment.ModeID] = i
+	}
+
+	restored := []string{}
+	for _, c := range snap.Outputs {
+		idx, ok := byMode[c.ModeID]
+		if !ok || c.Parsed == nil {
+			continue
+		}
+		name, err := normalizeCheckpointModeID(c.ModeID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("snapshot output: %w", err)
+		}
+		data, err := yaml.Marshal(c.Parsed)
+		if err != nil {
+			return nil, nil, fmt.Errorf("marshal output %s: %w", c.ModeID, err)
+		}
+		if err := os.MkdirAll(outputDir, 0o755); err != nil {
+			return nil, nil, fmt.Errorf("create output directory: %w", err)
+		}
+		path := filepath.Join(outputDir, name+".yaml")
+		if err := util.AtomicWriteFile(path, data, 0o644); err != nil {
+			return nil, nil, fmt.Errorf("write output %s: %w", c.ModeID, err)
+		}
+
+		assignment := &state.Assignments[idx]
+		assignment.Status = AssignmentDone
+		assignment.Error = ""
+		assignment.OutputPath = path
+		if assignment.CompletedAt == nil {
+			capturedAt := c.CapturedAt
+			if capturedAt.IsZero() {
+				capturedAt = snap.CreatedAt
+			}
+			assignment.CompletedAt = &capturedAt
+		}
+		restored = append(restored, c.ModeID)
+	}
+
+	// Modes that were still running have no pane to finish in any more.
+	for i := range state.Assignments {
+		assignment := &state.Assignments[i]
+		if assignment.Status.IsTerminal() {
+			continue
+		}
+		assignment.Status = AssignmentError
+		assignment.Error = "no output captured in snapshot"
+	}
+	return &state, restored, nil
+}
@@ -0,0 +1,123 @@
+package ensemble
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	state := &EnsembleSession{
+		SessionName: "snap-session",
+		Question:    "What broke?",
+		Status:      EnsembleActive,
+		Assignments: []ModeAssignment{
+			{ModeID: "deductive", PaneName: "snap-session__cc_1", AgentType: "cc", Status: AssignmentActive},
+			{ModeID: "abductive", PaneName: "snap-session__cod_1", AgentType: "cod", Status: AssignmentActive},
+		},
+	}
+	captured := []CapturedOutput{
+		{ModeID: "deductive", PaneName: "snap-session__cc_1", RawOutput: "thesis: x", Parsed: &ModeOutput{ModeID: "deductive", Thesis: "x"}, CapturedAt: now},
+		{ModeID: "abductive", PaneName: "snap-session__cod_1", RawOutput: "partial", ParseErrors: []error{errors.New("no yaml block")}, CapturedAt: now},
+	}
+
+	snap, err := NewSnapshot(state, captured, now)
+	if err != nil {
+		t.Fatalf("NewSnapshot: %v", err)
+	}
+	path, err := WriteSnapshot(dir, snap)
+	if err != nil {
+		t.Fatalf("WriteSnapshot: %v", err)
+	}
+	if want := filepath.Join(dir, "snap-session-20260304T050607Z.json"); path != want {
+		t.Fatalf("path = %q, want %q", path, want)
+	}
+
+	loaded, err := LoadSnapshot(path)
+	if err != nil {
+		t.Fatalf("LoadSnapshot: %v", err)
+	}
+	if loaded.Session.Question != "What broke?" || len(loaded.Session.Assignments) != 2 {
+		t.Fatalf("unexpected session: %+v", loaded.Session)
+	}
+	if len(loaded.Outputs) != 2 || loaded.Outputs[1].ParseErrors[0] != "no yaml block" {
+		t.Fatalf("unexpected outputs: %+v", loaded.Outputs)
+	}
+	outputs := loaded.ModeOutputs()
+	if len(outputs) != 1 || outputs[0].Thesis != "x" {
+		t.Fatalf("ModeOutputs() = %+v, want the one parsed output", outputs)
+	}
+}
+
+func TestWriteSnapshotRejectsUnsafeSessionName(t *testing.T) {
+	snap, err := NewSnapshot(&EnsembleSession{SessionName: "../escape"}, nil, time.Now())
+	if err != nil {
+		t.Fatalf("NewSnapshot: %v", err)
+	}
+	if _, err := WriteSnapshot(t.TempDir(), snap); err == nil || !strings.Contains(err.Error(), "invalid run ID") {
+		t.Fatalf("WriteSnapshot error = %v, want invalid run ID", err)
+	}
+}
+
+func TestRestoreSnapshotWritesOutputsForSavedSynthesis(t *testing.T) {
+	now := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	state := &EnsembleSession{
+		SessionName: "snap-session",
+		Question:    "What broke?",
+		Status:      EnsembleActive,
+		Assignments: []ModeAssignment{
+			{ModeID: "deductive", PaneName: "snap-session__cc_1", Status: AssignmentActive},
+			{ModeID: "abductive", PaneName: "snap-session__cod_1", Status: AssignmentActive},
+		},
+	}
+	parsed := &ModeOutput{
+		ModeID:     "deductive",
+		Thesis:     "The cache key changed",
+		Confidence: 0.8,
+		TopFindings: []Finding{
+			{Finding: "Key includes timestamp", Impact: ImpactHigh, Confidence: 0.9},
+		},
+	}
+	snap, err := NewSnapshot(state, []CapturedOutput{
+		{ModeID: "deductive", Parsed: parsed, CapturedAt: now},
+		{ModeID: "abductive", RawOutput: "partial"},
+	}, now)
+	if err != nil {
+		t.Fatalf("NewSnapshot: %v", err)
+	}
+
+	dir := t.TempDir()
+	restored, modes, err := RestoreSnapshot(snap, dir)
+	if err != nil {
+		t.Fatalf("RestoreSnapshot: %v", err)
+	}
+	if len(modes) != 1 || modes[0] != "deductive" {
+		t.Fatalf("restored modes = %v, want [deductive]", modes)
+	}
+	done := restored.Assignments[0]
+	if done.Status != AssignmentDone || done.OutputPath != filepath.Join(dir, "deductive.yaml") || done.CompletedAt == nil {
+		t.Fatalf("deductive assignment = %+v", done)
+	}
+	if got := restored.Assignments[1]; got.Status != AssignmentError {
+		t.Fatalf("abductive status = %s, want error", got.Status)
+	}
+	if state.Assignments[0].Status != AssignmentActive {
+		t.Fatal("RestoreSnapshot modified the snapshot's session")
+	}
+
+	collector := NewOutputCollector(DefaultOutputCollectorConfig())
+	if err := collector.CollectFromSavedOutputs(restored); err != nil {
+		t.Fatalf("CollectFromSavedOutputs: %v", err)
+	}
+	if collector.Count() != 1 || collector.Outputs[0].Thesis != parsed.Thesis {
+		t.Fatalf("collected %d outputs (%+v), want the restored one", collector.Count(), collector.ValidationErrors)
+	}
+
+	if _, _, err := RestoreSnapshot(&Snapshot{}, dir); err == nil {
+		t.Fatal("expected error for snapshot without session")
+	}
+}
@@ -167,14 +167,16 @@ func toStateSession(session *EnsembleSession) *state.EnsembleSession {
 			status = string(AssignmentPending)
 		}
 		assignments = append(assignments, state.ModeAssignment{
-			ModeID:      assignment.ModeID,
-			PaneName:    assignment.PaneName,
-			AgentType:   assignment.AgentType,
-			Status:      status,
-			OutputPath:  assignment.OutputPath,
-			AssignedAt:  assignment.AssignedAt,
-			CompletedAt: assignment.CompletedAt,
-			Error:       assignment.Error,
+			ModeID:           assignment.ModeID,
+			PaneName:         assignment.PaneName,
+			AgentType:        assignment.AgentType,
+			Status:           status,
+			OutputPath:       assignment.OutputPath,
+			AssignedAt:       assignment.AssignedAt,
+			CompletedAt:      assignment.CompletedAt,
+			Error:            assignment.Error,
+			Prompt:           assignment.Prompt,
+			RateLimitRetries: assignment.RateLimitRetries,
 		})
 	}
 
@@ -188,6 +190,11 @@ func toStateSession(session *EnsembleSession) *state.EnsembleSession {
 		SynthesizedAt:     session.SynthesizedAt,
 		SynthesisOutput:   session.SynthesisOutput,
 		Error:             session.Error,
+		BudgetSpent:       session.BudgetSpent,
+		BudgetLimit:       session.BudgetLimit,
+		BudgetEstimate:    session.BudgetEstimate,
+		BudgetCutoff:      session.BudgetCutoff,
+		Seed:              session.Seed,
 		Assignments:       assignments,
 	}
 }
@@ -200,14 +207,16 @@ func fromStateSession(session *state.EnsembleSession) *EnsembleSession {
 	assignments := make([]ModeAssignment, 0, len(session.Assignments))
 	for _, assignment := range session.Assignments {
 		assignments = append(assignments, ModeAssignment{
-			ModeID:      assignment.ModeID,
-			PaneName:    assignment.PaneName,
-			AgentType:   assignment.AgentType,
-			Status:      AssignmentStatus(assignment.Status),
-			OutputPath:  assignment.OutputPath,
-			AssignedAt:  assignment.AssignedAt,
-			CompletedAt: assignment.CompletedAt,
-			Error:       assignment.Error,
+			ModeID:           assignment.ModeID,
+			PaneName:         assignment.PaneName,
+			AgentType:        assignment.AgentType,
+			Status:           AssignmentStatus(assignment.Status),
+			OutputPath:       assignment.OutputPath,
+			AssignedAt:       assignment.AssignedAt,
+			CompletedAt:      assignment.CompletedAt,
+			Error:            assignment.Error,
+			Prompt:           assignment.Prompt,
+			RateLimitRetries: assignment.RateLimitRetries,
 		})
 	}
 
@@ -222,5 +231,10 @@ func fromStateSession(session *state.EnsembleSession) *EnsembleSession {
 		SynthesizedAt:     session.SynthesizedAt,
 		SynthesisOutput:   session.SynthesisOutput,
 		Error:             session.Error,
+		BudgetSpent:       session.BudgetSpent,
+		BudgetLimit:       session.BudgetLimit,
+		BudgetEstimate:    session.BudgetEstimate,
+		BudgetCutoff:      session.BudgetCutoff,
+		Seed:              session.Seed,
 	}
 }
@@ -0,0 +1,129 @@
+package ensemble
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// statusWebhookTimeout bounds each status POST so a slow endpoint cannot
+// stall injection.
+const statusWebhookTimeout = 5 * time.Second
+
+// AssignmentStatusEvent is the JSON body POSTed to the ensemble webhook when
+// a mode assignment changes status.
+type AssignmentStatusEvent struct {
+	Session   string           `json:"session"`
+	ModeID    string           `json:"mode_id"`
+	Status    AssignmentStatus `json:"status"`
+	Timestamp time.Time        `json:"timestamp"`
+}
+
+// statusWebhook posts assignment status changes to a configured URL.
+// A nil *statusWebhook is valid and discards every event.
+type statusWebhook struct {
+	url    string
+	client *http.Client
+	logger *slog.Logger
+}
+
+func newStatusWebhook(rawURL string, logger *slog.Logger) *statusWebhook {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &statusWebhook{
+		url:    rawURL,
+		client: &http.Client{Timeout: statusWebhookTimeout},
+		logger: logger,
+	}
+}
+
+// notify reports the assignment's current status. Failures are logged and
+// never returned: the webhook is advisory and must not abort the run.
+func (w *statusWebhook) notify(ctx context.Context, session string, assignment *ModeAssignment) {
+	if w == nil || assignment == nil {
+		return
+	}
+	w.send(ctx, w.event(session, assignment))
+}
+
+// event builds the status event for the assignment's current status.
+func (w *statusWebhook) event(session string, assignment *ModeAssignment) AssignmentStatusEvent {
+	return AssignmentStatusEvent{
+		Session:   session,
+		ModeID:    assignment.ModeID,
+		Status:    assignment.Status,
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+// sendAll posts events concurrently under a single statusWebhookTimeout, so
+// a slow endpoint delays the caller by at most one timeout however many
+// events there are.
+func (w *statusWebhook) sendAll(ctx context.Context, events []AssignmentStatusEvent) {
+	if w == nil || len(events) == 0 {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, statusWebhookTimeout)
+	defer cancel()
+	var wg sync.WaitGroup
+	for _, event := range events {
+		wg.Add(1)
+		go func(event AssignmentStatusEvent) {
+			defer wg.Done()
+			w.send(ctx, event)
+		}(event)
+	}
+	wg.Wait()
+}
+
+func (w *statusWebhook) send(ctx context.Context, event AssignmentStatusEvent) {
+	if w == nil {
+		return
+	}
+	if err := w.post(ctx, event); err != nil {
+		w.logger.Warn("ensemble status webhook failed",
+			"session", event.Session,
+			"mode_id", event.ModeID,
+			"status", event.Status,
+			"error", err,
+		)
+	}
+}
+
+func (w *statusWebhook) post(ctx context.Context, event AssignmentStatusEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package ensemble
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestStatusWebhookPostsAssignmentEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu     sync.Mutex
+		events []AssignmentStatusEvent
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Content-Type = %q, want application/json", ct)
+		}
+		var event AssignmentStatusEvent
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		mu.Lock()
+		events = append(events, event)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	hook := newStatusWebhook(srv.URL, nil)
+	assignment := &ModeAssignment{ModeID: "deductive", Status: AssignmentActive}
+	hook.notify(context.Background(), "ens-1", assignment)
+	assignment.Status = AssignmentDone
+	hook.notify(context.Background(), "ens-1", assignment)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2", len(events))
+	}
+	if events[0].Session != "ens-1" || events[0].ModeID != "deductive" || events[0].Status != AssignmentActive {
+		t.Errorf("first event = %+v", events[0])
+	}
+	if events[1].Status != AssignmentDone || events[1].Timestamp.IsZero() {
+		t.Errorf("second event = %+v", events[1])
+	}
+}
+
+func TestStatusWebhookFailuresAreNonFatal(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	hook := newStatusWebhook(srv.URL, nil)
+	if err := hook.post(context.Background(), AssignmentStatusEvent{ModeID: "deductive"}); err == nil {
+		t.Fatal("expected error for 500 response")
+	}
+	// notify must swallow the failure.
+	hook.notify(context.Background(), "ens-1", &ModeAssignment{ModeID: "deductive", Status: AssignmentError})
+
+	var disabled *statusWebhook
+	disabled.notify(context.Background(), "ens-1", &ModeAssignment{ModeID: "deductive"})
+	if newStatusWebhook("  ", nil) != nil {
+		t.Fatal("expected nil webhook for empty URL")
+	}
+}
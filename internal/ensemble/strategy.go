@@ -125,6 +125,14 @@ var strategyRegistry = []*StrategyConfig{
 		BestFor:         []string{"Argument mapping", "Debate analysis", "Legal/policy reasoning"},
 		TemplateKey:     "synthesis_argumentation",
 	},
+	{
+		Name:          StrategyDebate,
+		Description:   "Surface the strongest opposing positions between modes as pro/con with cited evidence",
+		RequiresAgent: false,
+		OutputFocus:   []string{"contested claims", "pro/con positions", "evidence each side cites"},
+		BestFor:       []string{"Decision-making questions", "Exposing hidden disagreement", "Go/no-go reviews"},
+		TemplateKey:   "synthesis_debate",
+	},
 }
 
 // strategyMap provides O(1) lookup by strategy name.
@@ -162,7 +170,6 @@ func ValidateStrategy(name string) bool {
 
 // deprecatedStrategies maps removed strategy names to their replacements.
 var deprecatedStrategies = map[string]string{
-	"debate":     "dialectical",
 	"weighted":   "prioritized",
 	"sequential": "manual",
 	"best-of":    "prioritized",
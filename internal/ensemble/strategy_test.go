@@ -18,9 +18,9 @@ func TestGetStrategy(t *testing.T) {
 		{"meta-reasoning", false},
 		{"voting", false},
 		{"argumentation-graph", false},
+		{"debate", false},
 		{"invalid", true},
 		{"", true},
-		{"debate", true},
 		{"weighted", true},
 	}
 
@@ -47,8 +47,8 @@ func TestGetStrategy(t *testing.T) {
 
 func TestListStrategies(t *testing.T) {
 	strategies := ListStrategies()
-	if len(strategies) != 11 {
-		t.Errorf("ListStrategies() returned %d, want 11", len(strategies))
+	if len(strategies) != 12 {
+		t.Errorf("ListStrategies() returned %d, want 12", len(strategies))
 	}
 
 	// Verify deterministic order matches allStrategies.
@@ -92,7 +92,7 @@ func TestMigrateStrategy(t *testing.T) {
 		want        string
 		wantMigrate bool
 	}{
-		{"debate", "dialectical", true},
+		{"debate", "debate", false},
 		{"weighted", "prioritized", true},
 		{"sequential", "manual", true},
 		{"best-of", "prioritized", true},
@@ -120,9 +120,9 @@ func TestValidateOrMigrateStrategy(t *testing.T) {
 	}
 
 	// Deprecated strategy returns error with guidance.
-	_, err = ValidateOrMigrateStrategy("debate")
+	_, err = ValidateOrMigrateStrategy("weighted")
 	if err == nil {
-		t.Error("ValidateOrMigrateStrategy(\"debate\") should error")
+		t.Error("ValidateOrMigrateStrategy(\"weighted\") should error")
 	}
 
 	// Unknown strategy returns error.
@@ -133,10 +133,11 @@ func TestValidateOrMigrateStrategy(t *testing.T) {
 }
 
 func TestStrategyRequiresAgent(t *testing.T) {
-	// Manual and voting should not require an agent.
+	// Manual, voting and debate should not require an agent.
 	noAgent := map[SynthesisStrategy]bool{
 		StrategyManual: true,
 		StrategyVoting: true,
+		StrategyDebate: true,
 	}
 
 	for _, s := range ListStrategies() {
@@ -244,6 +244,10 @@ func (s *Synthesizer) mechanicalSynthesize(input *SynthesisInput) (*SynthesisRes
 		GeneratedAt:      time.Now().UTC(),
 	}
 
+	if s.Strategy.Name == StrategyDebate {
+		result.Debate = BuildDebate(input.Outputs)
+	}
+
 	if input.Provenance != nil {
 		for i, mf := range merged.Findings {
 			if mf.ProvenanceID != "" {
@@ -70,3 +70,15 @@ func assertEqualTimebox(t *testing.T, desc string, got, want any) {
 		t.Fatalf("%s: got %v want %v", desc, got, want)
 	}
 }
+
+func TestStrictBudget_Exhausted(t *testing.T) {
+	input := map[string]any{"spent": 47000, "per_mode": 4000, "limit": 50000}
+	logTestStartTimebox(t, input)
+
+	exhausted := strictBudgetExhausted(47000, 4000, 50000)
+	logTestResultTimebox(t, exhausted)
+
+	assertTrueTimebox(t, "next mode would exceed cap", exhausted)
+	assertTrueTimebox(t, "room for next mode", !strictBudgetExhausted(10000, 4000, 50000))
+	assertTrueTimebox(t, "zero limit disables enforcement", !strictBudgetExhausted(99999, 4000, 0))
+}
@@ -171,53 +171,53 @@ func CategoryFromLetter(letter string) (ModeCategory, bool) {
 type ReasoningMode struct {
 	// ID is the unique identifier for this mode (e.g., "deductive", "bayesian").
 	// Must be lowercase alphanumeric with optional hyphens.
-	ID string `json:"id" toml:"id"`
+	ID string `json:"id" toml:"id" yaml:"id"`
 
 	// Code is the taxonomy code (e.g., "A1", "B3") mapping to the category letter
 	// and a numeric index within that category. Format: [A-L][0-9]+.
-	Code string `json:"code" toml:"code"`
+	Code string `json:"code" toml:"code" yaml:"code"`
 
 	// Name is the human-readable name (e.g., "Deductive Logic").
-	Name string `json:"name" toml:"name"`
+	Name string `json:"name" toml:"name" yaml:"name"`
 
 	// Category is the taxonomy category this mode belongs to.
-	Category ModeCategory `json:"category" toml:"category"`
+	Category ModeCategory `json:"category" toml:"category" yaml:"category"`
 
 	// Tier indicates the maturity/visibility level (core, advanced, experimental).
 	// Core modes are shown by default; advanced and experimental require opt-in.
-	Tier ModeTier `json:"tier" toml:"tier"`
+	Tier ModeTier `json:"tier" toml:"tier" yaml:"tier"`
 
 	// ShortDesc is a one-line description for listings (max 80 chars).
-	ShortDesc string `json:"short_desc" toml:"short_desc"`
+	ShortDesc string `json:"short_desc" toml:"short_desc" yaml:"short_desc"`
 
 	// Description is the full explanation of this reasoning approach.
-	Description string `json:"description" toml:"description"`
+	Description string `json:"description" toml:"description" yaml:"description"`
 
 	// Outputs describes what this mode produces (e.g., "Proof or counterexample").
-	Outputs string `json:"outputs" toml:"outputs"`
+	Outputs string `json:"outputs" toml:"outputs" yaml:"outputs"`
 
 	// BestFor lists problem types where this mode excels.
-	BestFor []string `json:"best_for" toml:"best_for"`
+	BestFor []string `json:"best_for" toml:"best_for" yaml:"best_for"`
 
 	// FailureModes lists common pitfalls when using this mode.
-	FailureModes []string `json:"failure_modes" toml:"failure_modes"`
+	FailureModes []string `json:"failure_modes" toml:"failure_modes" yaml:"failure_modes"`
 
 	// Differentiator explains what makes this mode unique vs similar modes.
-	Differentiator string `json:"differentiator" toml:"differentiator"`
+	Differentiator string `json:"differentiator" toml:"differentiator" yaml:"differentiator"`
 
 	// Icon is a single emoji or Nerd Font glyph for UI display.
-	Icon string `json:"icon" toml:"icon"`
+	Icon string `json:"icon" toml:"icon" yaml:"icon"`
 
 	// Color is the hex color code for UI display (e.g., "#cba6f7").
-	Color string `json:"color" toml:"color"`
+	Color string `json:"color" toml:"color" yaml:"color"`
 
 	// PreambleKey is the key to lookup the preamble template for this mode.
 	// The preamble is injected into the agent prompt to set its reasoning approach.
-	PreambleKey string `json:"preamble_key" toml:"preamble_key"`
+	PreambleKey string `json:"preamble_key" toml:"preamble_key" yaml:"preamble_key"`
 
 	// Source indicates where this mode was loaded from (embedded, user, project).
-	// Set at load time, not persisted in TOML.
-	Source string `json:"source,omitempty" toml:"-"`
+	// Set at load time, not persisted in TOML or YAML.
+	Source string `json:"source,omitempty" toml:"-" yaml:"-"`
 }
 
 // Validate checks that the mode has all required fields and valid values.
@@ -277,6 +277,19 @@ type ModeAssignment struct {
 
 	// Error holds any error message if status = error.
 	Error string `json:"error,omitempty"`
+
+	// Prompt is the exact text injected into the pane (preamble, context,
+	// and question), recorded for reproducibility.
+	Prompt string `json:"prompt,omitempty"`
+
+	// RateLimitRetries counts how many times the prompt was re-injected after
+	// the agent reported a rate-limit or quota error.
+	RateLimitRetries int `json:"rate_limit_retries,omitempty"`
+
+	// RateLimitAnchor is the last few lines of pane output seen when the
+	// prompt was last re-injected. Rate-limit detection only scans output
+	// after it, so the error that caused that retry is not counted again.
+	RateLimitAnchor string `json:"rate_limit_anchor,omitempty"`
 }
 
 // AssignmentStatus tracks the lifecycle of a mode assignment.
@@ -325,6 +338,8 @@ const (
 	EnsembleError EnsembleStatus = "error"
 	// EnsembleStopped means the ensemble was manually stopped.
 	EnsembleStopped EnsembleStatus = "stopped"
+	// EnsembleAborted means the ensemble was killed without collecting outputs.
+	EnsembleAborted EnsembleStatus = "aborted"
 )
 
 // String returns the status as a string.
@@ -332,9 +347,9 @@ func (s EnsembleStatus) String() string {
 	return string(s)
 }
 
-// IsTerminal returns true if this is a final status (complete, error, or stopped).
+// IsTerminal returns true if this is a final status (complete, error, stopped, or aborted).
 func (s EnsembleStatus) IsTerminal() bool {
-	return s == EnsembleComplete || s == EnsembleError || s == EnsembleStopped
+	return s == EnsembleComplete || s == EnsembleError || s == EnsembleStopped || s == EnsembleAborted
 }
 
 // EnsembleSession tracks a reasoning ensemble session.
@@ -370,6 +385,23 @@ type EnsembleSession struct {
 
 	// Error holds the error message if status = error.
 	Error string `json:"error,omitempty"`
+
+	// BudgetSpent is the measured output token total recorded by strict budget enforcement.
+	BudgetSpent int `json:"budget_spent,omitempty"`
+
+	// BudgetLimit is the total token cap enforced by strict budget enforcement (0 = not enforced).
+	BudgetLimit int `json:"budget_limit,omitempty"`
+
+	// BudgetEstimate is the estimated token total of the modes spawn injected.
+	BudgetEstimate int `json:"budget_estimate,omitempty"`
+
+	// BudgetCutoff is the MaxTotalTokens value at which spawn stopped
+	// injecting modes under OverBudgetSkip (0 = no modes were cut off).
+	BudgetCutoff int `json:"budget_cutoff,omitempty"`
+
+	// Seed is the --seed that shuffled mode-to-pane assignment (0 = unshuffled).
+	// Spawning again with the same seed and modes reproduces the layout.
+	Seed int64 `json:"seed,omitempty"`
 }
 
 // SynthesisStrategy defines how ensemble outputs are combined.
@@ -398,6 +430,8 @@ const (
 	StrategyVoting SynthesisStrategy = "voting"
 	// StrategyArgumentation builds support/attack graph from outputs.
 	StrategyArgumentation SynthesisStrategy = "argumentation-graph"
+	// StrategyDebate surfaces opposing positions as pro/con instead of merging.
+	StrategyDebate SynthesisStrategy = "debate"
 )
 
 // allStrategies is the canonical ordered list of valid strategies.
@@ -405,7 +439,7 @@ var allStrategies = []SynthesisStrategy{
 	StrategyManual, StrategyAdversarial, StrategyConsensus,
 	StrategyCreative, StrategyAnalytical, StrategyDeliberative,
 	StrategyPrioritized, StrategyDialectical, StrategyMetaReasoning,
-	StrategyVoting, StrategyArgumentation,
+	StrategyVoting, StrategyArgumentation, StrategyDebate,
 }
 
 // String returns the strategy as a string.
@@ -1240,6 +1274,48 @@ type BudgetConfig struct {
 	MaxRetries int `json:"max_retries,omitempty" toml:"max_retries" yaml:"max_retries,omitempty"`
 }
 
+// OverBudgetPolicy controls what spawn does when the estimated tokens of an
+// ensemble's modes exceed BudgetConfig.MaxTotalTokens.
+type OverBudgetPolicy string
+
+const (
+	// OverBudgetAllow injects every mode regardless of the estimate.
+	OverBudgetAllow OverBudgetPolicy = "allow"
+	// OverBudgetSkip injects modes until the running estimate would exceed
+	// the budget and skips the rest. It is the default.
+	OverBudgetSkip OverBudgetPolicy = "skip"
+	// OverBudgetError refuses to spawn an ensemble whose estimate exceeds
+	// the budget.
+	OverBudgetError OverBudgetPolicy = "error"
+)
+
+// OverBudgetSkipReason is the assignment error recorded for modes skipped by
+// OverBudgetSkip.
+const OverBudgetSkipReason = "skipped: estimated tokens exceed budget"
+
+// IsValid reports whether the policy is a known value. The empty policy is
+// valid and behaves as OverBudgetSkip.
+func (p OverBudgetPolicy) IsValid() bool {
+	switch p {
+	case "", OverBudgetAllow, OverBudgetSkip, OverBudgetError:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseOverBudgetPolicy parses an --over-budget value.
+func ParseOverBudgetPolicy(value string) (OverBudgetPolicy, error) {
+	policy := OverBudgetPolicy(strings.ToLower(strings.TrimSpace(value)))
+	if !policy.IsValid() {
+		return "", fmt.Errorf("invalid over-budget policy %q: must be allow, skip, or error", value)
+	}
+	if policy == "" {
+		policy = OverBudgetSkip
+	}
+	return policy, nil
+}
+
 // DefaultBudgetConfig returns sensible default budget limits.
 func DefaultBudgetConfig() BudgetConfig {
 	return BudgetConfig{
@@ -195,9 +195,9 @@ func TestSynthesisStrategy_IsValid(t *testing.T) {
 		{StrategyMetaReasoning, true},
 		{StrategyVoting, true},
 		{StrategyArgumentation, true},
+		{StrategyDebate, true},
 		{SynthesisStrategy("invalid"), false},
 		{SynthesisStrategy(""), false},
-		{SynthesisStrategy("debate"), false},
 		{SynthesisStrategy("weighted"), false},
 		{SynthesisStrategy("sequential"), false},
 		{SynthesisStrategy("best-of"), false},
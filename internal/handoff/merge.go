@@ -0,0 +1,84 @@
+package handoff
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DecisionConflict records a decision key that a later handoff in a merge
+// set to a different value.
+type DecisionConflict struct {
+	Key      string `json:"key"`
+	Previous string `json:"previous"`
+	Value    string `json:"value"`
+}
+
+// Merge combines a chronological sequence of handoffs into one. File changes
+// and findings are unioned, completed tasks concatenated, and goal, now,
+// status, outcome, next steps, and blockers come from the last handoff that
+// sets them. Later decisions win; each override is noted in the merged value
+// and returned as a conflict.
+func Merge(handoffs ...*Handoff) (*Handoff, []DecisionConflict) {
+	session := ""
+	for i, h := range handoffs {
+		if i == 0 {
+			session = h.Session
+		} else if h.Session != session {
+			session = "general"
+			break
+		}
+	}
+
+	merged := New(session)
+	var conflicts []DecisionConflict
+	decided := make(map[string]string) // raw decision values, without override notes
+	for _, h := range handoffs {
+		if h.Goal != "" {
+			merged.Goal = h.Goal
+		}
+		if h.Now != "" {
+			merged.Now = h.Now
+		}
+		if h.Test != "" {
+			merged.Test = h.Test
+		}
+		if h.Status != "" {
+			merged.Status = h.Status
+			merged.Outcome = h.Outcome
+		}
+		if len(h.Next) > 0 {
+			merged.Next = h.Next
+		}
+		if len(h.Blockers) > 0 {
+			merged.Blockers = h.Blockers
+		}
+
+		merged.DoneThisSession = append(merged.DoneThisSession, h.DoneThisSession...)
+		merged.Files.Created = uniqueStrings(append(merged.Files.Created, h.Files.Created...))
+		merged.Files.Modified = uniqueStrings(append(merged.Files.Modified, h.Files.Modified...))
+		merged.Files.Deleted = uniqueStrings(append(merged.Files.Deleted, h.Files.Deleted...))
+		merged.ActiveBeads = uniqueStrings(append(merged.ActiveBeads, h.ActiveBeads...))
+		for k, v := range h.Findings {
+			merged.AddFinding(k, v)
+		}
+
+		keys := make([]string, 0, len(h.Decisions))
+		for k := range h.Decisions {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v := h.Decisions[k]
+			prev, seen := decided[k]
+			decided[k] = v
+			switch {
+			case !seen:
+				merged.AddDecision(k, v)
+			case prev != v:
+				conflicts = append(conflicts, DecisionConflict{Key: k, Previous: prev, Value: v})
+				merged.AddDecision(k, fmt.Sprintf("%s (overrides earlier: %s)", v, prev))
+			}
+		}
+	}
+	return merged, conflicts
+}
@@ -0,0 +1,46 @@
+package handoff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	first := New("proj").WithGoalAndNow("Design auth", "Write handler").WithStatus(StatusPartial, OutcomePartialPlus)
+	first.AddTask("Sketched API").AddDecision("store", "postgres").AddDecision("auth", "jwt")
+	first.MarkCreated("api.md").MarkModified("main.go")
+	first.AddBlocker("Waiting on schema")
+
+	second := New("proj").WithGoalAndNow("", "Add tests").WithStatus(StatusComplete, OutcomeSucceeded)
+	second.AddTask("Wrote handler").AddDecision("store", "sqlite").AddDecision("auth", "jwt")
+	second.MarkCreated("handler.go").MarkModified("main.go")
+
+	merged, conflicts := Merge(first, second)
+	if merged.Session != "proj" || merged.Goal != "Design auth" || merged.Now != "Add tests" || merged.Status != StatusComplete {
+		t.Errorf("merged header = %q/%q/%q/%q", merged.Session, merged.Goal, merged.Now, merged.Status)
+	}
+	if len(merged.DoneThisSession) != 2 || merged.DoneThisSession[1].Task != "Wrote handler" {
+		t.Errorf("DoneThisSession = %+v", merged.DoneThisSession)
+	}
+	if got := strings.Join(merged.Files.Created, ","); got != "api.md,handler.go" {
+		t.Errorf("Files.Created = %q", got)
+	}
+	if got := strings.Join(merged.Files.Modified, ","); got != "main.go" {
+		t.Errorf("Files.Modified = %q", got)
+	}
+	if len(merged.Blockers) != 1 {
+		t.Errorf("Blockers = %v, want the last non-empty list", merged.Blockers)
+	}
+	if merged.Decisions["auth"] != "jwt" || merged.Decisions["store"] != "sqlite (overrides earlier: postgres)" {
+		t.Errorf("Decisions = %v", merged.Decisions)
+	}
+	if len(conflicts) != 1 || conflicts[0] != (DecisionConflict{Key: "store", Previous: "postgres", Value: "sqlite"}) {
+		t.Errorf("conflicts = %+v", conflicts)
+	}
+
+	if merged, _ := Merge(first, New("other")); merged.Session != "general" {
+		t.Errorf("mixed sessions merged into %q, want general", merged.Session)
+	}
+}
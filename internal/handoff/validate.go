@@ -231,3 +231,39 @@ func (h *Handoff) MustValidate() {
 		panic("handoff validation failed: " + errs.Error())
 	}
 }
+
+// ValidateSchema is the stricter check behind `ntm handoff validate`. On top
+// of Validate it requires session, status, and outcome to be set, and it
+// warns when a partial handoff leaves next or blockers empty, since the
+// next session then has nothing to pick up.
+func (h *Handoff) ValidateSchema() (errs, warnings ValidationErrors) {
+	for _, f := range []struct{ field, value string }{
+		{"session", h.Session},
+		{"status", h.Status},
+		{"outcome", h.Outcome},
+	} {
+		if f.value == "" {
+			errs = append(errs, ValidationError{
+				Field:   f.field,
+				Message: "required field missing",
+			})
+		}
+	}
+	errs = append(errs, h.Validate()...)
+
+	if h.Status == StatusPartial {
+		if len(h.Next) == 0 {
+			warnings = append(warnings, ValidationError{
+				Field:   "next",
+				Message: "empty on a partial handoff - list the remaining steps",
+			})
+		}
+		if len(h.Blockers) == 0 {
+			warnings = append(warnings, ValidationError{
+				Field:   "blockers",
+				Message: "empty on a partial handoff - say what stopped the work",
+			})
+		}
+	}
+	return errs, warnings
+}
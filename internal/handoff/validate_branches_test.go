@@ -58,3 +58,33 @@ func TestMustValidate_InvalidHandoff_Panics(t *testing.T) {
 
 	h.MustValidate()
 }
+
+// ---------------------------------------------------------------------------
+// ValidateSchema
+// ---------------------------------------------------------------------------
+
+func TestValidateSchema(t *testing.T) {
+	t.Parallel()
+
+	h := New("test-session").
+		WithGoalAndNow("Implement feature", "Write tests").
+		WithStatus(StatusComplete, OutcomeSucceeded)
+	if errs, warnings := h.ValidateSchema(); len(errs) != 0 || len(warnings) != 0 {
+		t.Errorf("complete handoff: errs=%v warnings=%v, want none", errs, warnings)
+	}
+
+	h.Status = StatusPartial
+	h.Outcome = "MOSTLY"
+	errs, warnings := h.ValidateSchema()
+	if got := errs.FieldNames(); len(got) != 1 || got[0] != "outcome" {
+		t.Errorf("partial handoff error fields = %v, want [outcome]", got)
+	}
+	if got := warnings.FieldNames(); len(got) != 2 || got[0] != "next" || got[1] != "blockers" {
+		t.Errorf("partial handoff warning fields = %v, want [next blockers]", got)
+	}
+
+	errs, _ = (&Handoff{Goal: "g", Now: "n"}).ValidateSchema()
+	if got := errs.FieldNames(); len(got) != 3 || got[0] != "session" || got[1] != "status" || got[2] != "outcome" {
+		t.Errorf("bare handoff error fields = %v, want [session status outcome]", got)
+	}
+}
@@ -511,6 +511,63 @@ func PruneByTime(cutoff time.Time) (int, error) {
 	return removed, nil
 }
 
+// RemoveWhere removes every entry for which match returns true and returns
+// how many were removed.
+func RemoveWhere(match func(HistoryEntry) bool) (int, error) {
+	unlock, err := acquireLock()
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	entries, err := readAllLocked()
+	if err != nil {
+		return 0, err
+	}
+
+	var toKeep []HistoryEntry
+	for _, e := range entries {
+		if !match(e) {
+			toKeep = append(toKeep, e)
+		}
+	}
+
+	removed := len(entries) - len(toKeep)
+	if removed == 0 {
+		return 0, nil
+	}
+
+	// Rewrite file atomically (re-encrypt if enabled)
+	var buf bytes.Buffer
+	skipped := 0
+	for _, entry := range toKeep {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			slog.Warn("history: remove: skipping entry that failed to marshal", "error", err)
+			skipped++
+			continue
+		}
+		data, err = encryptJSONLine(data)
+		if err != nil {
+			slog.Warn("history: remove: skipping entry that failed to encrypt", "error", err)
+			skipped++
+			continue
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	if skipped > 0 {
+		slog.Warn("history: remove: entries lost during rewrite", "skipped", skipped)
+	}
+
+	path := StoragePath()
+	if err := util.AtomicWriteFile(path, buf.Bytes(), 0600); err != nil {
+		return 0, err
+	}
+
+	return removed, nil
+}
+
 // Search finds entries matching a query string in the prompt.
 func Search(query string) ([]HistoryEntry, error) {
 	entries, err := ReadAll()
@@ -265,6 +265,36 @@ func TestPruneByTime(t *testing.T) {
 	}
 }
 
+func TestRemoveWhere(t *testing.T) {
+	tmpDir := t.TempDir()
+	os.Setenv("XDG_DATA_HOME", tmpDir)
+	defer os.Unsetenv("XDG_DATA_HOME")
+
+	Clear()
+
+	Append(NewEntry("alpha", nil, "one", SourceCLI))
+	Append(NewEntry("beta", nil, "two", SourceCLI))
+	Append(NewEntry("alpha", nil, "three", SourcePalette))
+
+	removed, err := RemoveWhere(func(e HistoryEntry) bool {
+		return e.Session == "alpha" && e.Source == SourceCLI
+	})
+	if err != nil {
+		t.Fatalf("failed to remove: %v", err)
+	}
+	if removed != 1 {
+		t.Errorf("expected 1 removed, got %d", removed)
+	}
+
+	entries, err := ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read all: %v", err)
+	}
+	if len(entries) != 2 || entries[0].Prompt != "two" || entries[1].Prompt != "three" {
+		t.Errorf("unexpected remaining entries: %+v", entries)
+	}
+}
+
 func TestSearch(t *testing.T) {
 	tmpDir := t.TempDir()
 	os.Setenv("XDG_DATA_HOME", tmpDir)
@@ -145,6 +145,19 @@ func TestWriteJSONPrettyAndCompact(t *testing.T) {
 	}
 }
 
+func TestWriteJSONForcedCompact(t *testing.T) {
+	SetCompactJSON(true)
+	t.Cleanup(func() { SetCompactJSON(false) })
+
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, map[string]any{"foo": "bar", "n": []int{1, 2}}, true); err != nil {
+		t.Fatalf("WriteJSON error: %v", err)
+	}
+	if got, want := buf.String(), "{\"foo\":\"bar\",\"n\":[1,2]}\n"; got != want {
+		t.Errorf("forced compact JSON = %q, want %q", got, want)
+	}
+}
+
 func TestPrintJSONCompactOutput(t *testing.T) {
 	stdout, _ := captureOutput(func() {
 		if err := PrintJSONCompact(map[string]string{"foo": "bar"}); err != nil {
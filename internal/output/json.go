@@ -4,18 +4,30 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"sync/atomic"
 	"time"
 )
 
+// compactJSON forces single-line JSON from WriteJSON and PrintJSON, for log
+// pipelines that expect one object per line. It is set from the global
+// --compact-json flag.
+var compactJSON atomic.Bool
+
+// SetCompactJSON turns forced compact JSON output on or off.
+func SetCompactJSON(compact bool) {
+	compactJSON.Store(compact)
+}
+
 // JSON outputs data as JSON to the formatter's writer
 func (f *Formatter) JSON(v interface{}) error {
 	return WriteJSON(f.writer, v, f.pretty)
 }
 
-// WriteJSON writes data as JSON to the given writer
+// WriteJSON writes data as JSON to the given writer. pretty is ignored
+// when compact output was requested with SetCompactJSON.
 func WriteJSON(w io.Writer, v interface{}, pretty bool) error {
 	encoder := json.NewEncoder(w)
-	if pretty {
+	if pretty && !compactJSON.Load() {
 		encoder.SetIndent("", "  ")
 	}
 	return encoder.Encode(v)
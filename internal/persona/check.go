@@ -0,0 +1,88 @@
+package persona
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Temperature bounds and the upper edge of each display band. A temperature
+// belongs to the first band whose upper edge it does not exceed.
+const (
+	MinTemperature = 0.0
+	MaxTemperature = 2.0
+
+	focusedMaxTemperature  = 0.3
+	balancedMaxTemperature = 0.7
+	creativeMaxTemperature = 1.0
+)
+
+// Temperature band names, from coolest to hottest.
+const (
+	BandFocused  = "focused"
+	BandBalanced = "balanced"
+	BandCreative = "creative"
+	BandWild     = "wild"
+)
+
+// TemperatureBand returns the display band for temp.
+func TemperatureBand(temp float64) string {
+	switch {
+	case temp <= focusedMaxTemperature:
+		return BandFocused
+	case temp <= balancedMaxTemperature:
+		return BandBalanced
+	case temp <= creativeMaxTemperature:
+		return BandCreative
+	default:
+		return BandWild
+	}
+}
+
+// Issue is a single problem found by Check.
+type Issue struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Check inspects a persona definition more thoroughly than Validate,
+// separating problems that make it unusable (errs) from ones worth a second
+// look (warnings). Fields a child can inherit through Extends are only
+// required when the persona has no parent (extends or base).
+func (p *Persona) Check() (errs, warnings []Issue) {
+	// Temperature is checked below with a more specific message.
+	base := *p
+	base.Temperature = nil
+	if err := base.Validate(); err != nil {
+		errs = append(errs, Issue{Field: "persona", Message: err.Error()})
+	}
+
+	if p.Temperature != nil {
+		temp := *p.Temperature
+		if temp < MinTemperature || temp > MaxTemperature {
+			errs = append(errs, Issue{Field: "temperature", Message: fmt.Sprintf("%g is outside %.1f-%.1f", temp, MinTemperature, MaxTemperature)})
+		} else if TemperatureBand(temp) == BandWild {
+			warnings = append(warnings, Issue{Field: "temperature", Message: fmt.Sprintf("%g is in the %q band (above %.1f)", temp, BandWild, creativeMaxTemperature)})
+		}
+	}
+
+	if strings.TrimSpace(p.Description) == "" && p.Parent() == "" {
+		errs = append(errs, Issue{Field: "description", Message: "description is blank"})
+	}
+
+	if len(p.Tags) == 0 && p.Parent() == "" {
+		warnings = append(warnings, Issue{Field: "tags", Message: "no tags"})
+	}
+	seen := make(map[string]bool, len(p.Tags))
+	for _, tag := range p.Tags {
+		switch {
+		case strings.TrimSpace(tag) == "":
+			errs = append(errs, Issue{Field: "tags", Message: "empty tag"})
+		case !tagRegex.MatchString(tag):
+			errs = append(errs, Issue{Field: "tags", Message: fmt.Sprintf("invalid tag %q (allowed: a-z, 0-9, _, -; must start with a letter or digit)", tag)})
+		case seen[tag]:
+			warnings = append(warnings, Issue{Field: "tags", Message: fmt.Sprintf("duplicate tag %q", tag)})
+		}
+		seen[tag] = true
+	}
+	return errs, warnings
+}
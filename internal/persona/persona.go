@@ -42,6 +42,10 @@ type Persona struct {
 	// Child settings override parent settings.
 	Extends string `toml:"extends,omitempty"`
 
+	// Base is an alias for Extends, for personas that read better as
+	// variants of a base persona. Setting both to different names is an error.
+	Base string `toml:"base,omitempty"`
+
 	// SystemPromptAppend is appended to the parent's system prompt when extending.
 	SystemPromptAppend string `toml:"system_prompt_append,omitempty"`
 
@@ -110,6 +114,15 @@ func (p *Persona) AgentTypeFlag() string {
 	}
 }
 
+// Parent returns the name of the persona p inherits from, whether declared
+// with extends or base, or "" if it inherits from none.
+func (p *Persona) Parent() string {
+	if p.Extends != "" {
+		return p.Extends
+	}
+	return p.Base
+}
+
 // Validate checks if the persona configuration is valid.
 func (p *Persona) Validate() error {
 	if p.Name == "" {
@@ -118,7 +131,10 @@ func (p *Persona) Validate() error {
 	if !nameRegex.MatchString(p.Name) {
 		return fmt.Errorf("persona name %q contains invalid characters (allowed: a-z, A-Z, 0-9, _, -)", p.Name)
 	}
-	if strings.TrimSpace(p.AgentType) == "" && strings.TrimSpace(p.Extends) == "" {
+	if p.Extends != "" && p.Base != "" && !strings.EqualFold(p.Extends, p.Base) {
+		return fmt.Errorf("persona %q: extends %q and base %q disagree", p.Name, p.Extends, p.Base)
+	}
+	if strings.TrimSpace(p.AgentType) == "" && strings.TrimSpace(p.Parent()) == "" {
 		return fmt.Errorf("persona %q: agent_type is required", p.Name)
 	}
 
@@ -136,7 +152,7 @@ func (p *Persona) Validate() error {
 
 	// Validate temperature if set
 	if p.Temperature != nil {
-		if *p.Temperature < 0 || *p.Temperature > 2 {
+		if *p.Temperature < MinTemperature || *p.Temperature > MaxTemperature {
 			return fmt.Errorf("persona %q: temperature must be between 0 and 2", p.Name)
 		}
 	}
@@ -219,16 +235,16 @@ func (r *Registry) ResolveInheritance() error {
 			return nil, fmt.Errorf("circular inheritance detected: %s", name)
 		}
 
-		if p.Extends == "" {
+		if p.Parent() == "" {
 			p.resolved = true
 			return p, nil
 		}
 
 		resolving[strings.ToLower(name)] = true
 
-		parent, err := resolve(p.Extends)
+		parent, err := resolve(p.Parent())
 		if err != nil {
-			return nil, fmt.Errorf("resolving parent %q for %q: %w", p.Extends, name, err)
+			return nil, fmt.Errorf("resolving parent %q for %q: %w", p.Parent(), name, err)
 		}
 
 		// Merge parent into child (child overrides parent)
@@ -262,6 +278,7 @@ func mergePersonas(parent, child *Persona) *Persona {
 		SystemPrompt:       child.SystemPrompt,
 		Temperature:        child.Temperature,
 		Extends:            child.Extends,
+		Base:               child.Base,
 		SystemPromptAppend: child.SystemPromptAppend,
 	}
 
@@ -318,17 +335,15 @@ func mergePersonas(parent, child *Persona) *Persona {
 			copy(merged.Tags, parent.Tags)
 		}
 	} else if len(parent.Tags) > 0 {
-		// Merge tags, avoiding duplicates
+		// Merge tags, avoiding duplicates: parent tags first, then the
+		// child's additions, so the order is stable across loads.
 		tagSet := make(map[string]bool)
-		for _, t := range parent.Tags {
-			tagSet[t] = true
-		}
-		for _, t := range child.Tags {
-			tagSet[t] = true
-		}
-		merged.Tags = make([]string, 0, len(tagSet))
-		for t := range tagSet {
-			merged.Tags = append(merged.Tags, t)
+		merged.Tags = make([]string, 0, len(parent.Tags)+len(child.Tags))
+		for _, t := range append(append([]string{}, parent.Tags...), child.Tags...) {
+			if !tagSet[t] {
+				tagSet[t] = true
+				merged.Tags = append(merged.Tags, t)
+			}
 		}
 	}
 	if len(merged.FocusPatterns) == 0 && len(parent.FocusPatterns) > 0 {
@@ -352,8 +367,8 @@ func undecodedPersonaFields(md toml.MetaData) []string {
 	return fields
 }
 
-// LoadFromFile loads personas from a TOML file.
-func LoadFromFile(path string) (*PersonasConfig, error) {
+// decodeFile parses a personas TOML file without validating its entries.
+func decodeFile(path string) (*PersonasConfig, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
@@ -367,6 +382,15 @@ func LoadFromFile(path string) (*PersonasConfig, error) {
 	if fields := undecodedPersonaFields(md); len(fields) > 0 {
 		return nil, fmt.Errorf("parsing personas file %s: unknown field(s): %s", path, strings.Join(fields, ", "))
 	}
+	return &cfg, nil
+}
+
+// LoadFromFile loads personas from a TOML file.
+func LoadFromFile(path string) (*PersonasConfig, error) {
+	cfg, err := decodeFile(path)
+	if err != nil {
+		return nil, err
+	}
 
 	seenPersonas := make(map[string]struct{}, len(cfg.Personas))
 	// Validate all personas
@@ -397,7 +421,7 @@ func LoadFromFile(path string) (*PersonasConfig, error) {
 		}
 	}
 
-	return &cfg, nil
+	return cfg, nil
 }
 
 func userPathCandidates() []string {
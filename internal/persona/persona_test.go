@@ -442,6 +442,36 @@ func TestPersonaInheritanceCycle(t *testing.T) {
 	}
 }
 
+func TestPersonaBaseAlias(t *testing.T) {
+	cool, hot := 0.2, 0.9
+	r := NewRegistry()
+	r.Add(&Persona{Name: "reviewer-base", AgentType: "claude", Description: "Shared reviewer", Temperature: &cool, Tags: []string{"review", "quality"}})
+	r.Add(&Persona{Name: "reviewer-hot", Base: "reviewer-base", Temperature: &hot, Tags: []string{"explore", "review"}})
+	if err := r.ResolveInheritance(); err != nil {
+		t.Fatalf("ResolveInheritance: %v", err)
+	}
+
+	got, _ := r.Get("reviewer-hot")
+	if got.Description != "Shared reviewer" || got.AgentType != "claude" || *got.Temperature != hot {
+		t.Errorf("resolved = %+v, want inherited description and agent, own temperature", got)
+	}
+	if strings.Join(got.Tags, ",") != "review,quality,explore" {
+		t.Errorf("tags = %v, want parent tags then child additions", got.Tags)
+	}
+
+	r = NewRegistry()
+	r.Add(&Persona{Name: "loop-a", Base: "loop-b", AgentType: "claude"})
+	r.Add(&Persona{Name: "loop-b", Extends: "loop-a", AgentType: "claude"})
+	if err := r.ResolveInheritance(); err == nil || !strings.Contains(err.Error(), "circular") {
+		t.Errorf("base cycle error = %v, want circular", err)
+	}
+
+	p := Persona{Name: "both", Extends: "architect", Base: "tester"}
+	if err := p.Validate(); err == nil || !strings.Contains(err.Error(), "disagree") {
+		t.Errorf("Validate() = %v, want extends/base disagreement", err)
+	}
+}
+
 func TestPersonaSets(t *testing.T) {
 	r := NewRegistry()
 
@@ -0,0 +1,214 @@
+package persona
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+var tagRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// SharedPersona is the portable YAML form of a Persona used by
+// `ntm personas export` and `ntm personas import`. It carries every
+// persona field so a round trip through YAML is lossless.
+type SharedPersona struct {
+	Name               string   `yaml:"name"`
+	Description        string   `yaml:"description,omitempty"`
+	AgentType          string   `yaml:"agent_type,omitempty"`
+	Model              string   `yaml:"model,omitempty"`
+	SystemPrompt       string   `yaml:"system_prompt,omitempty"`
+	Temperature        *float64 `yaml:"temperature,omitempty"`
+	ReasoningEffort    string   `yaml:"reasoning_effort,omitempty"`
+	ContextFiles       []string `yaml:"context_files,omitempty"`
+	Tags               []string `yaml:"tags,omitempty"`
+	FocusPatterns      []string `yaml:"focus_patterns,omitempty"`
+	Extends            string   `yaml:"extends,omitempty"`
+	Base               string   `yaml:"base,omitempty"`
+	SystemPromptAppend string   `yaml:"system_prompt_append,omitempty"`
+}
+
+func sharedFromPersona(p *Persona) SharedPersona {
+	return SharedPersona{
+		Name:               p.Name,
+		Description:        p.Description,
+		AgentType:          p.AgentType,
+		Model:              p.Model,
+		SystemPrompt:       p.SystemPrompt,
+		Temperature:        p.Temperature,
+		ReasoningEffort:    p.ReasoningEffort,
+		ContextFiles:       p.ContextFiles,
+		Tags:               p.Tags,
+		FocusPatterns:      p.FocusPatterns,
+		Extends:            p.Extends,
+		Base:               p.Base,
+		SystemPromptAppend: p.SystemPromptAppend,
+	}
+}
+
+func (s SharedPersona) persona() *Persona {
+	return &Persona{
+		Name:               s.Name,
+		Description:        s.Description,
+		AgentType:          s.AgentType,
+		Model:              s.Model,
+		SystemPrompt:       s.SystemPrompt,
+		Temperature:        s.Temperature,
+		ReasoningEffort:    s.ReasoningEffort,
+		ContextFiles:       s.ContextFiles,
+		Tags:               s.Tags,
+		FocusPatterns:      s.FocusPatterns,
+		Extends:            s.Extends,
+		Base:               s.Base,
+		SystemPromptAppend: s.SystemPromptAppend,
+	}
+}
+
+// ExportYAML renders p in the portable YAML form.
+func ExportYAML(p *Persona) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(sharedFromPersona(p)); err != nil {
+		return nil, fmt.Errorf("encoding persona %q: %w", p.Name, err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ImportYAML parses a persona written by ExportYAML. Unknown fields are
+// rejected, and the result is validated with Validate and ValidateTags.
+func ImportYAML(data []byte) (*Persona, error) {
+	var shared SharedPersona
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&shared); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("parsing persona: empty document")
+		}
+		return nil, fmt.Errorf("parsing persona: %w", err)
+	}
+
+	p := shared.persona()
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	if err := p.ValidateTags(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ValidateTags checks that every tag is lowercase letters, digits, '_' or
+// '-', starts with a letter or digit, and appears only once.
+func (p *Persona) ValidateTags() error {
+	seen := make(map[string]bool, len(p.Tags))
+	for _, tag := range p.Tags {
+		if !tagRegex.MatchString(tag) {
+			return fmt.Errorf("persona %q: invalid tag %q (allowed: a-z, 0-9, _, -; must start with a letter or digit)", p.Name, tag)
+		}
+		if seen[tag] {
+			return fmt.Errorf("persona %q: duplicate tag %q", p.Name, tag)
+		}
+		seen[tag] = true
+	}
+	return nil
+}
+
+// FindDefinition returns the persona named name as written in its source,
+// before inheritance is resolved, along with that source ("project", "user",
+// or "built-in"). Sources are searched project first, matching the override
+// order of LoadRegistry. It returns nil when no source defines name.
+func FindDefinition(projectDir, name string) (*Persona, string, error) {
+	return findDefinition(projectDir, name, LoadFromFile)
+}
+
+// InspectDefinition is FindDefinition without validating the personas
+// files, so a misconfigured persona can still be loaded and diagnosed.
+func InspectDefinition(projectDir, name string) (*Persona, string, error) {
+	return findDefinition(projectDir, name, decodeFile)
+}
+
+func findDefinition(projectDir, name string, load func(string) (*PersonasConfig, error)) (*Persona, string, error) {
+	if projectDir != "" {
+		cfg, err := load(filepath.Join(projectDir, DefaultProjectPath()))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, "", fmt.Errorf("loading project personas: %w", err)
+		}
+		if p := findInConfig(cfg, name); p != nil {
+			return p, "project", nil
+		}
+	}
+
+	// Like LoadUserConfig, only the first existing user file counts.
+	for _, path := range userPathCandidates() {
+		cfg, err := load(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("loading user personas: %w", err)
+		}
+		if p := findInConfig(cfg, name); p != nil {
+			return p, "user", nil
+		}
+		break
+	}
+
+	for _, bp := range BuiltinPersonas() {
+		if strings.EqualFold(bp.Name, name) {
+			return &bp, "built-in", nil
+		}
+	}
+	return nil, "", nil
+}
+
+func findInConfig(cfg *PersonasConfig, name string) *Persona {
+	if cfg == nil {
+		return nil
+	}
+	for i := range cfg.Personas {
+		if strings.EqualFold(cfg.Personas[i].Name, name) {
+			return &cfg.Personas[i]
+		}
+	}
+	return nil
+}
+
+// UpsertPersona adds p to cfg, replacing a persona with the same name
+// (case-insensitive). It reports whether an existing entry was replaced.
+func (cfg *PersonasConfig) UpsertPersona(p Persona) bool {
+	for i := range cfg.Personas {
+		if strings.EqualFold(cfg.Personas[i].Name, p.Name) {
+			cfg.Personas[i] = p
+			return true
+		}
+	}
+	cfg.Personas = append(cfg.Personas, p)
+	return false
+}
+
+// SaveToFile writes cfg to path as TOML, creating parent directories if
+// needed. Comments in an existing file are not preserved.
+func SaveToFile(path string, cfg *PersonasConfig) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating personas dir: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(cfg); err != nil {
+		return fmt.Errorf("encoding personas file %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("writing personas file %s: %w", path, err)
+	}
+	return nil
+}
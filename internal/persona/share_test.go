@@ -0,0 +1,86 @@
+package persona
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExportImportYAMLRoundTrip(t *testing.T) {
+	temp := 0.35
+	want := &Persona{
+		Name:               "tuned-reviewer",
+		Description:        "Reviewer tuned for\nconcurrency bugs",
+		AgentType:          "codex",
+		Model:              "gpt-5",
+		SystemPrompt:       "Look for races.\n\nBe terse.",
+		Temperature:        &temp,
+		ReasoningEffort:    "high",
+		ContextFiles:       []string{"docs/*.md"},
+		Tags:               []string{"review", "go_concurrency"},
+		FocusPatterns:      []string{"internal/**"},
+		Extends:            "reviewer",
+		SystemPromptAppend: "Cite line numbers.",
+	}
+
+	data, err := ExportYAML(want)
+	if err != nil {
+		t.Fatalf("ExportYAML: %v", err)
+	}
+	got, err := ImportYAML(data)
+	if err != nil {
+		t.Fatalf("ImportYAML: %v\n%s", err, data)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip = %+v, want %+v", got, want)
+	}
+}
+
+func TestImportYAMLValidates(t *testing.T) {
+	for body, wantErr := range map[string]string{
+		"name: a\nagent_type: claude\ntemperature: 2.5\n":       "temperature must be between 0 and 2",
+		"name: a\nagent_type: claude\ntags: [Review]\n":         "invalid tag",
+		"name: a\nagent_type: claude\ntags: [\"#review\"]\n":    "invalid tag",
+		"name: a\nagent_type: claude\ntags: [review, review]\n": "duplicate tag",
+		"name: a\nagent_type: claude\ncolour: red\n":            "field colour not found",
+		"name: a b\nagent_type: claude\n":                       "invalid characters",
+		"":                                                      "empty document",
+	} {
+		if _, err := ImportYAML([]byte(body)); err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("ImportYAML(%q) error = %v, want %q", body, err, wantErr)
+		}
+	}
+}
+
+func TestTemperatureBand(t *testing.T) {
+	for temp, want := range map[float64]string{0: BandFocused, 0.3: BandFocused, 0.5: BandBalanced, 0.7: BandBalanced, 1.0: BandCreative, 1.01: BandWild, 2: BandWild} {
+		if got := TemperatureBand(temp); got != want {
+			t.Errorf("TemperatureBand(%v) = %q, want %q", temp, got, want)
+		}
+	}
+}
+
+func TestPersonaCheck(t *testing.T) {
+	hot, wild := 5.0, 1.5
+	errs, warnings := (&Persona{Name: "bad", AgentType: "claude", Temperature: &hot, Tags: []string{"ok", "", "Bad Tag"}}).Check()
+	if len(errs) != 4 {
+		t.Errorf("errs = %+v, want temperature, description, and two tag errors", errs)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("warnings = %+v, want none", warnings)
+	}
+	for _, e := range errs {
+		if e.Field == "persona" {
+			t.Errorf("temperature reported twice: %+v", errs)
+		}
+	}
+
+	errs, warnings = (&Persona{Name: "hot", AgentType: "claude", Description: "d", Temperature: &wild}).Check()
+	if len(errs) != 0 || len(warnings) != 2 || warnings[0].Field != "temperature" || warnings[1].Field != "tags" {
+		t.Errorf("Check() = %+v, %+v; want wild-band and no-tags warnings", errs, warnings)
+	}
+
+	if errs, warnings = (&Persona{Name: "child", Extends: "architect"}).Check(); len(errs)+len(warnings) != 0 {
+		t.Errorf("extending persona: %+v, %+v; want no issues", errs, warnings)
+	}
+}
@@ -20,21 +20,28 @@ type EnsembleSession struct {
 	SynthesizedAt     *time.Time       `json:"synthesized_at,omitempty"`
 	SynthesisOutput   string           `json:"synthesis_output,omitempty"`
 	Error             string           `json:"error,omitempty"`
+	BudgetSpent       int              `json:"budget_spent,omitempty"`
+	BudgetLimit       int              `json:"budget_limit,omitempty"`
+	BudgetEstimate    int              `json:"budget_estimate,omitempty"`
+	BudgetCutoff      int              `json:"budget_cutoff,omitempty"`
+	Seed              int64            `json:"seed,omitempty"`
 	Assignments       []ModeAssignment `json:"assignments,omitempty"`
 }
 
 // ModeAssignment represents a persisted mode assignment for an ensemble session.
 type ModeAssignment struct {
-	ID          int64      `json:"id"`
-	EnsembleID  int64      `json:"ensemble_id"`
-	ModeID      string     `json:"mode_id"`
-	PaneName    string     `json:"pane_name"`
-	AgentType   string     `json:"agent_type"`
-	Status      string     `json:"status"`
-	OutputPath  string     `json:"output_path,omitempty"`
-	AssignedAt  time.Time  `json:"assigned_at,omitempty"`
-	CompletedAt *time.Time `json:"completed_at,omitempty"`
-	Error       string     `json:"error,omitempty"`
+	ID               int64      `json:"id"`
+	EnsembleID       int64      `json:"ensemble_id"`
+	ModeID           string     `json:"mode_id"`
+	PaneName         string     `json:"pane_name"`
+	AgentType        string     `json:"agent_type"`
+	Status           string     `json:"status"`
+	OutputPath       string     `json:"output_path,omitempty"`
+	AssignedAt       time.Time  `json:"assigned_at,omitempty"`
+	CompletedAt      *time.Time `json:"completed_at,omitempty"`
+	Error            string     `json:"error,omitempty"`
+	Prompt           string     `json:"prompt,omitempty"`
+	RateLimitRetries int        `json:"rate_limit_retries,omitempty"`
 }
 
 // EnsembleStore provides persistence for ensemble sessions.
@@ -80,9 +87,11 @@ func (s *EnsembleStore) SaveEnsemble(e *EnsembleSession) error {
 	if err := func() error {
 		result, err := tx.Exec(`
 			UPDATE ensemble_sessions
-			SET question = ?, preset_used = ?, status = ?, synthesis_strategy = ?, synthesized_at = ?, synthesis_output = ?, error = ?
+			SET question = ?, preset_used = ?, status = ?, synthesis_strategy = ?, synthesized_at = ?, synthesis_output = ?, error = ?,
+			    budget_spent = ?, budget_limit = ?, budget_estimate = ?, budget_cutoff = ?, seed = ?
 			WHERE session_name = ?`,
-			e.Question, e.PresetUsed, e.Status, e.SynthesisStrategy, e.SynthesizedAt, e.SynthesisOutput, e.Error, e.SessionName,
+			e.Question, e.PresetUsed, e.Status, e.SynthesisStrategy, e.SynthesizedAt, e.SynthesisOutput, e.Error,
+			e.BudgetSpent, e.BudgetLimit, e.BudgetEstimate, e.BudgetCutoff, e.Seed, e.SessionName,
 		)
 		if err != nil {
 			return fmt.Errorf("update ensemble session: %w", err)
@@ -95,9 +104,10 @@ func (s *EnsembleStore) SaveEnsemble(e *EnsembleSession) error {
 		if rows == 0 {
 			_, err := tx.Exec(`
 				INSERT INTO ensemble_sessions
-					(session_name, question, preset_used, status, synthesis_strategy, created_at, synthesized_at, synthesis_output, error)
-				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					(session_name, question, preset_used, status, synthesis_strategy, created_at, synthesized_at, synthesis_output, error, budget_spent, budget_limit, budget_estimate, budget_cutoff, seed)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 				e.SessionName, e.Question, e.PresetUsed, e.Status, e.SynthesisStrategy, e.CreatedAt, e.SynthesizedAt, e.SynthesisOutput, e.Error,
+				e.BudgetSpent, e.BudgetLimit, e.BudgetEstimate, e.BudgetCutoff, e.Seed,
 			)
 			if err != nil {
 				return fmt.Errorf("insert ensemble session: %w", err)
@@ -120,8 +130,8 @@ func (s *EnsembleStore) SaveEnsemble(e *EnsembleSession) error {
 
 		stmt, err := tx.Prepare(`
 			INSERT INTO mode_assignments
-				(ensemble_id, mode_id, pane_name, agent_type, status, output_path, assigned_at, completed_at, error)
-			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+				(ensemble_id, mode_id, pane_name, agent_type, status, output_path, assigned_at, completed_at, error, prompt, rate_limit_retries)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
 		if err != nil {
 			return fmt.Errorf("prepare assignment insert: %w", err)
 		}
@@ -157,6 +167,8 @@ func (s *EnsembleStore) SaveEnsemble(e *EnsembleSession) error {
 				assignedAt,
 				completedAt,
 				assignment.Error,
+				assignment.Prompt,
+				assignment.RateLimitRetries,
 			); err != nil {
 				return fmt.Errorf("insert assignment: %w", err)
 			}
@@ -191,7 +203,7 @@ func (s *EnsembleStore) GetEnsemble(sessionName string) (*EnsembleSession, error
 	err := s.store.db.QueryRow(`
 		SELECT id, session_name, question, COALESCE(preset_used, ''), status,
 		       COALESCE(synthesis_strategy, ''), created_at, synthesized_at,
-		       COALESCE(synthesis_output, ''), COALESCE(error, '')
+		       COALESCE(synthesis_output, ''), COALESCE(error, ''), budget_spent, budget_limit, budget_estimate, budget_cutoff, seed
 		FROM ensemble_sessions
 		WHERE session_name = ?`, sessionName,
 	).Scan(
@@ -205,6 +217,11 @@ func (s *EnsembleStore) GetEnsemble(sessionName string) (*EnsembleSession, error
 		&synthesizedAt,
 		&session.SynthesisOutput,
 		&session.Error,
+		&session.BudgetSpent,
+		&session.BudgetLimit,
+		&session.BudgetEstimate,
+		&session.BudgetCutoff,
+		&session.Seed,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -298,7 +315,7 @@ func (s *EnsembleStore) ListEnsembles() ([]*EnsembleSession, error) {
 	rows, err := s.store.db.Query(`
 		SELECT id, session_name, question, COALESCE(preset_used, ''), status,
 		       COALESCE(synthesis_strategy, ''), created_at, synthesized_at,
-		       COALESCE(synthesis_output, ''), COALESCE(error, '')
+		       COALESCE(synthesis_output, ''), COALESCE(error, ''), budget_spent, budget_limit, budget_estimate, budget_cutoff, seed
 		FROM ensemble_sessions
 		ORDER BY created_at DESC`)
 	if err != nil {
@@ -323,6 +340,11 @@ func (s *EnsembleStore) ListEnsembles() ([]*EnsembleSession, error) {
 			&synthesizedAt,
 			&session.SynthesisOutput,
 			&session.Error,
+			&session.BudgetSpent,
+			&session.BudgetLimit,
+			&session.BudgetEstimate,
+			&session.BudgetCutoff,
+			&session.Seed,
 		); err != nil {
 			return nil, fmt.Errorf("scan ensemble: %w", err)
 		}
@@ -400,7 +422,7 @@ func (s *EnsembleStore) DeleteEnsemble(sessionName string) error {
 func (s *EnsembleStore) fetchAssignments(ensembleID int64) ([]ModeAssignment, error) {
 	rows, err := s.store.db.Query(`
 		SELECT id, ensemble_id, mode_id, pane_name, agent_type, status, COALESCE(output_path, ''),
-		       assigned_at, completed_at, COALESCE(error, '')
+		       assigned_at, completed_at, COALESCE(error, ''), COALESCE(prompt, ''), rate_limit_retries
 		FROM mode_assignments
 		WHERE ensemble_id = ?
 		ORDER BY id`, ensembleID)
@@ -427,6 +449,8 @@ func (s *EnsembleStore) fetchAssignments(ensembleID int64) ([]ModeAssignment, er
 			&assignedAt,
 			&completedAt,
 			&assignment.Error,
+			&assignment.Prompt,
+			&assignment.RateLimitRetries,
 		); err != nil {
 			return nil, fmt.Errorf("scan assignment: %w", err)
 		}
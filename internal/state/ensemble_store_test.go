@@ -125,12 +125,14 @@ func TestEnsembleStore_SaveWithAssignments(t *testing.T) {
 				Status:    "pending",
 			},
 			{
-				ModeID:     "analytical",
-				PaneName:   "cod_1",
-				AgentType:  "cod",
-				Status:     "completed",
-				OutputPath: "/tmp/output.txt",
-				AssignedAt: now.Add(-5 * time.Minute),
+				ModeID:           "analytical",
+				PaneName:         "cod_1",
+				AgentType:        "cod",
+				Status:           "completed",
+				OutputPath:       "/tmp/output.txt",
+				AssignedAt:       now.Add(-5 * time.Minute),
+				Prompt:           "Analyze this code analytically.",
+				RateLimitRetries: 2,
 			},
 		},
 	}
@@ -154,6 +156,15 @@ func TestEnsembleStore_SaveWithAssignments(t *testing.T) {
 	if got.Assignments[0].ModeID != "creative" {
 		t.Errorf("assignment[0].ModeID: want %q, got %q", "creative", got.Assignments[0].ModeID)
 	}
+	if got.Assignments[0].Prompt != "" {
+		t.Errorf("assignment[0].Prompt: want empty, got %q", got.Assignments[0].Prompt)
+	}
+	if got.Assignments[1].Prompt != "Analyze this code analytically." {
+		t.Errorf("assignment[1].Prompt: got %q", got.Assignments[1].Prompt)
+	}
+	if got.Assignments[1].RateLimitRetries != 2 {
+		t.Errorf("assignment[1].RateLimitRetries: want 2, got %d", got.Assignments[1].RateLimitRetries)
+	}
 	if got.Assignments[0].PaneName != "cc_1" {
 		t.Errorf("assignment[0].PaneName: want %q, got %q", "cc_1", got.Assignments[0].PaneName)
 	}
@@ -199,6 +210,11 @@ func TestEnsembleStore_SaveUpdatesExisting(t *testing.T) {
 	session.Status = "synthesized"
 	session.SynthesizedAt = &synthTime
 	session.SynthesisOutput = "The answer is 42."
+	session.BudgetSpent = 12000
+	session.BudgetLimit = 50000
+	session.BudgetEstimate = 26000
+	session.BudgetCutoff = 30000
+	session.Seed = 424242
 	if err := es.SaveEnsemble(session); err != nil {
 		t.Fatalf("save update: %v", err)
 	}
@@ -219,6 +235,15 @@ func TestEnsembleStore_SaveUpdatesExisting(t *testing.T) {
 	if got.SynthesisOutput != "The answer is 42." {
 		t.Errorf("SynthesisOutput: want %q, got %q", "The answer is 42.", got.SynthesisOutput)
 	}
+	if got.BudgetSpent != 12000 || got.BudgetLimit != 50000 {
+		t.Errorf("Budget: want 12000/50000, got %d/%d", got.BudgetSpent, got.BudgetLimit)
+	}
+	if got.BudgetEstimate != 26000 || got.BudgetCutoff != 30000 {
+		t.Errorf("Budget cutoff: want 26000/30000, got %d/%d", got.BudgetEstimate, got.BudgetCutoff)
+	}
+	if got.Seed != 424242 {
+		t.Errorf("Seed: want 424242, got %d", got.Seed)
+	}
 }
 
 func TestEnsembleStore_SaveValidation(t *testing.T) {
@@ -0,0 +1,6 @@
+-- NTM State Store: Ensemble Budget Tracking
+-- Version: 016
+-- Description: Persists measured token spend and the strict budget limit
+
+ALTER TABLE ensemble_sessions ADD COLUMN budget_spent INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ensemble_sessions ADD COLUMN budget_limit INTEGER NOT NULL DEFAULT 0;
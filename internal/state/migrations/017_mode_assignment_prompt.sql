@@ -0,0 +1,5 @@
+-- NTM State Store: Mode Assignment Prompts
+-- Version: 017
+-- Description: Stores the exact prompt injected for each mode assignment
+
+ALTER TABLE mode_assignments ADD COLUMN prompt TEXT;
@@ -0,0 +1,5 @@
+-- NTM State Store: Mode Assignment Rate-Limit Retries
+-- Version: 018
+-- Description: Counts rate-limit re-injections per mode assignment
+
+ALTER TABLE mode_assignments ADD COLUMN rate_limit_retries INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+-- NTM State Store: Ensemble Assignment Seed
+-- Version: 019
+-- Description: Records the seed that shuffled mode-to-pane assignment
+
+ALTER TABLE ensemble_sessions ADD COLUMN seed INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,7 @@
+-- NTM State Store: Ensemble Budget Cutoff
+-- Version: 020
+-- Description: Records the estimated tokens injected at spawn and the budget
+-- at which remaining modes were skipped
+
+ALTER TABLE ensemble_sessions ADD COLUMN budget_estimate INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ensemble_sessions ADD COLUMN budget_cutoff INTEGER NOT NULL DEFAULT 0;
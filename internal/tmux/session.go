@@ -907,6 +907,33 @@ func (c *Client) GetPanesContext(ctx context.Context, session string) ([]Pane, e
 	return panes, nil
 }
 
+// GetPaneCurrentPathsContext returns each pane's working directory keyed by
+// pane ID. Panes for which tmux reports no path are omitted.
+func (c *Client) GetPaneCurrentPathsContext(ctx context.Context, session string) (map[string]string, error) {
+	sep := FieldSeparator
+	format := fmt.Sprintf("#{pane_id}%s#{pane_current_path}", sep)
+	output, err := c.RunContext(ctx, "list-panes", "-s", "-t", session, "-F", format)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		id, path, ok := strings.Cut(line, sep)
+		if !ok || id == "" || strings.TrimSpace(path) == "" {
+			continue
+		}
+		paths[id] = path
+	}
+	return paths, nil
+}
+
+// GetPaneCurrentPathsContext returns each pane's working directory keyed by
+// pane ID (default client).
+func GetPaneCurrentPathsContext(ctx context.Context, session string) (map[string]string, error) {
+	return DefaultClient.GetPaneCurrentPathsContext(ctx, session)
+}
+
 // GetPanes returns all panes in a session (default client)
 func GetPanes(session string) ([]Pane, error) {
 	return DefaultClient.GetPanes(session)
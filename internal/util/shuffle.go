@@ -0,0 +1,33 @@
+package util
+
+// SeededPermutation returns a Fisher-Yates permutation of [0..n) driven by an
+// xorshift64 generator seeded with seed, so a given seed yields the same order
+// across Go versions and platforms. Callers that want a random order pick the
+// seed themselves (e.g. from the clock) and report it for reproducibility.
+func SeededPermutation(n int, seed int64) []int {
+	if n < 0 {
+		n = 0
+	}
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+
+	// xorshift64 never leaves the zero state, so substitute a fixed constant.
+	x := uint64(seed)
+	if x == 0 {
+		x = 0x9e3779b97f4a7c15
+	}
+	next := func() uint64 {
+		x ^= x << 13
+		x ^= x >> 7
+		x ^= x << 17
+		return x
+	}
+
+	for i := n - 1; i > 0; i-- {
+		j := int(next() % uint64(i+1))
+		perm[i], perm[j] = perm[j], perm[i]
+	}
+	return perm
+}